@@ -0,0 +1,86 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// currentCurator names the person running add: git's user.name, falling
+// back to the login name.
+func currentCurator() string {
+	if out, err := exec.Command("git", "config", "user.name").Output(); err == nil {
+		if name := strings.TrimSpace(string(out)); name != "" {
+			return name
+		}
+	}
+	return os.Getenv("USER")
+}
+
+func runAdd(args []string) int {
+	fs := flag.NewFlagSet("add", flag.ExitOnError)
+	comments := fs.String("comments", "", "geographical focus of the feeds, e.g. \"Kerala, India\" or global")
+	language := fs.String("language", "en", "ISO 639-1 language of the feeds")
+	tier := fs.String("tier", "", "validation tier (tier1, tier2 or tier3)")
+	addedBy := fs.String("by", currentCurator(), "who is adding the feeds")
+	source := fs.String("source", "", "where the suggestion came from, e.g. an issue or discussion URL")
+	force := fs.Bool("force", false, "add feeds that fail validation (duplicates are never added)")
+	datasetPath := fs.String("dataset", "feeds.csv", "dataset file or directory to add the feeds to")
+	noHeader := fs.Bool("no-header", false, "dataset has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s add --comments FOCUS [--language en] [--source URL] FEED_URL...\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	dataset, err := loadDataset(*datasetPath, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", *datasetPath, err)
+		return 1
+	}
+
+	var suggested []suggestedFeed
+	for _, u := range positional {
+		suggested = append(suggested, suggestedFeed{URL: u})
+	}
+	candidates := checkSuggestions(suggested, *comments, *language, dataset)
+	printCandidates(candidates)
+
+	var feeds []Feed
+	for _, c := range candidates {
+		if c.Duplicate != "" || (!c.accepted() && !*force) {
+			continue
+		}
+		for _, note := range c.Notes {
+			fmt.Fprintf(os.Stderr, "  note: %s\n", note)
+		}
+		c.Feed.AddedBy, c.Feed.Source = *addedBy, *source
+		if *tier != "" {
+			c.Feed.Tier = validator.ParseTier(*tier)
+		}
+		feeds = append(feeds, c.Feed)
+	}
+	if len(feeds) == 0 {
+		fmt.Fprintln(os.Stderr, "No feeds added")
+		return 1
+	}
+
+	if err := addToDataset(*datasetPath, !*noHeader, feeds); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", *datasetPath, err)
+		return 1
+	}
+	fmt.Printf("Added %d feeds to %s\n", len(feeds), *datasetPath)
+	return 0
+}
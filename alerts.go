@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// alertRule is an SLO-style rule from the --alerts file. It applies to the
+// feeds of Tier and Country (all feeds if unset) and sets exactly one limit:
+//
+//   - MaxFailedRuns: no feed may be invalid for more than this many
+//     consecutive runs.
+//   - MinHealthy: no country may have fewer than this many feeds whose last
+//     definite result was valid.
+type alertRule struct {
+	Name          string `json:"name"`
+	Tier          int    `json:"tier,omitempty"`
+	Country       string `json:"country,omitempty"`
+	MaxFailedRuns *int   `json:"max_failed_runs,omitempty"`
+	MinHealthy    *int   `json:"min_healthy_per_country,omitempty"`
+}
+
+// alertRules are the rules a run is checked against.
+type alertRules []alertRule
+
+// alertViolation is a rule broken by a feed or a country.
+type alertViolation struct {
+	Rule    string `json:"rule"`
+	FeedID  string `json:"feed_id,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Country string `json:"country,omitempty"`
+	Message string `json:"message"`
+}
+
+func (v alertViolation) String() string {
+	return fmt.Sprintf("[Alert] %s: %s", v.Rule, v.Message)
+}
+
+// loadAlertRules reads a JSON file of the form {"rules": [...]}.
+func loadAlertRules(path string) (alertRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Rules alertRules `json:"rules"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for i := range file.Rules {
+		r := &file.Rules[i]
+		if r.Name == "" {
+			return nil, fmt.Errorf("%s: rule %d has no name", path, i+1)
+		}
+		if (r.MaxFailedRuns == nil) == (r.MinHealthy == nil) {
+			return nil, fmt.Errorf("%s: rule %q must set one of max_failed_runs and min_healthy_per_country", path, r.Name)
+		}
+		if r.Tier != 0 {
+			if _, ok := validator.TierPolicies[r.Tier]; !ok {
+				return nil, fmt.Errorf("%s: rule %q: unknown tier %d", path, r.Name, r.Tier)
+			}
+		}
+		if r.Country != "" {
+			c, ok := lookupCountry(r.Country)
+			if !ok {
+				return nil, fmt.Errorf("%s: rule %q: unknown country %q", path, r.Name, r.Country)
+			}
+			r.Country = c.Name
+		}
+	}
+	return file.Rules, nil
+}
+
+func (r alertRule) applies(feed Feed) bool {
+	return (r.Tier == 0 || feed.Tier == r.Tier) && (r.Country == "" || feedCountry(feed) == r.Country)
+}
+
+// check evaluates every rule against the dataset's state after a run.
+// Feeds not yet validated count as neither failing nor healthy.
+func (rules alertRules) check(feeds []Feed, state *validatorState) []alertViolation {
+	var violations []alertViolation
+	for _, rule := range rules {
+		healthy := make(map[string]int)
+		for _, feed := range feeds {
+			if !rule.applies(feed) {
+				continue
+			}
+			fs := state.Feeds[feed.URL]
+			country := feedCountry(feed)
+			if _, ok := healthy[country]; !ok {
+				healthy[country] = 0
+			}
+			if fs == nil {
+				continue
+			}
+			if fs.LastStatus == "valid" {
+				healthy[country]++
+			}
+			if rule.MaxFailedRuns != nil && fs.FailedRuns > *rule.MaxFailedRuns {
+				violations = append(violations, alertViolation{
+					Rule: rule.Name, FeedID: feed.ID, URL: feed.URL, Country: country,
+					Message: fmt.Sprintf("%s has been invalid for %d consecutive runs (limit %d)", feed.URL, fs.FailedRuns, *rule.MaxFailedRuns),
+				})
+			}
+		}
+		if rule.MinHealthy == nil {
+			continue
+		}
+		countries := make([]string, 0, len(healthy))
+		for country := range healthy {
+			if country != "" {
+				countries = append(countries, country)
+			}
+		}
+		sort.Strings(countries)
+		for _, country := range countries {
+			if n := healthy[country]; n < *rule.MinHealthy {
+				violations = append(violations, alertViolation{
+					Rule: rule.Name, Country: country,
+					Message: fmt.Sprintf("%s has %d healthy feeds (minimum %d)", country, n, *rule.MinHealthy),
+				})
+			}
+		}
+	}
+	return violations
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// anomalyBaselineRuns is how many recent runs a run's failure rates are
+	// compared with, and anomalyMinRuns how many are needed to compare.
+	anomalyBaselineRuns = 14
+	anomalyMinRuns      = 5
+	// A failure rate is anomalous when it is at least anomalyFactor times
+	// its baseline and anomalyMinIncrease percentage points above it.
+	anomalyFactor      = 3
+	anomalyMinIncrease = 15
+)
+
+// runAnomaly is a run-level failure rate far above its recent baseline,
+// which usually means the runner or its network was at fault rather than
+// the feeds.
+type runAnomaly struct {
+	Status   string
+	Rate     float64
+	Baseline float64
+}
+
+func (a runAnomaly) String() string {
+	return fmt.Sprintf("[Anomaly] %s results %.1f%% of the run, against a median of %.1f%% over recent runs", a.Status, a.Rate, a.Baseline)
+}
+
+// alert presents the anomaly as an alert, so it reaches the configured
+// notifiers.
+func (a runAnomaly) alert() alertViolation {
+	return alertViolation{Rule: "anomaly", Message: fmt.Sprintf("%s results were %.1f%% of the run against a median of %.1f%%; failures were not recorded against feeds", a.Status, a.Rate, a.Baseline)}
+}
+
+func failureRate(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(n) / float64(total)
+}
+
+// runAnomalies compares the invalid and transient rates of results with
+// their medians over the most recent runs in history, which must not yet
+// include this run.
+func runAnomalies(h historyStore, results []ValidationResult) ([]runAnomaly, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+	recent, err := h.recentRuns(anomalyBaselineRuns)
+	if err != nil {
+		return nil, err
+	}
+	if len(recent) < anomalyMinRuns {
+		return nil, nil
+	}
+	counts := make(map[string]int)
+	for _, r := range results {
+		counts[string(r.Status)]++
+	}
+	var anomalies []runAnomaly
+	for _, status := range []string{"invalid", "transient"} {
+		rates := make([]float64, len(recent))
+		for i, o := range recent {
+			n := o.Invalid
+			if status == "transient" {
+				n = o.Transient
+			}
+			rates[i] = failureRate(n, o.Valid+o.Invalid+o.Transient)
+		}
+		sort.Float64s(rates)
+		baseline := rates[len(rates)/2]
+		rate := failureRate(counts[status], len(results))
+		if rate >= anomalyFactor*baseline && rate-baseline >= anomalyMinIncrease {
+			anomalies = append(anomalies, runAnomaly{Status: status, Rate: rate, Baseline: baseline})
+		}
+	}
+	return anomalies, nil
+}
+
+// checkAnomalies runs runAnomalies against the history database at path.
+func checkAnomalies(path string, results []ValidationResult) ([]runAnomaly, error) {
+	h, err := openHistory(path)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Close()
+	return runAnomalies(h, results)
+}
+
+// validOnly returns the valid results. After an anomalous run only these
+// are recorded against feeds, so a runner fault doesn't mark healthy feeds
+// as died, count towards their failed runs or open issues for them.
+func validOnly(results []ValidationResult) []ValidationResult {
+	var valid []ValidationResult
+	for _, r := range results {
+		if r.Status == "valid" {
+			valid = append(valid, r)
+		}
+	}
+	return valid
+}
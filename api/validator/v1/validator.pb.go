@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/validator/v1/validator.proto
+
+// Package feedvalidator.v1 is the gRPC interface of serve --grpc-addr, for
+// services that want to validate feeds or check their health without
+// running the validator themselves.
+
+package validatorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_VALID       Status = 1
+	Status_STATUS_INVALID     Status = 2
+	// A network error or server failure that may clear up on its own.
+	Status_STATUS_TRANSIENT Status = 3
+	// Not validated yet.
+	Status_STATUS_UNKNOWN Status = 4
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_VALID",
+		2: "STATUS_INVALID",
+		3: "STATUS_TRANSIENT",
+		4: "STATUS_UNKNOWN",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_VALID":       1,
+		"STATUS_INVALID":     2,
+		"STATUS_TRANSIENT":   3,
+		"STATUS_UNKNOWN":     4,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_validator_v1_validator_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_api_validator_v1_validator_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_validator_v1_validator_proto_rawDescGZIP(), []int{0}
+}
+
+type ValidateFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Tier (1-3) sets validation depth as for dataset rows: 1 samples item
+	// links, 2 parses the feed and 3 only checks it is reachable. Defaults to
+	// the dataset's default tier.
+	Tier          int32 `protobuf:"varint,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateFeedRequest) Reset() {
+	*x = ValidateFeedRequest{}
+	mi := &file_api_validator_v1_validator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFeedRequest) ProtoMessage() {}
+
+func (x *ValidateFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_v1_validator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFeedRequest.ProtoReflect.Descriptor instead.
+func (*ValidateFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_validator_v1_validator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidateFeedRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ValidateFeedRequest) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+type ValidateBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feeds         []*ValidateFeedRequest `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateBatchRequest) Reset() {
+	*x = ValidateBatchRequest{}
+	mi := &file_api_validator_v1_validator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateBatchRequest) ProtoMessage() {}
+
+func (x *ValidateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_v1_validator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateBatchRequest.ProtoReflect.Descriptor instead.
+func (*ValidateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_validator_v1_validator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidateBatchRequest) GetFeeds() []*ValidateFeedRequest {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+type ValidationResult struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Url        string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Status     Status                 `protobuf:"varint,2,opt,name=status,proto3,enum=feedvalidator.v1.Status" json:"status,omitempty"`
+	Message    string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ItemCount  int32                  `protobuf:"varint,4,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	LastUpdate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	// License hint from the feed's copyright element or the publisher's
+	// known terms.
+	License          string `protobuf:"bytes,6,opt,name=license,proto3" json:"license,omitempty"`
+	NoRedistribution bool   `protobuf:"varint,7,opt,name=no_redistribution,json=noRedistribution,proto3" json:"no_redistribution,omitempty"`
+	// Paywall or registration restriction seen on this fetch, if any.
+	Access string `protobuf:"bytes,8,opt,name=access,proto3" json:"access,omitempty"`
+	// Set when only reachability was checked.
+	ProbeOnly     bool  `protobuf:"varint,9,opt,name=probe_only,json=probeOnly,proto3" json:"probe_only,omitempty"`
+	LatencyMs     int64 `protobuf:"varint,10,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	TtlMinutes    int32 `protobuf:"varint,11,opt,name=ttl_minutes,json=ttlMinutes,proto3" json:"ttl_minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationResult) Reset() {
+	*x = ValidationResult{}
+	mi := &file_api_validator_v1_validator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationResult) ProtoMessage() {}
+
+func (x *ValidationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_v1_validator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationResult.ProtoReflect.Descriptor instead.
+func (*ValidationResult) Descriptor() ([]byte, []int) {
+	return file_api_validator_v1_validator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidationResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ValidationResult) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *ValidationResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidationResult) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *ValidationResult) GetLastUpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return nil
+}
+
+func (x *ValidationResult) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *ValidationResult) GetNoRedistribution() bool {
+	if x != nil {
+		return x.NoRedistribution
+	}
+	return false
+}
+
+func (x *ValidationResult) GetAccess() string {
+	if x != nil {
+		return x.Access
+	}
+	return ""
+}
+
+func (x *ValidationResult) GetProbeOnly() bool {
+	if x != nil {
+		return x.ProbeOnly
+	}
+	return false
+}
+
+func (x *ValidationResult) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ValidationResult) GetTtlMinutes() int32 {
+	if x != nil {
+		return x.TtlMinutes
+	}
+	return 0
+}
+
+type GetHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Feed IDs or URLs to report on; all feeds if empty.
+	Feeds []string `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	// Only report feeds covering this country (ISO code or name).
+	Country       string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHealthRequest) Reset() {
+	*x = GetHealthRequest{}
+	mi := &file_api_validator_v1_validator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthRequest) ProtoMessage() {}
+
+func (x *GetHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_v1_validator_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthRequest) Descriptor() ([]byte, []int) {
+	return file_api_validator_v1_validator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetHealthRequest) GetFeeds() []string {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+func (x *GetHealthRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type FeedHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Tier  int32                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	// ISO 3166-1 alpha-2 code, when the dataset names a country.
+	Country string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	// The last definite status; transient errors don't change it.
+	Status        Status                 `protobuf:"varint,5,opt,name=status,proto3,enum=feedvalidator.v1.Status" json:"status,omitempty"`
+	Flapping      bool                   `protobuf:"varint,6,opt,name=flapping,proto3" json:"flapping,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,8,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	LastUpdate    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	LastValidated *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_validated,json=lastValidated,proto3" json:"last_validated,omitempty"`
+	NextDue       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_due,json=nextDue,proto3" json:"next_due,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedHealth) Reset() {
+	*x = FeedHealth{}
+	mi := &file_api_validator_v1_validator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedHealth) ProtoMessage() {}
+
+func (x *FeedHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_v1_validator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedHealth.ProtoReflect.Descriptor instead.
+func (*FeedHealth) Descriptor() ([]byte, []int) {
+	return file_api_validator_v1_validator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FeedHealth) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeedHealth) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FeedHealth) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *FeedHealth) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *FeedHealth) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *FeedHealth) GetFlapping() bool {
+	if x != nil {
+		return x.Flapping
+	}
+	return false
+}
+
+func (x *FeedHealth) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FeedHealth) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *FeedHealth) GetLastUpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return nil
+}
+
+func (x *FeedHealth) GetLastValidated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastValidated
+	}
+	return nil
+}
+
+func (x *FeedHealth) GetNextDue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDue
+	}
+	return nil
+}
+
+type GetHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feeds         []*FeedHealth          `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHealthResponse) Reset() {
+	*x = GetHealthResponse{}
+	mi := &file_api_validator_v1_validator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthResponse) ProtoMessage() {}
+
+func (x *GetHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_v1_validator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetHealthResponse) Descriptor() ([]byte, []int) {
+	return file_api_validator_v1_validator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetHealthResponse) GetFeeds() []*FeedHealth {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+var File_api_validator_v1_validator_proto protoreflect.FileDescriptor
+
+const file_api_validator_v1_validator_proto_rawDesc = "" +
+	"\n" +
+	" api/validator/v1/validator.proto\x12\x10feedvalidator.v1\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\x13ValidateFeedRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04tier\x18\x02 \x01(\x05R\x04tier\"S\n" +
+	"\x14ValidateBatchRequest\x12;\n" +
+	"\x05feeds\x18\x01 \x03(\v2%.feedvalidator.v1.ValidateFeedRequestR\x05feeds\"\x8a\x03\n" +
+	"\x10ValidationResult\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.feedvalidator.v1.StatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x04 \x01(\x05R\titemCount\x12;\n" +
+	"\vlast_update\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUpdate\x12\x18\n" +
+	"\alicense\x18\x06 \x01(\tR\alicense\x12+\n" +
+	"\x11no_redistribution\x18\a \x01(\bR\x10noRedistribution\x12\x16\n" +
+	"\x06access\x18\b \x01(\tR\x06access\x12\x1d\n" +
+	"\n" +
+	"probe_only\x18\t \x01(\bR\tprobeOnly\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\n" +
+	" \x01(\x03R\tlatencyMs\x12\x1f\n" +
+	"\vttl_minutes\x18\v \x01(\x05R\n" +
+	"ttlMinutes\"B\n" +
+	"\x10GetHealthRequest\x12\x14\n" +
+	"\x05feeds\x18\x01 \x03(\tR\x05feeds\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\"\x9a\x03\n" +
+	"\n" +
+	"FeedHealth\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\x05R\x04tier\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x120\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x18.feedvalidator.v1.StatusR\x06status\x12\x1a\n" +
+	"\bflapping\x18\x06 \x01(\bR\bflapping\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\b \x01(\x05R\titemCount\x12;\n" +
+	"\vlast_update\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUpdate\x12A\n" +
+	"\x0elast_validated\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rlastValidated\x125\n" +
+	"\bnext_due\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\anextDue\"G\n" +
+	"\x11GetHealthResponse\x122\n" +
+	"\x05feeds\x18\x01 \x03(\v2\x1c.feedvalidator.v1.FeedHealthR\x05feeds*p\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fSTATUS_VALID\x10\x01\x12\x12\n" +
+	"\x0eSTATUS_INVALID\x10\x02\x12\x14\n" +
+	"\x10STATUS_TRANSIENT\x10\x03\x12\x12\n" +
+	"\x0eSTATUS_UNKNOWN\x10\x042\x9f\x02\n" +
+	"\rFeedValidator\x12Y\n" +
+	"\fValidateFeed\x12%.feedvalidator.v1.ValidateFeedRequest\x1a\".feedvalidator.v1.ValidationResult\x12]\n" +
+	"\rValidateBatch\x12&.feedvalidator.v1.ValidateBatchRequest\x1a\".feedvalidator.v1.ValidationResult0\x01\x12T\n" +
+	"\tGetHealth\x12\".feedvalidator.v1.GetHealthRequest\x1a#.feedvalidator.v1.GetHealthResponseBIZGgithub.com/reddot-watch/curated-world-news/api/validator/v1;validatorv1b\x06proto3"
+
+var (
+	file_api_validator_v1_validator_proto_rawDescOnce sync.Once
+	file_api_validator_v1_validator_proto_rawDescData []byte
+)
+
+func file_api_validator_v1_validator_proto_rawDescGZIP() []byte {
+	file_api_validator_v1_validator_proto_rawDescOnce.Do(func() {
+		file_api_validator_v1_validator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_validator_v1_validator_proto_rawDesc), len(file_api_validator_v1_validator_proto_rawDesc)))
+	})
+	return file_api_validator_v1_validator_proto_rawDescData
+}
+
+var file_api_validator_v1_validator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_validator_v1_validator_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_validator_v1_validator_proto_goTypes = []any{
+	(Status)(0),                   // 0: feedvalidator.v1.Status
+	(*ValidateFeedRequest)(nil),   // 1: feedvalidator.v1.ValidateFeedRequest
+	(*ValidateBatchRequest)(nil),  // 2: feedvalidator.v1.ValidateBatchRequest
+	(*ValidationResult)(nil),      // 3: feedvalidator.v1.ValidationResult
+	(*GetHealthRequest)(nil),      // 4: feedvalidator.v1.GetHealthRequest
+	(*FeedHealth)(nil),            // 5: feedvalidator.v1.FeedHealth
+	(*GetHealthResponse)(nil),     // 6: feedvalidator.v1.GetHealthResponse
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_api_validator_v1_validator_proto_depIdxs = []int32{
+	1,  // 0: feedvalidator.v1.ValidateBatchRequest.feeds:type_name -> feedvalidator.v1.ValidateFeedRequest
+	0,  // 1: feedvalidator.v1.ValidationResult.status:type_name -> feedvalidator.v1.Status
+	7,  // 2: feedvalidator.v1.ValidationResult.last_update:type_name -> google.protobuf.Timestamp
+	0,  // 3: feedvalidator.v1.FeedHealth.status:type_name -> feedvalidator.v1.Status
+	7,  // 4: feedvalidator.v1.FeedHealth.last_update:type_name -> google.protobuf.Timestamp
+	7,  // 5: feedvalidator.v1.FeedHealth.last_validated:type_name -> google.protobuf.Timestamp
+	7,  // 6: feedvalidator.v1.FeedHealth.next_due:type_name -> google.protobuf.Timestamp
+	5,  // 7: feedvalidator.v1.GetHealthResponse.feeds:type_name -> feedvalidator.v1.FeedHealth
+	1,  // 8: feedvalidator.v1.FeedValidator.ValidateFeed:input_type -> feedvalidator.v1.ValidateFeedRequest
+	2,  // 9: feedvalidator.v1.FeedValidator.ValidateBatch:input_type -> feedvalidator.v1.ValidateBatchRequest
+	4,  // 10: feedvalidator.v1.FeedValidator.GetHealth:input_type -> feedvalidator.v1.GetHealthRequest
+	3,  // 11: feedvalidator.v1.FeedValidator.ValidateFeed:output_type -> feedvalidator.v1.ValidationResult
+	3,  // 12: feedvalidator.v1.FeedValidator.ValidateBatch:output_type -> feedvalidator.v1.ValidationResult
+	6,  // 13: feedvalidator.v1.FeedValidator.GetHealth:output_type -> feedvalidator.v1.GetHealthResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_validator_v1_validator_proto_init() }
+func file_api_validator_v1_validator_proto_init() {
+	if File_api_validator_v1_validator_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_validator_v1_validator_proto_rawDesc), len(file_api_validator_v1_validator_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_validator_v1_validator_proto_goTypes,
+		DependencyIndexes: file_api_validator_v1_validator_proto_depIdxs,
+		EnumInfos:         file_api_validator_v1_validator_proto_enumTypes,
+		MessageInfos:      file_api_validator_v1_validator_proto_msgTypes,
+	}.Build()
+	File_api_validator_v1_validator_proto = out.File
+	file_api_validator_v1_validator_proto_goTypes = nil
+	file_api_validator_v1_validator_proto_depIdxs = nil
+}
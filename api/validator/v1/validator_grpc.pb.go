@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/validator/v1/validator.proto
+
+// Package feedvalidator.v1 is the gRPC interface of serve --grpc-addr, for
+// services that want to validate feeds or check their health without
+// running the validator themselves.
+
+package validatorv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FeedValidator_ValidateFeed_FullMethodName  = "/feedvalidator.v1.FeedValidator/ValidateFeed"
+	FeedValidator_ValidateBatch_FullMethodName = "/feedvalidator.v1.FeedValidator/ValidateBatch"
+	FeedValidator_GetHealth_FullMethodName     = "/feedvalidator.v1.FeedValidator/GetHealth"
+)
+
+// FeedValidatorClient is the client API for FeedValidator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FeedValidatorClient interface {
+	// ValidateFeed fetches and validates one feed now.
+	ValidateFeed(ctx context.Context, in *ValidateFeedRequest, opts ...grpc.CallOption) (*ValidationResult, error)
+	// ValidateBatch validates feeds concurrently, streaming each result as it
+	// completes; results do not arrive in request order.
+	ValidateBatch(ctx context.Context, in *ValidateBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ValidationResult], error)
+	// GetHealth returns the daemon's current view of dataset feeds, without
+	// validating them.
+	GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*GetHealthResponse, error)
+}
+
+type feedValidatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeedValidatorClient(cc grpc.ClientConnInterface) FeedValidatorClient {
+	return &feedValidatorClient{cc}
+}
+
+func (c *feedValidatorClient) ValidateFeed(ctx context.Context, in *ValidateFeedRequest, opts ...grpc.CallOption) (*ValidationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidationResult)
+	err := c.cc.Invoke(ctx, FeedValidator_ValidateFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedValidatorClient) ValidateBatch(ctx context.Context, in *ValidateBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ValidationResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FeedValidator_ServiceDesc.Streams[0], FeedValidator_ValidateBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ValidateBatchRequest, ValidationResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FeedValidator_ValidateBatchClient = grpc.ServerStreamingClient[ValidationResult]
+
+func (c *feedValidatorClient) GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*GetHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHealthResponse)
+	err := c.cc.Invoke(ctx, FeedValidator_GetHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeedValidatorServer is the server API for FeedValidator service.
+// All implementations must embed UnimplementedFeedValidatorServer
+// for forward compatibility.
+type FeedValidatorServer interface {
+	// ValidateFeed fetches and validates one feed now.
+	ValidateFeed(context.Context, *ValidateFeedRequest) (*ValidationResult, error)
+	// ValidateBatch validates feeds concurrently, streaming each result as it
+	// completes; results do not arrive in request order.
+	ValidateBatch(*ValidateBatchRequest, grpc.ServerStreamingServer[ValidationResult]) error
+	// GetHealth returns the daemon's current view of dataset feeds, without
+	// validating them.
+	GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error)
+	mustEmbedUnimplementedFeedValidatorServer()
+}
+
+// UnimplementedFeedValidatorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeedValidatorServer struct{}
+
+func (UnimplementedFeedValidatorServer) ValidateFeed(context.Context, *ValidateFeedRequest) (*ValidationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateFeed not implemented")
+}
+func (UnimplementedFeedValidatorServer) ValidateBatch(*ValidateBatchRequest, grpc.ServerStreamingServer[ValidationResult]) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateBatch not implemented")
+}
+func (UnimplementedFeedValidatorServer) GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
+}
+func (UnimplementedFeedValidatorServer) mustEmbedUnimplementedFeedValidatorServer() {}
+func (UnimplementedFeedValidatorServer) testEmbeddedByValue()                       {}
+
+// UnsafeFeedValidatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeedValidatorServer will
+// result in compilation errors.
+type UnsafeFeedValidatorServer interface {
+	mustEmbedUnimplementedFeedValidatorServer()
+}
+
+func RegisterFeedValidatorServer(s grpc.ServiceRegistrar, srv FeedValidatorServer) {
+	// If the following call pancis, it indicates UnimplementedFeedValidatorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeedValidator_ServiceDesc, srv)
+}
+
+func _FeedValidator_ValidateFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedValidatorServer).ValidateFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedValidator_ValidateFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedValidatorServer).ValidateFeed(ctx, req.(*ValidateFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedValidator_ValidateBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidateBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FeedValidatorServer).ValidateBatch(m, &grpc.GenericServerStream[ValidateBatchRequest, ValidationResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FeedValidator_ValidateBatchServer = grpc.ServerStreamingServer[ValidationResult]
+
+func _FeedValidator_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedValidatorServer).GetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedValidator_GetHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedValidatorServer).GetHealth(ctx, req.(*GetHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeedValidator_ServiceDesc is the grpc.ServiceDesc for FeedValidator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeedValidator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "feedvalidator.v1.FeedValidator",
+	HandlerType: (*FeedValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateFeed",
+			Handler:    _FeedValidator_ValidateFeed_Handler,
+		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _FeedValidator_GetHealth_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidateBatch",
+			Handler:       _FeedValidator_ValidateBatch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/validator/v1/validator.proto",
+}
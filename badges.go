@@ -0,0 +1,174 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// shieldsBadge is a Shields.io endpoint badge:
+// https://shields.io/badges/endpoint-badge
+type shieldsBadge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// healthBadge describes valid of definite (valid or invalid) feeds.
+func healthBadge(label string, valid, definite int, message string) shieldsBadge {
+	b := shieldsBadge{SchemaVersion: 1, Label: label, Message: message, Color: "lightgrey"}
+	p := percent(valid, definite)
+	switch {
+	case p == nil:
+		b.Message = "unknown"
+	case *p >= 95:
+		b.Color = "brightgreen"
+	case *p >= 80:
+		b.Color = "green"
+	case *p >= 60:
+		b.Color = "yellow"
+	case *p >= 40:
+		b.Color = "orange"
+	default:
+		b.Color = "red"
+	}
+	return b
+}
+
+type badgeTally struct{ valid, definite int }
+
+func (t *badgeTally) add(status string) {
+	if status == "valid" || status == "invalid" {
+		t.definite++
+	}
+	if status == "valid" {
+		t.valid++
+	}
+}
+
+// datasetBadges returns the overall badge, showing the percentage of feeds
+// valid, and a badge per alpha-2 country code showing its count of valid
+// feeds. status gives a feed's last definite status, or "" if it has none.
+func datasetBadges(feeds []Feed, status func(Feed) string) (shieldsBadge, map[string]shieldsBadge) {
+	var all badgeTally
+	countries := make(map[string]*badgeTally)
+	for _, feed := range feeds {
+		s := status(feed)
+		all.add(s)
+		if c, ok := countryFromComments(feed.Comments); ok {
+			if countries[c.Alpha2] == nil {
+				countries[c.Alpha2] = &badgeTally{}
+			}
+			countries[c.Alpha2].add(s)
+		}
+	}
+
+	overall := healthBadge("feeds valid", all.valid, all.definite, formatPercent(percent(all.valid, all.definite))+"%")
+	badges := make(map[string]shieldsBadge, len(countries))
+	for code, t := range countries {
+		badges[code] = healthBadge(code+" feeds", t.valid, t.definite, fmt.Sprintf("%d/%d valid", t.valid, t.definite))
+	}
+	return overall, badges
+}
+
+// lastStatuses returns each feed's last definite status: from state when
+// there is one, otherwise from this run's results for feeds.
+func lastStatuses(state *validatorState, feeds []Feed, results []ValidationResult) func(Feed) string {
+	if state != nil {
+		return func(feed Feed) string {
+			if fs, ok := state.Feeds[feed.URL]; ok {
+				return fs.LastStatus
+			}
+			return ""
+		}
+	}
+	rows := indexRows(feeds)
+	statuses := make(map[string]string, len(results))
+	for _, r := range results {
+		if feed, ok := rows.of(r); ok && r.Status != "transient" {
+			statuses[feed.URL] = string(r.Status)
+		}
+	}
+	return func(feed Feed) string { return statuses[feed.URL] }
+}
+
+// badgeList is the file in a --badges directory listing the country badges
+// written to it, so the next run can remove those of countries that no
+// longer have feeds without touching other files, such as the pages of
+// country-dashboard.
+const badgeList = ".badges"
+
+// writeBadges writes feeds.json and countries/XX.json to dir, removing the
+// badges it wrote before for countries that no longer have feeds.
+func writeBadges(dir string, feeds []Feed, status func(Feed) string) error {
+	overall, countries := datasetBadges(feeds, status)
+	countryDir := filepath.Join(dir, "countries")
+	if err := os.MkdirAll(countryDir, 0o755); err != nil {
+		return err
+	}
+	write := func(path string, b shieldsBadge) error {
+		data, err := json.Marshal(b)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, append(data, '\n'), 0o644)
+	}
+	if err := write(filepath.Join(dir, "feeds.json"), overall); err != nil {
+		return err
+	}
+	codes := make([]string, 0, len(countries))
+	for code := range countries {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	written := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		name := "countries/" + strings.ToLower(code) + ".json"
+		if err := write(filepath.Join(dir, filepath.FromSlash(name)), countries[code]); err != nil {
+			return err
+		}
+		written[name] = true
+	}
+	if previous, err := os.ReadFile(filepath.Join(dir, badgeList)); err == nil {
+		for _, name := range strings.Fields(string(previous)) {
+			// Only names of the form this function writes are removed.
+			if written[name] || path.Dir(name) != "countries" || !strings.HasSuffix(name, ".json") {
+				continue
+			}
+			if err := os.Remove(filepath.Join(dir, filepath.FromSlash(name))); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	var list strings.Builder
+	for _, code := range codes {
+		list.WriteString("countries/" + strings.ToLower(code) + ".json\n")
+	}
+	return os.WriteFile(filepath.Join(dir, badgeList), []byte(list.String()), 0o644)
+}
+
+// handleBadge serves /badges/feeds.json and /badges/countries/XX.json, where
+// XX is an ISO alpha-2 or alpha-3 code.
+func (d *daemon) handleBadge(w http.ResponseWriter, r *http.Request) {
+	d.mu.RLock()
+	overall, countries := datasetBadges(d.feeds, lastStatuses(d.state, nil, nil))
+	d.mu.RUnlock()
+	file := r.PathValue("file")
+	if file == "" {
+		writeJSON(w, overall)
+		return
+	}
+	c, ok := lookupCountry(strings.TrimSuffix(file, ".json"))
+	b, found := countries[c.Alpha2]
+	if !ok || !found {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, b)
+}
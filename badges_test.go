@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteBadges(t *testing.T) {
+	dir := t.TempDir()
+	status := func(Feed) string { return "valid" }
+	if err := writeBadges(dir, []Feed{{URL: "https://a.example/rss", Comments: "Ghana"}, {URL: "https://b.example/rss", Comments: "Kenya"}}, status); err != nil {
+		t.Fatal(err)
+	}
+	// Another tool's files share the directory.
+	dashboard := filepath.Join(dir, "countries", "ke.html")
+	if err := os.WriteFile(dashboard, []byte("<html>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Kenya no longer has feeds: its badge goes, the rest stays.
+	if err := writeBadges(dir, []Feed{{URL: "https://a.example/rss", Comments: "Ghana"}}, status); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]bool{"feeds.json": true, "countries/gh.json": true, "countries/ke.json": false, "countries/ke.html": true} {
+		_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name)))
+		if exists := err == nil; exists != want {
+			t.Errorf("%s exists: %v, want %v", name, exists, want)
+		}
+	}
+}
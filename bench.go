@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// benchFetch is one timed fetch of a feed by bench.
+type benchFetch struct {
+	Feed Feed
+	// TotalMS is until the body was read, and FirstByteMS until the
+	// response started; both are 0 for fetches that failed.
+	TotalMS     int64
+	FirstByteMS int64
+	Err         error
+}
+
+// benchStats summarizes the fetches of a host or region.
+type benchStats struct {
+	Key     string
+	Fetches int
+	Errors  int
+	// P50, P95 and Max are of the successful fetches' total times;
+	// FirstByteP50 of their times to first byte.
+	P50          int64
+	P95          int64
+	Max          int64
+	FirstByteP50 int64
+}
+
+// timeFetch fetches url once, reading and discarding the body, and times it.
+func timeFetch(ctx context.Context, client *http.Client, url, userAgent string) (total, firstByte time.Duration, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", validator.RequestURL(url), nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	start := time.Now()
+	req = req.WithContext(httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotFirstResponseByte: func() { firstByte = time.Since(start) },
+	}))
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(io.Discard, io.LimitReader(resp.Body, validator.DefaultMaxBodySize)); err != nil {
+		return 0, 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("HTTP status %d", resp.StatusCode)
+	}
+	return time.Since(start), firstByte, nil
+}
+
+// runBenchRounds fetches each of feeds rounds times, concurrency at a time,
+// with a new connection for each fetch as validation runs have, pausing
+// between rounds.
+func runBenchRounds(feeds []Feed, rounds, concurrency int, timeout time.Duration, userAgent string, pause time.Duration) []benchFetch {
+	client := validator.NewHTTPClient()
+	if t, ok := client.Transport.(*http.Transport); ok {
+		t.DisableKeepAlives = true
+	}
+
+	var fetches []benchFetch
+	var mu sync.Mutex
+	for round := 1; round <= rounds; round++ {
+		if round > 1 {
+			time.Sleep(pause)
+		}
+		jobs := make(chan Feed)
+		var wg sync.WaitGroup
+		for range max(concurrency, 1) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for feed := range jobs {
+					ctx, cancel := context.WithTimeout(context.Background(), timeout)
+					total, firstByte, err := timeFetch(ctx, client, feed.URL, userAgent)
+					cancel()
+					mu.Lock()
+					fetches = append(fetches, benchFetch{Feed: feed, TotalMS: total.Milliseconds(), FirstByteMS: firstByte.Milliseconds(), Err: err})
+					mu.Unlock()
+				}
+			}()
+		}
+		for _, feed := range feeds {
+			jobs <- feed
+		}
+		close(jobs)
+		wg.Wait()
+		fmt.Fprintf(os.Stderr, "Round %d/%d done\n", round, rounds)
+	}
+	return fetches
+}
+
+// benchStatsBy groups fetches by key and summarizes each group, slowest
+// (by p95) first; groups whose every fetch failed come last.
+func benchStatsBy(fetches []benchFetch, key func(Feed) string) []benchStats {
+	groups := make(map[string][]benchFetch)
+	for _, f := range fetches {
+		k := key(f.Feed)
+		groups[k] = append(groups[k], f)
+	}
+	var stats []benchStats
+	for k, group := range groups {
+		s := benchStats{Key: k, Fetches: len(group)}
+		var totals, firstBytes []int64
+		for _, f := range group {
+			if f.Err != nil {
+				s.Errors++
+				continue
+			}
+			totals = append(totals, f.TotalMS)
+			firstBytes = append(firstBytes, f.FirstByteMS)
+		}
+		if len(totals) > 0 {
+			sort.Slice(totals, func(i, j int) bool { return totals[i] < totals[j] })
+			sort.Slice(firstBytes, func(i, j int) bool { return firstBytes[i] < firstBytes[j] })
+			s.P50, s.P95, s.Max = percentile(totals, 50), percentile(totals, 95), totals[len(totals)-1]
+			s.FirstByteP50 = percentile(firstBytes, 50)
+		}
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].P95 != stats[j].P95 {
+			return stats[i].P95 > stats[j].P95
+		}
+		return stats[i].Key < stats[j].Key
+	})
+	return stats
+}
+
+// writeBenchTable prints stats as a table, with their keys under column.
+func writeBenchTable(w io.Writer, column string, stats []benchStats) {
+	fmt.Fprintf(w, "%8s %8s %8s %8s %7s %6s  %s\n", "p50", "p95", "max", "ttfb p50", "fetches", "errors", column)
+	for _, s := range stats {
+		if s.Errors == s.Fetches {
+			fmt.Fprintf(w, "%8s %8s %8s %8s %7d %6d  %s\n", "-", "-", "-", "-", s.Fetches, s.Errors, s.Key)
+			continue
+		}
+		fmt.Fprintf(w, "%8s %8s %8s %8s %7d %6d  %s\n", formatMillis(s.P50), formatMillis(s.P95), formatMillis(s.Max), formatMillis(s.FirstByteP50), s.Fetches, s.Errors, s.Key)
+	}
+	fmt.Fprintln(w)
+}
+
+func runBench(args []string) int {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	sample := fs.Int("sample", 50, "fetch this many feeds, picked across countries and tiers as for --canary (0 fetches all)")
+	var seed uint64
+	fs.Uint64Var(&seed, "seed", 1, "seed for picking the sample; the same seed picks the same feeds")
+	rounds := fs.Int("rounds", 5, "fetch each feed this many times")
+	pause := fs.Duration("pause", 0, "wait this long between rounds, e.g. 1m to spread them over time")
+	concurrency := fs.Int("concurrency", validator.DefaultConcurrency, "how many feeds to fetch at once")
+	timeout := fs.Duration("timeout", validator.DefaultTimeout, "give up on a fetch after this long, counting it as an error")
+	userAgent := fs.String("user-agent", validator.DefaultUserAgent, "User-Agent header sent with requests")
+	by := fs.String("by", "host,region", "summarize by host, region or both")
+	format := fs.String("format", "text", "output format: text or csv")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s bench [--sample 50] [--rounds 5] [--by host,region] [--format text|csv] [feeds.csv]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Fetches a sample of feeds repeatedly and reports the latency distribution per host and region.\n")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+	groupings := map[string]func(Feed) string{
+		"host":   func(f Feed) string { return feedHost(f.URL) },
+		"region": func(f Feed) string { return regionForFeed(f).Region },
+	}
+	var keys []string
+	for _, k := range strings.Split(*by, ",") {
+		k = strings.TrimSpace(k)
+		if groupings[k] == nil {
+			fmt.Fprintf(os.Stderr, "--by %q isn't host or region\n", k)
+			return 2
+		}
+		keys = append(keys, k)
+	}
+	if *format != "text" && *format != "csv" {
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	if *rounds < 1 {
+		fmt.Fprintln(os.Stderr, "--rounds must be at least 1")
+		return 2
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	if *sample > 0 {
+		feeds = canarySubset(feeds, *sample, seed)
+	}
+	fmt.Fprintf(os.Stderr, "Fetching %d feeds %d times each\n", len(feeds), *rounds)
+	fetches := runBenchRounds(feeds, *rounds, *concurrency, *timeout, *userAgent, *pause)
+
+	if *format == "csv" {
+		w := csv.NewWriter(os.Stdout)
+		w.Write([]string{"by", "key", "fetches", "errors", "p50_ms", "p95_ms", "max_ms", "first_byte_p50_ms"})
+		for _, k := range keys {
+			for _, s := range benchStatsBy(fetches, groupings[k]) {
+				w.Write([]string{k, s.Key, strconv.Itoa(s.Fetches), strconv.Itoa(s.Errors),
+					strconv.FormatInt(s.P50, 10), strconv.FormatInt(s.P95, 10), strconv.FormatInt(s.Max, 10), strconv.FormatInt(s.FirstByteP50, 10)})
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing results: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	for _, k := range keys {
+		writeBenchTable(os.Stdout, strings.ToUpper(k[:1])+k[1:], benchStatsBy(fetches, groupings[k]))
+	}
+	var totals []int64
+	var timeouts int
+	for _, f := range fetches {
+		if f.Err == nil {
+			totals = append(totals, f.TotalMS)
+		} else if errors.Is(f.Err, context.DeadlineExceeded) {
+			timeouts++
+		}
+	}
+	if len(totals) > 0 {
+		sort.Slice(totals, func(i, j int) bool { return totals[i] < totals[j] })
+		fmt.Printf("All %d successful fetches: p50 %s, p95 %s, p99 %s, max %s\n", len(totals),
+			formatMillis(percentile(totals, 50)), formatMillis(percentile(totals, 95)), formatMillis(percentile(totals, 99)), formatMillis(totals[len(totals)-1]))
+	}
+	fmt.Printf("%d of %d fetches failed, %d of them timing out after %s\n", len(fetches)-len(totals), len(fetches), timeouts, *timeout)
+	return 0
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/google"
+)
+
+// bigqueryEndpoint is BigQuery's REST API. BIGQUERY_ENDPOINT overrides it,
+// without authentication, for emulators.
+const bigqueryEndpoint = "https://bigquery.googleapis.com/bigquery/v2"
+
+// bigqueryBatchSize is how many rows one insertAll request carries, well
+// under the API's recommended maximum of 500.
+const bigqueryBatchSize = 250
+
+// bigqueryField is a column of the results table.
+type bigqueryField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Mode string `json:"mode,omitempty"`
+}
+
+// bigquerySchema is the results table's schema: one row per feed per run.
+var bigquerySchema = []bigqueryField{
+	{Name: "run_started_at", Type: "TIMESTAMP", Mode: "REQUIRED"},
+	{Name: "dataset", Type: "STRING"},
+	{Name: "feed_id", Type: "STRING"},
+	{Name: "url", Type: "STRING", Mode: "REQUIRED"},
+	{Name: "country", Type: "STRING"},
+	{Name: "tier", Type: "INTEGER"},
+	{Name: "status", Type: "STRING", Mode: "REQUIRED"},
+	{Name: "message", Type: "STRING"},
+	{Name: "item_count", Type: "INTEGER"},
+	{Name: "latency_ms", Type: "INTEGER"},
+	{Name: "last_update", Type: "TIMESTAMP"},
+	{Name: "probe_only", Type: "BOOLEAN"},
+	{Name: "access", Type: "STRING"},
+	{Name: "license", Type: "STRING"},
+}
+
+// bigqueryRow is a result as inserted, matching bigquerySchema.
+type bigqueryRow struct {
+	RunStartedAt time.Time  `json:"run_started_at"`
+	Dataset      string     `json:"dataset"`
+	FeedID       string     `json:"feed_id,omitempty"`
+	URL          string     `json:"url"`
+	Country      string     `json:"country,omitempty"`
+	Tier         int        `json:"tier,omitempty"`
+	Status       string     `json:"status"`
+	Message      string     `json:"message,omitempty"`
+	ItemCount    int        `json:"item_count"`
+	LatencyMS    int64      `json:"latency_ms"`
+	LastUpdate   *time.Time `json:"last_update,omitempty"`
+	ProbeOnly    bool       `json:"probe_only"`
+	Access       string     `json:"access,omitempty"`
+	License      string     `json:"license,omitempty"`
+}
+
+// bigqueryTable streams run results into a BigQuery table, creating it,
+// partitioned by day of run_started_at, if it doesn't exist.
+type bigqueryTable struct {
+	project, dataset, table string
+	endpoint                string
+	client                  *http.Client
+	checked                 bool
+}
+
+// newBigQueryTable parses a PROJECT.DATASET.TABLE ID. Credentials come from
+// Application Default Credentials: GOOGLE_APPLICATION_CREDENTIALS, gcloud's
+// login or the metadata server.
+func newBigQueryTable(ctx context.Context, id string) (*bigqueryTable, error) {
+	parts := strings.Split(id, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("%q is not a PROJECT.DATASET.TABLE table ID", id)
+	}
+	t := &bigqueryTable{project: parts[0], dataset: parts[1], table: parts[2], endpoint: os.Getenv("BIGQUERY_ENDPOINT")}
+	if t.endpoint != "" {
+		t.client = &http.Client{Timeout: time.Minute}
+		return t, nil
+	}
+	t.endpoint = bigqueryEndpoint
+	client, err := google.DefaultClient(ctx, "https://www.googleapis.com/auth/bigquery.insertdata", "https://www.googleapis.com/auth/bigquery")
+	if err != nil {
+		return nil, fmt.Errorf("finding Google credentials: %w", err)
+	}
+	client.Timeout = time.Minute
+	t.client = client
+	return t, nil
+}
+
+func (t *bigqueryTable) String() string {
+	return t.project + "." + t.dataset + "." + t.table
+}
+
+// call sends a JSON request to the API and decodes the response into out.
+// A 404 is reported as os.ErrNotExist.
+func (t *bigqueryTable) call(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(t.endpoint, "/")+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return os.ErrNotExist
+	}
+	if resp.StatusCode >= 300 {
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&apiErr)
+		return fmt.Errorf("HTTP status %d: %s", resp.StatusCode, apiErr.Error.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (t *bigqueryTable) tablesPath() string {
+	return "/projects/" + url.PathEscape(t.project) + "/datasets/" + url.PathEscape(t.dataset) + "/tables"
+}
+
+// ensure creates the table on first use if it doesn't exist. The dataset
+// must already exist.
+func (t *bigqueryTable) ensure(ctx context.Context) error {
+	if t.checked {
+		return nil
+	}
+	err := t.call(ctx, "GET", t.tablesPath()+"/"+url.PathEscape(t.table), nil, nil)
+	if err == os.ErrNotExist {
+		create := map[string]any{
+			"tableReference":   map[string]string{"projectId": t.project, "datasetId": t.dataset, "tableId": t.table},
+			"schema":           map[string]any{"fields": bigquerySchema},
+			"timePartitioning": map[string]string{"type": "DAY", "field": "run_started_at"},
+		}
+		err = t.call(ctx, "POST", t.tablesPath(), create, nil)
+		if err == nil {
+			fmt.Printf("Created BigQuery table %s\n", t)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("checking table %s: %w", t, err)
+	}
+	t.checked = true
+	return nil
+}
+
+// insert streams a run's results in batches. Each row's insert ID is derived
+// from the run and feed, so BigQuery drops a retried insert's duplicates.
+func (t *bigqueryTable) insert(ctx context.Context, report *runReport, feeds []Feed) error {
+	if err := t.ensure(ctx); err != nil {
+		return err
+	}
+	feedRows := indexRows(feeds)
+	type insertRow struct {
+		InsertID string      `json:"insertId"`
+		JSON     bigqueryRow `json:"json"`
+	}
+	var rows []insertRow
+	for _, r := range report.Results {
+		feed, _ := feedRows.of(r)
+		row := bigqueryRow{
+			RunStartedAt: report.StartedAt.UTC(), Dataset: report.Input, FeedID: r.ID, URL: r.URL, Tier: feed.Tier,
+			Status: string(r.Status), Message: r.Message, ItemCount: r.ItemCount, LatencyMS: r.LatencyMS, ProbeOnly: r.ProbeOnly,
+			Country: feedCountry(feed), Access: r.Access, License: r.License,
+		}
+		if !r.LastUpdate.IsZero() {
+			lastUpdate := r.LastUpdate.UTC()
+			row.LastUpdate = &lastUpdate
+		}
+		id := sha256.Sum256(fmt.Appendf(nil, "%d %s", report.StartedAt.UnixNano(), r.URL))
+		rows = append(rows, insertRow{InsertID: hex.EncodeToString(id[:]), JSON: row})
+	}
+
+	path := t.tablesPath() + "/" + url.PathEscape(t.table) + "/insertAll"
+	for start := 0; start < len(rows); start += bigqueryBatchSize {
+		batch := rows[start:min(start+bigqueryBatchSize, len(rows))]
+		var resp struct {
+			InsertErrors []struct {
+				Index  int `json:"index"`
+				Errors []struct {
+					Message string `json:"message"`
+				} `json:"errors"`
+			} `json:"insertErrors"`
+		}
+		if err := t.call(ctx, "POST", path, map[string]any{"rows": batch}, &resp); err != nil {
+			return fmt.Errorf("inserting into %s: %w", t, err)
+		}
+		if n := len(resp.InsertErrors); n > 0 {
+			first := resp.InsertErrors[0]
+			var msg string
+			if len(first.Errors) > 0 {
+				msg = first.Errors[0].Message
+			}
+			return fmt.Errorf("inserting into %s: %d rows rejected, first (%s): %s", t, n, batch[first.Index].JSON.URL, msg)
+		}
+	}
+	return nil
+}
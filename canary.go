@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+)
+
+// canarySubset picks n feeds for a quick smoke run, spread across countries
+// and tiers: feeds are grouped by country (or region, for feeds without one)
+// and tier, and one feed is taken from each group in turn, in an order
+// shuffled by seed, until n are picked. The same seed and dataset always
+// give the same subset, returned in dataset order.
+func canarySubset(feeds []Feed, n int, seed uint64) []Feed {
+	if n >= len(feeds) {
+		return feeds
+	}
+	rng := rand.New(rand.NewPCG(seed, seed))
+
+	groups := make(map[string][]int)
+	var keys []string
+	for i, feed := range feeds {
+		place := regionForFeed(feed).Region
+		if c, ok := countryFromComments(feed.Comments); ok {
+			place = c.Alpha2
+		}
+		key := fmt.Sprintf("%s/%d", place, feed.Tier)
+		if groups[key] == nil {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+	sort.Strings(keys)
+	rng.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+	for _, key := range keys {
+		g := groups[key]
+		rng.Shuffle(len(g), func(i, j int) { g[i], g[j] = g[j], g[i] })
+	}
+
+	var picked []int
+	for round := 0; len(picked) < n; round++ {
+		for _, key := range keys {
+			if g := groups[key]; round < len(g) && len(picked) < n {
+				picked = append(picked, g[round])
+			}
+		}
+	}
+	sort.Ints(picked)
+	subset := make([]Feed, len(picked))
+	for i, idx := range picked {
+		subset[i] = feeds[idx]
+	}
+	return subset
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// defaultCaptureKB is how much of a failing response's body is kept unless
+// --capture-kb says otherwise.
+const defaultCaptureKB = 64
+
+// captureStore keeps the raw responses of feeds found invalid, so failures
+// such as "Not a valid feed format" can be diagnosed without fetching the
+// feed again. Each capture is a text file of the request line, the response
+// status and headers, and up to limit bytes of the body, named after the
+// fetch time and a hash of the URL.
+type captureStore struct {
+	dir   string
+	limit int
+}
+
+func newCaptureStore(dir string, kb int) *captureStore {
+	if dir == "" {
+		return nil
+	}
+	return &captureStore{dir: dir, limit: kb * 1024}
+}
+
+// Limit is how many bytes of a body are kept.
+func (s *captureStore) Limit() int {
+	return s.limit
+}
+
+// Capture saves a capture of resp, fetched now; see save.
+func (s *captureStore) Capture(url string, resp *http.Response, body []byte, truncated bool) (string, error) {
+	return s.save(url, resp, body, truncated, time.Now())
+}
+
+// save writes a capture of resp, whose body was read into body, and returns
+// its path. truncated reports that body is only the start of the response.
+func (s *captureStore) save(url string, resp *http.Response, body []byte, truncated bool, at time.Time) (string, error) {
+	if len(body) > s.limit {
+		body, truncated = body[:s.limit], true
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(url))
+	path := filepath.Join(s.dir, at.UTC().Format("20060102T150405Z")+"-"+hex.EncodeToString(sum[:6])+".http")
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "GET %s\nFetched: %s\n\n", url, at.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "%s %s\n", resp.Proto, resp.Status)
+	resp.Header.Write(&b)
+	b.WriteString("\n")
+	b.Write(body)
+	if truncated {
+		fmt.Fprintf(&b, "\n[truncated after %d bytes]\n", s.limit)
+	}
+	return path, os.WriteFile(path, b.Bytes(), 0o644)
+}
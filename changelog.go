@@ -0,0 +1,363 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// feedChange is a dataset row that differs between two versions.
+type feedChange struct {
+	Old, New Feed
+	Fields   []string // "field: old → new" for modified rows
+}
+
+// datasetDiff groups added, removed and modified feeds by the country (or
+// region) they are assigned to.
+type datasetDiff struct {
+	Added    map[string][]Feed
+	Removed  map[string][]Feed
+	Modified map[string][]feedChange
+}
+
+func (d *datasetDiff) empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+// changelogGroup names the section a feed is listed under.
+func changelogGroup(feed Feed) string {
+	if c := feedCountry(feed); c != "" {
+		return c
+	}
+	r := regionForFeed(feed)
+	if r.SubRegion != "" {
+		return r.SubRegion
+	}
+	return r.Region
+}
+
+// diffFeeds compares two versions of the dataset by feed ID, so a row whose
+// URL changed is reported as modified rather than removed and re-added.
+// Rows sharing an ID are matched in order. Rows without an id column value
+// have an ID derived from their URL, which changes with it, so those left
+// over are paired by position instead (see pairByPosition).
+func diffFeeds(oldFeeds, newFeeds []Feed) *datasetDiff {
+	byID := make(map[string][]int, len(oldFeeds))
+	for i, f := range oldFeeds {
+		byID[f.ID] = append(byID[f.ID], i)
+	}
+	// partner holds each new row's old row, or -1 for an added one.
+	partner := make([]int, len(newFeeds))
+	matched := make([]bool, len(oldFeeds))
+	for j, f := range newFeeds {
+		partner[j] = -1
+		if rows := byID[f.ID]; len(rows) > 0 {
+			partner[j], byID[f.ID] = rows[0], rows[1:]
+			matched[rows[0]] = true
+		}
+	}
+	pairByPosition(oldFeeds, newFeeds, partner, matched)
+
+	d := &datasetDiff{Added: map[string][]Feed{}, Removed: map[string][]Feed{}, Modified: map[string][]feedChange{}}
+	for j, f := range newFeeds {
+		if partner[j] < 0 {
+			group := changelogGroup(f)
+			d.Added[group] = append(d.Added[group], f)
+			continue
+		}
+		o := oldFeeds[partner[j]]
+		if fields := changedFields(o, f); len(fields) > 0 {
+			group := changelogGroup(f)
+			d.Modified[group] = append(d.Modified[group], feedChange{Old: o, New: f, Fields: fields})
+		}
+	}
+	for i, f := range oldFeeds {
+		if !matched[i] {
+			group := changelogGroup(f)
+			d.Removed[group] = append(d.Removed[group], f)
+		}
+	}
+	return d
+}
+
+// pairByPosition pairs the unmatched rows whose IDs are derived from their
+// URLs as edits of one another, as when URLs are corrected in place: where a
+// file lost and gained as many of them between the same two matched rows,
+// in order, and otherwise those there on the same host.
+func pairByPosition(oldFeeds, newFeeds []Feed, partner []int, matched []bool) {
+	derived := func(f Feed) bool { return f.ID == validator.DeriveID(f.URL) }
+	// A gap is where rows sit in a file: after the matched row with old
+	// index after-1, or at its start for 0.
+	type gap struct {
+		file  string
+		after int
+	}
+	removed := make(map[gap][]int)
+	last := make(map[string]int)
+	for i, f := range oldFeeds {
+		if matched[i] {
+			last[f.File] = i + 1
+		} else if derived(f) {
+			g := gap{f.File, last[f.File]}
+			removed[g] = append(removed[g], i)
+		}
+	}
+	added := make(map[gap][]int)
+	clear(last)
+	for j, f := range newFeeds {
+		if partner[j] >= 0 {
+			last[f.File] = partner[j] + 1
+		} else if derived(f) {
+			g := gap{f.File, last[f.File]}
+			added[g] = append(added[g], j)
+		}
+	}
+	for g, rows := range added {
+		candidates := removed[g]
+		for k, j := range rows {
+			i := -1
+			if len(rows) == len(candidates) {
+				i = candidates[k]
+			} else {
+				host := feedHost(newFeeds[j].URL)
+				for _, c := range candidates {
+					if !matched[c] && feedHost(oldFeeds[c].URL) == host {
+						i = c
+						break
+					}
+				}
+			}
+			if i >= 0 {
+				partner[j], matched[i] = i, true
+			}
+		}
+	}
+}
+
+func changedFields(a, b Feed) []string {
+	var fields []string
+	compare := func(name, before, after string) {
+		if before != after {
+			fields = append(fields, fmt.Sprintf("%s: %q → %q", name, before, after))
+		}
+	}
+	compare("url", a.URL, b.URL)
+	compare("comments", a.Comments, b.Comments)
+	compare("language", a.Language, b.Language)
+	compare("status", a.Status, b.Status)
+	compare("license", a.License, b.License)
+	compare("paywall", a.Paywall, b.Paywall)
+	compare("tier", strconv.Itoa(a.Tier), strconv.Itoa(b.Tier))
+	return fields
+}
+
+func sortedGroups[T any](groups map[string][]T) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func countGroups[T any](groups map[string][]T) int {
+	n := 0
+	for _, g := range groups {
+		n += len(g)
+	}
+	return n
+}
+
+// writeChangelog renders the diff as markdown release notes.
+func writeChangelog(w io.Writer, from, to string, d *datasetDiff) {
+	fmt.Fprintf(w, "## Changes from %s to %s\n\n", from, to)
+	if d.empty() {
+		fmt.Fprintf(w, "No feeds were added, removed or modified.\n")
+		return
+	}
+	fmt.Fprintf(w, "%d added, %d removed, %d modified.\n", countGroups(d.Added), countGroups(d.Removed), countGroups(d.Modified))
+
+	if len(d.Added) > 0 {
+		fmt.Fprintf(w, "\n### Added\n")
+		for _, group := range sortedGroups(d.Added) {
+			fmt.Fprintf(w, "\n**%s**\n\n", group)
+			for _, f := range d.Added[group] {
+				fmt.Fprintf(w, "- %s\n", f.URL)
+			}
+		}
+	}
+	if len(d.Removed) > 0 {
+		fmt.Fprintf(w, "\n### Removed\n")
+		for _, group := range sortedGroups(d.Removed) {
+			fmt.Fprintf(w, "\n**%s**\n\n", group)
+			for _, f := range d.Removed[group] {
+				fmt.Fprintf(w, "- %s\n", f.URL)
+			}
+		}
+	}
+	if len(d.Modified) > 0 {
+		fmt.Fprintf(w, "\n### Modified\n")
+		for _, group := range sortedGroups(d.Modified) {
+			fmt.Fprintf(w, "\n**%s**\n\n", group)
+			for _, c := range d.Modified[group] {
+				fmt.Fprintf(w, "- %s (%s)\n", c.New.URL, strings.Join(c.Fields, ", "))
+			}
+		}
+	}
+}
+
+// urlChange is a feed whose URL changed between two versions.
+type urlChange struct {
+	ID     string `json:"id"`
+	OldURL string `json:"old_url"`
+	NewURL string `json:"new_url"`
+}
+
+// datasetDelta is the machine-readable form of a datasetDiff, for consumers
+// updating their subscriptions incrementally: the feeds added and removed,
+// with their metadata as exported, and those whose URL changed. Other
+// modifications aren't listed.
+type datasetDelta struct {
+	SchemaVersion string         `json:"schema_version"`
+	From          string         `json:"from"`
+	To            string         `json:"to"`
+	Added         []exportRecord `json:"added"`
+	Removed       []exportRecord `json:"removed"`
+	URLChanged    []urlChange    `json:"url_changed"`
+}
+
+// newDatasetDelta flattens d, its groups in the order of the changelog.
+func newDatasetDelta(from, to string, d *datasetDiff) datasetDelta {
+	delta := datasetDelta{SchemaVersion: validator.SchemaVersion, From: from, To: to, Added: []exportRecord{}, Removed: []exportRecord{}, URLChanged: []urlChange{}}
+	for _, group := range sortedGroups(d.Added) {
+		delta.Added = append(delta.Added, buildExportRecords(d.Added[group], nil, nil)...)
+	}
+	for _, group := range sortedGroups(d.Removed) {
+		delta.Removed = append(delta.Removed, buildExportRecords(d.Removed[group], nil, nil)...)
+	}
+	for _, group := range sortedGroups(d.Modified) {
+		for _, c := range d.Modified[group] {
+			if c.Old.URL != c.New.URL {
+				delta.URLChanged = append(delta.URLChanged, urlChange{ID: c.New.ID, OldURL: c.Old.URL, NewURL: c.New.URL})
+			}
+		}
+	}
+	return delta
+}
+
+// feedsAtRef reads the dataset as of a git ref. path may be a file or a
+// directory of per-country files.
+func feedsAtRef(ref, path string, hasHeader bool) ([]Feed, error) {
+	files, err := gitOutput("ls-tree", "-r", "--name-only", ref, "--", path)
+	if err != nil {
+		return nil, err
+	}
+	var feeds []Feed
+	found := false
+	for _, file := range strings.Split(strings.TrimSpace(string(files)), "\n") {
+		if !strings.HasSuffix(file, ".csv") {
+			continue
+		}
+		found = true
+		content, err := gitOutput("show", ref+":"+file)
+		if err != nil {
+			return nil, err
+		}
+		fileFeeds, err := readFeeds(bytes.NewReader(content), hasHeader)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		for i := range fileFeeds {
+			fileFeeds[i].File = file
+		}
+		feeds = append(feeds, fileFeeds...)
+	}
+	if !found {
+		return nil, fmt.Errorf("no dataset at %s:%s", ref, path)
+	}
+	return feeds, nil
+}
+
+func gitOutput(args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+func runChangelog(args []string) int {
+	fs := flag.NewFlagSet("changelog", flag.ExitOnError)
+	path := fs.String("path", "feeds.csv", "dataset file or directory within the repository")
+	outPath := fs.String("o", "", "write the changelog to this file (default stdout)")
+	format := fs.String("format", "markdown", "output format: markdown release notes, or json, the added, removed and URL-changed feeds for consumers")
+	noHeader := fs.Bool("no-header", false, "dataset has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s changelog [--path feeds.csv] [--format markdown|json] FROM_REF [TO_REF]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "TO_REF defaults to HEAD.\n")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) < 1 || len(positional) > 2 {
+		fs.Usage()
+		return 2
+	}
+	if *format != "markdown" && *format != "json" {
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	from, to := positional[0], "HEAD"
+	if len(positional) == 2 {
+		to = positional[1]
+	}
+
+	oldFeeds, err := feedsAtRef(from, *path, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", from, err)
+		return 1
+	}
+	newFeeds, err := feedsAtRef(to, *path, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", to, err)
+		return 1
+	}
+
+	var w io.Writer = os.Stdout
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outPath, err)
+			return 1
+		}
+		defer file.Close()
+		w = file
+	}
+	diff := diffFeeds(oldFeeds, newFeeds)
+	if *format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(newDatasetDelta(from, to, diff)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing the delta: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+	writeChangelog(w, from, to, diff)
+	return 0
+}
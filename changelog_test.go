@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDatasetDelta(t *testing.T) {
+	read := func(csv string) []Feed {
+		feeds, err := readFeeds(strings.NewReader(csv), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return feeds
+	}
+	tests := []struct {
+		name                  string
+		old, new              string
+		wantAdded, wantRemove []string
+		wantURLChanged        map[string]string
+	}{
+		{
+			name: "without IDs",
+			old:  "url\nhttps://a.example/rss\nhttps://b.example/rss\nhttps://c.example/rss\nhttps://d.example/rss\nhttps://f.example/rss\n",
+			// b is edited in place beside c's removal, f edited at the end
+			// and e added.
+			new:            "url\nhttps://a.example/rss\nhttp://b.example/feed\nhttps://d.example/rss\nhttps://e.example/rss\nhttps://f.example/atom\n",
+			wantAdded:      []string{"https://e.example/rss"},
+			wantRemove:     []string{"https://c.example/rss"},
+			wantURLChanged: map[string]string{"https://b.example/rss": "http://b.example/feed", "https://f.example/rss": "https://f.example/atom"},
+		},
+		{
+			name:           "replaced by another site",
+			old:            "url\nhttps://a.example/rss\nhttps://b.example/rss\nhttps://c.example/rss\n",
+			new:            "url\nhttps://a.example/rss\nhttps://x.example/rss\n",
+			wantAdded:      []string{"https://x.example/rss"},
+			wantRemove:     []string{"https://b.example/rss", "https://c.example/rss"},
+			wantURLChanged: map[string]string{},
+		},
+		{
+			name:           "with IDs",
+			old:            "id,url\nb,https://b.example/rss\nc,https://c.example/rss\n",
+			new:            "id,url\nc,https://c.example/rss\nb,https://news.example/b\nx,https://x.example/rss\n",
+			wantAdded:      []string{"https://x.example/rss"},
+			wantRemove:     []string{},
+			wantURLChanged: map[string]string{"https://b.example/rss": "https://news.example/b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(newDatasetDelta("v1", "v2", diffFeeds(read(tt.old), read(tt.new))))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var delta struct {
+				Added, Removed []struct {
+					URL string `json:"url"`
+				}
+				URLChanged []urlChange `json:"url_changed"`
+			}
+			if err := json.Unmarshal(data, &delta); err != nil {
+				t.Fatal(err)
+			}
+			urls := func(records []struct {
+				URL string `json:"url"`
+			}) []string {
+				list := []string{}
+				for _, r := range records {
+					list = append(list, r.URL)
+				}
+				return list
+			}
+			if got := urls(delta.Added); !reflect.DeepEqual(got, tt.wantAdded) {
+				t.Errorf("added %v, want %v", got, tt.wantAdded)
+			}
+			if got := urls(delta.Removed); !reflect.DeepEqual(got, tt.wantRemove) {
+				t.Errorf("removed %v, want %v", got, tt.wantRemove)
+			}
+			changed := map[string]string{}
+			for _, c := range delta.URLChanged {
+				changed[c.OldURL] = c.NewURL
+			}
+			if !reflect.DeepEqual(changed, tt.wantURLChanged) {
+				t.Errorf("url changes %v, want %v", changed, tt.wantURLChanged)
+			}
+		})
+	}
+}
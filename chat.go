@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// chatListLimit caps how many newly broken feeds a chat message names.
+const chatListLimit = 15
+
+// discordMessageLimit is Discord's maximum message length.
+const discordMessageLimit = 2000
+
+// runSummary is what chat notifications report about a run.
+type runSummary struct {
+	Dataset                   string
+	Valid, Invalid, Transient int
+	Skipped                   int
+	Changes                   runTransitions
+	Alerts                    []alertViolation
+}
+
+func newRunSummary(dataset string, results []ValidationResult, skipped int, changes runTransitions) runSummary {
+	s := runSummary{Dataset: dataset, Skipped: skipped, Changes: changes}
+	for _, r := range results {
+		switch r.Status {
+		case "valid":
+			s.Valid++
+		case "invalid":
+			s.Invalid++
+		case "transient":
+			s.Transient++
+		}
+	}
+	return s
+}
+
+// message renders the summary as chat markdown; bold is the platform's
+// bold marker. Feed lines are dropped from the end to stay within limit.
+func (s runSummary) message(bold string, limit int) string {
+	header := fmt.Sprintf("%sFeed validation%s: %s\n✅ %d valid, ❌ %d invalid, ⚠️ %d transient", bold, bold, s.Dataset, s.Valid, s.Invalid, s.Transient)
+	if s.Skipped > 0 {
+		header += fmt.Sprintf(" (%d not due)", s.Skipped)
+	}
+	var footer []string
+	if n := len(s.Changes.Recovered); n > 0 {
+		footer = append(footer, fmt.Sprintf("%d recovered", n))
+	}
+	var flapping int
+	for _, c := range s.Changes.Flaps {
+		if c.Flapping {
+			flapping++
+		}
+	}
+	if flapping > 0 {
+		footer = append(footer, fmt.Sprintf("%d started flapping", flapping))
+	}
+
+	var alerts strings.Builder
+	if len(s.Alerts) > 0 {
+		fmt.Fprintf(&alerts, "\n\n%sAlerts (%d):%s", bold, len(s.Alerts), bold)
+		for _, a := range s.Alerts[:min(len(s.Alerts), chatListLimit)] {
+			fmt.Fprintf(&alerts, "\n• %s: %s", a.Rule, a.Message)
+		}
+		if more := len(s.Alerts) - chatListLimit; more > 0 {
+			fmt.Fprintf(&alerts, "\n…and %d more", more)
+		}
+	}
+
+	var lines []string
+	for _, r := range s.Changes.Died {
+		lines = append(lines, fmt.Sprintf("• %s — %s", r.URL, r.Message))
+	}
+	listed := min(len(lines), chatListLimit)
+	for {
+		var b strings.Builder
+		b.WriteString(header)
+		b.WriteString(alerts.String())
+		if len(lines) > 0 {
+			fmt.Fprintf(&b, "\n\n%sNewly broken (%d):%s\n", bold, len(lines), bold)
+			b.WriteString(strings.Join(lines[:listed], "\n"))
+			if more := len(lines) - listed; more > 0 {
+				fmt.Fprintf(&b, "\n…and %d more", more)
+			}
+		}
+		if len(footer) > 0 {
+			b.WriteString("\n\n" + strings.Join(footer, ", "))
+		}
+		if b.Len() <= limit || listed == 0 {
+			return b.String()
+		}
+		listed--
+	}
+}
+
+// chatNotifier posts run summaries to Slack and Discord incoming webhooks,
+// but only for runs where at least threshold feeds newly broke or an alert
+// rule is violated.
+type chatNotifier struct {
+	slack, discord string
+	threshold      int
+	client         *http.Client
+}
+
+func newChatNotifier(slack, discord string, threshold int) *chatNotifier {
+	if slack == "" && discord == "" {
+		return nil
+	}
+	return &chatNotifier{slack: slack, discord: discord, threshold: threshold, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (c *chatNotifier) notify(s runSummary) error {
+	if len(s.Changes.Died) < c.threshold && len(s.Alerts) == 0 {
+		return nil
+	}
+	var errs []error
+	if c.slack != "" {
+		body, _ := json.Marshal(map[string]string{"text": s.message("*", 40000)})
+		if err := postJSON(c.client, c.slack, body, nil); err != nil {
+			errs = append(errs, fmt.Errorf("slack: %w", err))
+		}
+	}
+	if c.discord != "" {
+		body, _ := json.Marshal(map[string]string{"content": s.message("**", discordMessageLimit)})
+		if err := postJSON(c.client, c.discord, body, nil); err != nil {
+			errs = append(errs, fmt.Errorf("discord: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"plugin"
+	"strings"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// loadCheckPlugin loads a check built as a Go plugin with
+// "go build -buildmode=plugin". The plugin exports it as a variable:
+//
+//	var Check = validator.Hook{AfterParse: ...}
+//
+// Plugins must be built with the same Go version and the same version of
+// this module as the binary loading them.
+func loadCheckPlugin(path string) (validator.Hook, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return validator.Hook{}, err
+	}
+	sym, err := p.Lookup("Check")
+	if err != nil {
+		return validator.Hook{}, err
+	}
+	hook, ok := sym.(*validator.Hook)
+	if !ok {
+		return validator.Hook{}, fmt.Errorf("%s: Check is a %T, not a validator.Hook", path, sym)
+	}
+	return *hook, nil
+}
+
+// checkHooks returns the hooks of --check commands, split into program and
+// arguments at spaces, --check-plugin files and --rules scripts.
+func checkHooks(commands, plugins, rules []string) ([]validator.Hook, error) {
+	var hooks []validator.Hook
+	for _, command := range commands {
+		fields := strings.Fields(command)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("--check is empty")
+		}
+		hooks = append(hooks, validator.CommandCheck(fields[0], fields[1:]...))
+	}
+	for _, path := range plugins {
+		hook, err := loadCheckPlugin(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading check plugin: %w", err)
+		}
+		hooks = append(hooks, hook)
+	}
+	for _, path := range rules {
+		hook, err := validator.RulesCheck(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading rules: %w", err)
+		}
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// commands maps subcommand names to their entry points. Anything else on the
+// command line is treated as the input file for feed validation.
+var commands = map[string]func(args []string) int{
+	"add":               runAdd,
+	"assign-ids":        runAssignIDs,
+	"bench":             runBench,
+	"changelog":         runChangelog,
+	"compare":           runCompare,
+	"country-dashboard": runCountryDashboard,
+	"coverage":          runCoverage,
+	"crossref":          runCrossref,
+	"duplicates":        runDuplicates,
+	"export":            runExport,
+	"export-sqlite":     runExportSQLite,
+	"get":               runGet,
+	"history":           runHistory,
+	"intake":            runIntake,
+	"latency":           runLatency,
+	"list":              runList,
+	"propose-removals":  runProposeRemovals,
+	"prune":             runPrune,
+	"publish":           runPublish,
+	"serve":             runServe,
+	"sign":              runSign,
+	"snapshot":          runSnapshot,
+	"split":             runSplit,
+	"status-feed":       runStatusFeed,
+	"survival":          runSurvival,
+	"trend":             runTrend,
+	"update":            runUpdate,
+	"uptime":            runUptime,
+	"verify":            runVerify,
+	"wikidata":          runWikidata,
+	"worker":            runWorker,
+}
+
+// stringList is a flag that may be given more than once.
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+// parseArgs parses flags that may be interleaved with positional arguments
+// and returns the positional arguments.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// openSource opens a local file or, for http(s) URLs, downloads it.
+func openSource(path string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		return os.Open(path)
+	}
+
+	client := &http.Client{Timeout: 5 * time.Minute}
+	resp, err := client.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("HTTP status %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+)
+
+func runCompare(args []string) int {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+	historyPath := fs.String("history", "history.db", "history database, or glob of run reports written by validation with --json")
+	from := fs.String("from", "", "earlier date (YYYY-MM-DD, UTC); feeds are compared as of the end of the day")
+	to := fs.String("to", "", "later date (YYYY-MM-DD, UTC; default today)")
+	window := fs.Int("window", 7, "compare freshness, item counts and latency over this many days up to each date")
+	format := fs.String("format", "markdown", "output format: markdown or html")
+	outPath := fs.String("o", "", "write the report to this file (default stdout)")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s compare --from DATE [--to DATE] [--window 7] [--history DB|GLOB] [--format markdown|html] [-o FILE] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+	if *format != "markdown" && *format != "html" {
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	if *from == "" || *window < 1 {
+		fs.Usage()
+		return 2
+	}
+	fromDay, err := time.Parse("2006-01-02", *from)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: --from: %v\n", err)
+		return 2
+	}
+	toDay := time.Now().UTC().Truncate(24 * time.Hour)
+	if *to != "" {
+		if toDay, err = time.Parse("2006-01-02", *to); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: --to: %v\n", err)
+			return 2
+		}
+	}
+	if !fromDay.Before(toDay) {
+		fmt.Fprintln(os.Stderr, "--from must be before --to")
+		return 2
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	reports, err := loadHistory(*historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+		return 1
+	}
+
+	// Each date's window ends at the end of that day.
+	span := func(day time.Time) period {
+		end := day.AddDate(0, 0, 1)
+		return period{Start: end.AddDate(0, 0, -*window), End: end}
+	}
+	report := compareWeeks(feeds, reports, span(fromDay), span(toDay))
+	report.Dataset = inputFile
+	if err := writeTrendReport(report, *format, *outPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing report: %v\n", err)
+		return 1
+	}
+	return 0
+}
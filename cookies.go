@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// seedCookie is an entry of the --cookies file: a cookie sent to a domain
+// and its subdomains, typically one recording consent to a site's cookie
+// wall.
+type seedCookie struct {
+	Domain string `json:"domain"`
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+}
+
+// newCookieJar returns a cookie jar holding the cookies in the JSON file at
+// path, of the form {"cookies": [...]}, or an empty jar if path is "".
+func newCookieJar(path string) (http.CookieJar, error) {
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return jar, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Cookies []seedCookie `json:"cookies"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for i, c := range file.Cookies {
+		if c.Domain == "" || c.Name == "" {
+			return nil, fmt.Errorf("%s: cookie %d needs a domain and a name", path, i+1)
+		}
+		domain := strings.ToLower(strings.TrimPrefix(c.Domain, "."))
+		jar.SetCookies(&url.URL{Scheme: "https", Host: domain}, []*http.Cookie{
+			{Name: c.Name, Value: c.Value, Domain: domain, Path: "/"},
+		})
+	}
+	return jar, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strings"
+)
+
+type Country struct {
+	Alpha2 string
+	Alpha3 string
+	Name   string
+}
+
+var (
+	countriesByCode  = make(map[string]Country)
+	countriesByName  = make(map[string]Country)
+	usStateNames     = make(map[string]bool)
+	usStatePostCodes = make(map[string]bool)
+)
+
+func init() {
+	for _, c := range isoCountries {
+		countriesByCode[c.Alpha2] = c
+		countriesByCode[c.Alpha3] = c
+		countriesByName[strings.ToLower(c.Name)] = c
+	}
+	for alias, code := range countryAliases {
+		countriesByName[alias] = countriesByCode[code]
+	}
+	for _, s := range usStates {
+		if len(s) == 2 {
+			usStatePostCodes[s] = true
+		} else {
+			usStateNames[strings.ToLower(s)] = true
+		}
+	}
+}
+
+// lookupCountry resolves a country name, alias or ISO alpha-2/alpha-3 code.
+func lookupCountry(s string) (Country, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Country{}, false
+	}
+	if c, ok := countriesByCode[strings.ToUpper(s)]; ok && (len(s) == 3 || s == strings.ToUpper(s)) {
+		return c, true
+	}
+	c, ok := countriesByName[strings.ToLower(s)]
+	return c, ok
+}
+
+// countryFromComments makes a best-effort guess at the country a feed covers
+// from the free-text comments column, e.g. "Kerala, India" or "Portland, OR".
+// Regional descriptions such as "global" or "Middle East" yield no country.
+func countryFromComments(comments string) (Country, bool) {
+	if c, ok := lookupCountry(comments); ok {
+		return c, true
+	}
+
+	parts := strings.FieldsFunc(comments, func(r rune) bool {
+		return r == ',' || r == '/' || r == ';'
+	})
+	// The most specific part usually comes first ("Kerala, India"), so the
+	// country is looked up from the end.
+	for i := len(parts) - 1; i >= 0; i-- {
+		part := strings.TrimSpace(parts[i])
+		if usStateNames[strings.ToLower(part)] || (len(parts) > 1 && usStatePostCodes[part]) {
+			return countriesByCode["US"], true
+		}
+		if c, ok := lookupCountry(part); ok {
+			return c, true
+		}
+	}
+	return Country{}, false
+}
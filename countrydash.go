@@ -0,0 +1,275 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// freshestShown is how many of a country's most recently updated feeds
+	// its page highlights.
+	freshestShown = 5
+	// recentLossDays is how far back a country's page lists feeds that
+	// went down.
+	recentLossDays = 30
+)
+
+// dashboardFeed is a feed's current health on a country page.
+type dashboardFeed struct {
+	ID          string     `json:"id"`
+	URL         string     `json:"url"`
+	Status      string     `json:"status"`
+	Message     string     `json:"message,omitempty"`
+	LastUpdate  *time.Time `json:"last_update,omitempty"`
+	LastChecked *time.Time `json:"last_checked,omitempty"`
+	// Availability30 is the share of runs in the last 30 days that found
+	// the feed valid.
+	Availability30 *float64 `json:"availability_30d"`
+}
+
+// dashboardLoss is a feed that went from valid to invalid.
+type dashboardLoss struct {
+	URL     string    `json:"url"`
+	At      time.Time `json:"at"`
+	Message string    `json:"message,omitempty"`
+}
+
+// countryDashboard is one country's page of the static dashboard.
+type countryDashboard struct {
+	Country        string          `json:"country"`
+	Name           string          `json:"name"`
+	Generated      time.Time       `json:"generated"`
+	Total          int             `json:"total"`
+	Healthy        int             `json:"healthy"`
+	HealthyPercent *float64        `json:"healthy_percent"`
+	Freshest       []dashboardFeed `json:"freshest"`
+	RecentLosses   []dashboardLoss `json:"recent_losses"`
+	Feeds          []dashboardFeed `json:"feeds"`
+}
+
+// countrySummary is a country's line in the dashboard index.
+type countrySummary struct {
+	Country        string   `json:"country"`
+	Name           string   `json:"name"`
+	Total          int      `json:"total"`
+	Healthy        int      `json:"healthy"`
+	HealthyPercent *float64 `json:"healthy_percent"`
+	RecentLosses   int      `json:"recent_losses"`
+}
+
+// buildCountryDashboards computes every country's page from the history.
+// A feed is healthy when its last definite result was valid; feeds whose
+// comments name no single country are left out.
+func buildCountryDashboards(feeds []Feed, reports []*runReport, now time.Time) []*countryDashboard {
+	history := indexHistory(reports)
+	uptimes := feedUptimes(feeds, reports, now)
+	byKey := make(map[string]Feed, len(feeds))
+	pages := make(map[string]*countryDashboard)
+	for _, feed := range feeds {
+		c, ok := countryFromComments(feed.Comments)
+		if !ok {
+			continue
+		}
+		if feed.ID != "" {
+			byKey[feed.ID] = feed
+		}
+		byKey[feed.URL] = feed
+		page := pages[c.Alpha2]
+		if page == nil {
+			page = &countryDashboard{Country: c.Alpha2, Name: c.Name, Generated: now, Freshest: []dashboardFeed{}, RecentLosses: []dashboardLoss{}}
+			pages[c.Alpha2] = page
+		}
+
+		df := dashboardFeed{ID: feed.ID, URL: feed.URL, Status: "unknown", Availability30: uptimes[feed.URL][1].Availability}
+		obs := history.forFeed(feed)
+		if len(obs) > 0 {
+			checked := obs[len(obs)-1].at
+			df.LastChecked = &checked
+		}
+		if r, ok := settledAt(obs, now.Add(time.Second)); ok {
+			df.Status, df.Message = string(r.Status), r.Message
+			if !r.LastUpdate.IsZero() {
+				lastUpdate := r.LastUpdate
+				df.LastUpdate = &lastUpdate
+			}
+		}
+		page.Total++
+		if df.Status == "valid" {
+			page.Healthy++
+		}
+		page.Feeds = append(page.Feeds, df)
+	}
+
+	since := now.AddDate(0, 0, -recentLossDays)
+	for _, change := range datasetChanges(reports, feeds) {
+		if change.At.Before(since) {
+			break
+		}
+		feed, ok := byKey[change.Result.ID]
+		if !ok {
+			feed, ok = byKey[change.Result.URL]
+		}
+		if !ok || change.Kind != "died" {
+			continue
+		}
+		c, _ := countryFromComments(feed.Comments)
+		page := pages[c.Alpha2]
+		page.RecentLosses = append(page.RecentLosses, dashboardLoss{URL: feed.URL, At: change.At, Message: change.Result.Message})
+	}
+
+	var sorted []*countryDashboard
+	for _, page := range pages {
+		page.HealthyPercent = percent(page.Healthy, page.Total)
+		for _, f := range page.Feeds {
+			if f.Status == "valid" && f.LastUpdate != nil {
+				page.Freshest = append(page.Freshest, f)
+			}
+		}
+		sort.SliceStable(page.Freshest, func(i, j int) bool { return page.Freshest[i].LastUpdate.After(*page.Freshest[j].LastUpdate) })
+		if len(page.Freshest) > freshestShown {
+			page.Freshest = page.Freshest[:freshestShown]
+		}
+		sorted = append(sorted, page)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	return sorted
+}
+
+var dashboardFuncs = template.FuncMap{
+	"percent": formatPercent,
+	"lower":   strings.ToLower,
+	"time": func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format("2006-01-02 15:04")
+	},
+	"date": func(t time.Time) string { return t.UTC().Format("2006-01-02") },
+}
+
+var countryIndexHTML = template.Must(template.New("index").Funcs(dashboardFuncs).Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Feed health by country</title></head>
+<body style="font-family: sans-serif">
+<h1>Feed health by country</h1>
+<p>Generated {{date .Generated}}. A feed is healthy when its latest definite validation succeeded. Also available as <a href="countries.json">JSON</a>.</p>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Country</th><th>Healthy</th><th>Feeds</th><th>Healthy (%)</th><th>Lost in the last {{.LossDays}} days</th></tr>
+{{range .Countries}}<tr><td><a href="countries/{{lower .Country}}.html">{{.Name}}</a></td><td>{{.Healthy}}</td><td>{{.Total}}</td><td>{{percent .HealthyPercent}}</td><td>{{.RecentLosses}}</td></tr>
+{{end}}</table>
+</body></html>
+`))
+
+var countryPageHTML = template.Must(template.New("country").Funcs(dashboardFuncs).Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Feed health: {{.Name}}</title></head>
+<body style="font-family: sans-serif">
+<p><a href="../index.html">All countries</a></p>
+<h1>Feed health: {{.Name}}</h1>
+<p>{{.Healthy}} of {{.Total}} feeds healthy ({{percent .HealthyPercent}}%) as of {{date .Generated}}. Also available as <a href="{{lower .Country}}.json">JSON</a>.</p>
+{{with .Freshest}}<h2>Freshest sources</h2>
+<ul>{{range .}}<li><a href="{{.URL}}">{{.URL}}</a>, updated {{time .LastUpdate}}</li>{{end}}</ul>{{end}}
+{{with .RecentLosses}}<h2>Recent losses</h2>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Feed</th><th>Went down</th><th>Error</th></tr>
+{{range .}}<tr><td>{{.URL}}</td><td>{{date .At}}</td><td>{{.Message}}</td></tr>
+{{end}}</table>{{end}}
+<h2>All feeds</h2>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Feed</th><th>Status</th><th>Last update</th><th>Last checked</th><th>Available, 30 days (%)</th></tr>
+{{range .Feeds}}<tr><td><a href="{{.URL}}">{{.URL}}</a></td><td>{{.Status}}{{with .Message}} ({{.}}){{end}}</td><td>{{time .LastUpdate}}</td><td>{{time .LastChecked}}</td><td>{{percent .Availability30}}</td></tr>
+{{end}}</table>
+</body></html>
+`))
+
+// writeCountryDashboards writes index.html and countries.json to dir, and
+// an HTML and a JSON page per country to dir/countries.
+func writeCountryDashboards(dir string, pages []*countryDashboard, now time.Time) error {
+	if err := os.MkdirAll(filepath.Join(dir, "countries"), 0o755); err != nil {
+		return err
+	}
+	writeJSONFile := func(path string, v any) error {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, append(data, '\n'), 0o644)
+	}
+	writeHTMLFile := func(path string, tmpl *template.Template, data any) error {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		if err := tmpl.Execute(f, data); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	}
+
+	summaries := make([]countrySummary, 0, len(pages))
+	for _, page := range pages {
+		summaries = append(summaries, countrySummary{
+			Country: page.Country, Name: page.Name, Total: page.Total, Healthy: page.Healthy,
+			HealthyPercent: page.HealthyPercent, RecentLosses: len(page.RecentLosses),
+		})
+		base := filepath.Join(dir, "countries", strings.ToLower(page.Country))
+		if err := writeJSONFile(base+".json", page); err != nil {
+			return err
+		}
+		if err := writeHTMLFile(base+".html", countryPageHTML, page); err != nil {
+			return err
+		}
+	}
+	if err := writeJSONFile(filepath.Join(dir, "countries.json"), summaries); err != nil {
+		return err
+	}
+	return writeHTMLFile(filepath.Join(dir, "index.html"), countryIndexHTML, map[string]any{
+		"Generated": now, "LossDays": recentLossDays, "Countries": summaries,
+	})
+}
+
+func runCountryDashboard(args []string) int {
+	fs := flag.NewFlagSet("country-dashboard", flag.ExitOnError)
+	historyPath := fs.String("history", "history.db", "history database, or glob of run reports written by validation with --json")
+	outDir := fs.String("o", "site", "directory to write the dashboard to")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s country-dashboard [--history DB|GLOB] [-o DIR] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	reports, err := loadHistory(*historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+		return 1
+	}
+
+	now := time.Now()
+	pages := buildCountryDashboards(feeds, reports, now)
+	if err := writeCountryDashboards(*outDir, pages, now); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing dashboard: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Wrote dashboards for %d countries to %s\n", len(pages), *outDir)
+	return 0
+}
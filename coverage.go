@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// countryCoverage counts feeds for one country.
+type countryCoverage struct {
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Feeds int    `json:"feeds"`
+}
+
+// subRegionCoverage counts feeds for a UN sub-region.
+type subRegionCoverage struct {
+	Name      string             `json:"name"`
+	Feeds     int                `json:"feeds"`
+	Countries []*countryCoverage `json:"countries"`
+}
+
+// regionCoverage rolls up feed counts for a UN region.
+type regionCoverage struct {
+	Name       string               `json:"name"`
+	Feeds      int                  `json:"feeds"`
+	Countries  int                  `json:"countries"`
+	SubRegions []*subRegionCoverage `json:"sub_regions,omitempty"`
+}
+
+// buildCoverage aggregates feeds by region, sub-region and country. Regions
+// are returned in regionOrder; sub-regions and countries by descending feed
+// count.
+func buildCoverage(feeds []Feed) []*regionCoverage {
+	regions := make(map[string]*regionCoverage)
+	subRegions := make(map[unRegion]*subRegionCoverage)
+	countries := make(map[string]*countryCoverage)
+
+	for _, feed := range feeds {
+		r := regionForFeed(feed)
+		rc := regions[r.Region]
+		if rc == nil {
+			rc = &regionCoverage{Name: r.Region}
+			regions[r.Region] = rc
+		}
+		rc.Feeds++
+
+		sub := r.SubRegion
+		if sub == "" {
+			sub = "(region-wide)"
+		}
+		key := unRegion{r.Region, sub}
+		sc := subRegions[key]
+		if sc == nil {
+			sc = &subRegionCoverage{Name: sub}
+			subRegions[key] = sc
+			rc.SubRegions = append(rc.SubRegions, sc)
+		}
+		sc.Feeds++
+
+		c, ok := countryFromComments(feed.Comments)
+		if !ok {
+			continue
+		}
+		cc := countries[c.Alpha2]
+		if cc == nil {
+			cc = &countryCoverage{Code: c.Alpha2, Name: c.Name}
+			countries[c.Alpha2] = cc
+			sc.Countries = append(sc.Countries, cc)
+			rc.Countries++
+		}
+		cc.Feeds++
+	}
+
+	var ordered []*regionCoverage
+	for _, name := range regionOrder {
+		rc := regions[name]
+		if rc == nil {
+			continue
+		}
+		sort.Slice(rc.SubRegions, func(i, j int) bool { return rc.SubRegions[i].Feeds > rc.SubRegions[j].Feeds })
+		for _, sc := range rc.SubRegions {
+			sort.Slice(sc.Countries, func(i, j int) bool { return sc.Countries[i].Feeds > sc.Countries[j].Feeds })
+		}
+		ordered = append(ordered, rc)
+	}
+	return ordered
+}
+
+func runCoverage(args []string) int {
+	fs := flag.NewFlagSet("coverage", flag.ExitOnError)
+	format := fs.String("format", "text", "output format: text or json")
+	countries := fs.Bool("countries", false, "list individual countries under each sub-region")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s coverage [--format text|json] [--countries] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+
+	coverage := buildCoverage(feeds)
+
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(coverage); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing coverage: %v\n", err)
+			return 1
+		}
+	case "text":
+		fmt.Printf("Coverage by Region (%d feeds):\n", len(feeds))
+		for _, rc := range coverage {
+			fmt.Printf("\n%s: %d feeds, %d countries\n", rc.Name, rc.Feeds, rc.Countries)
+			for _, sc := range rc.SubRegions {
+				fmt.Printf("  %s: %d feeds\n", sc.Name, sc.Feeds)
+				if *countries {
+					for _, cc := range sc.Countries {
+						fmt.Printf("    %s (%s): %d\n", cc.Name, cc.Code, cc.Feeds)
+					}
+				}
+			}
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// feedCredential is an entry of the --credentials file: the basic-auth
+// credentials or headers sent with requests for the feeds Match covers.
+// Match is a host, covering its subdomains too, or, when it has a path, a
+// URL prefix such as https://example.com/members/.
+type feedCredential struct {
+	Match    string            `json:"match"`
+	Username string            `json:"username,omitempty"`
+	Password string            `json:"password,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	// prefix is Match parsed, when it is a URL prefix.
+	prefix *url.URL
+}
+
+// covers reports whether the credential applies to the feed at rawURL. A
+// URL prefix covers feeds with the same scheme and host whose path is its
+// path or below it, so https://example.com/members covers neither
+// https://example.com.attacker.example/ nor https://example.com/membership.
+func (c feedCredential) covers(rawURL string) bool {
+	u, err := url.Parse(validator.NormalizeURL(rawURL))
+	if err != nil {
+		return false
+	}
+	if c.prefix == nil {
+		host := strings.ToLower(u.Hostname())
+		return host == c.Match || strings.HasSuffix(host, "."+c.Match)
+	}
+	if !strings.EqualFold(u.Scheme, c.prefix.Scheme) || !strings.EqualFold(u.Host, c.prefix.Host) {
+		return false
+	}
+	dir := strings.TrimSuffix(c.prefix.Path, "/")
+	return u.Path == dir || strings.HasPrefix(u.Path, dir+"/")
+}
+
+// feedCredentials are the entries of a --credentials file, the first that
+// covers a feed applying to it.
+type feedCredentials []feedCredential
+
+// loadCredentials reads a JSON file of the form {"credentials": [...]},
+// warning when other users can read it.
+func loadCredentials(path string) (feedCredentials, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode().Perm()&0o077 != 0 {
+		fmt.Fprintf(os.Stderr, "Warning: %s can be read by other users; chmod 600 it\n", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Credentials feedCredentials `json:"credentials"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for i := range file.Credentials {
+		c := &file.Credentials[i]
+		if c.Match == "" {
+			return nil, fmt.Errorf("%s: credential %d has no match", path, i+1)
+		}
+		if c.Username == "" && c.Password == "" && len(c.Headers) == 0 {
+			return nil, fmt.Errorf("%s: credential for %s has no username, password or headers", path, c.Match)
+		}
+		if !strings.Contains(c.Match, "/") {
+			c.Match = strings.ToLower(strings.TrimPrefix(c.Match, "www."))
+			continue
+		}
+		prefix, err := url.Parse(c.Match)
+		if err != nil || prefix.Scheme == "" || prefix.Host == "" {
+			return nil, fmt.Errorf("%s: credential match %q is neither a host nor a URL prefix", path, c.Match)
+		}
+		c.prefix = prefix
+	}
+	return file.Credentials, nil
+}
+
+// hook returns a hook adding the credentials covering each feed to its
+// request.
+func (creds feedCredentials) hook() validator.Hook {
+	return validator.Hook{
+		BeforeFetch: func(ctx context.Context, feed validator.Feed, req *http.Request) error {
+			for _, c := range creds {
+				if !c.covers(feed.URL) {
+					continue
+				}
+				if c.Username != "" || c.Password != "" {
+					req.SetBasicAuth(c.Username, c.Password)
+				}
+				for name, value := range c.Headers {
+					req.Header.Set(name, value)
+				}
+				return nil
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCredentialCovers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	data := `{"credentials": [
+		{"match": "www.Example.com", "username": "u"},
+		{"match": "https://api.example.org/feeds/", "headers": {"X-API-Key": "k"}},
+		{"match": "https://api.example.net", "headers": {"X-API-Key": "k"}}
+	]}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	creds, err := loadCredentials(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, prefix, bare := creds[0], creds[1], creds[2]
+
+	tests := []struct {
+		name string
+		c    feedCredential
+		url  string
+		want bool
+	}{
+		{"host", host, "https://example.com/rss", true},
+		{"subdomain", host, "https://feeds.example.com/rss", true},
+		{"host suffix", host, "https://badexample.com/rss", false},
+		{"scheme-less", host, "example.com/rss", true},
+		{"prefix", prefix, "https://api.example.org/feeds/world", true},
+		{"prefix itself", prefix, "https://api.example.org/feeds", true},
+		{"prefix sibling path", prefix, "https://api.example.org/feedsx/world", false},
+		{"prefix other scheme", prefix, "http://api.example.org/feeds/world", false},
+		{"prefix host extended", bare, "https://api.example.net.attacker.example/rss", false},
+		{"prefix host with port", bare, "https://api.example.net:8443/rss", false},
+		{"prefix whole host", bare, "https://api.example.net/rss", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.covers(tt.url); got != tt.want {
+				t.Errorf("%s covers %s = %v, want %v", tt.c.Match, tt.url, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// catalogEntry is a source's country/language assignment in an external
+// catalog such as GDELT or MediaCloud.
+type catalogEntry struct {
+	Domain     string
+	RawCountry string
+	Country    Country
+	Language   string
+}
+
+// sourceCatalog indexes catalog entries by domain.
+type sourceCatalog struct {
+	Name    string
+	Entries map[string]catalogEntry
+}
+
+// lookup matches a feed URL by its exact host first and falls back to the
+// registrable domain, so "feeds.bbci.co.uk" matches a "bbci.co.uk" entry.
+func (c *sourceCatalog) lookup(feedURL string) (catalogEntry, bool) {
+	host := feedHost(feedURL)
+	if host == "" {
+		return catalogEntry{}, false
+	}
+	if e, ok := c.Entries[host]; ok {
+		return e, true
+	}
+	e, ok := c.Entries[registrableDomain(host)]
+	return e, ok
+}
+
+func (c *sourceCatalog) add(domain, country, language string) {
+	domain = feedHost("http://" + strings.TrimSpace(domain))
+	if domain == "" {
+		return
+	}
+	entry := catalogEntry{
+		Domain:     domain,
+		RawCountry: strings.TrimSpace(country),
+		Language:   normalizeLanguage(language),
+	}
+	entry.Country, _ = lookupCountry(entry.RawCountry)
+	c.Entries[domain] = entry
+}
+
+// loadGDELTCatalog reads GDELT's domains-by-country list, a tab-separated
+// file of domain, FIPS country code and country name. FIPS codes differ from
+// ISO codes, so countries are matched by name.
+func loadGDELTCatalog(path string) (*sourceCatalog, error) {
+	r, err := openSource(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	catalog := &sourceCatalog{Name: "gdelt", Entries: make(map[string]catalogEntry)}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) < 3 {
+			continue
+		}
+		catalog.add(fields[0], fields[2], "")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+// loadMediaCloudCatalog reads a MediaCloud sources CSV export. Columns are
+// located by header name since the export layout has changed over time.
+func loadMediaCloudCatalog(path string) (*sourceCatalog, error) {
+	r, err := openSource(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	// Newer exports carry the domain in "name", older ones a display name
+	// there and the site in "url"; prefer the most specific column present.
+	domainCol, urlCol, nameCol, countryCol, languageCol := -1, -1, -1, -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "domain":
+			domainCol = i
+		case "homepage", "url":
+			urlCol = i
+		case "name":
+			nameCol = i
+		case "pub_country", "country":
+			countryCol = i
+		case "primary_language", "language":
+			languageCol = i
+		}
+	}
+	if domainCol < 0 {
+		domainCol = urlCol
+	}
+	if domainCol < 0 {
+		domainCol = nameCol
+	}
+	if domainCol < 0 {
+		return nil, fmt.Errorf("no domain, name or url column in header")
+	}
+
+	catalog := &sourceCatalog{Name: "mediacloud", Entries: make(map[string]catalogEntry)}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			continue
+		}
+		var country, language string
+		if countryCol >= 0 && countryCol < len(record) {
+			country = record[countryCol]
+		}
+		if languageCol >= 0 && languageCol < len(record) {
+			language = record[languageCol]
+		}
+		if domainCol < len(record) {
+			domain := record[domainCol]
+			if strings.Contains(domain, "://") {
+				domain = feedHost(domain)
+			}
+			catalog.add(domain, country, language)
+		}
+	}
+	return catalog, nil
+}
+
+// normalizeLanguage reduces language tags such as "en-US" or "EN" to their
+// lowercase primary subtag.
+func normalizeLanguage(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "-_"); i > 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+func runCrossref(args []string) int {
+	fs := flag.NewFlagSet("crossref", flag.ExitOnError)
+	gdeltPath := fs.String("gdelt", "", "GDELT domains-by-country list (file or URL)")
+	mediaCloudPath := fs.String("mediacloud", "", "MediaCloud sources CSV export (file or URL)")
+	outPath := fs.String("o", "", "write matches that disagree with or add to ours as CSV")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s crossref [--gdelt FILE] [--mediacloud FILE] [-o OUT.csv] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	if *gdeltPath == "" && *mediaCloudPath == "" {
+		fs.Usage()
+		return 2
+	}
+
+	var catalogs []*sourceCatalog
+	if *gdeltPath != "" {
+		catalog, err := loadGDELTCatalog(*gdeltPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading GDELT catalog: %v\n", err)
+			return 1
+		}
+		catalogs = append(catalogs, catalog)
+	}
+	if *mediaCloudPath != "" {
+		catalog, err := loadMediaCloudCatalog(*mediaCloudPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading MediaCloud catalog: %v\n", err)
+			return 1
+		}
+		catalogs = append(catalogs, catalog)
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+
+	var out *csv.Writer
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outPath, err)
+			return 1
+		}
+		defer file.Close()
+		out = csv.NewWriter(file)
+		defer out.Flush()
+		out.Write([]string{"url", "comments", "language", "catalog", "catalog_domain", "catalog_country", "catalog_language", "issue"})
+	}
+
+	for _, catalog := range catalogs {
+		var matched, countryMismatches, languageMismatches, countryImports int
+		for _, feed := range feeds {
+			entry, ok := catalog.lookup(feed.URL)
+			if !ok {
+				continue
+			}
+			matched++
+
+			var issues []string
+			ours, known := countryFromComments(feed.Comments)
+			if entry.Country.Alpha2 != "" {
+				if !known {
+					countryImports++
+					issues = append(issues, "country-missing")
+				} else if ours.Alpha2 != entry.Country.Alpha2 {
+					countryMismatches++
+					issues = append(issues, "country-mismatch")
+					fmt.Printf("[Country] %s (ours: %s, %s: %s)\n", feed.URL, ours.Name, catalog.Name, entry.Country.Name)
+				}
+			}
+			if entry.Language != "" && normalizeLanguage(feed.Language) != entry.Language {
+				languageMismatches++
+				issues = append(issues, "language-mismatch")
+				fmt.Printf("[Language] %s (ours: %s, %s: %s)\n", feed.URL, feed.Language, catalog.Name, entry.Language)
+			}
+
+			if out != nil && len(issues) > 0 {
+				out.Write([]string{feed.URL, feed.Comments, feed.Language, catalog.Name, entry.Domain,
+					entry.Country.Alpha2, entry.Language, strings.Join(issues, ";")})
+			}
+		}
+
+		fmt.Printf("\n%s: %d of %d feeds matched (%d catalog domains)\n", catalog.Name, matched, len(feeds), len(catalog.Entries))
+		fmt.Printf("  Country disagreements: %d\n", countryMismatches)
+		fmt.Printf("  Language disagreements: %d\n", languageMismatches)
+		fmt.Printf("  Countries available for feeds without one: %d\n", countryImports)
+	}
+
+	return 0
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+// dashboardFiles is the web UI served by serve on /, built on the JSON API.
+//
+//go:embed web
+var dashboardFiles embed.FS
+
+func dashboardHandler() http.Handler {
+	root, err := fs.Sub(dashboardFiles, "web")
+	if err != nil {
+		panic(err)
+	}
+	return http.FileServerFS(root)
+}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// Feed is a single row of the curated dataset.
+type Feed = validator.Feed
+
+// loadFeeds reads the dataset CSV; see validator.ReadCSV.
+func loadFeeds(path string, hasHeader bool) ([]Feed, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	feeds, err := readFeeds(file, hasHeader)
+	for i := range feeds {
+		feeds[i].File = path
+	}
+	return feeds, err
+}
+
+// readFeeds parses dataset CSV content; see validator.ReadCSV. Malformed
+// rows are skipped with a warning.
+func readFeeds(r io.Reader, hasHeader bool) ([]Feed, error) {
+	feeds, err := validator.ReadCSV(r, hasHeader)
+	var skipped *validator.SkippedRowsError
+	if errors.As(err, &skipped) {
+		for _, row := range skipped.Rows {
+			fmt.Fprintf(os.Stderr, "Warning: Skipping %v\n", row)
+		}
+		err = nil
+	}
+	return feeds, err
+}
+
+// feedHost returns the lowercased hostname of a feed URL without a leading
+// "www." label.
+func feedHost(rawURL string) string {
+	u, err := url.Parse(validator.NormalizeURL(rawURL))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// registrableDomain returns the eTLD+1 of host (e.g. "bbc.co.uk" for
+// "feeds.bbc.co.uk"), or host itself when it cannot be determined.
+func registrableDomain(host string) string {
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
+
+// feedRecord formats a feed as a CSV record with the given columns.
+func feedRecord(feed Feed, columns []string) []string {
+	record := make([]string, len(columns))
+	for i, column := range columns {
+		switch column {
+		case "id":
+			record[i] = feed.ID
+		case "url":
+			record[i] = feed.URL
+		case "comments":
+			record[i] = feed.Comments
+		case "language":
+			record[i] = feed.Language
+		case "status":
+			record[i] = feed.Status
+		case "license":
+			record[i] = feed.License
+		case "paywall":
+			record[i] = feed.Paywall
+		case "tier":
+			if feed.Tier != validator.DefaultTier {
+				record[i] = fmt.Sprintf("tier%d", feed.Tier)
+			}
+		case "headers":
+			record[i] = feed.Headers
+		case "added_by":
+			record[i] = feed.AddedBy
+		case "added_date":
+			record[i] = feed.AddedDate
+		case "source":
+			record[i] = feed.Source
+		}
+	}
+	return record
+}
+
+// datasetHeaderColumns returns the dataset's lowercased column names, or the
+// default layout for files without a header.
+func datasetHeaderColumns(path string, hasHeader bool) ([]string, error) {
+	if !hasHeader {
+		return validator.DatasetColumns, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	reader := csv.NewReader(file)
+	reader.LazyQuotes = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = strings.ToLower(strings.TrimSpace(name))
+	}
+	return columns, nil
+}
+
+// provenanceColumns are added to the header by appendFeeds when missing.
+var provenanceColumns = []string{"id", "added_by", "added_date", "source"}
+
+// appendFeeds appends feeds as new rows of the dataset at path. The id and
+// provenance columns are added to the header if missing; existing rows are
+// left untouched since shorter rows read as empty trailing cells.
+func appendFeeds(path string, hasHeader bool, feeds []Feed) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) && hasHeader {
+		// A new file in a directory layout.
+		var b strings.Builder
+		w := csv.NewWriter(&b)
+		w.Write(append(slices.Clone(validator.DatasetColumns), provenanceColumns...))
+		w.Flush()
+		data, err = []byte(b.String()), nil
+	}
+	if err != nil {
+		return err
+	}
+	content := string(data)
+
+	columns := validator.DatasetColumns
+	if hasHeader {
+		headerLine, rest, _ := strings.Cut(content, "\n")
+		header, err := csv.NewReader(strings.NewReader(headerLine)).Read()
+		if err != nil {
+			return fmt.Errorf("reading header: %w", err)
+		}
+		columns = make([]string, len(header))
+		for i, name := range header {
+			columns[i] = strings.ToLower(strings.TrimSpace(name))
+		}
+		extended := false
+		for _, column := range provenanceColumns {
+			if !slices.Contains(columns, column) {
+				columns = append(columns, column)
+				header = append(header, column)
+				extended = true
+			}
+		}
+		if extended {
+			var b strings.Builder
+			w := csv.NewWriter(&b)
+			w.Write(header)
+			w.Flush()
+			eol := "\n"
+			if strings.HasSuffix(headerLine, "\r") {
+				eol = "\r\n"
+			}
+			content = strings.TrimSuffix(b.String(), "\n") + eol + rest
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(content)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		b.WriteString("\n")
+	}
+	w := csv.NewWriter(&b)
+	for _, feed := range feeds {
+		w.Write(feedRecord(feed, columns))
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// removeDatasetRows drops the rows whose URL is in urls from dataset content,
+// and returns the new content and the number of rows removed. Records are
+// parsed as CSV, so quoted fields spanning lines go with their row.
+func removeDatasetRows(data []byte, hasHeader bool, urls map[string]bool) ([]byte, int, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, 0, err
+	}
+	urlCol := 0
+	kept := records[:0]
+	for i, record := range records {
+		if i == 0 && hasHeader {
+			for j, name := range record {
+				if strings.ToLower(strings.TrimSpace(name)) == "url" {
+					urlCol = j
+				}
+			}
+		} else if urlCol < len(record) && urls[strings.TrimSpace(record[urlCol])] {
+			continue
+		}
+		kept = append(kept, record)
+	}
+	var out bytes.Buffer
+	w := csv.NewWriter(&out)
+	w.WriteAll(kept)
+	if err := w.Error(); err != nil {
+		return nil, 0, err
+	}
+	return out.Bytes(), len(records) - len(kept), nil
+}
+
+// updateDatasetColumn sets column to values[url] for each matching row of the
+// dataset at path, adding the column to the header if it is missing. Existing
+// non-empty cells are only replaced when overwrite is set. All other content
+// is preserved. It returns the number of rows changed.
+func updateDatasetColumn(path string, hasHeader bool, column string, values map[string]string, overwrite bool) (int, error) {
+	if !hasHeader {
+		return 0, fmt.Errorf("updating the %s column requires a header row", column)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	file.Close()
+	if err != nil {
+		return 0, err
+	}
+	if len(records) == 0 {
+		return 0, fmt.Errorf("%s is empty", path)
+	}
+
+	header := records[0]
+	col, urlCol := -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case column:
+			col = i
+		case "url":
+			urlCol = i
+		}
+	}
+	if urlCol < 0 {
+		return 0, fmt.Errorf("%s has no url column", path)
+	}
+	if col < 0 {
+		col = len(header)
+		records[0] = append(header, column)
+		for i := 1; i < len(records); i++ {
+			if len(records[i]) == col {
+				records[i] = append(records[i], "")
+			}
+		}
+	}
+
+	changed := 0
+	for i, record := range records[1:] {
+		if urlCol >= len(record) {
+			continue
+		}
+		value, ok := values[strings.TrimSpace(record[urlCol])]
+		if !ok {
+			continue
+		}
+		for len(record) <= col {
+			record = append(record, "")
+		}
+		records[i+1] = record
+		if record[col] == value || (record[col] != "" && !overwrite) {
+			continue
+		}
+		record[col] = value
+		changed++
+	}
+
+	tmp := path + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	w := csv.NewWriter(out)
+	w.WriteAll(records)
+	if err := w.Error(); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return 0, err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return changed, os.Rename(tmp, path)
+}
@@ -0,0 +1,26 @@
+package main
+
+import "testing"
+
+func TestRemoveDatasetRows(t *testing.T) {
+	data := "url,comments\n" +
+		"https://a.example/rss,\"Kept,\nover two lines\"\n" +
+		"https://b.example/rss,\"Removed,\nhttps://a.example/rss\"\n" +
+		"https://c.example/rss,Kept\n"
+	got, removed, err := removeDatasetRows([]byte(data), true, map[string]bool{"https://b.example/rss": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "url,comments\n" +
+		"https://a.example/rss,\"Kept,\nover two lines\"\n" +
+		"https://c.example/rss,Kept\n"
+	if string(got) != want || removed != 1 {
+		t.Errorf("removed %d rows, leaving\n%s\nwant 1, leaving\n%s", removed, got, want)
+	}
+
+	// Without a header the first column is the URL, and the first row can go.
+	got, removed, err = removeDatasetRows([]byte("https://b.example/rss\nhttps://c.example/rss\n"), false, map[string]bool{"https://b.example/rss": true})
+	if err != nil || string(got) != "https://c.example/rss\n" || removed != 1 {
+		t.Errorf("got %q, %d removed, %v", got, removed, err)
+	}
+}
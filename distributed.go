@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+const (
+	// workBatchSize is how many feeds the coordinator hands a worker at a
+	// time; workerBatches is how many batches each worker has in flight, so
+	// it isn't idle while the slowest feeds of a batch finish.
+	workBatchSize = 100
+	workerBatches = 2
+	// batchTimeout bounds one batch, long enough for every feed in it to
+	// exhaust its retries.
+	batchTimeout = 10 * time.Minute
+)
+
+// workItem is a feed as sent to a worker: just what validation needs,
+// including the Language and Comments its Accept-Language is built from.
+type workItem struct {
+	ID       string `json:"id"`
+	URL      string `json:"url"`
+	Tier     int    `json:"tier"`
+	Headers  string `json:"headers,omitempty"`
+	Language string `json:"language,omitempty"`
+	Comments string `json:"comments,omitempty"`
+}
+
+type workRequest struct {
+	Feeds []workItem `json:"feeds"`
+}
+
+// validateRemote shards feeds across worker processes (see runWorker),
+// calling fn with each result as it completes, like Validator.ValidateEach.
+// A worker that fails is dropped for the rest of the run and its unfinished
+// feeds go back on the queue; if every worker fails, the remaining feeds are
+// reported as transient errors. Feeds not yet handed out when ctx is
+// canceled are skipped.
+func validateRemote(ctx context.Context, feeds []Feed, workers []string, fn func(ValidationResult)) {
+	queue := make(chan []Feed, len(feeds)/workBatchSize+1)
+	var pending sync.WaitGroup
+	for start := 0; start < len(feeds); start += workBatchSize {
+		pending.Add(1)
+		queue <- feeds[start:min(start+workBatchSize, len(feeds))]
+	}
+	go func() {
+		pending.Wait()
+		close(queue)
+	}()
+
+	var mu sync.Mutex
+	emit := func(r ValidationResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		fn(r)
+	}
+	client := &http.Client{}
+	alive := len(workers) * workerBatches
+
+	var wg sync.WaitGroup
+	for _, worker := range workers {
+		for range workerBatches {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var failure error
+				for batch := range queue {
+					switch {
+					case ctx.Err() != nil:
+					case failure != nil:
+						for _, feed := range batch {
+							r := ValidationResult{ID: feed.ID, URL: feed.URL}
+							r.Fail(validator.StatusTransient, validator.NewError(validator.CodeFetch, failure, "No worker available: "+failure.Error()))
+							emit(r)
+						}
+					default:
+						done, err := postBatch(ctx, client, worker, batch, emit)
+						if err == nil || ctx.Err() != nil {
+							break
+						}
+						fmt.Fprintf(os.Stderr, "Error from worker %s: %v\n", worker, err)
+						var rest []Feed
+						for _, feed := range batch {
+							if done[feed.ID] > 0 {
+								done[feed.ID]--
+								continue
+							}
+							rest = append(rest, feed)
+						}
+						if len(rest) > 0 {
+							pending.Add(1)
+							queue <- rest
+						}
+						mu.Lock()
+						alive--
+						last := alive == 0
+						mu.Unlock()
+						// The last worker standing drains the queue so the
+						// run still ends.
+						if !last {
+							pending.Done()
+							return
+						}
+						failure = err
+					}
+					pending.Done()
+				}
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+// postBatch sends one batch to a worker and emits the results it streams
+// back, returning how many results it got for each feed ID. Results are
+// counted rather than IDs, as feeds without an ID, or sharing one, each
+// get a result of their own; a result for no feed left in the batch is an
+// error.
+func postBatch(ctx context.Context, client *http.Client, worker string, batch []Feed, emit func(ValidationResult)) (map[string]int, error) {
+	done := make(map[string]int, len(batch))
+	expected := make(map[string]int, len(batch))
+	req := workRequest{Feeds: make([]workItem, len(batch))}
+	for i, feed := range batch {
+		req.Feeds[i] = workItem{ID: feed.ID, URL: feed.URL, Tier: feed.Tier, Headers: feed.Headers, Language: feed.Language, Comments: feed.Comments}
+		expected[feed.ID]++
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return done, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, batchTimeout)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", strings.TrimSuffix(worker, "/")+"/validate", bytes.NewReader(body))
+	if err != nil {
+		return done, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if token := os.Getenv("WORKER_TOKEN"); token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return done, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return done, fmt.Errorf("HTTP status %d", resp.StatusCode)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	for n := 0; n < len(batch); n++ {
+		var r ValidationResult
+		if err := dec.Decode(&r); err != nil {
+			return done, fmt.Errorf("reading results after %d of %d feeds: %w", n, len(batch), err)
+		}
+		if done[r.ID] == expected[r.ID] {
+			return done, fmt.Errorf("unexpected result for feed %q", r.ID)
+		}
+		done[r.ID]++
+		emit(r)
+	}
+	return done, nil
+}
+
+// hasBearer reports whether r carries token as its bearer token.
+func hasBearer(r *http.Request, token string) bool {
+	return isBearer(r.Header.Get("Authorization"), token)
+}
+
+// isBearer reports whether an Authorization value carries token.
+func isBearer(auth, token string) bool {
+	got := strings.TrimPrefix(auth, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
+
+// handleValidate returns a handler validating batches of feeds with v for a
+// coordinator, streaming each result as a line of JSON as it completes.
+func handleValidate(v *validator.Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if token := os.Getenv("WORKER_TOKEN"); token != "" && !hasBearer(r, token) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var req workRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(req.Feeds) > maxBatchFeeds {
+			http.Error(w, fmt.Sprintf("at most %d feeds per batch", maxBatchFeeds), http.StatusBadRequest)
+			return
+		}
+		feeds := make([]Feed, len(req.Feeds))
+		for i, item := range req.Feeds {
+			if _, ok := validator.TierPolicies[item.Tier]; !ok {
+				item.Tier = validator.DefaultTier
+			}
+			feeds[i] = Feed{ID: item.ID, URL: item.URL, Tier: item.Tier, Headers: item.Headers, Language: item.Language, Comments: item.Comments}
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		flusher, _ := w.(http.Flusher)
+		// Results carry the schema_version, as in the ndjson report.
+		report := validator.NewNDJSONReporter(w)
+		v.ValidateEach(r.Context(), feeds, func(result ValidationResult) {
+			report.Result(result)
+			if flusher != nil {
+				flusher.Flush()
+			}
+		})
+	}
+}
+
+func runWorker(args []string) int {
+	fs := flag.NewFlagSet("worker", flag.ExitOnError)
+	addr := fs.String("addr", ":8081", "address to accept batches from a coordinator on")
+	validation := addValidatorFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s worker [--addr :8081]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Validates batches of feeds sent by runs and serve with --workers. Set WORKER_TOKEN on both sides to require it.\n")
+		fs.PrintDefaults()
+	}
+
+	if _, err := parseArgs(fs, args); err != nil {
+		return 2
+	}
+
+	validatorOptions, err := validation.options()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	shutdownTracing, err := setupTracing(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error setting up tracing: %v\n", err)
+		return 1
+	}
+	defer shutdownTracing(context.Background())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) { fmt.Fprintln(w, "ok") })
+	mux.HandleFunc("POST /validate", handleValidate(newValidator(nil, nil, validatorOptions...)))
+	server := &http.Server{Addr: *addr, Handler: mux}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- server.ListenAndServe() }()
+	fmt.Printf("Worker listening on %s\n", *addr)
+
+	select {
+	case err := <-serveErr:
+		fmt.Fprintf(os.Stderr, "Error serving: %v\n", err)
+		return 1
+	case <-ctx.Done():
+		// Batches in progress are finished, so the coordinator gets their
+		// results rather than retrying them elsewhere.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), batchTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Fprintf(os.Stderr, "Error shutting down: %v\n", err)
+		}
+		return 0
+	}
+}
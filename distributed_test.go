@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+func TestPostBatch(t *testing.T) {
+	// The worker answers the first n feeds of each batch, echoing their
+	// IDs, or with extra, one result too many.
+	worker := func(n int, extra bool) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req workRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+				return
+			}
+			enc := json.NewEncoder(w)
+			for _, item := range req.Feeds[:min(n, len(req.Feeds))] {
+				enc.Encode(ValidationResult{ID: item.ID, URL: item.URL, Status: "valid"})
+			}
+			if extra {
+				enc.Encode(ValidationResult{ID: "stray", Status: "valid"})
+			}
+		}))
+	}
+	batch := []Feed{{ID: "a", URL: "https://a.example/rss"}, {ID: "a", URL: "https://a.example/atom"}, {URL: "https://b.example/rss"}, {URL: "https://c.example/rss"}}
+
+	tests := []struct {
+		name     string
+		answered int
+		extra    bool
+		wantDone map[string]int
+		wantErr  bool
+	}{
+		{"all, with shared and missing IDs", 4, false, map[string]int{"a": 2, "": 2}, false},
+		{"cut short", 3, false, map[string]int{"a": 2, "": 1}, true},
+		{"result for another feed", 2, true, map[string]int{"a": 2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := worker(tt.answered, tt.extra)
+			defer srv.Close()
+			emitted := 0
+			done, err := postBatch(context.Background(), srv.Client(), srv.URL, batch, func(ValidationResult) { emitted++ })
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error %v, want one: %v", err, tt.wantErr)
+			}
+			if fmt.Sprint(done) != fmt.Sprint(tt.wantDone) {
+				t.Errorf("done %v, want %v", done, tt.wantDone)
+			}
+			if emitted != min(tt.answered, len(batch)) {
+				t.Errorf("emitted %d results, want %d", emitted, tt.answered)
+			}
+		})
+	}
+}
+
+func TestWorkerFeedFields(t *testing.T) {
+	// The worker's fetches record the headers they were sent with.
+	var mu sync.Mutex
+	sent := make(map[string]http.Header)
+	v := validator.NewValidator(validator.WithRetries(0), validator.WithAcceptLanguage(acceptLanguage), validator.WithFetcher(validator.FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		sent[req.URL.String()] = req.Header.Clone()
+		mu.Unlock()
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	})))
+	srv := httptest.NewServer(handleValidate(v))
+	defer srv.Close()
+
+	feed := Feed{ID: "a", URL: "https://a.example/rss", Headers: "Referer: https://a.example/", Language: "fr", Comments: "Canada"}
+	if _, err := postBatch(context.Background(), srv.Client(), srv.URL, []Feed{feed}, func(ValidationResult) {}); err != nil {
+		t.Fatal(err)
+	}
+	header, ok := sent[feed.URL]
+	if !ok {
+		t.Fatalf("worker didn't fetch %s", feed.URL)
+	}
+	if got := header.Get("Referer"); got != "https://a.example/" {
+		t.Errorf("Referer %q, want https://a.example/", got)
+	}
+	if got, want := header.Get("Accept-Language"), acceptLanguage(feed); got != want {
+		t.Errorf("Accept-Language %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// aggregatorHosts serve feeds for many unrelated outlets or queries, so
+// sharing one of these hosts says nothing about sharing a publisher.
+var aggregatorHosts = map[string]bool{
+	"news.google.com": true,
+}
+
+// sharedFeedHosts host feeds on behalf of many publishers under a per-feed
+// path prefix, e.g. feeds.feedburner.com/<name>.
+var sharedFeedHosts = map[string]bool{
+	"feeds.feedburner.com": true,
+	"feedproxy.google.com": true,
+	"rss.app":              true,
+}
+
+// outletKey identifies the outlet behind a feed: its Wikidata publisher when
+// the cache resolved one, so bbc.com and bbc.co.uk group together, and its
+// registrable domain otherwise. It returns "" for aggregator feeds.
+func outletKey(feed Feed, publishers wikidataCache) string {
+	host := feedHost(feed.URL)
+	if host == "" || aggregatorHosts[host] {
+		return ""
+	}
+	if p, ok := publishers.publisherFor(feed.URL); ok {
+		return p.WikidataID
+	}
+	if sharedFeedHosts[host] {
+		u, err := url.Parse(feed.URL)
+		if err != nil {
+			return host
+		}
+		first, _, _ := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+		return host + "/" + strings.ToLower(first)
+	}
+	return registrableDomain(host)
+}
+
+// feedCountry is the name of the country a feed is assigned to, or "" when
+// its comments name no single country.
+func feedCountry(feed Feed) string {
+	if c, ok := countryFromComments(feed.Comments); ok {
+		return c.Name
+	}
+	return ""
+}
+
+// outletDuplicate is an outlet with feeds assigned to more than one country.
+type outletDuplicate struct {
+	Outlet    string
+	Publisher string
+	Countries []string
+	Feeds     []Feed
+	// SameURL lists URLs that appear in more than one row, which is almost
+	// always accidental, as opposed to distinct editions of one outlet.
+	SameURL map[string]bool
+}
+
+// findCrossCountryDuplicates groups feeds by outlet and returns the outlets
+// whose feeds span more than one country, most countries first.
+func findCrossCountryDuplicates(feeds []Feed, publishers wikidataCache) []*outletDuplicate {
+	groups := make(map[string]*outletDuplicate)
+	var order []string
+	for _, feed := range feeds {
+		key := outletKey(feed, publishers)
+		if key == "" {
+			continue
+		}
+		g := groups[key]
+		if g == nil {
+			g = &outletDuplicate{Outlet: key, SameURL: make(map[string]bool)}
+			if p, ok := publishers.publisherFor(feed.URL); ok {
+				g.Outlet = registrableDomain(feedHost(feed.URL))
+				g.Publisher = p.Name
+			}
+			groups[key] = g
+			order = append(order, key)
+		}
+		g.Feeds = append(g.Feeds, feed)
+	}
+
+	var duplicates []*outletDuplicate
+	for _, key := range order {
+		g := groups[key]
+		seenCountry := make(map[string]bool)
+		seenURL := make(map[string]bool)
+		for _, feed := range g.Feeds {
+			if country := feedCountry(feed); country != "" && !seenCountry[country] {
+				seenCountry[country] = true
+				g.Countries = append(g.Countries, country)
+			}
+			if seenURL[feed.URL] {
+				g.SameURL[feed.URL] = true
+			}
+			seenURL[feed.URL] = true
+		}
+		if len(g.Countries) > 1 {
+			sort.Strings(g.Countries)
+			duplicates = append(duplicates, g)
+		}
+	}
+	sort.SliceStable(duplicates, func(i, j int) bool { return len(duplicates[i].Countries) > len(duplicates[j].Countries) })
+	return duplicates
+}
+
+func runDuplicates(args []string) int {
+	fs := flag.NewFlagSet("duplicates", flag.ExitOnError)
+	format := fs.String("format", "text", "output format: text or csv")
+	cachePath := fs.String("wikidata-cache", "wikidata-cache.json", "publisher cache written by the wikidata command")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s duplicates [--format text|csv] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+
+	publishers, err := loadWikidataCache(*cachePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading Wikidata cache: %v\n", err)
+		return 1
+	}
+
+	duplicates := findCrossCountryDuplicates(feeds, publishers)
+
+	switch *format {
+	case "csv":
+		out := csv.NewWriter(os.Stdout)
+		out.Write([]string{"outlet", "publisher", "country", "comments", "url", "position", "same_url"})
+		for _, d := range duplicates {
+			for _, feed := range d.Feeds {
+				out.Write([]string{d.Outlet, d.Publisher, feedCountry(feed), feed.Comments, feed.URL, feed.Position(), strconv.FormatBool(d.SameURL[feed.URL])})
+			}
+		}
+		out.Flush()
+		if err := out.Error(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing duplicates: %v\n", err)
+			return 1
+		}
+	case "text":
+		fmt.Printf("Outlets Listed Under Multiple Countries (%d):\n", len(duplicates))
+		for _, d := range duplicates {
+			name := d.Outlet
+			if d.Publisher != "" {
+				name += " (" + d.Publisher + ")"
+			}
+			fmt.Printf("\n%s: %d feeds in %s\n", name, len(d.Feeds), strings.Join(d.Countries, ", "))
+			for _, feed := range d.Feeds {
+				marker := ""
+				if d.SameURL[feed.URL] {
+					marker = " [Duplicate URL]"
+				}
+				location := feedCountry(feed)
+				if location == "" {
+					location = fmt.Sprintf("%q", feed.Comments)
+				}
+				fmt.Printf("  %s  %s  %s%s\n", feed.Position(), location, feed.URL, marker)
+			}
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	return 0
+}
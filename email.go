@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"strings"
+	"time"
+)
+
+// markdown renders the full summary, naming every changed feed, for email.
+func (s runSummary) markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Feed validation: %s\n\n", s.Dataset)
+	fmt.Fprintf(&b, "- Valid: %d\n- Invalid: %d\n- Transient errors: %d\n", s.Valid, s.Invalid, s.Transient)
+	if s.Skipped > 0 {
+		fmt.Fprintf(&b, "- Not due for their tier: %d\n", s.Skipped)
+	}
+	if len(s.Alerts) > 0 {
+		fmt.Fprintf(&b, "\n## Alerts (%d)\n\n", len(s.Alerts))
+		for _, a := range s.Alerts {
+			fmt.Fprintf(&b, "- %s: %s\n", a.Rule, a.Message)
+		}
+	}
+	if len(s.Changes.Died) > 0 {
+		fmt.Fprintf(&b, "\n## Newly broken (%d)\n\n| Feed | Error |\n| --- | --- |\n", len(s.Changes.Died))
+		for _, r := range s.Changes.Died {
+			fmt.Fprintf(&b, "| %s | %s |\n", r.URL, strings.ReplaceAll(r.Message, "|", "\\|"))
+		}
+	}
+	if len(s.Changes.Recovered) > 0 {
+		fmt.Fprintf(&b, "\n## Recovered (%d)\n\n", len(s.Changes.Recovered))
+		for _, r := range s.Changes.Recovered {
+			fmt.Fprintf(&b, "- %s\n", r.URL)
+		}
+	}
+	if len(s.Changes.Flaps) > 0 {
+		fmt.Fprintf(&b, "\n## Flapping\n\n")
+		for _, c := range s.Changes.Flaps {
+			fmt.Fprintf(&b, "- %s\n", c)
+		}
+	}
+	return b.String()
+}
+
+var summaryHTML = template.Must(template.New("summary").Parse(`<!DOCTYPE html>
+<html><body style="font-family: sans-serif">
+<h1>Feed validation: {{.Dataset}}</h1>
+<ul>
+<li>Valid: {{.Valid}}</li>
+<li>Invalid: {{.Invalid}}</li>
+<li>Transient errors: {{.Transient}}</li>
+{{if .Skipped}}<li>Not due for their tier: {{.Skipped}}</li>{{end}}
+</ul>
+{{with .Alerts}}<h2>Alerts ({{len .}})</h2>
+<ul>{{range .}}<li>{{.Rule}}: {{.Message}}</li>{{end}}</ul>{{end}}
+{{with .Changes.Died}}<h2>Newly broken ({{len .}})</h2>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Feed</th><th>Error</th></tr>
+{{range .}}<tr><td><a href="{{.URL}}">{{.URL}}</a></td><td>{{.Message}}</td></tr>
+{{end}}</table>{{end}}
+{{with .Changes.Recovered}}<h2>Recovered ({{len .}})</h2>
+<ul>{{range .}}<li>{{.URL}}</li>{{end}}</ul>{{end}}
+{{with .Changes.Flaps}}<h2>Flapping</h2>
+<ul>{{range .}}<li>{{.String}}</li>{{end}}</ul>{{end}}
+</body></html>
+`))
+
+// emailNotifier mails run summaries through the SMTP server in SMTP_ADDR
+// (host:port), authenticating with SMTP_USERNAME and SMTP_PASSWORD when set.
+// net/smtp upgrades to TLS when the server offers STARTTLS.
+type emailNotifier struct {
+	addr, from   string
+	to           []string
+	onRegression bool
+	auth         smtp.Auth
+}
+
+func newEmailNotifier(from string, to []string, onRegression bool) (*emailNotifier, error) {
+	if len(to) == 0 {
+		return nil, nil
+	}
+	addr := os.Getenv("SMTP_ADDR")
+	if addr == "" || from == "" {
+		return nil, fmt.Errorf("emailing requires SMTP_ADDR and --email-from")
+	}
+	n := &emailNotifier{addr: addr, from: from, to: to, onRegression: onRegression}
+	if user := os.Getenv("SMTP_USERNAME"); user != "" {
+		host, _, _ := strings.Cut(addr, ":")
+		n.auth = smtp.PlainAuth("", user, os.Getenv("SMTP_PASSWORD"), host)
+	}
+	return n, nil
+}
+
+// notify sends the summary as a multipart message with Markdown and HTML
+// alternatives. With onRegression, runs where no feed newly broke and no
+// alert rule is violated are not mailed.
+func (n *emailNotifier) notify(s runSummary) error {
+	if n.onRegression && len(s.Changes.Died) == 0 && len(s.Alerts) == 0 {
+		return nil
+	}
+	var html bytes.Buffer
+	if err := summaryHTML.Execute(&html, s); err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", s.markdown()},
+		{"text/html; charset=utf-8", html.String()},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return err
+		}
+		qp := quotedprintable.NewWriter(w)
+		qp.Write([]byte(part.content))
+		qp.Close()
+	}
+	mw.Close()
+
+	subject := mime.QEncoding.Encode("utf-8", fmt.Sprintf("Feed validation: %d newly broken, %d invalid (%s)", len(s.Changes.Died), s.Invalid, s.Dataset))
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nMIME-Version: 1.0\r\nContent-Type: multipart/alternative; boundary=%s\r\n\r\n",
+		n.from, strings.Join(n.to, ", "), subject, time.Now().Format(time.RFC1123Z), mw.Boundary())
+	msg.Write(body.Bytes())
+	return smtp.SendMail(n.addr, n.auth, n.from, n.to, msg.Bytes())
+}
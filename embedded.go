@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// embeddedDataset is the dataset as of the build, so the binary alone is a
+// usable copy of the curated sources.
+//
+//go:embed feeds.csv
+var embeddedDataset []byte
+
+// embeddedDatasetName stands for the embedded dataset where a dataset spec
+// is expected.
+const embeddedDatasetName = "embedded"
+
+// loadListedDataset loads spec, or the embedded dataset for
+// embeddedDatasetName.
+func loadListedDataset(spec string) ([]Feed, error) {
+	if spec != embeddedDatasetName {
+		return loadDataset(spec, true)
+	}
+	feeds, err := readFeeds(bytes.NewReader(embeddedDataset), true)
+	for i := range feeds {
+		feeds[i].File = embeddedDatasetName
+	}
+	return feeds, err
+}
+
+// writeListText prints records as an aligned table for reading.
+func writeListText(w io.Writer, records []exportRecord) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "COUNTRY\tLANGUAGE\tURL\tCOMMENTS")
+	for _, r := range records {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.Country, r.Language, r.URL, r.Comments)
+	}
+	return tw.Flush()
+}
+
+func runList(args []string) int {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	country := fs.String("country", "", "only feeds covering this country (name or ISO code)")
+	language := fs.String("language", "", "only feeds in this language (e.g. en)")
+	format := fs.String("format", "text", "output format: text, json or csv")
+	datasetSpec := fs.String("dataset", embeddedDatasetName, "list this dataset instead of the one built into the program")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s list [--country CC] [--language LANG] [--format text|json|csv] [--dataset feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) > 0 {
+		fs.Usage()
+		return 2
+	}
+	write, ok := map[string]func(io.Writer, []exportRecord) error{
+		"text": writeListText,
+		"json": writeExportJSON,
+		"csv":  writeExportCSV,
+	}[*format]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	var want Country
+	if *country != "" {
+		c := *country
+		if len(c) == 2 {
+			c = strings.ToUpper(c)
+		}
+		if want, ok = lookupCountry(c); !ok {
+			fmt.Fprintf(os.Stderr, "Unknown country %q\n", *country)
+			return 2
+		}
+	}
+
+	feeds, err := loadListedDataset(*datasetSpec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", *datasetSpec, err)
+		return 1
+	}
+	var records []exportRecord
+	for _, r := range buildExportRecords(feeds, nil, nil) {
+		if *country != "" && r.Country != want.Alpha2 {
+			continue
+		}
+		if *language != "" && !strings.EqualFold(strings.TrimSpace(r.Language), *language) {
+			continue
+		}
+		records = append(records, r)
+	}
+	if err := write(os.Stdout, records); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func runGet(args []string) int {
+	fs := flag.NewFlagSet("get", flag.ExitOnError)
+	datasetSpec := fs.String("dataset", embeddedDatasetName, "look in this dataset instead of the one built into the program")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s get [--dataset feeds.csv] URL|ID\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Prints the feed's row as JSON. URLs match regardless of scheme, \"www.\" and trailing slash.\n")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return 2
+	}
+	key := strings.TrimSpace(positional[0])
+
+	feeds, err := loadListedDataset(*datasetSpec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", *datasetSpec, err)
+		return 1
+	}
+	for _, r := range buildExportRecords(feeds, nil, nil) {
+		if r.ID == key || validator.CanonicalURL(r.URL) == validator.CanonicalURL(key) {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(r); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return 1
+			}
+			return 0
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%s is not in the dataset\n", key)
+	return 1
+}
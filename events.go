@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// eventBuffer is how many events a slow subscriber may fall behind by
+// before further events are dropped for it.
+const eventBuffer = 256
+
+// eventKeepalive is how often an idle event stream gets a comment line, so
+// proxies don't time it out between runs.
+const eventKeepalive = 30 * time.Second
+
+// progressEvent is one server-sent event: run_started, result or
+// run_finished. Every event carries the run's progress so far, so a client
+// joining mid-run can render it immediately.
+type progressEvent struct {
+	SchemaVersion string            `json:"schema_version"`
+	Type          string            `json:"type"`
+	Input         string            `json:"input"`
+	StartedAt     time.Time         `json:"started_at"`
+	Feeds         int               `json:"feeds"`
+	Done          int               `json:"done"`
+	Counts        map[string]int    `json:"counts"`
+	Result        *ValidationResult `json:"result,omitempty"`
+}
+
+// eventHub fans validation progress out to Server-Sent Events clients on
+// /events.
+type eventHub struct {
+	mu          sync.Mutex
+	subscribers map[chan progressEvent]bool
+	closed      bool
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{subscribers: make(map[chan progressEvent]bool)}
+}
+
+func (h *eventHub) subscribe() chan progressEvent {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ch := make(chan progressEvent, eventBuffer)
+	if h.closed {
+		close(ch)
+		return ch
+	}
+	h.subscribers[ch] = true
+	return ch
+}
+
+func (h *eventHub) unsubscribe(ch chan progressEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[ch] {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+}
+
+func (h *eventHub) publish(e progressEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// close ends every stream, letting the HTTP server shut down.
+func (h *eventHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for ch := range h.subscribers {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+}
+
+func (h *eventHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	ch := h.subscribe()
+	defer h.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepalive := time.NewTicker(eventKeepalive)
+	defer keepalive.Stop()
+	for {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return
+			}
+			data, _ := json.Marshal(e)
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+		case <-keepalive.C:
+			fmt.Fprintf(w, ": keepalive\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// runProgress publishes a run's progress to a hub, and its results to a
+// --pubsub sink. A nil *runProgress publishes nothing, for runs with
+// neither.
+type runProgress struct {
+	hub   *eventHub
+	sink  *resultSink
+	event progressEvent
+}
+
+// startRun publishes run_started for a run validating feeds feeds. Either
+// hub or sink may be nil.
+func startRun(hub *eventHub, sink *resultSink, input string, startedAt time.Time, feeds int) *runProgress {
+	if hub == nil && sink == nil {
+		return nil
+	}
+	p := &runProgress{hub: hub, sink: sink, event: progressEvent{SchemaVersion: validator.SchemaVersion, Input: input, StartedAt: startedAt, Feeds: feeds, Counts: make(map[string]int)}}
+	p.send("run_started", nil)
+	return p
+}
+
+// add publishes a result. It is called from one goroutine at a time, as by
+// Validator.ValidateEach.
+func (p *runProgress) add(r ValidationResult) {
+	if p == nil {
+		return
+	}
+	p.event.Done++
+	p.event.Counts[string(r.Status)]++
+	p.send("result", &r)
+	p.sink.result(p.event.Input, p.event.StartedAt, r)
+}
+
+func (p *runProgress) finish() {
+	if p == nil {
+		return
+	}
+	p.send("run_finished", nil)
+}
+
+func (p *runProgress) send(kind string, r *ValidationResult) {
+	if p.hub == nil {
+		return
+	}
+	e := p.event
+	e.Type, e.Result = kind, r
+	// Counts keeps changing after publishing, so each event gets a copy.
+	e.Counts = maps.Clone(p.event.Counts)
+	p.hub.publish(e)
+}
+
+// serveEvents serves a hub's stream on addr's /events for the length of a
+// one-shot run. stop ends open streams and shuts the server down.
+func serveEvents(addr string) (hub *eventHub, stop func(), err error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	hub = newEventHub()
+	mux := http.NewServeMux()
+	mux.Handle("GET /events", hub)
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	return hub, func() {
+		hub.close()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	}, nil
+}
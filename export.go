@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportRecord is a dataset row enriched with derived and cached metadata.
+type exportRecord struct {
+	ID        string     `json:"id"`
+	URL       string     `json:"url"`
+	Comments  string     `json:"comments"`
+	Language  string     `json:"language"`
+	Status    string     `json:"status"`
+	License   string     `json:"license,omitempty"`
+	Paywall   string     `json:"paywall,omitempty"`
+	Tier      int        `json:"tier"`
+	Country   string     `json:"country,omitempty"`
+	AddedBy   string     `json:"added_by,omitempty"`
+	AddedDate string     `json:"added_date,omitempty"`
+	Source    string     `json:"source,omitempty"`
+	Publisher *Publisher `json:"publisher,omitempty"`
+	// Uptime is the feed's availability and freshness, when exporting with
+	// --history.
+	Uptime []uptimeWindow `json:"uptime,omitempty"`
+}
+
+// buildExportRecords enriches feeds with their inferred country and, when a
+// cache or uptimes are given, their Wikidata publisher and uptime.
+func buildExportRecords(feeds []Feed, publishers wikidataCache, uptimes map[string][]uptimeWindow) []exportRecord {
+	records := make([]exportRecord, 0, len(feeds))
+	for _, feed := range feeds {
+		rec := exportRecord{
+			ID:        feed.ID,
+			URL:       feed.URL,
+			Comments:  feed.Comments,
+			Language:  feed.Language,
+			Status:    feed.Status,
+			License:   feed.License,
+			Paywall:   feed.Paywall,
+			Tier:      feed.Tier,
+			AddedBy:   feed.AddedBy,
+			AddedDate: feed.AddedDate,
+			Source:    feed.Source,
+			Uptime:    uptimes[feed.URL],
+		}
+		if c, ok := countryFromComments(feed.Comments); ok {
+			rec.Country = c.Alpha2
+		}
+		if p, ok := publishers.publisherFor(feed.URL); ok {
+			rec.Publisher = &p
+		}
+		records = append(records, rec)
+	}
+	return records
+}
+
+func writeExportCSV(w io.Writer, records []exportRecord) error {
+	out := csv.NewWriter(w)
+	out.Write(append([]string{"id", "url", "comments", "language", "status", "license", "paywall", "tier", "country", "added_by", "added_date", "source", "wikidata_id", "publisher", "owner", "founded"}, uptimeColumns()...))
+	for _, r := range records {
+		var p Publisher
+		if r.Publisher != nil {
+			p = *r.Publisher
+		}
+		out.Write(append([]string{r.ID, r.URL, r.Comments, r.Language, r.Status, r.License, r.Paywall, strconv.Itoa(r.Tier), r.Country, r.AddedBy, r.AddedDate, r.Source, p.WikidataID, p.Name, p.Owner, p.Founded}, uptimeCells(r.Uptime)...))
+	}
+	out.Flush()
+	return out.Error()
+}
+
+func writeExportJSON(w io.Writer, records []exportRecord) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+// writeExportGo writes records as the Go source of pkg/data, whose Feed has
+// the fields of a record that come from the dataset.
+func writeExportGo(w io.Writer, records []exportRecord) error {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by \"export --format go\"; DO NOT EDIT.\n\npackage curatedworldnews\n\nvar feeds = []Feed{\n")
+	for _, r := range records {
+		var fields []string
+		for _, f := range []struct{ name, value string }{
+			{"ID", r.ID}, {"URL", r.URL}, {"Comments", r.Comments}, {"Language", r.Language}, {"Status", r.Status},
+			{"License", r.License}, {"Paywall", r.Paywall}, {"Country", r.Country},
+			{"AddedBy", r.AddedBy}, {"AddedDate", r.AddedDate}, {"Source", r.Source},
+		} {
+			if f.value != "" {
+				fields = append(fields, fmt.Sprintf("%s: %q", f.name, f.value))
+			}
+		}
+		fields = append(fields, fmt.Sprintf("Tier: %d", r.Tier))
+		fmt.Fprintf(&b, "\t{%s},\n", strings.Join(fields, ", "))
+	}
+	b.WriteString("}\n")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+func runExport(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "json", "output format: json, csv, or go for the source of pkg/data")
+	outPath := fs.String("o", "", "output file (default stdout)")
+	cachePath := fs.String("wikidata-cache", "wikidata-cache.json", "publisher cache written by the wikidata command")
+	historyPath := fs.String("history", "", "include uptime computed from this history database or glob of run reports")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s export [--format json|csv|go] [--history DB|GLOB] [-o FILE] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	var write func(io.Writer, []exportRecord) error
+	switch *format {
+	case "json":
+		write = writeExportJSON
+	case "csv":
+		write = writeExportCSV
+	case "go":
+		write = writeExportGo
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+
+	publishers, err := loadWikidataCache(*cachePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading Wikidata cache: %v\n", err)
+		return 1
+	}
+
+	var uptimes map[string][]uptimeWindow
+	if *historyPath != "" {
+		reports, err := loadHistory(*historyPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+			return 1
+		}
+		uptimes = feedUptimes(feeds, reports, time.Now())
+	}
+
+	var w io.Writer = os.Stdout
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outPath, err)
+			return 1
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := write(w, buildExportRecords(feeds, publishers, uptimes)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing export: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// A feed is flapping once its definite status has changed flapThreshold
+// times within flapWindow, and stops flapping after flapQuiet without a
+// change. While flapping, its failures and recoveries are not reported
+// individually.
+const (
+	flapWindow    = 14 * 24 * time.Hour
+	flapThreshold = 4
+	flapQuiet     = 3 * 24 * time.Hour
+)
+
+// flapChange is a feed starting or stopping to flap.
+type flapChange struct {
+	Result   ValidationResult
+	Flapping bool
+	// Changes is the number of status changes within flapWindow.
+	Changes int
+	Since   time.Time
+}
+
+func (c flapChange) String() string {
+	if c.Flapping {
+		return fmt.Sprintf("[Flapping] %s (%d status changes in %d days, now %s)", c.Result.URL, c.Changes, int(flapWindow.Hours()/24), c.Result.Status)
+	}
+	return fmt.Sprintf("[Stable] %s (%s, flapping since %s)", c.Result.URL, c.Result.Status, c.Since.Format("2006-01-02"))
+}
+
+// noteStatus records a run's outcome for flap detection and reports whether
+// the feed started or stopped flapping. Transient errors are not status
+// changes.
+func (fs *feedState) noteStatus(r ValidationResult, now time.Time) (flapChange, bool) {
+	if string(r.Status) != "transient" && fs.LastStatus != "" && fs.LastStatus != string(r.Status) {
+		fs.StatusChanges = append(fs.StatusChanges, now)
+	}
+	for len(fs.StatusChanges) > 0 && now.Sub(fs.StatusChanges[0]) > flapWindow {
+		fs.StatusChanges = fs.StatusChanges[1:]
+	}
+
+	change := flapChange{Result: r, Changes: len(fs.StatusChanges), Since: fs.FlappingSince}
+	switch {
+	case !fs.Flapping && len(fs.StatusChanges) >= flapThreshold:
+		fs.Flapping, fs.FlappingSince = true, now
+		change.Flapping, change.Since = true, now
+		return change, true
+	case fs.Flapping && (len(fs.StatusChanges) == 0 || now.Sub(fs.StatusChanges[len(fs.StatusChanges)-1]) >= flapQuiet):
+		fs.Flapping, fs.FlappingSince = false, time.Time{}
+		return change, true
+	}
+	return change, false
+}
+
+// isFlapping reports whether state marks a feed as flapping.
+func (s *validatorState) isFlapping(url string) bool {
+	if s == nil {
+		return false
+	}
+	fs, ok := s.Feeds[url]
+	return ok && fs.Flapping
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// qualityGate is the --gate file: thresholds a run's results must meet for
+// it to pass, for CI on pull requests that touch many rows, where any one
+// invalid feed failing the run is too blunt. Unset thresholds aren't
+// checked.
+type qualityGate struct {
+	// MaxInvalidPercent is the largest share of the results that may be
+	// invalid, in percent.
+	MaxInvalidPercent *float64 `yaml:"max_invalid_percent"`
+	// MaxInvalidPerCountry is how many feeds of any one country may be
+	// invalid.
+	MaxInvalidPerCountry *int `yaml:"max_invalid_per_country"`
+	// MaxInvalidPerTier is how many feeds of each tier listed may be
+	// invalid, e.g. {1: 0} for no tier1 feed.
+	MaxInvalidPerTier map[int]int `yaml:"max_invalid_per_tier"`
+	// MinHealthy is how many feeds must be valid.
+	MinHealthy *int `yaml:"min_healthy"`
+}
+
+// gateCheck is the outcome of one threshold of a gate.
+type gateCheck struct {
+	Name   string
+	Passed bool
+	// Detail gives the value found against the threshold, and for failed
+	// checks what exceeded it.
+	Detail string
+}
+
+// loadQualityGate reads a YAML gate file, or returns nil for path "".
+func loadQualityGate(path string) (*qualityGate, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var g qualityGate
+	if err := yaml.Unmarshal(data, &g); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if g.MaxInvalidPercent == nil && g.MaxInvalidPerCountry == nil && len(g.MaxInvalidPerTier) == 0 && g.MinHealthy == nil {
+		return nil, fmt.Errorf("%s sets no thresholds", path)
+	}
+	for tier := range g.MaxInvalidPerTier {
+		if _, ok := validator.TierPolicies[tier]; !ok {
+			return nil, fmt.Errorf("%s: unknown tier %d in max_invalid_per_tier", path, tier)
+		}
+	}
+	return &g, nil
+}
+
+// check evaluates the gate's thresholds against a run's results, in the
+// order of the file's fields.
+func (g *qualityGate) check(feeds []Feed, results []ValidationResult) []gateCheck {
+	// Results are matched to rows by ID, as corrected URLs differ from
+	// the row's.
+	byID := make(map[string]Feed, len(feeds))
+	for _, feed := range feeds {
+		byID[feed.ID] = feed
+	}
+	var invalid, valid int
+	invalidByCountry := make(map[string]int)
+	invalidByTier := make(map[int]int)
+	for _, r := range results {
+		switch r.Status {
+		case validator.StatusValid:
+			valid++
+		case validator.StatusInvalid:
+			invalid++
+			feed := byID[r.ID]
+			if country := feedCountry(feed); country != "" {
+				invalidByCountry[country]++
+			}
+			invalidByTier[feed.Tier]++
+		}
+	}
+
+	var checks []gateCheck
+	if g.MaxInvalidPercent != nil {
+		percent := 0.0
+		if len(results) > 0 {
+			percent = 100 * float64(invalid) / float64(len(results))
+		}
+		checks = append(checks, gateCheck{
+			Name:   "invalid overall",
+			Passed: percent <= *g.MaxInvalidPercent,
+			Detail: fmt.Sprintf("%.1f%%, %d of %d (max %g%%)", percent, invalid, len(results), *g.MaxInvalidPercent),
+		})
+	}
+	if g.MaxInvalidPerCountry != nil {
+		var over []string
+		worst := 0
+		for country, n := range invalidByCountry {
+			if n > *g.MaxInvalidPerCountry {
+				over = append(over, fmt.Sprintf("%s %d", country, n))
+			}
+			worst = max(worst, n)
+		}
+		sort.Strings(over)
+		detail := fmt.Sprintf("at most %d (max %d)", worst, *g.MaxInvalidPerCountry)
+		if len(over) > 0 {
+			detail = fmt.Sprintf("%s (max %d)", strings.Join(over, ", "), *g.MaxInvalidPerCountry)
+		}
+		checks = append(checks, gateCheck{Name: "invalid per country", Passed: len(over) == 0, Detail: detail})
+	}
+	tiers := make([]int, 0, len(g.MaxInvalidPerTier))
+	for tier := range g.MaxInvalidPerTier {
+		tiers = append(tiers, tier)
+	}
+	slices.Sort(tiers)
+	for _, tier := range tiers {
+		limit := g.MaxInvalidPerTier[tier]
+		checks = append(checks, gateCheck{
+			Name:   fmt.Sprintf("invalid tier%d feeds", tier),
+			Passed: invalidByTier[tier] <= limit,
+			Detail: fmt.Sprintf("%d (max %d)", invalidByTier[tier], limit),
+		})
+	}
+	if g.MinHealthy != nil {
+		checks = append(checks, gateCheck{
+			Name:   "healthy feeds",
+			Passed: valid >= *g.MinHealthy,
+			Detail: fmt.Sprintf("%d (min %d)", valid, *g.MinHealthy),
+		})
+	}
+	return checks
+}
+
+// writeGateChecks prints the gate's breakdown and reports whether every
+// check passed.
+func writeGateChecks(w io.Writer, checks []gateCheck) bool {
+	passed := true
+	fmt.Fprintf(w, "\nQuality Gate:\n")
+	for _, c := range checks {
+		mark := "✅"
+		if !c.Passed {
+			mark, passed = "❌", false
+		}
+		fmt.Fprintf(w, "  %s %s: %s\n", mark, c.Name, c.Detail)
+	}
+	if passed {
+		fmt.Fprintln(w, "Quality gate passed")
+	} else {
+		fmt.Fprintln(w, "Quality gate failed")
+	}
+	return passed
+}
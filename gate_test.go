@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+func TestQualityGateCheck(t *testing.T) {
+	feeds := []Feed{
+		{ID: "fr1", Comments: "France", Tier: 1},
+		{ID: "fr2", Comments: "Lyon, France", Tier: 2},
+		{ID: "de1", Comments: "Germany", Tier: 1},
+		{ID: "de2", Comments: "Germany", Tier: 2},
+	}
+	results := []ValidationResult{
+		{ID: "fr1", Status: validator.StatusInvalid},
+		{ID: "fr2", Status: validator.StatusInvalid},
+		{ID: "de1", Status: validator.StatusValid},
+		{ID: "de2", Status: validator.StatusTransient},
+	}
+	percent := func(v float64) *float64 { return &v }
+	count := func(v int) *int { return &v }
+
+	tests := []struct {
+		name string
+		gate qualityGate
+		want map[string]bool
+	}{
+		{"no thresholds", qualityGate{}, map[string]bool{}},
+		{"overall within", qualityGate{MaxInvalidPercent: percent(50)}, map[string]bool{"invalid overall": true}},
+		{"overall over", qualityGate{MaxInvalidPercent: percent(49.9)}, map[string]bool{"invalid overall": false}},
+		{"per country over", qualityGate{MaxInvalidPerCountry: count(1)}, map[string]bool{"invalid per country": false}},
+		{"per country within", qualityGate{MaxInvalidPerCountry: count(2)}, map[string]bool{"invalid per country": true}},
+		{"per tier", qualityGate{MaxInvalidPerTier: map[int]int{1: 0, 2: 1}}, map[string]bool{"invalid tier1 feeds": false, "invalid tier2 feeds": true}},
+		{"transient is not healthy", qualityGate{MinHealthy: count(2)}, map[string]bool{"healthy feeds": false}},
+		{"healthy", qualityGate{MinHealthy: count(1)}, map[string]bool{"healthy feeds": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks := tt.gate.check(feeds, results)
+			if len(checks) != len(tt.want) {
+				t.Fatalf("got %d checks, want %d: %+v", len(checks), len(tt.want), checks)
+			}
+			for _, c := range checks {
+				passed, ok := tt.want[c.Name]
+				if !ok {
+					t.Errorf("unexpected check %q", c.Name)
+				} else if c.Passed != passed {
+					t.Errorf("%s: passed %v, want %v (%s)", c.Name, c.Passed, passed, c.Detail)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const githubAPI = "https://api.github.com"
+
+// githubClient is a minimal GitHub REST API client for proposing dataset
+// changes as pull requests.
+type githubClient struct {
+	repo  string // "owner/name"
+	token string
+	http  *http.Client
+}
+
+// newGitHubClient configures a client from GITHUB_TOKEN and
+// GITHUB_REPOSITORY, as set in GitHub Actions.
+func newGitHubClient() (*githubClient, error) {
+	token, repo := os.Getenv("GITHUB_TOKEN"), os.Getenv("GITHUB_REPOSITORY")
+	if token == "" || repo == "" {
+		return nil, fmt.Errorf("GITHUB_TOKEN and GITHUB_REPOSITORY must be set")
+	}
+	return &githubClient{repo: repo, token: token, http: &http.Client{Timeout: time.Minute}}, nil
+}
+
+func (g *githubClient) do(method, path string, body, out any) error {
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, githubAPI+"/repos/"+g.repo+path, payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+g.token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := g.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s: HTTP status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// getFile returns a file's content and blob SHA at ref.
+func (g *githubClient) getFile(path, ref string) ([]byte, string, error) {
+	var file struct {
+		Content string `json:"content"`
+		SHA     string `json:"sha"`
+	}
+	if err := g.do("GET", "/contents/"+path+"?ref="+url.QueryEscape(ref), nil, &file); err != nil {
+		return nil, "", err
+	}
+	content, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(file.Content, "\n", ""))
+	return content, file.SHA, err
+}
+
+// pullRequest describes a change to some files proposed on a new branch.
+type pullRequest struct {
+	Base, Branch  string
+	Paths         []string
+	CommitMessage string
+	Title, Body   string
+}
+
+// openPullRequest creates pr.Branch from pr.Base, commits edit's result for
+// each of pr.Paths onto it and opens a pull request. edit receives a file's
+// path and content on the base branch; files it leaves unchanged are not
+// committed. It returns the pull request's URL.
+func (g *githubClient) openPullRequest(pr pullRequest, edit func(path string, content []byte) ([]byte, error)) (string, error) {
+	var ref struct {
+		Object struct {
+			SHA string `json:"sha"`
+		} `json:"object"`
+	}
+	if err := g.do("GET", "/git/ref/heads/"+pr.Base, nil, &ref); err != nil {
+		return "", err
+	}
+
+	if err := g.do("POST", "/git/refs", map[string]string{"ref": "refs/heads/" + pr.Branch, "sha": ref.Object.SHA}, nil); err != nil {
+		return "", err
+	}
+	for _, path := range pr.Paths {
+		content, blobSHA, err := g.getFile(path, pr.Base)
+		if err != nil {
+			return "", err
+		}
+		updated, err := edit(path, content)
+		if err != nil {
+			return "", err
+		}
+		if bytes.Equal(updated, content) {
+			continue
+		}
+		if err := g.do("PUT", "/contents/"+path, map[string]string{
+			"message": pr.CommitMessage,
+			"content": base64.StdEncoding.EncodeToString(updated),
+			"sha":     blobSHA,
+			"branch":  pr.Branch,
+		}, nil); err != nil {
+			return "", err
+		}
+	}
+
+	var created struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := g.do("POST", "/pulls", map[string]string{"title": pr.Title, "head": pr.Branch, "base": pr.Base, "body": pr.Body}, &created); err != nil {
+		return "", err
+	}
+	return created.HTMLURL, nil
+}
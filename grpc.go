@@ -0,0 +1,216 @@
+package main
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative api/validator/v1/validator.proto
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	validatorv1 "github.com/reddot-watch/curated-world-news/api/validator/v1"
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// maxBatchFeeds caps how many feeds one ValidateBatch call may ask for.
+const maxBatchFeeds = 10000
+
+// grpcServer implements the FeedValidator service for serve --grpc-addr.
+// Feeds validated on request are not part of the daemon's schedule and
+// don't change its state or history.
+type grpcServer struct {
+	validatorv1.UnimplementedFeedValidatorServer
+	d *daemon
+	// validator validates feeds on request for calls bearing token; it is
+	// nil unless serve was started with --validate-endpoint.
+	validator *validator.Validator
+	token     string
+}
+
+// newGRPCServer serves the FeedValidator service. ValidateFeed and
+// ValidateBatch fetch any URL they are given, so like POST /validate they
+// are only served given validateOpts, the daemon's options without its
+// credentials, and to calls with token as their bearer token.
+func newGRPCServer(d *daemon, token string, validateOpts []validator.Option) *grpc.Server {
+	s := &grpcServer{d: d, token: token}
+	if validateOpts != nil {
+		s.validator = newValidator(nil, nil, validateOpts...)
+	}
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := s.authorize(ctx, info.FullMethod); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := s.authorize(stream.Context(), info.FullMethod); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}),
+	)
+	validatorv1.RegisterFeedValidatorServer(server, s)
+	return server
+}
+
+// authorize refuses calls that validate on request unless that is enabled
+// and they carry the token. GetHealth is as open as GET /status.
+func (s *grpcServer) authorize(ctx context.Context, method string) error {
+	if method != validatorv1.FeedValidator_ValidateFeed_FullMethodName && method != validatorv1.FeedValidator_ValidateBatch_FullMethodName {
+		return nil
+	}
+	if s.validator == nil {
+		return status.Error(codes.Unimplemented, "validating on request needs serve --validate-endpoint")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, auth := range md.Get("authorization") {
+		if isBearer(auth, s.token) {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "unauthorized")
+}
+
+// requestedFeed turns a request into a feed to validate.
+func requestedFeed(req *validatorv1.ValidateFeedRequest) (Feed, error) {
+	if req.GetUrl() == "" {
+		return Feed{}, status.Error(codes.InvalidArgument, "url is required")
+	}
+	tier := int(req.GetTier())
+	if tier == 0 {
+		tier = validator.DefaultTier
+	}
+	if _, ok := validator.TierPolicies[tier]; !ok {
+		return Feed{}, status.Errorf(codes.InvalidArgument, "unknown tier %d", tier)
+	}
+	return Feed{ID: validator.DeriveID(req.GetUrl()), URL: req.GetUrl(), Tier: tier}, nil
+}
+
+func (s *grpcServer) ValidateFeed(ctx context.Context, req *validatorv1.ValidateFeedRequest) (*validatorv1.ValidationResult, error) {
+	feed, err := requestedFeed(req)
+	if err != nil {
+		return nil, err
+	}
+	var result *validatorv1.ValidationResult
+	s.validator.ValidateEach(ctx, []Feed{feed}, func(r ValidationResult) {
+		result = resultToProto(r)
+	})
+	if result == nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return result, nil
+}
+
+func (s *grpcServer) ValidateBatch(req *validatorv1.ValidateBatchRequest, stream grpc.ServerStreamingServer[validatorv1.ValidationResult]) error {
+	if len(req.GetFeeds()) > maxBatchFeeds {
+		return status.Errorf(codes.InvalidArgument, "at most %d feeds per batch", maxBatchFeeds)
+	}
+	feeds := make([]Feed, 0, len(req.GetFeeds()))
+	for _, r := range req.GetFeeds() {
+		feed, err := requestedFeed(r)
+		if err != nil {
+			return err
+		}
+		feeds = append(feeds, feed)
+	}
+
+	// A failed send means the client is gone, so the rest of the batch is
+	// abandoned.
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	var sendErr error
+	s.validator.ValidateEach(ctx, feeds, func(r ValidationResult) {
+		if sendErr != nil {
+			return
+		}
+		if sendErr = stream.Send(resultToProto(r)); sendErr != nil {
+			cancel()
+		}
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if err := stream.Context().Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
+
+func (s *grpcServer) GetHealth(ctx context.Context, req *validatorv1.GetHealthRequest) (*validatorv1.GetHealthResponse, error) {
+	var country string
+	if req.GetCountry() != "" {
+		c, ok := lookupCountry(req.GetCountry())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown country %q", req.GetCountry())
+		}
+		country = c.Alpha2
+	}
+	resp := &validatorv1.GetHealthResponse{}
+	for _, s := range s.d.statuses() {
+		if country != "" && s.Country != country {
+			continue
+		}
+		if len(req.GetFeeds()) > 0 && !slices.Contains(req.GetFeeds(), s.ID) && !slices.Contains(req.GetFeeds(), s.URL) {
+			continue
+		}
+		resp.Feeds = append(resp.Feeds, &validatorv1.FeedHealth{
+			Id:            s.ID,
+			Url:           s.URL,
+			Tier:          int32(s.Tier),
+			Country:       s.Country,
+			Status:        statusToProto(s.Status),
+			Flapping:      s.Flapping,
+			Message:       s.Message,
+			ItemCount:     int32(s.ItemCount),
+			LastUpdate:    timestampProto(s.LastUpdate),
+			LastValidated: timestampProto(s.LastValidated),
+			NextDue:       timestampProto(s.NextDue),
+		})
+	}
+	return resp, nil
+}
+
+func resultToProto(r ValidationResult) *validatorv1.ValidationResult {
+	return &validatorv1.ValidationResult{
+		Url:              r.URL,
+		Status:           statusToProto(string(r.Status)),
+		Message:          r.Message,
+		ItemCount:        int32(r.ItemCount),
+		LastUpdate:       timestampProto(r.LastUpdate),
+		License:          r.License,
+		NoRedistribution: r.NoRedistribution,
+		Access:           r.Access,
+		ProbeOnly:        r.ProbeOnly,
+		LatencyMs:        r.LatencyMS,
+		TtlMinutes:       int32(r.TTLMinutes),
+	}
+}
+
+func statusToProto(s string) validatorv1.Status {
+	switch s {
+	case "valid":
+		return validatorv1.Status_STATUS_VALID
+	case "invalid":
+		return validatorv1.Status_STATUS_INVALID
+	case "transient":
+		return validatorv1.Status_STATUS_TRANSIENT
+	case "unknown":
+		return validatorv1.Status_STATUS_UNKNOWN
+	}
+	return validatorv1.Status_STATUS_UNSPECIFIED
+}
+
+// timestampProto leaves unset times unset rather than sending the zero
+// time.
+func timestampProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
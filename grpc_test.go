@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	validatorv1 "github.com/reddot-watch/curated-world-news/api/validator/v1"
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+func TestGRPCValidateAuthorization(t *testing.T) {
+	offline := validator.WithFetcher(validator.FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	}))
+	tests := []struct {
+		name     string
+		opts     []validator.Option
+		auth     string
+		wantCode codes.Code
+	}{
+		{"not enabled", nil, "Bearer secret", codes.Unimplemented},
+		{"no token", []validator.Option{offline}, "", codes.Unauthenticated},
+		{"wrong token", []validator.Option{offline}, "Bearer guess", codes.Unauthenticated},
+		{"token", []validator.Option{offline}, "Bearer secret", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listener := bufconn.Listen(1 << 20)
+			server := newGRPCServer(&daemon{}, "secret", tt.opts)
+			go server.Serve(listener)
+			defer server.Stop()
+			conn, err := grpc.NewClient("passthrough:///bufconn",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+				grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			client := validatorv1.NewFeedValidatorClient(conn)
+
+			ctx := context.Background()
+			if tt.auth != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", tt.auth)
+			}
+			_, err = client.ValidateFeed(ctx, &validatorv1.ValidateFeedRequest{Url: "https://example.com/rss"})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("ValidateFeed: %v, want %v", err, tt.wantCode)
+			}
+			stream, err := client.ValidateBatch(ctx, &validatorv1.ValidateBatchRequest{Feeds: []*validatorv1.ValidateFeedRequest{{Url: "https://example.com/rss"}}})
+			if err == nil {
+				for err == nil {
+					_, err = stream.Recv()
+				}
+				if err == io.EOF {
+					err = nil
+				}
+			}
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("ValidateBatch: %v, want %v", err, tt.wantCode)
+			}
+			if _, err := client.GetHealth(context.Background(), &validatorv1.GetHealthRequest{}); err != nil {
+				t.Errorf("GetHealth: %v", err)
+			}
+		})
+	}
+}
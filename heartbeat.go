@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// runStatus is the outcome of a one-shot run, written by --status-file and
+// sent to --heartbeat-url, so a cron job that stops running is noticed.
+type runStatus struct {
+	Input      string         `json:"input"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt time.Time      `json:"finished_at"`
+	Feeds      int            `json:"feeds"`
+	Validated  int            `json:"validated"`
+	Counts     map[string]int `json:"counts"`
+	// Skipped are feeds not due for their tier or cached from history.
+	Skipped  int `json:"skipped"`
+	ExitCode int `json:"exit_code"`
+}
+
+func newRunStatus(input string, startedAt time.Time, feeds int, results []ValidationResult, skipped, exitCode int) runStatus {
+	s := runStatus{
+		Input: input, StartedAt: startedAt, FinishedAt: time.Now(),
+		Feeds: feeds, Validated: len(results), Counts: make(map[string]int), Skipped: skipped, ExitCode: exitCode,
+	}
+	for _, r := range results {
+		s.Counts[string(r.Status)]++
+	}
+	return s
+}
+
+// reportCompletion writes the status file and pings the heartbeat URL,
+// whichever are configured. A completed run is a successful heartbeat even
+// if feeds failed; the exit code in the body tells them apart. Errors are
+// printed but don't change the run's outcome.
+func reportCompletion(statusPath, heartbeatURL string, s runStatus) {
+	if statusPath == "" && heartbeatURL == "" {
+		return
+	}
+	body, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error encoding run status: %v\n", err)
+		return
+	}
+	if statusPath != "" {
+		if err := os.WriteFile(statusPath, append(body, '\n'), 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", statusPath, err)
+		}
+	}
+	if heartbeatURL != "" {
+		if err := postJSON(&http.Client{Timeout: 10 * time.Second}, heartbeatURL, body, nil); err != nil {
+			fmt.Fprintf(os.Stderr, "Error pinging heartbeat URL: %v\n", err)
+		}
+	}
+}
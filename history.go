@@ -0,0 +1,693 @@
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const sqliteHistorySchema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id          INTEGER PRIMARY KEY,
+	input       TEXT NOT NULL,
+	started_at  TEXT NOT NULL UNIQUE,
+	finished_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS results (
+	run_id            INTEGER NOT NULL REFERENCES runs (id),
+	feed_id           TEXT,
+	url               TEXT NOT NULL,
+	status            TEXT NOT NULL,
+	message           TEXT NOT NULL,
+	item_count        INTEGER NOT NULL,
+	latency_ms        INTEGER NOT NULL,
+	last_update       TEXT,
+	license           TEXT NOT NULL,
+	no_redistribution INTEGER NOT NULL,
+	access            TEXT NOT NULL,
+	access_observed   INTEGER NOT NULL,
+	probe_only        INTEGER NOT NULL,
+	snapshot          TEXT NOT NULL,
+	ttl_minutes       INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS results_run ON results (run_id, status);
+CREATE INDEX IF NOT EXISTS results_feed ON results (feed_id, run_id);
+CREATE INDEX IF NOT EXISTS results_url ON results (url, run_id);
+CREATE TABLE IF NOT EXISTS daily_rollups (
+	day              TEXT NOT NULL,
+	feed_id          TEXT,
+	url              TEXT NOT NULL,
+	runs             INTEGER NOT NULL,
+	valid            INTEGER NOT NULL,
+	invalid          INTEGER NOT NULL,
+	transient        INTEGER NOT NULL,
+	latency_ms_total INTEGER NOT NULL,
+	PRIMARY KEY (day, url)
+);
+`
+
+// postgresHistorySchema mirrors the SQLite schema. Timestamps are kept as
+// historyTime text in both, so the same queries work on either.
+const postgresHistorySchema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id          BIGSERIAL PRIMARY KEY,
+	input       TEXT NOT NULL,
+	started_at  TEXT NOT NULL UNIQUE,
+	finished_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS results (
+	run_id            BIGINT NOT NULL REFERENCES runs (id),
+	feed_id           TEXT,
+	url               TEXT NOT NULL,
+	status            TEXT NOT NULL,
+	message           TEXT NOT NULL,
+	item_count        INTEGER NOT NULL,
+	latency_ms        BIGINT NOT NULL,
+	last_update       TEXT,
+	license           TEXT NOT NULL,
+	no_redistribution BOOLEAN NOT NULL,
+	access            TEXT NOT NULL,
+	access_observed   BOOLEAN NOT NULL,
+	probe_only        BOOLEAN NOT NULL,
+	snapshot          TEXT NOT NULL,
+	ttl_minutes       INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS results_run ON results (run_id, status);
+CREATE INDEX IF NOT EXISTS results_feed ON results (feed_id, run_id);
+CREATE INDEX IF NOT EXISTS results_url ON results (url, run_id);
+CREATE TABLE IF NOT EXISTS daily_rollups (
+	day              TEXT NOT NULL,
+	feed_id          TEXT,
+	url              TEXT NOT NULL,
+	runs             INTEGER NOT NULL,
+	valid            INTEGER NOT NULL,
+	invalid          INTEGER NOT NULL,
+	transient        INTEGER NOT NULL,
+	latency_ms_total BIGINT NOT NULL,
+	PRIMARY KEY (day, url)
+);
+`
+
+// historyTime is how timestamps are stored: fixed width in UTC, so they sort
+// as text.
+const historyTime = "2006-01-02T15:04:05.000000Z"
+
+// historyStore accumulates every validation run's per-feed results, written
+// with --history.
+type historyStore interface {
+	// recordRun stores a run and its results, returning the run's ID.
+	recordRun(report *runReport) (int64, error)
+	// runs loads the runs started at or after since, oldest first, with
+	// their results.
+	runs(since time.Time) ([]*runReport, error)
+	// run loads one run with its results, or returns nil if there is no
+	// run with that ID.
+	run(id int64) (*runReport, error)
+	// recentRuns summarizes the limit most recent runs, newest first.
+	recentRuns(limit int) ([]runOverview, error)
+	// feedHistory returns a feed's results in runs started at or after
+	// since, oldest first.
+	feedHistory(feed Feed, since time.Time) ([]feedPoint, error)
+	// prune folds the results of runs started before cutoff into
+	// daily_rollups and deletes the runs, returning how many were removed.
+	prune(cutoff time.Time) (int64, error)
+	Close() error
+}
+
+// isPostgresURL reports whether a --history value names a Postgres database
+// rather than a SQLite file.
+func isPostgresURL(spec string) bool {
+	return strings.HasPrefix(spec, "postgres://") || strings.HasPrefix(spec, "postgresql://")
+}
+
+// isHistoryDatabase reports whether spec names a history database, as
+// opposed to a glob of JSON run reports.
+func isHistoryDatabase(spec string) bool {
+	return strings.HasSuffix(spec, ".db") || isPostgresURL(spec)
+}
+
+// openHistory opens the history database at spec: a SQLite file, or a
+// postgres:// URL for a history shared by several daemons.
+func openHistory(spec string) (historyStore, error) {
+	driver, schema, dsn := "sqlite3", sqliteHistorySchema, spec+"?_journal_mode=WAL&_busy_timeout=5000"
+	if isPostgresURL(spec) {
+		driver, schema, dsn = "pgx", postgresHistorySchema, spec
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("opening %s: %w", redactURL(spec), err)
+	}
+	return &sqlHistory{db: db, numbered: driver == "pgx"}, nil
+}
+
+// redactURL hides a database URL's password in messages.
+func redactURL(spec string) string {
+	if u, err := url.Parse(spec); err == nil && u.User != nil {
+		return u.Redacted()
+	}
+	return spec
+}
+
+// sqlHistory is a historyStore in a SQLite or Postgres database. Queries
+// are written with ? placeholders and rebound to $1, $2... for Postgres.
+type sqlHistory struct {
+	db       *sql.DB
+	numbered bool
+}
+
+func (h *sqlHistory) rebind(query string) string {
+	if !h.numbered {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (h *sqlHistory) Close() error {
+	return h.db.Close()
+}
+
+// recordRun leaves a run already recorded with the same start time alone,
+// so importing the same reports twice is harmless.
+func (h *sqlHistory) recordRun(report *runReport) (int64, error) {
+	tx, err := h.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var runID int64
+	err = tx.QueryRow(h.rebind(`SELECT id FROM runs WHERE started_at = ?`), report.StartedAt.UTC().Format(historyTime)).Scan(&runID)
+	if err == nil {
+		return runID, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, err
+	}
+	if err := tx.QueryRow(h.rebind(`INSERT INTO runs (input, started_at, finished_at) VALUES (?, ?, ?) RETURNING id`),
+		report.Input, report.StartedAt.UTC().Format(historyTime), report.FinishedAt.UTC().Format(historyTime)).Scan(&runID); err != nil {
+		return 0, err
+	}
+
+	insert, err := tx.Prepare(h.rebind(`INSERT INTO results (run_id, feed_id, url, status, message, item_count, latency_ms, last_update, license, no_redistribution, access, access_observed, probe_only, snapshot, ttl_minutes) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`))
+	if err != nil {
+		return 0, err
+	}
+	defer insert.Close()
+	for _, r := range report.Results {
+		var lastUpdate any
+		if !r.LastUpdate.IsZero() {
+			lastUpdate = r.LastUpdate.UTC().Format(historyTime)
+		}
+		if _, err := insert.Exec(runID, nullString(r.ID), r.URL, r.Status, r.Message, r.ItemCount, r.LatencyMS, lastUpdate,
+			r.License, r.NoRedistribution, r.Access, r.AccessObserved, r.ProbeOnly, r.Snapshot, r.TTLMinutes); err != nil {
+			return 0, err
+		}
+	}
+	return runID, tx.Commit()
+}
+
+func (h *sqlHistory) runs(since time.Time) ([]*runReport, error) {
+	rows, err := h.db.Query(h.rebind(`SELECT id, input, started_at, finished_at FROM runs WHERE started_at >= ? ORDER BY started_at`),
+		since.UTC().Format(historyTime))
+	if err != nil {
+		return nil, err
+	}
+	var reports []*runReport
+	byID := make(map[int64]*runReport)
+	for rows.Next() {
+		var report runReport
+		var started, finished string
+		if err := rows.Scan(&report.ID, &report.Input, &started, &finished); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		report.StartedAt, _ = time.Parse(historyTime, started)
+		report.FinishedAt, _ = time.Parse(historyTime, finished)
+		reports = append(reports, &report)
+		byID[report.ID] = &report
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+	return reports, h.loadResults(byID, `JOIN runs ON runs.id = results.run_id WHERE runs.started_at >= ?`, since.UTC().Format(historyTime))
+}
+
+func (h *sqlHistory) run(id int64) (*runReport, error) {
+	var report runReport
+	var started, finished string
+	err := h.db.QueryRow(h.rebind(`SELECT id, input, started_at, finished_at FROM runs WHERE id = ?`), id).
+		Scan(&report.ID, &report.Input, &started, &finished)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	report.StartedAt, _ = time.Parse(historyTime, started)
+	report.FinishedAt, _ = time.Parse(historyTime, finished)
+	return &report, h.loadResults(map[int64]*runReport{id: &report}, `WHERE run_id = ?`, id)
+}
+
+// loadResults appends the results selected by where to the reports in
+// byID.
+func (h *sqlHistory) loadResults(byID map[int64]*runReport, where string, args ...any) error {
+	rows, err := h.db.Query(h.rebind(`SELECT run_id, feed_id, url, status, message, item_count, latency_ms, last_update, license, no_redistribution, access, access_observed, probe_only, snapshot, ttl_minutes
+		FROM results `+where), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var runID int64
+		var r ValidationResult
+		var id, lastUpdate sql.NullString
+		if err := rows.Scan(&runID, &id, &r.URL, &r.Status, &r.Message, &r.ItemCount, &r.LatencyMS, &lastUpdate,
+			&r.License, &r.NoRedistribution, &r.Access, &r.AccessObserved, &r.ProbeOnly, &r.Snapshot, &r.TTLMinutes); err != nil {
+			return err
+		}
+		r.ID = id.String
+		if lastUpdate.Valid {
+			r.LastUpdate, _ = time.Parse(historyTime, lastUpdate.String)
+		}
+		if report, ok := byID[runID]; ok {
+			report.Results = append(report.Results, r)
+		}
+	}
+	return rows.Err()
+}
+
+// runOverview is a run's result counts, without the results themselves.
+type runOverview struct {
+	ID         int64     `json:"id"`
+	Input      string    `json:"input"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Valid      int       `json:"valid"`
+	Invalid    int       `json:"invalid"`
+	Transient  int       `json:"transient"`
+}
+
+func (h *sqlHistory) recentRuns(limit int) ([]runOverview, error) {
+	rows, err := h.db.Query(h.rebind(`SELECT runs.id, input, started_at, finished_at,
+			COUNT(CASE WHEN status = 'valid' THEN 1 END), COUNT(CASE WHEN status = 'invalid' THEN 1 END), COUNT(CASE WHEN status = 'transient' THEN 1 END)
+		FROM runs LEFT JOIN results ON results.run_id = runs.id
+		GROUP BY runs.id ORDER BY started_at DESC LIMIT ?`), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var runs []runOverview
+	for rows.Next() {
+		var o runOverview
+		var started, finished string
+		if err := rows.Scan(&o.ID, &o.Input, &started, &finished, &o.Valid, &o.Invalid, &o.Transient); err != nil {
+			return nil, err
+		}
+		o.StartedAt, _ = time.Parse(historyTime, started)
+		o.FinishedAt, _ = time.Parse(historyTime, finished)
+		runs = append(runs, o)
+	}
+	return runs, rows.Err()
+}
+
+// feedPoint is one feed's result in one run, for charting.
+type feedPoint struct {
+	RunID     int64     `json:"run_id"`
+	At        time.Time `json:"at"`
+	Status    string    `json:"status"`
+	Message   string    `json:"message,omitempty"`
+	ItemCount int       `json:"item_count"`
+	LatencyMS int64     `json:"latency_ms"`
+}
+
+// feedHistory matches results recorded before the feed had an ID by URL.
+func (h *sqlHistory) feedHistory(feed Feed, since time.Time) ([]feedPoint, error) {
+	rows, err := h.db.Query(h.rebind(`SELECT runs.id, started_at, status, message, item_count, latency_ms
+		FROM results JOIN runs ON runs.id = results.run_id
+		WHERE (feed_id = ? OR (feed_id IS NULL AND url = ?)) AND started_at >= ?
+		ORDER BY started_at`), feed.ID, feed.URL, since.UTC().Format(historyTime))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var points []feedPoint
+	for rows.Next() {
+		var p feedPoint
+		var at string
+		if err := rows.Scan(&p.RunID, &at, &p.Status, &p.Message, &p.ItemCount, &p.LatencyMS); err != nil {
+			return nil, err
+		}
+		p.At, _ = time.Parse(historyTime, at)
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
+
+func (h *sqlHistory) prune(cutoff time.Time) (int64, error) {
+	tx, err := h.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	before := cutoff.UTC().Format(historyTime)
+	// Days split by the cutoff are folded in twice, so counts are added to
+	// any existing rollup rather than replacing it.
+	if _, err := tx.Exec(h.rebind(`INSERT INTO daily_rollups (day, feed_id, url, runs, valid, invalid, transient, latency_ms_total)
+		SELECT substr(started_at, 1, 10), MAX(feed_id), url, COUNT(*),
+			COUNT(CASE WHEN status = 'valid' THEN 1 END), COUNT(CASE WHEN status = 'invalid' THEN 1 END), COUNT(CASE WHEN status = 'transient' THEN 1 END),
+			SUM(latency_ms)
+		FROM results JOIN runs ON runs.id = results.run_id
+		WHERE started_at < ?
+		GROUP BY substr(started_at, 1, 10), url
+		ON CONFLICT (day, url) DO UPDATE SET
+			feed_id = COALESCE(excluded.feed_id, daily_rollups.feed_id),
+			runs = daily_rollups.runs + excluded.runs,
+			valid = daily_rollups.valid + excluded.valid,
+			invalid = daily_rollups.invalid + excluded.invalid,
+			transient = daily_rollups.transient + excluded.transient,
+			latency_ms_total = daily_rollups.latency_ms_total + excluded.latency_ms_total`), before); err != nil {
+		return 0, fmt.Errorf("rolling up results: %w", err)
+	}
+	if _, err := tx.Exec(h.rebind(`DELETE FROM results WHERE run_id IN (SELECT id FROM runs WHERE started_at < ?)`), before); err != nil {
+		return 0, err
+	}
+	res, err := tx.Exec(h.rebind(`DELETE FROM runs WHERE started_at < ?`), before)
+	if err != nil {
+		return 0, err
+	}
+	pruned, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return pruned, tx.Commit()
+}
+
+// recordHistory appends a run to the history database at path.
+func recordHistory(path string, report *runReport) error {
+	h, err := openHistory(path)
+	if err != nil {
+		return err
+	}
+	_, err = h.recordRun(report)
+	if closeErr := h.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// recentlyValid returns, keyed by URL, when each feed was found valid by
+// its most recent run started since since. Feeds whose latest result there
+// is a failure, transient or not, are left out so they are checked again.
+func recentlyValid(path string, feeds []Feed, since time.Time) (map[string]time.Time, error) {
+	h, err := openHistory(path)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Close()
+	reports, err := h.runs(since)
+	if err != nil {
+		return nil, err
+	}
+	history := indexHistory(reports)
+	valid := make(map[string]time.Time)
+	for _, feed := range feeds {
+		if obs := history.forFeed(feed); len(obs) > 0 && obs[len(obs)-1].result.Status == "valid" {
+			valid[feed.URL] = obs[len(obs)-1].at
+		}
+	}
+	return valid, nil
+}
+
+// recentStatuses returns, keyed by URL, the status of each feed's latest
+// result in runs started since since, for the feeds it has results for.
+func recentStatuses(path string, feeds []Feed, since time.Time) (map[string]string, error) {
+	h, err := openHistory(path)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Close()
+	reports, err := h.runs(since)
+	if err != nil {
+		return nil, err
+	}
+	history := indexHistory(reports)
+	statuses := make(map[string]string)
+	for _, feed := range feeds {
+		if obs := history.forFeed(feed); len(obs) > 0 {
+			statuses[feed.URL] = string(obs[len(obs)-1].result.Status)
+		}
+	}
+	return statuses, nil
+}
+
+// recentVolumeCollapses reports feeds whose item counts over the last week
+// collapsed compared with the week before.
+func recentVolumeCollapses(path string, feeds []Feed, now time.Time) ([]volumeCollapse, error) {
+	h, err := openHistory(path)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Close()
+	reports, err := h.runs(now.AddDate(0, 0, -14))
+	if err != nil {
+		return nil, err
+	}
+	// The run just recorded started at now; the week includes it.
+	week := weekBefore(now.Add(time.Second))
+	return volumeCollapses(feeds, indexHistory(reports), weekBefore(week.Start), week), nil
+}
+
+// loadHistory loads past runs from a history database (a .db file or a
+// postgres:// URL) or from the JSON run reports matching a glob, oldest
+// first.
+func loadHistory(spec string) ([]*runReport, error) {
+	if !isHistoryDatabase(spec) {
+		return loadRunReports(spec)
+	}
+	if !isPostgresURL(spec) {
+		if _, err := os.Stat(spec); err != nil {
+			return nil, err
+		}
+	}
+	h, err := openHistory(spec)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Close()
+	return h.runs(time.Time{})
+}
+
+// observation is one feed's result in one run.
+type observation struct {
+	at     time.Time
+	result ValidationResult
+}
+
+// historyIndex groups past results by feed ID, or by URL for results
+// recorded before feeds had IDs.
+type historyIndex map[string][]observation
+
+func indexHistory(reports []*runReport) historyIndex {
+	history := make(historyIndex)
+	for _, report := range reports {
+		for _, r := range report.Results {
+			key := r.ID
+			if key == "" {
+				key = r.URL
+			}
+			history[key] = append(history[key], observation{report.StartedAt, r})
+		}
+	}
+	return history
+}
+
+// forFeed returns a feed's results oldest first. Results are matched by
+// feed ID where recorded, so history survives URL changes.
+func (h historyIndex) forFeed(feed Feed) []observation {
+	obs := slices.Concat(h[feed.URL], h[feed.ID])
+	slices.SortStableFunc(obs, func(a, b observation) int { return a.at.Compare(b.at) })
+	return obs
+}
+
+// statusChange is a feed whose status differs between two runs.
+type statusChange struct {
+	URL      string
+	From, To ValidationResult
+}
+
+// diffRuns returns the feeds whose status changed from one run to the next,
+// matched by feed ID where both runs recorded one. Feeds missing from either
+// run, e.g. because their tier wasn't due, are not compared.
+func diffRuns(from, to *runReport) []statusChange {
+	key := func(r ValidationResult) string {
+		if r.ID != "" {
+			return r.ID
+		}
+		return r.URL
+	}
+	before := make(map[string]ValidationResult, len(from.Results))
+	for _, r := range from.Results {
+		before[key(r)] = r
+	}
+	var changes []statusChange
+	for _, r := range to.Results {
+		if prev, ok := before[key(r)]; ok && prev.Status != r.Status {
+			changes = append(changes, statusChange{URL: r.URL, From: prev, To: r})
+		}
+	}
+	slices.SortFunc(changes, func(a, b statusChange) int { return strings.Compare(a.URL, b.URL) })
+	return changes
+}
+
+func runHistory(args []string) int {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+	dbPath := fs.String("db", "history.db", "history database written by validation with --history")
+	importGlob := fs.String("import", "", "record the JSON run reports matching this glob into the database first")
+	limit := fs.Int("runs", 10, "number of most recent runs to summarize")
+	diff := fs.Bool("diff", false, "list feeds whose status changed between the two most recent runs")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s history [--db history.db] [--import GLOB] [--runs N | --diff]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	if _, err := parseArgs(fs, args); err != nil {
+		return 2
+	}
+
+	h, err := openHistory(*dbPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening history: %v\n", err)
+		return 1
+	}
+	defer h.Close()
+
+	if *importGlob != "" {
+		reports, err := loadRunReports(*importGlob)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+			return 1
+		}
+		for _, report := range reports {
+			if _, err := h.recordRun(report); err != nil {
+				fmt.Fprintf(os.Stderr, "Error recording run from %s: %v\n", report.StartedAt.Format(time.RFC3339), err)
+				return 1
+			}
+		}
+		fmt.Printf("Imported %d run reports into %s\n", len(reports), *dbPath)
+	}
+
+	reports, err := h.runs(time.Time{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading history: %v\n", err)
+		return 1
+	}
+	if len(reports) == 0 {
+		fmt.Printf("No runs recorded in %s\n", *dbPath)
+		return 0
+	}
+
+	if *diff {
+		if len(reports) < 2 {
+			fmt.Println("Only one run recorded; nothing to compare")
+			return 0
+		}
+		from, to := reports[len(reports)-2], reports[len(reports)-1]
+		changes := diffRuns(from, to)
+		fmt.Printf("Status changes from run %d (%s) to run %d (%s): %d\n", from.ID, from.StartedAt.Format(time.RFC3339),
+			to.ID, to.StartedAt.Format(time.RFC3339), len(changes))
+		for _, c := range changes {
+			fmt.Printf("[Changed] %s (%s → %s", c.URL, c.From.Status, c.To.Status)
+			if c.To.Message != "" {
+				fmt.Printf(": %s", c.To.Message)
+			}
+			fmt.Println(")")
+		}
+		return 0
+	}
+
+	fmt.Printf("%-6s %-20s %6s %7s %9s %10s\n", "Run", "Started", "Valid", "Invalid", "Transient", "Median ms")
+	for _, report := range reports[max(0, len(reports)-*limit):] {
+		counts := make(map[string]int)
+		var latencies []int64
+		for _, r := range report.Results {
+			counts[string(r.Status)]++
+			latencies = append(latencies, r.LatencyMS)
+		}
+		slices.Sort(latencies)
+		var median int64
+		if len(latencies) > 0 {
+			median = latencies[len(latencies)/2]
+		}
+		fmt.Printf("%-6d %-20s %6d %7d %9d %10d\n", report.ID, report.StartedAt.Format("2006-01-02 15:04"),
+			counts["valid"], counts["invalid"], counts["transient"], median)
+	}
+	return 0
+}
+
+// pruneCutoff is the start of the UTC day keepDays before now. Pruning whole
+// days keeps each day's rollup complete.
+func pruneCutoff(now time.Time, keepDays int) time.Time {
+	y, m, d := now.UTC().AddDate(0, 0, -keepDays).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func runPrune(args []string) int {
+	fs := flag.NewFlagSet("prune", flag.ExitOnError)
+	dbPath := fs.String("db", "history.db", "history database to prune: a SQLite file or postgres:// URL")
+	keepDays := fs.Int("keep-days", 90, "keep full results for this many days; older runs are rolled up into daily_rollups")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s prune [--db history.db] [--keep-days 90]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	if _, err := parseArgs(fs, args); err != nil {
+		return 2
+	}
+	if *keepDays < 1 {
+		fmt.Fprintf(os.Stderr, "Error: --keep-days must be at least 1\n")
+		return 2
+	}
+
+	h, err := openHistory(*dbPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening history: %v\n", err)
+		return 1
+	}
+	defer h.Close()
+
+	cutoff := pruneCutoff(time.Now(), *keepDays)
+	pruned, err := h.prune(cutoff)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error pruning history: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Rolled up and removed %d runs started before %s\n", pruned, cutoff.Format("2006-01-02"))
+	return 0
+}
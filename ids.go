@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// duplicateIDs returns the rows sharing each non-unique feed ID.
+func duplicateIDs(feeds []Feed) map[string][]string {
+	lines := make(map[string][]string)
+	for _, feed := range feeds {
+		lines[feed.ID] = append(lines[feed.ID], feed.Position())
+	}
+	for id, l := range lines {
+		if len(l) < 2 {
+			delete(lines, id)
+		}
+	}
+	return lines
+}
+
+// printDuplicateIDs lists duplicates, as returned by duplicateIDs, in ID
+// order.
+func printDuplicateIDs(w io.Writer, duplicates map[string][]string) {
+	var sorted []string
+	for id := range duplicates {
+		sorted = append(sorted, id)
+	}
+	sort.Strings(sorted)
+	for _, id := range sorted {
+		fmt.Fprintf(w, "[Duplicate ID] %s (%s)\n", id, strings.Join(duplicates[id], ", "))
+	}
+}
+
+// runAssignIDs writes derived IDs into the id column for rows that don't
+// have one yet, making them permanent. Nothing is written while two rows
+// share an ID, since the rows would stay indistinguishable once it is.
+func runAssignIDs(args []string) int {
+	fs := flag.NewFlagSet("assign-ids", flag.ExitOnError)
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s assign-ids [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+
+	if duplicates := duplicateIDs(feeds); len(duplicates) > 0 {
+		printDuplicateIDs(os.Stdout, duplicates)
+		fmt.Fprintf(os.Stderr, "Not assigning IDs: %d IDs are shared by more than one row; remove the duplicate feeds or give them distinct IDs\n", len(duplicates))
+		return 1
+	}
+
+	ids := make(map[string]string, len(feeds))
+	for _, feed := range feeds {
+		ids[feed.URL] = feed.ID
+	}
+	assigned, err := updateDatasetColumnFiles(inputFile, !*noHeader, "id", ids, false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating id column: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Assigned IDs to %d feeds in %s\n", assigned, inputFile)
+	return 0
+}
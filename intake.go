@@ -0,0 +1,378 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// Headings of the fields in .github/ISSUE_TEMPLATE/feed-suggestion.yml, as
+// rendered into the issue body.
+const (
+	intakeFieldFeeds    = "Feed URLs"
+	intakeFieldFocus    = "Geographical focus"
+	intakeFieldLanguage = "Language"
+)
+
+// issueData is the subset of `gh issue view --json number,url,title,body,author`
+// used by intake.
+type issueData struct {
+	Number int    `json:"number"`
+	URL    string `json:"url"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	Author struct {
+		Login string `json:"login"`
+	} `json:"author"`
+}
+
+// readIssue reads an issue as JSON from gh, or as the raw markdown body.
+func readIssue(r io.Reader) (*issueData, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var issue issueData
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		if err := json.Unmarshal(data, &issue); err != nil {
+			return nil, fmt.Errorf("parsing issue JSON: %w", err)
+		}
+		return &issue, nil
+	}
+	issue.Body = string(data)
+	return &issue, nil
+}
+
+// parseIssueForm splits an issue form body into its "### Heading" sections.
+// Unanswered optional fields ("_No response_") are returned empty.
+func parseIssueForm(body string) map[string]string {
+	fields := make(map[string]string)
+	var heading string
+	var value strings.Builder
+	flush := func() {
+		if heading != "" {
+			v := strings.TrimSpace(value.String())
+			if v == "_No response_" {
+				v = ""
+			}
+			fields[heading] = v
+		}
+		value.Reset()
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if h, ok := strings.CutPrefix(line, "### "); ok {
+			flush()
+			heading = strings.TrimSpace(h)
+			continue
+		}
+		value.WriteString(line)
+		value.WriteByte('\n')
+	}
+	flush()
+	return fields
+}
+
+// suggestedFeed is one line of the issue's feed list.
+type suggestedFeed struct {
+	URL      string
+	Comments string
+}
+
+// parseSuggestedFeeds extracts feeds from the "Feed URLs" field: one per
+// line, optionally followed by a comma and the feed's geographical focus.
+// Code fences, list markers and lines without an http(s) URL are ignored.
+func parseSuggestedFeeds(text string) []suggestedFeed {
+	var feeds []suggestedFeed
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimLeft(line, "-*+ ")
+		if line == "" || strings.HasPrefix(line, "```") {
+			continue
+		}
+		rawURL, comments := line, ""
+		if i := strings.IndexAny(line, ", \t"); i >= 0 {
+			rawURL, comments = line[:i], strings.TrimLeft(line[i:], ", \t")
+		}
+		rawURL = strings.Trim(rawURL, "<>")
+		u, err := url.Parse(rawURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			continue
+		}
+		feeds = append(feeds, suggestedFeed{URL: rawURL, Comments: comments})
+	}
+	return feeds
+}
+
+// intakeCandidate is a suggested feed with the outcome of its checks.
+type intakeCandidate struct {
+	Feed      Feed
+	Result    ValidationResult
+	Duplicate string   // why the feed is already covered, if it is
+	Notes     []string // non-blocking observations for curators
+}
+
+func (c *intakeCandidate) accepted() bool {
+	return c.Duplicate == "" && c.Result.Status == "valid"
+}
+
+// checkSuggestions turns suggested feeds into candidates, checking them
+// against the dataset and each other and validating the rest.
+func checkSuggestions(suggested []suggestedFeed, focus, language string, dataset []Feed) []*intakeCandidate {
+	existing := make(map[string]Feed, len(dataset))
+	outlets := make(map[string][]Feed)
+	for _, feed := range dataset {
+		existing[validator.CanonicalURL(feed.URL)] = feed
+		domain := registrableDomain(feedHost(feed.URL))
+		outlets[domain] = append(outlets[domain], feed)
+	}
+
+	var candidates []*intakeCandidate
+	seen := make(map[string]bool)
+	for _, s := range suggested {
+		comments := s.Comments
+		if comments == "" {
+			comments = focus
+		}
+		c := &intakeCandidate{Feed: Feed{
+			ID:        validator.DeriveID(s.URL),
+			URL:       s.URL,
+			Comments:  comments,
+			Language:  normalizeLanguage(language),
+			Status:    "active",
+			Tier:      validator.DefaultTier,
+			AddedDate: time.Now().Format("2006-01-02"),
+		}}
+		candidates = append(candidates, c)
+
+		canonical := validator.CanonicalURL(s.URL)
+		if feed, ok := existing[canonical]; ok {
+			c.Duplicate = "already listed at " + feed.Position()
+			continue
+		}
+		if seen[canonical] {
+			c.Duplicate = "suggested twice"
+			continue
+		}
+		seen[canonical] = true
+
+		if same := outlets[registrableDomain(feedHost(s.URL))]; len(same) > 0 {
+			c.Notes = append(c.Notes, fmt.Sprintf("%d other feeds from this outlet are listed, e.g. %s (%s)", len(same), same[0].Position(), same[0].Comments))
+		}
+		if r := regionForFeed(c.Feed); r.Region == regionUnassigned {
+			c.Notes = append(c.Notes, fmt.Sprintf("geographical focus %q not recognized", comments))
+		}
+		if c.Feed.Language == "" {
+			c.Notes = append(c.Notes, "no language given")
+		}
+	}
+
+	// The validator runs the candidates within its concurrency limit, however
+	// many an issue lists. Each has its own ID, as duplicates were set aside.
+	byID := make(map[string]*intakeCandidate, len(candidates))
+	var feeds []Feed
+	for _, c := range candidates {
+		if c.Duplicate == "" {
+			byID[c.Feed.ID] = c
+			feeds = append(feeds, c.Feed)
+		}
+	}
+	for result := range newValidator(nil, nil).ValidateAll(context.Background(), feeds) {
+		c := byID[result.ID]
+		c.Result = result
+		if c.Result.Access != validator.AccessOpen {
+			c.Notes = append(c.Notes, "items look access-restricted ("+c.Result.Access+")")
+		}
+	}
+	return candidates
+}
+
+// printCandidates reports each candidate's outcome on stderr and returns how
+// many were accepted.
+func printCandidates(candidates []*intakeCandidate) int {
+	accepted := 0
+	for _, c := range candidates {
+		status := "accepted"
+		switch {
+		case c.Duplicate != "":
+			status = "duplicate: " + c.Duplicate
+		case !c.accepted():
+			status = string(c.Result.Status) + ": " + c.Result.Message
+		default:
+			accepted++
+		}
+		fmt.Fprintf(os.Stderr, "%s → %s\n", c.Feed.URL, status)
+	}
+	return accepted
+}
+
+// intakeComment renders the reply posted on the suggestion issue.
+func intakeComment(issue *issueData, candidates []*intakeCandidate) string {
+	var b strings.Builder
+	if issue.Author.Login != "" {
+		fmt.Fprintf(&b, "Thanks for the suggestion, @%s! ", issue.Author.Login)
+	} else {
+		b.WriteString("Thanks for the suggestion! ")
+	}
+	if len(candidates) == 0 {
+		b.WriteString("We couldn't find any feed URLs in the issue. Please list one http(s) feed URL per line under \"" + intakeFieldFeeds + "\".\n")
+		return b.String()
+	}
+	b.WriteString("Here are the results of the automated checks:\n\n| Feed | Result |\n| --- | --- |\n")
+
+	accepted := 0
+	for _, c := range candidates {
+		var outcome string
+		switch {
+		case c.Duplicate != "":
+			outcome = "♻️ Duplicate: " + c.Duplicate
+		case c.Result.Status == "valid":
+			accepted++
+			outcome = fmt.Sprintf("✅ Valid feed with %d items", c.Result.ItemCount)
+			if c.Result.Message != "" {
+				outcome += " (" + strings.TrimPrefix(c.Result.Message, "Warning: ") + ")"
+			}
+		case c.Result.Status == "transient":
+			outcome = "⚠️ Temporarily unreachable (" + c.Result.Message + "); we'll retry"
+		default:
+			outcome = "❌ " + c.Result.Message
+		}
+		for _, note := range c.Notes {
+			outcome += "<br>ℹ️ " + note
+		}
+		fmt.Fprintf(&b, "| %s | %s |\n", c.Feed.URL, strings.ReplaceAll(outcome, "|", "\\|"))
+	}
+
+	switch accepted {
+	case 0:
+		b.WriteString("\nNone of the feeds can be added as they are.\n")
+	case 1:
+		b.WriteString("\nA curator will review the valid feed before adding it.\n")
+	default:
+		fmt.Fprintf(&b, "\nA curator will review the %d valid feeds before adding them.\n", accepted)
+	}
+	return b.String()
+}
+
+func runIntake(args []string) int {
+	fs := flag.NewFlagSet("intake", flag.ExitOnError)
+	datasetPath := fs.String("dataset", "feeds.csv", "dataset to check for duplicates")
+	outPath := fs.String("o", "", "write accepted rows to this file (default stdout)")
+	apply := fs.Bool("apply", false, "append accepted feeds to the dataset instead of printing rows")
+	commentPath := fs.String("comment", "", "write the issue reply (markdown) to this file")
+	noHeader := fs.Bool("no-header", false, "dataset has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s intake [--comment FILE] [-o FILE | --apply] ISSUE.json|ISSUE.md|-\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Reads a feed-suggestion issue, e.g. from `gh issue view N --json number,url,title,body,author`.\n")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	var in io.Reader = os.Stdin
+	if positional[0] != "-" {
+		src, err := openSource(positional[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening %s: %v\n", positional[0], err)
+			return 1
+		}
+		defer src.Close()
+		in = src
+	}
+	issue, err := readIssue(in)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading issue: %v\n", err)
+		return 1
+	}
+
+	dataset, err := loadDataset(*datasetPath, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", *datasetPath, err)
+		return 1
+	}
+
+	form := parseIssueForm(issue.Body)
+	suggested := parseSuggestedFeeds(form[intakeFieldFeeds])
+	candidates := checkSuggestions(suggested, form[intakeFieldFocus], form[intakeFieldLanguage], dataset)
+
+	source := issue.URL
+	if source == "" && issue.Number > 0 {
+		source = fmt.Sprintf("issue #%d", issue.Number)
+	}
+	var feeds []Feed
+	for _, c := range candidates {
+		c.Feed.AddedBy, c.Feed.Source = issue.Author.Login, source
+		if c.accepted() {
+			feeds = append(feeds, c.Feed)
+		}
+	}
+	accepted := printCandidates(candidates)
+
+	if *apply {
+		if len(feeds) > 0 {
+			if err := addToDataset(*datasetPath, !*noHeader, feeds); err != nil {
+				fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", *datasetPath, err)
+				return 1
+			}
+		}
+	} else {
+		files, err := datasetFiles(*datasetPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", *datasetPath, err)
+			return 1
+		}
+		columns, err := datasetHeaderColumns(files[0], !*noHeader)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", *datasetPath, err)
+			return 1
+		}
+		var w io.Writer = os.Stdout
+		if *outPath != "" {
+			file, err := os.Create(*outPath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outPath, err)
+				return 1
+			}
+			defer file.Close()
+			w = file
+		}
+		out := csv.NewWriter(w)
+		for _, feed := range feeds {
+			out.Write(feedRecord(feed, columns))
+		}
+		out.Flush()
+		if err := out.Error(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing rows: %v\n", err)
+			return 1
+		}
+	}
+
+	if *commentPath != "" {
+		if err := os.WriteFile(*commentPath, []byte(intakeComment(issue, candidates)), 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *commentPath, err)
+			return 1
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "%d of %d suggested feeds accepted\n", accepted, len(candidates))
+	return 0
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// outageHistoryLimit caps how many failure spans state keeps per outage;
+// the oldest are dropped first.
+const outageHistoryLimit = 20
+
+// deadFeedLabel is applied to every issue the tracker opens, alongside a
+// country:XX label.
+const deadFeedLabel = "dead-feed"
+
+// noteOutage tracks consecutive invalid runs and their failure history.
+// Transient errors during an outage are recorded but neither start nor end
+// it, as in findDeadFeeds.
+func (fs *feedState) noteOutage(r ValidationResult, now time.Time) {
+	switch {
+	case r.Status == "valid":
+		fs.FailedRuns, fs.Outage = 0, nil
+		return
+	case r.Status == "invalid":
+		fs.FailedRuns++
+	case fs.FailedRuns == 0:
+		return
+	}
+	if n := len(fs.Outage); n > 0 && fs.Outage[n-1].Status == string(r.Status) && fs.Outage[n-1].Message == r.Message {
+		fs.Outage[n-1].To = now
+		fs.Outage[n-1].Runs++
+		return
+	}
+	fs.Outage = append(fs.Outage, outageSpan{From: now, To: now, Runs: 1, Status: string(r.Status), Message: r.Message})
+	if len(fs.Outage) > outageHistoryLimit {
+		fs.Outage = fs.Outage[len(fs.Outage)-outageHistoryLimit:]
+	}
+}
+
+// issueTracker keeps one GitHub issue open per dead feed: it opens an
+// issue once a feed has been invalid for deadAfter consecutive runs, and
+// closes it when the feed validates again or leaves the dataset.
+type issueTracker struct {
+	gh        *githubClient
+	deadAfter int
+}
+
+func newIssueTracker(deadAfter int) (*issueTracker, error) {
+	if deadAfter <= 0 {
+		return nil, nil
+	}
+	gh, err := newGitHubClient()
+	if err != nil {
+		return nil, err
+	}
+	return &issueTracker{gh: gh, deadAfter: deadAfter}, nil
+}
+
+// sync opens and closes issues for a run's results, recording issue
+// numbers in state. Failed calls are retried on the next run.
+func (t *issueTracker) sync(state *validatorState, feeds []Feed, results []ValidationResult, now time.Time) error {
+	byURL := make(map[string]Feed, len(feeds))
+	for _, feed := range feeds {
+		byURL[feed.URL] = feed
+	}
+	rows := indexRows(feeds)
+	var errs []error
+	for _, r := range results {
+		feed, ok := rows.of(r)
+		if !ok {
+			continue
+		}
+		fs, ok := state.Feeds[feed.URL]
+		if !ok {
+			continue
+		}
+		switch {
+		case fs.Issue == 0 && fs.FailedRuns >= t.deadAfter:
+			number, err := t.open(feed, fs)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("opening issue for %s: %w", r.URL, err))
+				continue
+			}
+			fs.Issue = number
+			fmt.Printf("[Issue] #%d opened for %s\n", number, r.URL)
+		case fs.Issue != 0 && r.Status == "valid":
+			if err := t.close(fs.Issue, fmt.Sprintf("Validated again at %s.", now.UTC().Format(time.RFC3339)), "completed"); err != nil {
+				errs = append(errs, fmt.Errorf("closing issue #%d: %w", fs.Issue, err))
+				continue
+			}
+			fmt.Printf("[Issue] #%d closed, %s recovered\n", fs.Issue, r.URL)
+			fs.Issue = 0
+		}
+	}
+	for url, fs := range state.Feeds {
+		if _, ok := byURL[url]; ok || fs.Issue == 0 {
+			continue
+		}
+		if err := t.close(fs.Issue, "The feed was removed from the dataset.", "not_planned"); err != nil {
+			errs = append(errs, fmt.Errorf("closing issue #%d: %w", fs.Issue, err))
+			continue
+		}
+		fmt.Printf("[Issue] #%d closed, %s removed\n", fs.Issue, url)
+		fs.Issue = 0
+	}
+	return errors.Join(errs...)
+}
+
+func (t *issueTracker) open(feed Feed, fs *feedState) (int, error) {
+	labels := []string{deadFeedLabel}
+	if c, ok := countryFromComments(feed.Comments); ok {
+		labels = append(labels, "country:"+c.Alpha2)
+	}
+	var created struct {
+		Number int `json:"number"`
+	}
+	err := t.gh.do("POST", "/issues", map[string]any{
+		"title":  "Dead feed: " + feed.URL,
+		"body":   issueBody(feed, fs),
+		"labels": labels,
+	}, &created)
+	return created.Number, err
+}
+
+// close comments on an issue and closes it with reason, "completed" or
+// "not_planned".
+func (t *issueTracker) close(number int, comment, reason string) error {
+	path := fmt.Sprintf("/issues/%d", number)
+	if err := t.gh.do("POST", path+"/comments", map[string]string{"body": comment}, nil); err != nil {
+		return err
+	}
+	return t.gh.do("PATCH", path, map[string]string{"state": "closed", "state_reason": reason}, nil)
+}
+
+// issueBody describes a dead feed and its failure history as markdown.
+func issueBody(feed Feed, fs *feedState) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s has failed validation for %d consecutive runs.\n\n", feed.URL, fs.FailedRuns)
+	fmt.Fprintf(&b, "- ID: `%s`\n", feed.ID)
+	if feed.Comments != "" {
+		fmt.Fprintf(&b, "- Focus: %s\n", feed.Comments)
+	}
+	if feed.File != "" {
+		fmt.Fprintf(&b, "- Dataset file: `%s`\n", feed.File)
+	}
+	if !fs.SnapshotAt.IsZero() {
+		fmt.Fprintf(&b, "- Last good fetch: %s\n", fs.SnapshotAt.UTC().Format("2006-01-02"))
+	}
+	fmt.Fprintf(&b, "\n## Failure history\n\n| From | To | Runs | Status | Message |\n| --- | --- | --- | --- | --- |\n")
+	for _, s := range fs.Outage {
+		fmt.Fprintf(&b, "| %s | %s | %d | %s | %s |\n", s.From.UTC().Format(time.RFC3339), s.To.UTC().Format(time.RFC3339), s.Runs, s.Status,
+			strings.ReplaceAll(s.Message, "|", "\\|"))
+	}
+	fmt.Fprintf(&b, "\nThis issue is closed automatically when the feed validates again.\n")
+	return b.String()
+}
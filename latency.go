@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// slowestFeedsShown is how many feeds the trend report lists as slowest.
+const slowestFeedsShown = 10
+
+// feedLatency summarizes how long a feed's validations took, retries
+// included, so slow sources can be polled with longer timeouts.
+type feedLatency struct {
+	Feed    Feed
+	Samples int
+	P50     int64
+	P95     int64
+	Max     int64
+}
+
+// percentile returns the nearest-rank percentile p of sorted values.
+func percentile(sorted []int64, p int) int64 {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+// feedLatencies computes each feed's latency percentiles over its results in
+// [from, to), slowest (by p95) first. Failed fetches count too, since a feed
+// that times out costs the most. Feeds without timed results are left out.
+func feedLatencies(feeds []Feed, history historyIndex, from, to time.Time) []feedLatency {
+	var latencies []feedLatency
+	for _, feed := range feeds {
+		var samples []int64
+		for _, o := range history.forFeed(feed) {
+			if !o.at.Before(from) && o.at.Before(to) && o.result.LatencyMS > 0 {
+				samples = append(samples, o.result.LatencyMS)
+			}
+		}
+		if len(samples) == 0 {
+			continue
+		}
+		sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+		latencies = append(latencies, feedLatency{
+			Feed: feed, Samples: len(samples),
+			P50: percentile(samples, 50), P95: percentile(samples, 95), Max: samples[len(samples)-1],
+		})
+	}
+	sort.SliceStable(latencies, func(i, j int) bool { return latencies[i].P95 > latencies[j].P95 })
+	return latencies
+}
+
+func runLatency(args []string) int {
+	fs := flag.NewFlagSet("latency", flag.ExitOnError)
+	historyPath := fs.String("history", "history.db", "history database, or glob of run reports written by validation with --json")
+	days := fs.Int("days", 30, "compute percentiles over this many days of runs")
+	top := fs.Int("top", 20, "list this many of the slowest feeds (0 lists all)")
+	format := fs.String("format", "text", "output format: text or csv")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s latency [--history DB|GLOB] [--days 30] [--top 20] [--format text|csv] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	reports, err := loadHistory(*historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+		return 1
+	}
+
+	now := time.Now()
+	latencies := feedLatencies(feeds, indexHistory(reports), now.AddDate(0, 0, -*days), now.Add(time.Second))
+	if *top > 0 && len(latencies) > *top {
+		latencies = latencies[:*top]
+	}
+
+	switch *format {
+	case "csv":
+		w := csv.NewWriter(os.Stdout)
+		w.Write([]string{"id", "url", "samples", "p50_ms", "p95_ms", "max_ms"})
+		for _, l := range latencies {
+			w.Write([]string{l.Feed.ID, l.Feed.URL, strconv.Itoa(l.Samples),
+				strconv.FormatInt(l.P50, 10), strconv.FormatInt(l.P95, 10), strconv.FormatInt(l.Max, 10)})
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing latencies: %v\n", err)
+			return 1
+		}
+	case "text":
+		fmt.Printf("Slowest feeds over the last %d days:\n\n", *days)
+		writeLatencyTable(os.Stdout, latencies)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	return 0
+}
+
+func writeLatencyTable(w io.Writer, latencies []feedLatency) {
+	fmt.Fprintf(w, "%8s %8s %8s %7s  %s\n", "p50", "p95", "max", "samples", "Feed")
+	for _, l := range latencies {
+		fmt.Fprintf(w, "%8s %8s %8s %7d  %s\n", formatMillis(l.P50), formatMillis(l.P95), formatMillis(l.Max), l.Samples, l.Feed.URL)
+	}
+}
+
+// formatMillis renders a latency as e.g. "850ms" or "12.4s".
+func formatMillis(ms int64) string {
+	if ms < 1000 {
+		return fmt.Sprintf("%dms", ms)
+	}
+	return fmt.Sprintf("%.1fs", float64(ms)/1000)
+}
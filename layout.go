@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// The dataset may be a single CSV file or split across several, typically
+// one per country (feeds/de.csv, feeds/br.csv, ...). Commands take a dataset
+// spec: a file, a directory of .csv files, or a glob.
+
+// datasetFiles resolves a dataset spec to its files in a stable order.
+func datasetFiles(spec string) ([]string, error) {
+	info, err := os.Stat(spec)
+	switch {
+	case err == nil && info.IsDir():
+		files, err := filepath.Glob(filepath.Join(spec, "*.csv"))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no .csv files in %s", spec)
+		}
+		return files, nil
+	case err == nil:
+		return []string{spec}, nil
+	case os.IsNotExist(err) && strings.ContainsAny(spec, "*?["):
+		files, err := filepath.Glob(spec)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no files match %s", spec)
+		}
+		sort.Strings(files)
+		return files, nil
+	}
+	return nil, err
+}
+
+// loadDataset loads every file of a dataset spec, or stdin for "-". A feed
+// listed in more than one file, however its URL is written there (see
+// validator.CanonicalURL), is reported and only its first row kept, so
+// merged files behave like a single dataset.
+func loadDataset(spec string, hasHeader bool) ([]Feed, error) {
+	if spec == "-" {
+		feeds, err := readFeeds(os.Stdin, hasHeader)
+		for i := range feeds {
+			feeds[i].File = spec
+		}
+		return feeds, err
+	}
+	files, err := datasetFiles(spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 1 {
+		return loadFeeds(files[0], hasHeader)
+	}
+
+	var feeds []Feed
+	seen := make(map[string]Feed)
+	for _, file := range files {
+		fileFeeds, err := loadFeeds(file, hasHeader)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		for _, feed := range fileFeeds {
+			key := validator.CanonicalURL(feed.URL)
+			if first, ok := seen[key]; ok && first.File != feed.File {
+				if first.URL == feed.URL {
+					fmt.Fprintf(os.Stderr, "Warning: %s is listed at both %s and %s; ignoring the latter\n", feed.URL, first.Position(), feed.Position())
+				} else {
+					fmt.Fprintf(os.Stderr, "Warning: %s at %s is listed as %s at %s; ignoring the latter\n", first.URL, first.Position(), feed.URL, feed.Position())
+				}
+				continue
+			}
+			seen[key] = feed
+			feeds = append(feeds, feed)
+		}
+	}
+	return feeds, nil
+}
+
+// updateDatasetColumnFiles applies updateDatasetColumn to every file of a
+// dataset and returns the total number of rows changed.
+func updateDatasetColumnFiles(spec string, hasHeader bool, column string, values map[string]string, overwrite bool) (int, error) {
+	files, err := datasetFiles(spec)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, file := range files {
+		n, err := updateDatasetColumn(file, hasHeader, column, values, overwrite)
+		if err != nil {
+			return total, fmt.Errorf("%s: %w", file, err)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// datasetFileFor picks the file a new feed is added to: the dataset itself
+// for a single file, or the file for the feed's country in a directory
+// layout (global.csv for worldwide feeds, other.csv when unknown).
+func datasetFileFor(spec string, feed Feed) (string, error) {
+	info, err := os.Stat(spec)
+	if err != nil {
+		return "", fmt.Errorf("feeds can only be added to a dataset file or directory: %w", err)
+	}
+	if !info.IsDir() {
+		return spec, nil
+	}
+	name := "other"
+	if c, ok := countryFromComments(feed.Comments); ok {
+		name = strings.ToLower(c.Alpha2)
+	} else if r := regionForFeed(feed); r.Region == regionGlobal {
+		name = "global"
+	}
+	return filepath.Join(spec, name+".csv"), nil
+}
+
+// addToDataset appends feeds to the files datasetFileFor picks for them.
+func addToDataset(spec string, hasHeader bool, feeds []Feed) error {
+	byFile := make(map[string][]Feed)
+	var order []string
+	for _, feed := range feeds {
+		file, err := datasetFileFor(spec, feed)
+		if err != nil {
+			return err
+		}
+		if _, ok := byFile[file]; !ok {
+			order = append(order, file)
+		}
+		byFile[file] = append(byFile[file], feed)
+	}
+	for _, file := range order {
+		if err := appendFeeds(file, hasHeader, byFile[file]); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+	return nil
+}
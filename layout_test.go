@@ -0,0 +1,37 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadDatasetDuplicates(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"de.csv": "url,comments\nhttps://www.example.de/rss,Germany\nhttps://example.de/other,Germany\n",
+		"fr.csv": "url,comments\nexample.de/rss/,France\nhttp://example.de/other,France\nhttps://example.fr/rss,France\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	feeds, err := loadDataset(dir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, feed := range feeds {
+		got = append(got, feed.URL)
+	}
+	want := []string{"https://www.example.de/rss", "https://example.de/other", "https://example.fr/rss"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("feed %d: got %s, want %s", i, got[i], want[i])
+		}
+	}
+}
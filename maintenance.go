@@ -0,0 +1,188 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// maintenanceWindow is a recurring period from the --maintenance file in
+// which some feeds are known to go offline, such as a broadcaster's nightly
+// CMS maintenance. It covers the feeds listed by ID or URL and every feed on
+// the listed hosts (and their subdomains). Start and End are HH:MM in
+// Timezone (UTC if unset); a window whose end is before its start runs past
+// midnight. Days, if set, are the weekdays ("mon" to "sun") it starts on.
+type maintenanceWindow struct {
+	Name     string   `json:"name"`
+	Hosts    []string `json:"hosts,omitempty"`
+	Feeds    []string `json:"feeds,omitempty"`
+	Timezone string   `json:"timezone,omitempty"`
+	Days     []string `json:"days,omitempty"`
+	Start    string   `json:"start"`
+	End      string   `json:"end"`
+
+	loc        *time.Location
+	start, end time.Duration
+	days       map[time.Weekday]bool
+}
+
+// maintenanceWindows are the windows failures are held for.
+type maintenanceWindows []maintenanceWindow
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("time %q is not HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// clockOn returns the time of day d on day, in day's location.
+func clockOn(day time.Time, d time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, int(d/time.Minute), 0, 0, day.Location())
+}
+
+// loadMaintenanceWindows reads a JSON file of the form {"windows": [...]}.
+func loadMaintenanceWindows(path string) (maintenanceWindows, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Windows maintenanceWindows `json:"windows"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for i := range file.Windows {
+		w := &file.Windows[i]
+		if w.Name == "" {
+			return nil, fmt.Errorf("%s: window %d has no name", path, i+1)
+		}
+		if len(w.Hosts) == 0 && len(w.Feeds) == 0 {
+			return nil, fmt.Errorf("%s: window %q lists no hosts or feeds", path, w.Name)
+		}
+		if w.loc, err = time.LoadLocation(w.Timezone); err != nil {
+			return nil, fmt.Errorf("%s: window %q: %w", path, w.Name, err)
+		}
+		if w.start, err = parseClock(w.Start); err != nil {
+			return nil, fmt.Errorf("%s: window %q: %w", path, w.Name, err)
+		}
+		if w.end, err = parseClock(w.End); err != nil {
+			return nil, fmt.Errorf("%s: window %q: %w", path, w.Name, err)
+		}
+		if w.start == w.end {
+			return nil, fmt.Errorf("%s: window %q is empty", path, w.Name)
+		}
+		if len(w.Days) > 0 {
+			w.days = make(map[time.Weekday]bool)
+			for _, d := range w.Days {
+				name := strings.ToLower(d)
+				day, ok := weekdays[name[:min(3, len(name))]]
+				if !ok {
+					return nil, fmt.Errorf("%s: window %q: unknown day %q", path, w.Name, d)
+				}
+				w.days[day] = true
+			}
+		}
+		for j, h := range w.Hosts {
+			w.Hosts[j] = strings.ToLower(strings.TrimPrefix(h, "www."))
+		}
+	}
+	return file.Windows, nil
+}
+
+// covers reports whether the window applies to feed. URLs are compared as
+// validated, so scheme-less and corrected rows are covered too.
+func (w *maintenanceWindow) covers(feed Feed) bool {
+	normalized := validator.NormalizeURL(feed.URL)
+	for _, f := range w.Feeds {
+		if f == feed.ID || validator.NormalizeURL(f) == normalized {
+			return true
+		}
+	}
+	host := feedHost(feed.URL)
+	if host == "" {
+		return false
+	}
+	for _, h := range w.Hosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// until returns the end of the occurrence of the window that at falls in.
+// Occurrences starting the day before are checked for windows that run past
+// midnight.
+func (w *maintenanceWindow) until(at time.Time) (time.Time, bool) {
+	local := at.In(w.loc)
+	for _, back := range []int{0, 1} {
+		day := time.Date(local.Year(), local.Month(), local.Day()-back, 0, 0, 0, 0, w.loc)
+		if w.days != nil && !w.days[day.Weekday()] {
+			continue
+		}
+		start, end := clockOn(day, w.start), clockOn(day, w.end)
+		if w.end < w.start {
+			end = clockOn(day.AddDate(0, 0, 1), w.end)
+		}
+		if !at.Before(start) && at.Before(end) {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// heldFailure is a failure inside one of its feed's maintenance windows,
+// held until the window ends instead of being reported.
+type heldFailure struct {
+	Result ValidationResult
+	Window string
+	Until  time.Time
+}
+
+func (h heldFailure) String() string {
+	return fmt.Sprintf("[Held] %s (%s; %s until %s)", h.Result.URL, h.Result.Message, h.Window, h.Until.Format(time.RFC3339))
+}
+
+// heldFor returns the hold of r, a failure of feed, if feed is inside one
+// of its maintenance windows at now.
+func (ws maintenanceWindows) heldFor(feed Feed, r ValidationResult, now time.Time) (heldFailure, bool) {
+	for i := range ws {
+		w := &ws[i]
+		if !w.covers(feed) {
+			continue
+		}
+		if until, ok := w.until(now); ok {
+			return heldFailure{Result: r, Window: w.Name, Until: until}, true
+		}
+	}
+	return heldFailure{}, false
+}
+
+// recordHeld makes held feeds due again when their window ends, without
+// changing what state knows of their status.
+func recordHeld(state *validatorState, feeds []Feed, held []heldFailure) {
+	if state == nil {
+		return
+	}
+	rows := indexRows(feeds)
+	for _, h := range held {
+		if feed, ok := rows.of(h.Result); ok {
+			state.feed(feed).HeldUntil = h.Until
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMaintenanceWindowDays(t *testing.T) {
+	tests := []struct {
+		name    string
+		day     string
+		wantErr bool
+	}{
+		{"short", "mon", false},
+		{"long", "Monday", false},
+		{"unknown", "someday", true},
+		// The Kelvin sign lowercases to a single byte.
+		{"shorter when lowercased", "K", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "maintenance.json")
+			data := `{"windows": [{"name": "nightly", "hosts": ["example.com"], "days": ["` + tt.day + `"], "start": "01:00", "end": "02:00"}]}`
+			if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := loadMaintenanceWindows(path); (err != nil) != tt.wantErr {
+				t.Errorf("error %v, want one: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowCovers(t *testing.T) {
+	w := maintenanceWindow{Hosts: []string{"example.com"}, Feeds: []string{"https://news.example.org/rss", "feed-id"}}
+	tests := []struct {
+		feed Feed
+		want bool
+	}{
+		{Feed{URL: "https://example.com/rss"}, true},
+		{Feed{URL: "https://www.Example.com/rss"}, true},
+		{Feed{URL: "feeds.example.com/rss"}, true},
+		{Feed{URL: "//example.com/rss"}, true},
+		{Feed{URL: "news.example.org/rss"}, true},
+		{Feed{ID: "feed-id", URL: "https://other.example/rss"}, true},
+		{Feed{URL: "https://notexample.com/rss"}, false},
+		{Feed{URL: "https://news.example.org/atom"}, false},
+	}
+	for _, tt := range tests {
+		if got := w.covers(tt.feed); got != tt.want {
+			t.Errorf("covers(%q): got %v, want %v", tt.feed.URL, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/url"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// runManifest describes how a run was made, so results from different
+// machines and versions can be compared: which build validated which exact
+// input with which settings.
+type runManifest struct {
+	SchemaVersion string      `json:"schema_version"`
+	Tool          toolInfo    `json:"tool"`
+	Input         string      `json:"input"`
+	InputFiles    []inputFile `json:"input_files"`
+	// DatasetVersion is the release tag of the dataset validated, when it
+	// was pinned with --dataset-version.
+	DatasetVersion string            `json:"dataset_version,omitempty"`
+	StartedAt      time.Time         `json:"started_at"`
+	FinishedAt     time.Time         `json:"finished_at"`
+	Config         map[string]string `json:"config"`
+	Settings       runSettings       `json:"settings"`
+	Feeds          int               `json:"feeds"`
+	Validated      int               `json:"validated"`
+	Counts         map[string]int    `json:"counts"`
+	Environment    environmentInfo   `json:"environment"`
+	// ConcurrencyChanges are the adjustments made by --adaptive-concurrency.
+	ConcurrencyChanges []validator.ConcurrencyChange `json:"concurrency_changes,omitempty"`
+}
+
+// toolInfo identifies the build, from the module and VCS information Go
+// embeds in binaries.
+type toolInfo struct {
+	Version   string `json:"version"`
+	Revision  string `json:"revision,omitempty"`
+	Modified  bool   `json:"modified,omitempty"`
+	GoVersion string `json:"go_version"`
+}
+
+type inputFile struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+}
+
+// runSettings are the validation limits that affect results.
+type runSettings struct {
+	Concurrency         int   `json:"concurrency"`
+	TimeoutSeconds      int   `json:"timeout_seconds"`
+	MaxRetries          int   `json:"max_retries"`
+	HostIntervalMS      int64 `json:"host_interval_ms,omitempty"`
+	MaxBodyMB           int   `json:"max_body_mb"`
+	DNSWarmup           bool  `json:"dns_warmup,omitempty"`
+	AdaptiveMin         int   `json:"adaptive_concurrency_min,omitempty"`
+	Hedge               bool  `json:"hedge,omitempty"`
+	SerialHosts         bool  `json:"serial_hosts,omitempty"`
+	DomainBudgetSeconds int   `json:"domain_budget_seconds,omitempty"`
+	CookieJar           bool  `json:"cookie_jar,omitempty"`
+	CheckHomepage       bool  `json:"check_homepage,omitempty"`
+}
+
+type environmentInfo struct {
+	Hostname string `json:"hostname,omitempty"`
+	OS       string `json:"os"`
+	Arch     string `json:"arch"`
+	CPUs     int    `json:"cpus"`
+	// CIRun links to the CI run, when running under GitHub Actions.
+	CIRun string `json:"ci_run,omitempty"`
+}
+
+func currentTool() toolInfo {
+	t := toolInfo{Version: "unknown", GoVersion: runtime.Version()}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return t
+	}
+	t.Version = info.Main.Version
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			t.Revision = s.Value
+		case "vcs.modified":
+			t.Modified = s.Value == "true"
+		}
+	}
+	return t
+}
+
+func currentEnvironment() environmentInfo {
+	env := environmentInfo{OS: runtime.GOOS, Arch: runtime.GOARCH, CPUs: runtime.NumCPU()}
+	env.Hostname, _ = os.Hostname()
+	if id := os.Getenv("GITHUB_RUN_ID"); id != "" {
+		env.CIRun = os.Getenv("GITHUB_SERVER_URL") + "/" + os.Getenv("GITHUB_REPOSITORY") + "/actions/runs/" + id
+	}
+	return env
+}
+
+// flagSnapshot records every flag's value. URLs are cut down to their scheme
+// and host, since webhook and broker URLs often carry credentials.
+func flagSnapshot(fs *flag.FlagSet) map[string]string {
+	config := make(map[string]string)
+	fs.VisitAll(func(f *flag.Flag) {
+		value := f.Value.String()
+		if u, err := url.Parse(value); err == nil && u.Scheme != "" && u.Host != "" {
+			value = u.Scheme + "://" + u.Host
+		}
+		config[f.Name] = value
+	})
+	return config
+}
+
+// hashInputFiles returns the SHA-256 of each of the dataset's files, none
+// for a dataset read from stdin.
+func hashInputFiles(spec string) ([]inputFile, error) {
+	if spec == "-" {
+		return []inputFile{}, nil
+	}
+	files, err := datasetFiles(spec)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]inputFile, 0, len(files))
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, inputFile{Path: file, SHA256: hex.EncodeToString(h.Sum(nil))})
+	}
+	return hashes, nil
+}
+
+// newRunManifest describes a finished run of feeds feeds.
+func newRunManifest(report *runReport, feeds int, config map[string]string, settings runSettings) (*runManifest, error) {
+	files, err := hashInputFiles(report.Input)
+	if err != nil {
+		return nil, err
+	}
+	m := &runManifest{
+		SchemaVersion: validator.SchemaVersion,
+		Tool:          currentTool(),
+		Input:         report.Input,
+		InputFiles:    files,
+		StartedAt:     report.StartedAt,
+		FinishedAt:    report.FinishedAt,
+		Config:        config,
+		Settings:      settings,
+		Feeds:         feeds,
+		Validated:     len(report.Results),
+		Counts:        make(map[string]int),
+		Environment:   currentEnvironment(),
+	}
+	for _, r := range report.Results {
+		m.Counts[string(r.Status)]++
+	}
+	return m, nil
+}
+
+func (m *runManifest) save(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "feed_validator"
+
+// validatorMetrics describes dataset health for Prometheus: served on
+// /metrics by serve, or written for node_exporter's textfile collector with
+// --metrics.
+type validatorMetrics struct {
+	registry  *prometheus.Registry
+	feeds     *prometheus.GaugeVec
+	countries *prometheus.GaugeVec
+	flapping  prometheus.Gauge
+	results   *prometheus.CounterVec
+	duration  *prometheus.HistogramVec
+	lastRun   prometheus.Gauge
+}
+
+func newValidatorMetrics() *validatorMetrics {
+	m := &validatorMetrics{
+		registry: prometheus.NewRegistry(),
+		feeds: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "feeds",
+			Help:      "Dataset feeds by last definite validation status.",
+		}, []string{"status"}),
+		countries: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "country_feeds",
+			Help:      "Dataset feeds by ISO 3166-1 country and last definite validation status.",
+		}, []string{"country", "status"}),
+		flapping: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "flapping_feeds",
+			Help:      "Feeds currently flapping between valid and failing.",
+		}),
+		results: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "results_total",
+			Help:      "Validation results by status, including transient errors.",
+		}, []string{"status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "validation_duration_seconds",
+			Help:      "Time taken to validate a feed, including retries.",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+		}, []string{"status"}),
+		lastRun: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_run_timestamp_seconds",
+			Help:      "When the last validation run or cycle started.",
+		}),
+	}
+	m.registry.MustRegister(m.feeds, m.countries, m.flapping, m.results, m.duration, m.lastRun)
+	return m
+}
+
+// observe records a run's results.
+func (m *validatorMetrics) observe(results []ValidationResult, startedAt time.Time) {
+	for _, r := range results {
+		m.results.WithLabelValues(string(r.Status)).Inc()
+		m.duration.WithLabelValues(string(r.Status)).Observe(float64(r.LatencyMS) / 1000)
+	}
+	m.lastRun.Set(float64(startedAt.Unix()))
+}
+
+// setStatuses replaces the per-status gauges. status gives a feed's last
+// definite status, or "" if it has none yet.
+func (m *validatorMetrics) setStatuses(feeds []Feed, status func(Feed) string, flapping int) {
+	m.feeds.Reset()
+	m.countries.Reset()
+	for _, feed := range feeds {
+		s := status(feed)
+		if s == "" {
+			s = "unknown"
+		}
+		country := "unknown"
+		if c, ok := countryFromComments(feed.Comments); ok {
+			country = c.Alpha2
+		}
+		m.feeds.WithLabelValues(s).Inc()
+		m.countries.WithLabelValues(country, s).Inc()
+	}
+	m.flapping.Set(float64(flapping))
+}
+
+// setStatusesFromState sets the status gauges from what state remembers of
+// each feed.
+func (m *validatorMetrics) setStatusesFromState(feeds []Feed, state *validatorState) {
+	var flapping int
+	for _, feed := range feeds {
+		if state.isFlapping(feed.URL) {
+			flapping++
+		}
+	}
+	m.setStatuses(feeds, func(feed Feed) string {
+		if fs, ok := state.Feeds[feed.URL]; ok {
+			return fs.LastStatus
+		}
+		return ""
+	}, flapping)
+}
+
+func (m *validatorMetrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// writeTextfile writes the metrics in the text exposition format, replacing
+// path atomically as the textfile collector expects.
+func (m *validatorMetrics) writeTextfile(path string) error {
+	return prometheus.WriteToTextfile(path, m.registry)
+}
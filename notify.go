@@ -0,0 +1,251 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// statusEvent is a feed status change sent to webhooks. Event is one of
+// died, recovered, flapping, stable or quarantined, the last with the
+// policy's reason as Message.
+type statusEvent struct {
+	Event   string    `json:"event"`
+	FeedID  string    `json:"feed_id"`
+	URL     string    `json:"url"`
+	Country string    `json:"country,omitempty"`
+	Status  string    `json:"status"`
+	Message string    `json:"message,omitempty"`
+	At      time.Time `json:"at"`
+}
+
+// events flattens a run's transitions into webhook events.
+func (t runTransitions) events(feeds []Feed, at time.Time) []statusEvent {
+	rows := indexRows(feeds)
+	event := func(kind string, r ValidationResult) statusEvent {
+		e := statusEvent{Event: kind, FeedID: r.ID, URL: r.URL, Status: string(r.Status), Message: r.Message, At: at}
+		feed, _ := rows.of(r)
+		if c, ok := countryFromComments(feed.Comments); ok {
+			e.Country = c.Alpha2
+		}
+		return e
+	}
+	var events []statusEvent
+	for _, r := range t.Died {
+		events = append(events, event("died", r))
+	}
+	for _, r := range t.Recovered {
+		events = append(events, event("recovered", r))
+	}
+	for _, c := range t.Flaps {
+		kind := "stable"
+		if c.Flapping {
+			kind = "flapping"
+		}
+		events = append(events, event(kind, c.Result))
+	}
+	for _, r := range t.Quarantined {
+		e := event("quarantined", r)
+		e.Message = r.Quarantine
+		events = append(events, e)
+	}
+	return events
+}
+
+// webhookPayload is the body POSTed to webhooks, once per run with changes.
+type webhookPayload struct {
+	SchemaVersion string        `json:"schema_version"`
+	Dataset       string        `json:"dataset"`
+	RunStartedAt  time.Time     `json:"run_started_at"`
+	Events        []statusEvent `json:"events"`
+	// Alerts are the --alerts rules the dataset violates after the run.
+	Alerts []alertViolation `json:"alerts,omitempty"`
+}
+
+// webhookRetries is how many times delivery to a webhook or chat is
+// attempted.
+const webhookRetries = 3
+
+// webhookNotifier POSTs status changes to a set of URLs. With
+// WEBHOOK_SECRET set, each body is signed with HMAC-SHA256 in the
+// X-Feed-Validator-Signature header as "sha256=<hex>", like GitHub's
+// webhooks.
+type webhookNotifier struct {
+	urls   []string
+	secret []byte
+	client *http.Client
+}
+
+func newWebhookNotifier(urls []string) *webhookNotifier {
+	return &webhookNotifier{urls: urls, secret: []byte(os.Getenv("WEBHOOK_SECRET")), client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// notify delivers payload to every webhook, returning the errors of those
+// that failed after retries.
+func (n *webhookNotifier) notify(payload webhookPayload) error {
+	if len(payload.Events) == 0 && len(payload.Alerts) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, url := range n.urls {
+		if err := n.post(url, body); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// post delivers body to one webhook, signed when a secret is configured.
+func (n *webhookNotifier) post(url string, body []byte) error {
+	header := make(http.Header)
+	if len(n.secret) > 0 {
+		mac := hmac.New(sha256.New, n.secret)
+		mac.Write(body)
+		header.Set("X-Feed-Validator-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return postJSON(n.client, url, body, header)
+}
+
+// postJSON POSTs a JSON body with extra headers, retrying with exponential
+// backoff on network errors, 429 and 5xx responses.
+func postJSON(client *http.Client, url string, body []byte, header http.Header) error {
+	var err error
+	backoff := time.Second
+	for attempt := 1; attempt <= webhookRetries; attempt++ {
+		if attempt > 1 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		var req *http.Request
+		req, err = http.NewRequest("POST", url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "FeedValidator/1.0")
+
+		var resp *http.Response
+		resp, err = client.Do(req)
+		if err != nil {
+			continue
+		}
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+		if resp.StatusCode < 300 {
+			return nil
+		}
+		err = fmt.Errorf("HTTP status %d", resp.StatusCode)
+		if resp.StatusCode != 429 && resp.StatusCode < 500 {
+			return err
+		}
+	}
+	return err
+}
+
+// notifiers are the destinations configured for a run's status changes, and
+// the alert rules checked after it.
+type notifiers struct {
+	webhooks *webhookNotifier
+	chat     *chatNotifier
+	email    *emailNotifier
+	// issues is synced separately, before state is saved, since it records
+	// issue numbers there.
+	issues *issueTracker
+	alerts alertRules
+}
+
+// notify sends a run's changes and alert rule violations to every
+// configured destination.
+func (n notifiers) notify(dataset string, startedAt time.Time, feeds []Feed, results []ValidationResult, skipped int, changes runTransitions, alerts []alertViolation) error {
+	var errs []error
+	if n.webhooks != nil {
+		payload := webhookPayload{SchemaVersion: validator.SchemaVersion, Dataset: dataset, RunStartedAt: startedAt, Events: changes.events(feeds, startedAt), Alerts: alerts}
+		if err := n.webhooks.notify(payload); err != nil {
+			errs = append(errs, fmt.Errorf("webhooks: %w", err))
+		}
+	}
+	summary := newRunSummary(dataset, results, skipped, changes)
+	summary.Alerts = alerts
+	if n.chat != nil {
+		if err := n.chat.notify(summary); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if n.email != nil {
+		if err := n.email.notify(summary); err != nil {
+			errs = append(errs, fmt.Errorf("email: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// notifierFlags are the notification flags shared by validation runs and
+// serve.
+type notifierFlags struct {
+	webhooks          stringList
+	slack, discord    *string
+	chatThreshold     *int
+	emailTo           stringList
+	emailFrom         *string
+	emailOnRegression *bool
+	issuesAfter       *int
+	alertsPath        *string
+}
+
+func addNotifierFlags(fs *flag.FlagSet) *notifierFlags {
+	f := &notifierFlags{}
+	fs.Var(&f.webhooks, "webhook", "POST status changes as JSON to this URL; repeatable")
+	f.slack = fs.String("slack", "", "post run summaries to this Slack incoming webhook")
+	f.discord = fs.String("discord", "", "post run summaries to this Discord webhook")
+	f.chatThreshold = fs.Int("chat-threshold", 1, "only post to chat when at least this many feeds newly broke; 0 posts every run")
+	fs.Var(&f.emailTo, "email-to", "mail run summaries to this address via SMTP_ADDR; repeatable")
+	f.emailFrom = fs.String("email-from", "", "sender address for --email-to")
+	f.emailOnRegression = fs.Bool("email-on-regression", false, "only mail runs where feeds newly broke or --alerts rules are violated")
+	f.issuesAfter = fs.Int("github-issues", 0, "open a GitHub issue for feeds invalid for this many consecutive runs, closed on recovery (uses GITHUB_TOKEN and GITHUB_REPOSITORY)")
+	f.alertsPath = fs.String("alerts", "", "check the run against the SLO rules in this JSON file, notifying and failing when any is violated")
+	return f
+}
+
+// enabled reports whether any notification destination was given.
+func (f *notifierFlags) enabled() bool {
+	return len(f.webhooks) > 0 || *f.slack != "" || *f.discord != "" || len(f.emailTo) > 0 || *f.issuesAfter > 0 || *f.alertsPath != ""
+}
+
+func (f *notifierFlags) notifiers() (notifiers, error) {
+	var n notifiers
+	if len(f.webhooks) > 0 {
+		n.webhooks = newWebhookNotifier(f.webhooks)
+	}
+	n.chat = newChatNotifier(*f.slack, *f.discord, *f.chatThreshold)
+	var err error
+	if n.email, err = newEmailNotifier(*f.emailFrom, f.emailTo, *f.emailOnRegression); err != nil {
+		return n, err
+	}
+	if n.issues, err = newIssueTracker(*f.issuesAfter); err != nil {
+		return n, err
+	}
+	if *f.alertsPath != "" {
+		if n.alerts, err = loadAlertRules(*f.alertsPath); err != nil {
+			return n, fmt.Errorf("loading alert rules: %w", err)
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import "github.com/reddot-watch/curated-world-news/pkg/validator"
+
+// paywallStabilityRuns is how many consecutive runs must agree on a new
+// access restriction before the persisted flag changes, so a single odd
+// response doesn't flip it back and forth.
+const paywallStabilityRuns = 3
+
+// paywallTransition is a change of a feed's persisted access flag.
+type paywallTransition struct {
+	URL      string
+	From, To string
+}
+
+// updatePaywallState folds this run's observations into the persisted
+// per-feed flags. Results that could not observe the feed's access (transient
+// errors, parse failures) leave the state untouched. Initial flags come from
+// the dataset's paywall column.
+func updatePaywallState(state *validatorState, feeds []Feed, results []ValidationResult) []paywallTransition {
+	rows := indexRows(feeds)
+
+	var transitions []paywallTransition
+	for _, r := range results {
+		feed, ok := rows.of(r)
+		if !r.AccessObserved || !ok {
+			continue
+		}
+		fs := state.feed(feed)
+
+		switch {
+		case r.Access == fs.Paywall:
+			fs.PaywallCandidate, fs.PaywallStreak = "", 0
+		case r.Access == fs.PaywallCandidate:
+			fs.PaywallStreak++
+		default:
+			fs.PaywallCandidate, fs.PaywallStreak = r.Access, 1
+		}
+
+		if fs.PaywallStreak >= paywallStabilityRuns {
+			transitions = append(transitions, paywallTransition{URL: r.URL, From: fs.Paywall, To: r.Access})
+			fs.Paywall = r.Access
+			fs.PaywallCandidate, fs.PaywallStreak = "", 0
+		}
+	}
+	return transitions
+}
+
+func accessLabel(access string) string {
+	if access == validator.AccessOpen {
+		return "open"
+	}
+	return access
+}
@@ -0,0 +1,58 @@
+// Package curatedworldnews is the curated dataset as Go values, for services
+// that would rather import the list than vendor and parse feeds.csv:
+//
+//	import curatedworldnews "github.com/reddot-watch/curated-world-news/pkg/data"
+//
+//	for _, feed := range curatedworldnews.FeedsByCountry("br") {
+//		...
+//	}
+//
+// The feeds are generated from feeds.csv with go generate, so they are the
+// dataset as of the version imported.
+package curatedworldnews
+
+import (
+	"slices"
+	"strings"
+)
+
+//go:generate go run ../.. export --format go -o feeds_gen.go ../../feeds.csv
+
+// Feed is a row of the dataset.
+type Feed struct {
+	// ID is the feed's stable ID, which stays the same when its URL
+	// changes.
+	ID       string
+	URL      string
+	Comments string
+	Language string
+	Status   string
+	License  string
+	Paywall  string
+	// Tier is how thoroughly the feed is validated, 1 the most.
+	Tier int
+	// Country is the ISO 3166-1 alpha-2 code of the country the feed
+	// covers, as inferred from Comments; empty for regional and global
+	// feeds.
+	Country   string
+	AddedBy   string
+	AddedDate string
+	Source    string
+}
+
+// Feeds returns every feed of the dataset, in its order.
+func Feeds() []Feed {
+	return slices.Clone(feeds)
+}
+
+// FeedsByCountry returns the feeds covering the country with the ISO 3166-1
+// alpha-2 code, in any case.
+func FeedsByCountry(code string) []Feed {
+	var matched []Feed
+	for _, f := range feeds {
+		if f.Country != "" && strings.EqualFold(f.Country, code) {
+			matched = append(matched, f)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,43 @@
+package curatedworldnews
+
+import (
+	"os"
+	"testing"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// TestGenerated checks that the generated feeds are those of feeds.csv, so a
+// dataset change without go generate fails.
+func TestGenerated(t *testing.T) {
+	f, err := os.Open("../../feeds.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	want, err := validator.ReadCSV(f, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := Feeds()
+	if len(got) != len(want) {
+		t.Fatalf("got %d feeds, feeds.csv has %d; run go generate ./pkg/data", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].ID != want[i].ID || got[i].URL != want[i].URL {
+			t.Fatalf("feed %d is %s (%s), feeds.csv has %s (%s); run go generate ./pkg/data", i, got[i].URL, got[i].ID, want[i].URL, want[i].ID)
+		}
+	}
+}
+
+func TestFeedsByCountry(t *testing.T) {
+	feeds := FeedsByCountry("br")
+	if len(feeds) == 0 {
+		t.Fatal("no feeds for br")
+	}
+	for _, f := range feeds {
+		if f.Country != "BR" {
+			t.Errorf("%s covers %q, not BR", f.URL, f.Country)
+		}
+	}
+}
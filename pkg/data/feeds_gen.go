@@ -0,0 +1,5780 @@
+// Code generated by "export --format go"; DO NOT EDIT.
+
+package curatedworldnews
+
+var feeds = []Feed{
+	{ID: "55a9ef7fc67a6bfd", URL: "https://www.thehindu.com/news/national/kerala/feeder/default.rss", Comments: "Kerala, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "207045150f5ca3ac", URL: "https://www.dailymailgh.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "7bb7e1bc02d160ba", URL: "https://www.nhpr.org/rss.xml", Comments: "New Hampshire", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ddca3f54587eb725", URL: "https://www.suchtv.pk/world.html?format=feed&type=rss", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "5f7864f4f5b1c1cb", URL: "https://feeds.elpais.com/mrss-s/pages/ep/site/english.elpais.com/portada", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ff1e1a32221b10b9", URL: "https://africa.cgtn.com/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "805daaeeb36b4e5c", URL: "https://therealnews.com/feed", Language: "en", Status: "active", Tier: 2},
+	{ID: "e449fe98be84cbc5", URL: "https://www.smdp.com/feed", Comments: "Santa Monica", Language: "en", Status: "active", Tier: 2},
+	{ID: "d8e33e81c44f5cbf", URL: "https://www.derbytelegraph.co.uk/news/local-news/rss.xml", Comments: "Derby, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a1186b5622734431", URL: "https://www.shorenewsnetwork.com/category/news/ocean-county-police-blotter/feed/", Comments: "Ocean County", Language: "en", Status: "active", Tier: 2},
+	{ID: "5777f448bdd584bb", URL: "https://www.dailynews.com/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "e52414b673505019", URL: "https://ridgefieldpark.dailyvoice.com/rss/", Comments: "Ridgefield Park", Language: "en", Status: "active", Tier: 2},
+	{ID: "2191a7712e3f07cc", URL: "https://news.wgcu.org/tags/national-news.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "66304dc77ddf509e", URL: "https://feeds.skynews.com/feeds/rss/uk.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "35960d0eff2df34f", URL: "https://ottawacitizen.com/category/news/feed.xml", Comments: "Ottawa", Language: "en", Status: "active", Tier: 2},
+	{ID: "678a0108bbad5ae3", URL: "https://www.first.org/newsroom/news/global.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6d4fa2049cba9e2c", URL: "https://english.newstracklive.com/rss-feed/gujarat.xml", Comments: "Gujarat, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "10e3f62b7b7fce70", URL: "https://www.leeds-live.co.uk/news/rss.xml", Comments: "Leeds, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "642bf52e788e1fca", URL: "https://charlestoncitypaper.com/category/news/feed/", Comments: "Charleston", Language: "en", Status: "active", Tier: 2},
+	{ID: "8c40583f9292ef6d", URL: "https://www.theblaze.com/feeds/news.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "fcab7f5038fac2f4", URL: "https://www.hongkongfp.com/feed/", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "c4d72d58bd17a206", URL: "http://www.watoday.com.au/rss/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac5597297a172b2b", URL: "https://www.leeds-live.co.uk/rss.xml", Comments: "Leeds", Language: "en", Status: "active", Tier: 2},
+	{ID: "f041242e3cda66b6", URL: "https://www.coloradodaily.com/rss", Comments: "Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fba3844ccafe544c", URL: "https://www.thejournal.ie/feed/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "06ea5596f31e8ef5", URL: "https://www.infectioncontroltoday.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "afd7d0cd16abaa3d", URL: "https://www.thestar.com.my/rss/News", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "824261b36b9aedd3", URL: "https://explorersweb.com/category/weather/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "51c1dc2af319d5f3", URL: "https://suffolk.dailyvoice.com/rss/", Comments: "Suffolk", Language: "en", Status: "active", Tier: 2},
+	{ID: "345e65fecb20f258", URL: "https://news.google.com/rss/search?q=%22an+explosive%22+OR+%22suicide+attack%22+OR+%22suicide%22+OR+%22explosive+belt%22+OR+%22bomb+kills%22+OR+%22suicide+terrorist%22+OR+%22suicide+bomber%22+OR+%22explosive+device%22+OR+%22detonatin%22+OR+%22bomber+kills%22+OR+%22suicide+bombers%22+OR+%22bomb+exploded%22&hl=en-US&gl=US&ceid=US:en", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "915266f36a373e68", URL: "https://tribuneonlineng.com/feed/", Comments: "Nigeria, Africa", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "f7d28c8e1256b695", URL: "https://economictimes.indiatimes.com/news/international/rssfeeds/858478126.cms", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d8d783023397257f", URL: "https://www.dailymail.co.uk/news/south-carolina/index.rss", Comments: "South Carolina", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0dc0144dab7367ba", URL: "https://www.hawaiipublicradio.org/local-news.rss", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1b9cfaba6e33d1ba", URL: "http://www.sciotopost.com/feed/", Comments: "Scioto", Language: "en", Status: "active", Tier: 2},
+	{ID: "8c78996eab48b528", URL: "https://www.chesterchronicle.co.uk/?service=rss", Comments: "Chester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f7fca24211640ec2", URL: "https://www.durhamradionews.com/archives/category/local/feed", Comments: "Durham", Language: "en", Status: "active", Tier: 2},
+	{ID: "fe1a3712f72883c2", URL: "https://www.economist.com/middle-east-and-africa/rss.xml", Comments: "Middle East, Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "86b5b2e4039a756d", URL: "https://www.dailymail.co.uk/news/network-rail/index.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "90d219ad79ec2d32", URL: "https://putnam.dailyvoice.com/rss/", Comments: "Putnam", Language: "en", Status: "active", Tier: 2},
+	{ID: "f4c97c6fdc75cd5e", URL: "https://www.theoaklandpress.com/feed/", Comments: "Oakland", Language: "en", Status: "active", Tier: 2},
+	{ID: "3e378b9757d8805a", URL: "https://www.spyuganda.com/feed/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "52ebde79843f911a", URL: "https://www.kshb.com/index.rss", Comments: "Kansas City", Language: "en", Status: "active", Tier: 2},
+	{ID: "9f4f7d1070addbdb", URL: "https://www.channelnewsasia.com/api/v1/rss-outbound-feed?_format=xml&category=10296", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "f82e3af7fb6499fb", URL: "https://www.vrt.be/vrtnws/en.rss.articles.xml", Comments: "Belgium, Europe, global", Language: "en", Status: "active", Country: "BE", Tier: 2},
+	{ID: "1e0d36698740b242", URL: "https://www.sundayworld.com/crime/irish-crime/rss/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "68a3253c187942fc", URL: "http://www.nwitimes.com/search/?f=rss&t=article&c=news/national&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "12e723903cffc1a0", URL: "http://www.tribune242.com/rss/headlines/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "cbdb578738c4588a", URL: "https://www.castanet.net/rss/page-7.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f2f92be426c9682", URL: "http://www.maravipost.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "f2166ea575e08541", URL: "https://www.timescall.com/location/colorado/front-range/boulder-county/longmont/feed/", Comments: "Longmont, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3842c08fd974fdb1", URL: "https://www.lincolnshirelive.co.uk/news/rss.xml", Comments: "Lincolnshire", Language: "en", Status: "active", Tier: 2},
+	{ID: "a67dbca9c54e0f30", URL: "https://www.bridgemi.com/rss.xml", Comments: "Michigan", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "336134e7ca8951d8", URL: "https://news.google.com/news/rss/headlines/section/topic/WORLD?hl=en-US&gl=US&ceid=US:en", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6506c60ea09aa21", URL: "https://www.fox47news.com/neighborhoods/dewitt-st-johns.rss", Comments: "DeWitt, St. Johns", Language: "en", Status: "active", Tier: 2},
+	{ID: "30294b7ef92b6b95", URL: "https://www.marinij.com/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "993498cef6fa1b7d", URL: "https://rss.24-7pressrelease.com/rss/government_law_enforcement.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d7960545e6cfe7ad", URL: "https://newstalk1400online.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "302d0c0de7f9b1d0", URL: "https://www.sajr.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "67611b112932a773", URL: "http://www.appeal-democrat.com/search/?f=rss&t=article&c=news/regional_news&l=50&s=start_time&sd=desc", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "66188d9cf155cdc8", URL: "http://www.dublinlive.ie/rss.xml", Comments: "Dublin", Language: "en", Status: "active", Tier: 2},
+	{ID: "4ec804ee0341ba3f", URL: "https://www.liverpoolecho.co.uk/news/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9788bad336126ecb", URL: "https://essex.dailyvoice.com/rss/", Comments: "Essex County, NJ, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cc89041d5cef9149", URL: "https://news.google.com/rss/search?q=%22brush+fires%22+OR+%22brush+fire%22+OR+%22forest+fire%22+OR+%22wild+fire%22+OR+%22fire%22+OR+%22bushfire%22+OR+%22wildfire%22+OR+%22bush+fires%22+OR+%22bushfires%22+OR+%22wildland+fire%22+OR+%22pefire%22+OR+%22fire+outbreak%22+OR+%22fires%22&hl=en-US&gl=US&ceid=US:en", Language: "en", Status: "active", Tier: 2},
+	{ID: "e63c3346982fccce", URL: "https://europeansting.com/category/refugee-crisis-2/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c7c44ec810c11d1", URL: "https://www.kpax.com/news/local-news/flathead-county.rss", Comments: "Flathead County, Montana, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "da934fd777fc67b4", URL: "https://cumberland-salem.dailyvoice.com/rss/", Comments: "Cumberland County, Salem County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "642feb183c390ae2", URL: "http://www.newsdzezimbabwe.co.uk/feeds/posts/default", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "7094e34e847c6052", URL: "https://www.sundayworld.com/crime/rss/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "6f0b14c4f66196dc", URL: "https://www.thenews.com.pk/rss/1/1", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "2744625dc91ee698", URL: "https://www.koin.com/feed/", Comments: "Portland, Oregon", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d93feb6f6875b882", URL: "https://www.ft.com/world/europe?format=rss", Comments: "World, Europe, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4e86c36baa83a661", URL: "https://www.getsurrey.co.uk/news/rss.xml", Comments: "Surrey", Language: "en", Status: "active", Tier: 2},
+	{ID: "640fdfe3eb7a966e", URL: "http://feeds.tucsonpost.com/rss/a548abef580c2494", Comments: "Tucson, Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8f862b826a9c7278", URL: "https://news.wjct.org/national-news.rss", Comments: "Jacksonville", Language: "en", Status: "active", Tier: 2},
+	{ID: "1fcf754ac1eae769", URL: "http://www.northescambia.com/feed/atom", Comments: "North Escambia, Florida, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0cdd903b48e36b5f", URL: "http://feeds.feedburner.com/zerohedge/feed", Language: "en", Status: "active", Tier: 2},
+	{ID: "c9cac32c24189f87", URL: "https://www.delcotimes.com/feed/", Comments: "Delaware County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b94123e33ac0f029", URL: "https://saddlebrook.dailyvoice.com/rss/", Comments: "Saddle Brook, NJ, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dfdd9b991650663e", URL: "https://commondreams.org/rss.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d08c78c9d3a4c6dc", URL: "https://www.chad.co.uk/rss", Comments: "UK, Chad", Language: "en", Status: "active", Country: "TD", Tier: 2},
+	{ID: "9d8f68db51905cdf", URL: "https://www.staradvertiser.com/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2518b089fb5dd556", URL: "https://www.suchtv.pk/pakistan/general.html?format=feed&type=atom", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "62c85abfe9ca9fb9", URL: "https://www.stabroeknews.com/news/regional/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "dbe373fa712c573a", URL: "https://rutherford.dailyvoice.com/rss/", Comments: "Rutherford, NJ, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "09394630968b9d80", URL: "https://www.kivitv.com/news/national.rss", Comments: "Boise, ID", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2bf6bd89b9f21dc8", URL: "https://canadanewsmedia.ca/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "3af2c79d122b4830", URL: "https://thestranger.com/seattle/Rss.xml", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "0f1277e44ed9bb1f", URL: "https://www.jwire.com.au/feed/atom/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a480f7df8d4af360", URL: "https://woay.com/news/national/feed/", Comments: "Oak Hill, WV", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d87d33ad353cfe1a", URL: "https://www.castanet.net/rss/page-3.xml", Comments: "Kelowna, BC", Language: "en", Status: "active", Tier: 2},
+	{ID: "e4e31088f51b7f9b", URL: "https://www.wmar2news.com/news.rss", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "df17bb5f78211d28", URL: "https://www.highland.gov.uk/rss/news", Comments: "UK, Highland Council", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f2bde2db71a91d96", URL: "https://www.fox47news.com/sitemap.rss", Comments: "Madison, WI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ac99dcc48bd22b1a", URL: "https://worldisraelnews.com/feed", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "67d1b0b7b0e76ca2", URL: "https://www.jdsupra.com/topics/cybersecurity_rss/", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9f8a446a354db76", URL: "https://shelton.dailyvoice.com/rss/", Comments: "Shelton, CT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "58677f9a464c1cdd", URL: "https://www.japantoday.com/category/world/feed", Comments: "Japan, global", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "806ef452239dea9c", URL: "http://www.columbiagorgenews.com/search/?f=rss&t=article&c=hoodriver&l=50&s=start_time&sd=desc", Comments: "Columbia Gorge, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a375eb78e2d3433a", URL: "https://www.weefmgrenada.com/feed/", Comments: "Grenada", Language: "en", Status: "active", Country: "GD", Tier: 2},
+	{ID: "83b9160c63925b39", URL: "https://www.kxxv.com/hometown/mclennan-county.rss", Comments: "Waco, TX, McLennan County", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "11772703fd91c55c", URL: "https://www.latestnigeriannews.com/feed/saharareporters/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "39afd0647ca3e7aa", URL: "https://www.beaufortcountynow.com/rss.php?id=109", Comments: "Beaufort County, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "39b09ef1e236b2d9", URL: "https://www.dailymail.co.uk/news/vladimir_putin/index.rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "130f53d8f93b323f", URL: "https://www.cbsnews.com/newyork/latest/rss/main", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7bbf7dcda198b753", URL: "https://english.newstracklive.com/rss-feed/india-news.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "e05c49ce59c5356a", URL: "https://www.sciotopost.com/category/news/feed/", Comments: "OH, Scioto County, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7f1537a52bd3b224", URL: "https://www.lex18.com/news/covering-kentucky.rss", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6eaabeca5ab08eef", URL: "https://www.skooknews.com/feeds/posts/default?alt=rss", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2e4a7c0b53c10768", URL: "https://newhaven.dailyvoice.com/rss/", Comments: "New Haven, CT, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a764655457adc335", URL: "https://www.fox4now.com/news/local-news/investigates.rss", Comments: "Fort Myers, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "598180915277f04e", URL: "https://westport.dailyvoice.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "43f950231a44b183", URL: "https://www.ktvq.com/news/local-news.rss", Comments: "Billings", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9ecf485461d775f", URL: "http://feeds.iraqnews.net/rss/c31d0aaa23b24a75", Comments: "Iraq", Language: "en", Status: "active", Country: "IQ", Tier: 2},
+	{ID: "d2588c4a757f3348", URL: "https://jknewstoday.com/category/news/feed/", Comments: "Jammu and Kashmir", Language: "en", Status: "active", Tier: 2},
+	{ID: "f967308606118469", URL: "https://chappaqua.dailyvoice.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "698b9093ad2c8251", URL: "http://www.portstephensexaminer.com.au/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "3e6ab952ec15d1a9", URL: "https://www.mercurynews.com/feed/", Comments: "San Jose", Language: "en", Status: "active", Tier: 2},
+	{ID: "0141cce505d9230c", URL: "https://feeds.feedburner.com/thethaiger", Comments: "Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "566f2f80171bdcd4", URL: "https://www.edinburghlive.co.uk/news/uk-world-news/rss.xml", Comments: "UK, world", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1ac2da138b65ab6d", URL: "https://www.bigislandvideonews.com/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6a76eeb323969790", URL: "https://www.derbytelegraph.co.uk/news/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8e7e46f5336cc55e", URL: "https://www.durhamradionews.com/archives/category/all/feed", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "6e7245aa93e00dbd", URL: "https://www.nagalandpost.com/index.php/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7cced96ca16909da", URL: "https://www.northantstelegraph.co.uk/news/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9c38258423929137", URL: "https://alerts.weather.gov/cap/ky.php?x=0", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "452cf9bc8f942e9b", URL: "http://www.belfasttelegraph.co.uk/news/republic-of-ireland/rss", Comments: "Republic of Ireland", Language: "en", Status: "active", Tier: 2},
+	{ID: "6d444ac4dd855a58", URL: "https://leaderpost.com/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "c95392053a4a55e8", URL: "https://www.abcactionnews.com/news/local-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "52207c53cd6c994b", URL: "http://torontosun.com/rss", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "9d064d68a204732b", URL: "https://edmontonjournal.com/category/news/feed.xml", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "95ede0217fda43c0", URL: "https://www.chesterchronicle.co.uk/news/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f6658b51cd8501d3", URL: "https://www.belfastlive.co.uk/news/northern-ireland/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "913f0c72525e23c2", URL: "https://www.theleader.com.au/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "3c2dbebec8a230c2", URL: "http://www.gmtoday.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0bd7234ab4540bb6", URL: "https://www.ladunliadinews.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "d9cd3ba96b76a13a", URL: "https://www.eastcoastdaily.in/feed", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "cd15288680227b41", URL: "https://www.dailymail.co.uk/news/north-carolina/index.rss", Comments: "North Carolina, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e78482308c3cbece", URL: "https://bringmethenews.com/.rss/full/minnesota-weather", Comments: "Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "862c5989d460d55f", URL: "https://www.latestnigeriannews.com/feed/punch/rss.xml", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "68587e42ce8917f7", URL: "http://abc7.com/feed/", Comments: "Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "b6002a4ba2244db7", URL: "https://www.dailymail.co.uk/news/immigration/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0ad39d58ab1303cc", URL: "https://www.environmental-expert.com/rss/news-8/water-wastewater", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6fc242b55cd8ee9", URL: "https://dailypost.ng/headlines/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "fe1c9dbeff150f7b", URL: "https://www.dailymail.co.uk/news/montana/index.rss", Comments: "Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2ee3384c45ee7338", URL: "https://saharareporters.com/articles/rss-feed", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "af5128400f4ce78a", URL: "https://www.dailymail.co.uk/news/nevada/index.rss", Comments: "Nevada", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6461bad037b4ed88", URL: "https://www.nbcwashington.com/investigations/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "7e5e133d697802c3", URL: "http://feeds.denverpost.com/dp-news-topstories", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0ad5178a68e4f171", URL: "https://www.theage.com.au/rss/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e18b568d279858bc", URL: "https://middlesexct.dailyvoice.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "96f45a8681fd1b1a", URL: "https://www.thesundaily.my/rss/world", Comments: "Malaysian", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "cf4440b0485392c8", URL: "https://www.eastcoastdaily.in/category/dh-latest-news/feed", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b0d3bd384d00f553", URL: "https://feeds.feedburner.com/InfiniteUnknown", Language: "en", Status: "active", Tier: 2},
+	{ID: "107f119f2b7583cb", URL: "https://nuclear-news.net/category/2-world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "af33272ce2726500", URL: "https://english.aawsat.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4abb3bbcff135d39", URL: "http://www.smh.com.au/rss/environment.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "b39831f1f9560606", URL: "http://timesofindia.indiatimes.com/rssfeedmostread.cms", Language: "en", Status: "active", Tier: 2},
+	{ID: "24aae1adff6e8ab5", URL: "https://www.edinburghlive.co.uk/news/rss.xml", Comments: "Edinburgh, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d3a77b1839ed429b", URL: "https://www.kpax.com/news/ravalli-county.rss", Comments: "Ravalli County, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cadba9780edf5aa1", URL: "https://www.citizen.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "d6681f9b074e184a", URL: "https://www.cambridge-news.co.uk/news/cambridge-news/rss.xml", Comments: "Cambridge, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "382211d2d5f42487", URL: "https://zambianews365.com/feed/", Comments: "Zambia", Language: "en", Status: "active", Country: "ZM", Tier: 2},
+	{ID: "3d5c827eb7b5e5f8", URL: "https://www.eastbaytimes.com/news/california/feed/", Comments: "East Bay, California, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8f32bcec37a315f1", URL: "https://moxie.foxnews.com/google-publisher/latest.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d7c7aaa9f27d1aa4", URL: "https://spectator.sme.sk/rss-title", Comments: "Slovakia", Language: "en", Status: "active", Country: "SK", Tier: 2},
+	{ID: "94d6ea3a2bd0e796", URL: "http://gazette.com/search/?f=rss&t=article&c=news/crime&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "197a3d342dbea379", URL: "https://www.swissinfo.ch/service/eng/rssxml/top-news/rss", Comments: "Swiss", Language: "en", Status: "active", Tier: 2},
+	{ID: "227a297e2d002705", URL: "https://www.thetrentonline.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "a687aa954171b857", URL: "https://www.wearegreenbay.com/feed/", Comments: "Green Bay (US)", Language: "en", Status: "active", Tier: 2},
+	{ID: "b4750fa0acd2e201", URL: "https://www.nzherald.co.nz/arc/outboundfeeds/rss/section/news/?outputType=xml&_website=bay-of-plenty-times", Comments: "Bay of Plenty (NZ)", Language: "en", Status: "active", Tier: 2},
+	{ID: "5f798880fb8d1dc2", URL: "https://www.newsheadline247.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "d81ed8866aacb9cb", URL: "https://www.orissapost.com/india-national-news/feed/", Comments: "India, Orissa", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "f1819264053283cf", URL: "http://www.indiandefensenews.in/feeds/posts/default?alt=rss", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "be57d8d761f9c994", URL: "https://www.dailymail.co.uk/news/indonesia/index.rss", Comments: "Indonesia", Language: "en", Status: "active", Country: "ID", Tier: 2},
+	{ID: "0b172ca083f8866d", URL: "https://www.wcsufm.org/latest-from-npr.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e6c8ff9002b89bda", URL: "https://billypenn.com/feed/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "48c29f00bb0e7205", URL: "https://www.tvcnews.tv/feed", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "117a200cce4e4b9d", URL: "https://www.portsmouth.co.uk/news/rss", Comments: "Portsmouth (UK)", Language: "en", Status: "active", Tier: 2},
+	{ID: "6c9dc0070224d815", URL: "https://voanews.com/api/zt_rqyeirqqy", Language: "en", Status: "active", Tier: 2},
+	{ID: "8b908668d5762662", URL: "https://rss.jpost.com/rss/rssfeedsfrontpage.aspx", Comments: "Israel, Middle East", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "f2e16cab3b55189e", URL: "https://www.sbsun.com/location/california/los-angeles-county/inland-empire/feed/", Comments: "Inland Empire, LA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8b886365e0cd810f", URL: "http://www.phillyvoice.com/feed/tag/investigations/", Comments: "Philly", Language: "en", Status: "active", Tier: 2},
+	{ID: "0fe95bbb5a1f68bc", URL: "http://alerts.weather.gov/cap/ar.php?x=0", Comments: "Arkansas, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9de35af1ad9a8b0c", URL: "https://www.nyasatimes.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "b49d721a4d855718", URL: "https://wfpl.org/feed/", Comments: "Louisville (US)", Language: "en", Status: "active", Tier: 2},
+	{ID: "f842e1b94217cc0e", URL: "https://www.heraldlive.co.za/rss/", Comments: "South African", Language: "en", Status: "active", Tier: 2},
+	{ID: "ebd5bd55e01a15ef", URL: "https://www.brainerddispatch.com/index.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "cd6b2c03b9f7f93a", URL: "https://www.thehindu.com/news/cities/Kochi/feeder/default.rss", Comments: "Kochi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "f08762a843be8c30", URL: "https://news.stv.tv/section/east-central/feed", Comments: "East Central Scotland, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b145dd34efe660a6", URL: "https://www.sunjournal.com/news/feed/", Comments: "Lewiston, Auburn, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0eafbef5e0ff0314", URL: "https://www.amny.com/feed/", Comments: "New York City, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "da172c772e95d8ff", URL: "https://www.arnnewscentre.ae/en/news/uae/feed.xml", Comments: "UAE", Language: "en", Status: "active", Country: "AE", Tier: 2},
+	{ID: "0c4b3078f3385a5a", URL: "http://www.bangkokpost.com/rss/data/most-recent.xml", Comments: "Bangkok, Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "babedb763f39adc1", URL: "http://deccanchronicle.com/rss_feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "dd3c7baa46f7bde1", URL: "https://newlondon.dailyvoice.com/rss/", Comments: "New London, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7cafdcf031259e7c", URL: "https://africanews.com/feed/rss", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa1a57d0a77bede7", URL: "https://www.kbbi.org/npr-news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "35b56fdf028131e7", URL: "https://www.ghpage.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "e96a175f7539f7df", URL: "https://www.shorenewsnetwork.com/category/local-news/delaware-news/feed/", Comments: "Delaware", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "50e0edce1a50a6c2", URL: "https://bernews.com/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "6605557504d9ab90", URL: "https://torontosun.com/category/news/world/feed.xml", Comments: "Toronto, World", Language: "en", Status: "active", Tier: 2},
+	{ID: "5d68b0933bbb08fe", URL: "https://www.wtvr.com/index.rss", Comments: "Richmond, VA (US)", Language: "en", Status: "active", Tier: 2},
+	{ID: "519d148390e41a18", URL: "https://www.scmp.com/rss/5/feed", Comments: "Hong Kong, world", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "c5bea5b4bfa54717", URL: "https://www.thenorthlines.com/feed/", Comments: "Northern NY (US)", Language: "en", Status: "active", Tier: 2},
+	{ID: "7a4d8ed970eea6f0", URL: "https://observatorial.com/category/news/world/feed/", Comments: "World", Language: "en", Status: "active", Tier: 2},
+	{ID: "9b7d2959b4aefe31", URL: "https://www.suchtv.pk/pakistan/general.html?format=feed", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "0efeee1ea38a4f2a", URL: "https://www.hemeltoday.co.uk/rss", Comments: "Hemel Hempstead, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "599eb4a51fd500ee", URL: "https://feeds.feedburner.com/dnyuz", Language: "en", Status: "active", Tier: 2},
+	{ID: "5d326e1d889be087", URL: "https://thediplomat.com/regions/east-asia/feed", Comments: "East Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "9b0cc20c7b6a068a", URL: "https://www.thejournal.ie/topic/homelessness/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9794cdb15097b5a", URL: "https://bernews.com/category/all/feed", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "8f3bedd91dc54fc9", URL: "https://dailypost.ng/hot-news/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "bee27a880eea101f", URL: "http://www.theage.com.au/rss/national/victoria.xml", Comments: "Australian state", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c546cc377046488", URL: "https://www.thehindu.com/news/cities/Madurai/feeder/default.rss", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "475f6941b9c755e4", URL: "https://www.wptv.com/news/local-news.rss", Comments: "West Palm Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "b1d387a6be59f19c", URL: "https://www.katc.com/news/st-martin-parish.rss", Comments: "Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7a12dbbae7661627", URL: "https://www.military.com/rss-feeds/content?keyword=dod&type=news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "aff0d95a137bbe2d", URL: "https://www.news5cleveland.com/news.rss", Comments: "Cleveland, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "594bfc18ad956462", URL: "https://www.dewsburyreporter.co.uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "58677f9a464c1cdd", URL: "https://japantoday.com/category/world/feed", Comments: "Japan, world", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "8b2339e495eab8de", URL: "https://www.ksby.com/news/california-news.rss", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e5816e618067b210", URL: "https://www.katc.com/news/st-mary-parish.rss", Comments: "Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "83c5ff87763b3843", URL: "https://alerts.weather.gov/cap/ia.php?x=0", Comments: "Iowa", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0219b90347b8dc7b", URL: "https://www.manchestereveningnews.co.uk/news/rss.xml", Comments: "Manchester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9fb104fcd6a3e465", URL: "https://www.kpax.com/news/western-montana-news.rss", Comments: "Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "57ba52dd4d25fa54", URL: "http://feeds.feedburner.com/IntelligenceOnline", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e414e0dff7e0597f", URL: "https://www.arlingtoncardinal.com/feed", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e17dd5d9463ba743", URL: "https://www.lincolnshirelive.co.uk/news/local-news/rss.xml", Comments: "Lincolnshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "16248662d7ccd734", URL: "https://arizonadailyindependent.com/feed/", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "12e8c73d429cd2af", URL: "https://www.wtvq.com/category/featured/feed/", Comments: "Lexington, KY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d77875c601e90ed6", URL: "https://www.katc.com/news/acadia-parish.rss", Comments: "Acadia Parish, LA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "78aca34191bbd4d6", URL: "https://intelligencebriefs.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9ef4678e4cba1076", URL: "https://newsafricanow.com/category/ghana/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "fbd9cd69803b6837", URL: "http://feeds.sierraleonetimes.com/rss/8fcd2bb0346b8627", Comments: "Sierra Leone", Language: "en", Status: "active", Country: "SL", Tier: 2},
+	{ID: "940382139cec82af", URL: "https://www.koaa.com/sitemap.rss", Comments: "Colorado Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "a0c233e8d2298dce", URL: "https://www.gazettelive.co.uk/news/rss.xml", Comments: "Teesside, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1c5c20a08c20c037", URL: "https://breakingthenews.net/news-feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8276b13a866fd1c2", URL: "https://www.dailysignal.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ad4555466400e429", URL: "http://www.edinburghlive.co.uk/rss.xml", Comments: "Edinburgh, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d3cbaafab4580cfc", URL: "https://newtown.dailyvoice.com/rss/", Comments: "Newtown, CT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b0c0ccf948beadcc", URL: "https://www.wtvr.com/news/local-news.rss", Comments: "Richmond, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3dc7b50dfc434c52", URL: "https://www.fox28spokane.com/category/news/national-news/feed/", Comments: "Spokane", Language: "en", Status: "active", Tier: 2},
+	{ID: "e3d60e5d077ece58", URL: "https://news.pngfacts.com/feeds/posts/default", Comments: "Papua New Guinea", Language: "en", Status: "active", Country: "PG", Tier: 2},
+	{ID: "4f9aa98c306195a6", URL: "https://fox28spokane.com/feed/", Comments: "Spokane", Language: "en", Status: "active", Tier: 2},
+	{ID: "b70f03fabaace7bb", URL: "https://feeds.feedburner.com/ummid", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "df9c7b81c58de8ef", URL: "https://whdh.com/news/us-world/feed/", Comments: "Boston, world", Language: "en", Status: "active", Tier: 2},
+	{ID: "89d6ab564eb386ce", URL: "https://portchester.dailyvoice.com/rss/", Comments: "Port Chester, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6887af6928c2ad06", URL: "https://www.ktvh.com/index.rss", Comments: "Helena, MT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d3e5a54c08c431ff", URL: "https://www.fox28spokane.com/category/news/top-stories/feed/", Comments: "Spokane", Language: "en", Status: "active", Tier: 2},
+	{ID: "7989ed9ce925e653", URL: "https://www.dailydispatchnewspaper.com/feed/", Comments: "Walterboro, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "183b3b4f62c12f98", URL: "http://www.progressive-charlestown.com/feeds/posts/default?alt=rss", Comments: "Charlestown, IN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a4e648a7aa795d67", URL: "https://insiderpaper.com/topic/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b3195fb010808c3", URL: "http://timesofindia.indiatimes.com/rssfeeds/-2128830821.cms", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b3ca77c2bee718c2", URL: "https://www.wwno.org/npr-news.rss", Comments: "New Orleans", Language: "en", Status: "active", Tier: 2},
+	{ID: "532cb7b6207e39e8", URL: "https://goanewshub.com/category/news/feed/", Comments: "Goa, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "f77807b573d303ca", URL: "http://calgarysun.com/rss", Comments: "Calgary", Language: "en", Status: "active", Tier: 2},
+	{ID: "fd9e442a8aef3c19", URL: "https://fox4kc.com/feed/", Comments: "Kansas City", Language: "en", Status: "active", Tier: 2},
+	{ID: "d7192bacad292de5", URL: "https://www.stvincenttimes.com/feed/", Comments: "Saint Vincent and Grenadines", Language: "en", Status: "active", Tier: 2},
+	{ID: "83e98cf1c0899a58", URL: "https://en.mercopress.com/rss/", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ceb34521673f465", URL: "https://www.thestar.co.uk/news/rss", Comments: "Sheffield, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7adda63242fa8e58", URL: "https://www.staradvertiser.com/tag/america-in-turmoil/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "90313b290bda6cad", URL: "https://www.katc.com/news/vermilion-parish.rss", Comments: "Vermilion Parish, LA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7fb45a023c082c86", URL: "http://www.theadvocate.com/search/?f=rss&t=article&c=baton_rouge/news&l=50&s=start_time&sd=desc", Comments: "Baton Rouge, LA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0c08165f084a348c", URL: "https://stafford.dailyvoice.com/rss/", Comments: "Stafford, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6038ae872132ecf0", URL: "https://northorange.dailyvoice.com/rss/", Comments: "North Orange County, CA, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "91be60dfa05d0c5c", URL: "https://southorange.dailyvoice.com/rss/", Comments: "South Orange County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e37c600f12c83b7f", URL: "https://feeds.a.dj.com/rss/RSSWorldNews.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "39f6a1403544c95a", URL: "https://alerts.weather.gov/cap/mzus.php?x=0", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ed1959af2fafe2e6", URL: "https://www.wakefieldexpress.co.uk/news/rss", Comments: "Wakefield (UK)", Language: "en", Status: "active", Tier: 2},
+	{ID: "752df559e3ae560e", URL: "http://www.theadvocate.com.au/rss.xml", Comments: "Burnie, Tasmania, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "7ff15bb2995373ca", URL: "https://www.wfae.org/united-states-world.rss", Comments: "US, Charlotte", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2e3fcdf06d344e20", URL: "https://www.africa-press.net/malawi/feed", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "312bb7b3d2bda6b6", URL: "http://feeds.foxnews.com/foxnews/latest", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5be95062acd8c471", URL: "https://www.spectator.com.au/feed/", Comments: "Australian", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "6b03d60fd61e1c53", URL: "https://lite987.com/feed/", Comments: "Evansville, IN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a8776db9bf26c26a", URL: "http://newsregister.com/index.php?rss=article", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b7620642d6b0cd7f", URL: "http://feeds.taiwansun.com/rss/0dd057261bcc461b", Comments: "Taiwan", Language: "en", Status: "active", Country: "TW", Tier: 2},
+	{ID: "c23a57b507d7785e", URL: "https://www.fingerlakes1.com/feed/", Comments: "Finger Lakes", Language: "en", Status: "active", Tier: 2},
+	{ID: "cdc1e29bae8b761a", URL: "https://www.wptv.com/news/protecting-paradise.rss", Comments: "West Palm Beach, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3a4383b60ec26a3f", URL: "https://akelicious.net/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "5ffd8d05d6f408f7", URL: "https://www.dailystar.co.uk/news/latest-news/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5635ea92a577d69", URL: "http://www.benningtonbanner.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1248ed1720ac8833", URL: "http://www.firemapchicago.net/feeds/posts/default", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f2656f6d8cb6e80", URL: "https://orangetown.dailyvoice.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "805d19e1eb99de61", URL: "https://www.suffolkfreepress.co.uk/_api/rss/suffolk_news_feed.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e4fcd6dec1f83f57", URL: "https://nation.cymru/category/news/feed/", Comments: "Wales", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "01e944a203b6dec8", URL: "https://en.baaghitv.com/category/international/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ff6887331f82e15", URL: "https://www.ktvh.com/news/montana-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "27d79330016410e4", URL: "https://www.wctrib.com/latest.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a2757c413e7e4898", URL: "https://www.arlingtoncardinal.com/category/news-flash/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a93a91b7b36b0c75", URL: "https://news.google.com/rss/search?q=%22explosive%22+OR+%22detonated+in%22+OR+%22explosion%22+OR+%22explosives%22&hl=en-US&gl=US&ceid=US:en", Language: "en", Status: "active", Tier: 2},
+	{ID: "69354e2ae915dc5c", URL: "https://www.tori.ng/feed/metro/rss.xml", Comments: "Niger", Language: "en", Status: "active", Country: "NE", Tier: 2},
+	{ID: "4583b12538f5df9a", URL: "https://www.bolnews.com/latest/feed/", Comments: "Pakistani", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "74a56aaddb8476f5", URL: "https://www.nbcnewyork.com/news/recall-alert/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "66d0094938f989e1", URL: "https://www.worldcrunch.com/feeds/feed.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "14de91cbf3b50c22", URL: "http://statesville.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "42bf64d6a553d049", URL: "https://newsday.co.tt/reporter/ryan-hamilton-davis/feed/", Comments: "Trinidad and Tobago", Language: "en", Status: "active", Country: "TT", Tier: 2},
+	{ID: "76a4ba21fe91a7c1", URL: "http://www.wbiw.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0d17e289b45de5cd", URL: "https://www.arlnow.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "02e403c9a4ab7038", URL: "https://www.thehindu.com/news/cities/Hyderabad/feeder/default.rss", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "3c6676fb765b21e4", URL: "https://www.superiortelegram.com/index.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "30019c4207bb2fde", URL: "http://nonpareilonline.com/search/?f=rss&t=article&c=news/local/crime-and-courts&l=50&s=start_time&sd=desc", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4906063ced721ae8", URL: "https://www.dimsumdaily.hk/feed/", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "2302481d54cf8a22", URL: "https://www.kxlf.com/news/local-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d421703b45bb50aa", URL: "http://activenorcal.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "456269a8aa92cb82", URL: "https://www.thenicheng.com/category/news/feed/", Comments: "China", Language: "en", Status: "active", Country: "CN", Tier: 2},
+	{ID: "6cdad425e3294632", URL: "https://bergenfield.dailyvoice.com/rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "db50291712428367", URL: "https://time.news/tag/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c743152810bf659", URL: "https://www.africa-press.net/ghana/feed", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "1ab85e7fc980fc5d", URL: "https://dnyuz.com/category/news/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "25f292a01cf836e8", URL: "https://www.wvpe.org/wvpe-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1b3b778f435e4cbf", URL: "https://capitaldistrict.dailyvoice.com/rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "501422a3baaaf3ac", URL: "https://news.pngfacts.com/feeds/posts/default?alt=rss", Comments: "Papua New Guinea", Language: "en", Status: "active", Country: "PG", Tier: 2},
+	{ID: "d4c47342e7eaa139", URL: "https://www.atlanticcouncil.org/issue/geopolitics-energy-security/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "53789168e9eb2b35", URL: "https://ridgewood.dailyvoice.com/rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1e4869cc29965dd9", URL: "https://www.thedenverchannel.com/index.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "704e56ba4a5f291b", URL: "http://www.thecourier.com.au/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "4fbd82e118c14969", URL: "https://www.gdacs.org/xml/gdacs_cap.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "669df10500669ced", URL: "https://the-journal.com/feeds/local-news", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0c678dbdda5fdab5", URL: "https://6abc.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6662519a7e856270", URL: "http://www.cjme.com/feed", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "7a2d5ed7da1cb425", URL: "https://www.wavy.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "003893f755b64782", URL: "https://rthk9.rthk.hk/rthk/news/rss/e_expressnews_elocal.xml", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "ab7fddb491af5501", URL: "https://www.the-journal.com/feeds/news", Comments: "San Juan Islands, Washington", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "845b2e60ec04db45", URL: "https://perilofafrica.com/category/world-news/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "dd6baee676faa6c4", URL: "https://www.nbc5i.com/news/local/feed/", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b3734d8ec8858f7", URL: "https://www.three.fm/news/isle-of-man-news/feed.xml", Comments: "Isle of Man", Language: "en", Status: "active", Country: "IM", Tier: 2},
+	{ID: "6e494527d820a3f4", URL: "https://tribune.com.pk/feed/punjab", Comments: "Punjab, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "50ec96b279aa6c22", URL: "https://bernews.com/category/accidents-and-fires/feed", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "464e82f127b5a879", URL: "https://www.cbsnews.com/latest/rss/crime", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "82a0a1fe7bfb502c", URL: "https://www.nbcbayarea.com/news/california/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c290e7f335f92df0", URL: "https://www.wkbw.com/index.rss", Comments: "Buffalo, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "630218aecc2dd2bc", URL: "https://www.thewhig.com/rss", Comments: "Kingston", Language: "en", Status: "active", Tier: 2},
+	{ID: "47b55cbd66b45ea7", URL: "https://kfbk.iheart.com/topic/national-news.rss/", Comments: "Sacramento", Language: "en", Status: "active", Tier: 2},
+	{ID: "ab7fddb491af5501", URL: "https://the-journal.com/feeds/news", Comments: "San Juan Islands, Washington", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9eb47325d82bc19e", URL: "https://www.lex18.com/index.rss", Comments: "Lexington", Language: "en", Status: "active", Tier: 2},
+	{ID: "2cddfb43153d619f", URL: "https://princewilliam.dailyvoice.com/rss/", Comments: "Prince William County, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2b3b739ecc0432a7", URL: "https://www.thedenverchannel.com/news/front-range.rss", Comments: "Denver, front range", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d374887fe540cf8", URL: "https://mynorthwest.com/category/world/feed/", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "870d17dce46579c9", URL: "https://sussex.dailyvoice.com/rss/", Comments: "Sussex County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e4e48d7b9c6293d6", URL: "https://kbnd.com/kbnd-news/regional-news/feed.xml", Comments: "Bend, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "deb6ecc0589a1893", URL: "https://www.thetrentonline.com/category/news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "80cadb59bd78f70c", URL: "https://forefrontng.com/rss", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "ca26b21961d815f2", URL: "https://www.africa-press.net/rwanda/feed", Comments: "Rwanda", Language: "en", Status: "active", Country: "RW", Tier: 2},
+	{ID: "c7ad3d327c1f00c0", URL: "https://nationaldailyng.com/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c2b8721a88de8884", URL: "http://www.devdiscourse.com/rss/website", Comments: "developing countries", Language: "en", Status: "active", Tier: 2},
+	{ID: "530a412104b9a1a1", URL: "https://awokonewspaper.sl/feed/", Comments: "Sierra Leone", Language: "en", Status: "active", Country: "SL", Tier: 2},
+	{ID: "6bc19d6e2607902e", URL: "https://harrison.dailyvoice.com/rss/", Comments: "Harrison, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "059e0b01e22e13a1", URL: "http://jamaica-gleaner.com/section_front/10/feed", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "9ab6b9c64e3a5548", URL: "https://home.nzcity.co.nz/rss/news.aspx?catid=985&fm=newsmain%2cnarts", Comments: "NZCity", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5f61651552511f0", URL: "https://www.wkrn.com/feed/", Comments: "Nashville", Language: "en", Status: "active", Tier: 2},
+	{ID: "f2b2d877521252d9", URL: "https://www.abc.net.au/radio/programs/abc-news-daily/feed/9443166/podcast.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b4dd384a7e6139e", URL: "https://www.kibskbov.com/feed/", Comments: "Bishop, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b22f7b0d69a8f1b8", URL: "https://www.kenyagist.com/feed/", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "bce05f742be7670c", URL: "http://www.indiandefensenews.in/feeds/posts/default", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "eaa1c4e355817aa4", URL: "https://worcester.dailyvoice.com/rss/", Comments: "Worcester County, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ac4a6d84e7d7913e", URL: "https://lagatar24.com/category/jharkhand/feed/", Comments: "Jharkhand", Language: "en", Status: "active", Tier: 2},
+	{ID: "c46840ad968e1a0f", URL: "https://www.nbcconnecticut.com/tag/florida/feed/", Comments: "Connecticut, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "586d8dfa0dd9cfd6", URL: "https://thewest.com.au/news/world/rss", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "597da9951fc021be", URL: "https://wlni.com/feed/", Comments: "Lynchburg, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dd0c04f735a70931", URL: "https://www.local10.com/arc/outboundfeeds/rss/category/news/?outputType=xml&size=10", Comments: "Miami, Fort Lauderdale", Language: "en", Status: "active", Tier: 2},
+	{ID: "14aa4d7d9893b125", URL: "https://www.igberetvnews.com/feed/atom", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "6b593ca6e4201275", URL: "https://www.irishmirror.ie/news/irish-news/rss.xml", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "52ed7042010ae57c", URL: "https://www.tspr.org/npr-news.rss", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "aa6b39ca4a4f22b5", URL: "https://www.durangoherald.com/feeds/news", Comments: "Durango", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa6b39ca4a4f22b5", URL: "https://durangoherald.com/feeds/news", Comments: "Durango", Language: "en", Status: "active", Tier: 2},
+	{ID: "dbd80b235c98df67", URL: "https://www.nbc26.com/news.rss", Comments: "Green Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "afd32a5d47695064", URL: "https://rustavi2.ge/en/rss", Comments: "Georgian", Language: "en", Status: "active", Tier: 2},
+	{ID: "55b145a9e507494c", URL: "https://www.kerrfatou.com/feed/", Comments: "Gambia", Language: "en", Status: "active", Country: "GM", Tier: 2},
+	{ID: "827de084be3a2feb", URL: "https://www.news4jax.com/arc/outboundfeeds/rss/category/news/?outputType=xml&size=10", Comments: "Jacksonville", Language: "en", Status: "active", Tier: 2},
+	{ID: "8c1337b063918520", URL: "http://www.recorderonline.com/search/?f=rss&t=article&c=news/state_news&l=50&s=start_time&sd=desc", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fca13d5492c05076", URL: "https://www.nbc4.com/news/local/prince-georges-county/feed/", Comments: "Prince George's County", Language: "en", Status: "active", Tier: 2},
+	{ID: "cabd926b988e6095", URL: "https://wtmj.com/category/news/featured-stories/feed/", Comments: "Milwaukee", Language: "en", Status: "active", Tier: 2},
+	{ID: "9404232694ca5cf2", URL: "http://alerts.weather.gov/cap/ga.php?x=0", Comments: "Georgia (US)", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e08f99329aa732b", URL: "https://dnyuz.com/category/news/us/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b264693faa3ee3b0", URL: "http://feeds.nepalnews.net/rss/7399985502eaed63", Comments: "Nepal", Language: "en", Status: "active", Country: "NP", Tier: 2},
+	{ID: "1920dd8ee7033df4", URL: "https://innonews.com.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "33e2c864408fd01c", URL: "https://www.fox17online.com/news.rss", Comments: "Grand Rapids, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c01e73dd32eb1c60", URL: "https://www.bluemountainsgazette.com.au/rss.xml", Comments: "Blue Mountains, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "30332bd6bfd67647", URL: "http://www.ummid.com/syndications/ummid.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "669c3a61d91c7051", URL: "https://westbridgfordwire.com/news/feed/", Comments: "West Bridgford, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "5fd06469754a8413", URL: "https://www.ktvq.com/sitemap.rss", Comments: "Billings, MT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c72c370f2e8ddb2f", URL: "https://www.krtv.com/news/u-s-and-the-world.rss", Comments: "Great Falls, MT, US, world", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d15bd7d024cf19d5", URL: "https://perilofafrica.com/category/news/feed", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "aef512f6c435b04d", URL: "https://lfpress.com/feed/", Comments: "London, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "45ec21c24af024a8", URL: "http://napavalleyregister.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Napa Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3350d7b6e910771", URL: "https://www.wbbjtv.com/feed/", Comments: "Jackson, TN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "deee06ef9d110f25", URL: "https://www.irishmirror.ie/news/world-news/rss.xml", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "c38c1dc3ad846224", URL: "https://www.katc.com/sitemap.rss", Comments: "Lafayette, LA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c0681fcd4ef497e6", URL: "https://wsvn.com/news/local/florida/feed/", Comments: "Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "704b4b39a9849f8b", URL: "http://denvergazette.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "3513aff84db92881", URL: "https://www.caribjournal.com/feed/rss/", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "f340e29b091ee984", URL: "https://scarsdale.dailyvoice.com/rss/", Comments: "Scarsdale", Language: "en", Status: "active", Tier: 2},
+	{ID: "0f2202892288cc21", URL: "https://fairfield.dailyvoice.com/rss/", Comments: "Fairfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "0e6cb941bfe04baf", URL: "https://www.thenews.com.pk/rss/1/12", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3487d3e6a9f929fb", URL: "https://www.fox47news.com/neighborhoods/east-lansing-okemos.rss", Comments: "Lansing, MI, East Lansing, Okemos", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9d3df8e84dcb9174", URL: "https://charlestoncitypaper.com/feed/", Comments: "Charleston", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7717ffe487d89ad", URL: "https://timesofsandiego.com/feed", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "82b9ca596f6bbc32", URL: "https://www.katc.com/news/covering-louisiana.rss", Comments: "Lafayette, LA, Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b321b176d63830a5", URL: "https://www.obantimes.co.uk/feed/", Comments: "Oban, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "bfd0904e46d7c064", URL: "https://www.africa-press.net/botswana/feed", Comments: "Botswana", Language: "en", Status: "active", Country: "BW", Tier: 2},
+	{ID: "6f9c9708c1e1c1f0", URL: "https://ladailypost.com/feed/", Comments: "Los Alamos", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec8498f598374897", URL: "http://www.ibtimes.sg/rss.xml", Comments: "Singapore, Asia", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "4d32076690cb384a", URL: "https://www.winnmediaskn.com/feed/", Comments: "St. Kitts and Nevis, Caribbean", Language: "en", Status: "active", Country: "KN", Tier: 2},
+	{ID: "f61e3c0d1af13ecc", URL: "https://www.latestnigeriannews.com/feed/allnews/rss.xml", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "8096bc12019529e5", URL: "https://www.wral.com/news/rss/142/", Comments: "Raleigh", Language: "en", Status: "active", Tier: 2},
+	{ID: "836f74f067cfacec", URL: "https://timesofoman.com/feed/oman", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "0b9609e0f7693892", URL: "http://billingsgazette.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Billings", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c2475e90acea133", URL: "https://www.fox47news.com/neighborhoods/state-capitol.rss", Comments: "Lansing, MI, State Capitol", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0a78259cd28afa93", URL: "https://www.krqe.com/feed/", Comments: "Albuquerque", Language: "en", Status: "active", Tier: 2},
+	{ID: "a09f38f092a2cf6b", URL: "https://www.citizen.co.za/news/south-africa/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "871f572cf70648f9", URL: "http://www.berkshireeagle.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Berkshire", Language: "en", Status: "active", Tier: 2},
+	{ID: "03f22e092457695a", URL: "http://feeds.brazilsun.com/rss/24437442923341f1", Comments: "Brazil", Language: "en", Status: "active", Country: "BR", Tier: 2},
+	{ID: "13bdcaa75a60c7bc", URL: "https://www.rferl.org/api/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4f46dcd5a2d6ade6", URL: "https://nationaldailyng.com/category/latest/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "000a9e87ac4e616b", URL: "https://neusroom.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "96c147f4aff5a1c3", URL: "https://www.fox47news.com/neighborhoods/jackson-hillsdale.rss", Comments: "Jackson, Hillsdale", Language: "en", Status: "active", Tier: 2},
+	{ID: "076e331d1939f6c2", URL: "https://www.eco-business.com/feeds/all/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "06d5b39792d17baf", URL: "http://www.benningtonbanner.com/search/?f=rss&t=article&c=community-news&l=50&s=start_time&sd=desc", Comments: "Bennington", Language: "en", Status: "active", Tier: 2},
+	{ID: "eb8c45f6d2877aa1", URL: "https://www.fox7austin.com/rss/tags/crime-publicsafety", Comments: "Austin", Language: "en", Status: "active", Tier: 2},
+	{ID: "eb6911c8bc855474", URL: "https://www.samoanews.com/rss.xml", Comments: "Samoa", Language: "en", Status: "active", Country: "WS", Tier: 2},
+	{ID: "9f323c88ecf79503", URL: "https://morris.dailyvoice.com/rss/", Comments: "Morris", Language: "en", Status: "active", Tier: 2},
+	{ID: "6e07109358a1fc48", URL: "https://www.duluthnewstribune.com/news.rss", Comments: "Duluth, Duluth", Language: "en", Status: "active", Tier: 2},
+	{ID: "e47339567e930fda", URL: "https://www.bostonherald.com/news/massachusetts-news/feed/", Comments: "Boston, Massachusetts", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2f8364ba3af7e6be", URL: "https://dailynorthwestern.com/feed/", Comments: "United States", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4804c8f7ea2adb54", URL: "https://www.rferl.org/api/zbqimetkiy", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e54c0af4fce760b1", URL: "http://www.toledoblade.com/rss/", Comments: "Toledo", Language: "en", Status: "active", Tier: 2},
+	{ID: "8c4167af2212f28b", URL: "https://lewisboro.dailyvoice.com/rss/", Comments: "Lewisboro", Language: "en", Status: "active", Tier: 2},
+	{ID: "e8a66e57f171afce", URL: "https://news.google.com/rss/search?q=%22mob%22+OR+%22riot%22+OR+%22tear+gas%22+OR+%22wounded%22+OR+%22smoke+bombs%22+OR+%22smoke+bomb%22+OR+%22riots%22&hl=en-US&gl=US&ceid=US:en", Language: "en", Status: "active", Tier: 2},
+	{ID: "31c6bceb76be847f", URL: "https://www.dailymail.co.uk/home/latest/index.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "41f1d308cb3b546f", URL: "http://thetandd.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9386e32e1166b9e5", URL: "https://news.google.com/rss/search?q=%22mob%22+OR+%22lynched%22+OR+%22wounded%22+OR+%22lynching%22+OR+%22mob+violence%22&hl=en-US&gl=US&ceid=US:en", Language: "en", Status: "active", Tier: 2},
+	{ID: "c40d87bec5e49ace", URL: "http://feeds.myanmarnews.net/rss/c3891022f175b678", Comments: "Myanmar", Language: "en", Status: "active", Country: "MM", Tier: 2},
+	{ID: "dda3bc03e8a07411", URL: "https://www.dailymail.co.uk/news/hungary/index.rss", Comments: "Hungary", Language: "en", Status: "active", Country: "HU", Tier: 2},
+	{ID: "6549a4d92ad45537", URL: "https://cfnrfm.ca/category/news/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "91486465d86e0ba7", URL: "http://feeds.venezuelastar.com/rss/262fd6adf564928c", Comments: "Venezuela", Language: "en", Status: "active", Country: "VE", Tier: 2},
+	{ID: "6d04e6697c4ba724", URL: "https://www.idomavoice.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "d0f84c110760a769", URL: "http://news.google.com/news?q=knysna&hl=en&lr=lang_en&gl=us&prmd=mi&prmdo=1&um=1&ie=UTF-8&output=rss", Comments: "Knysna", Language: "en", Status: "active", Tier: 2},
+	{ID: "a44ee17611609d04", URL: "https://www.nbnnews.com.au/feed/", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "eacf7bd8d279aa3b", URL: "http://jamaica-star.com/feed/news.xml", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "a655879b71faca57", URL: "https://globalsentinelng.com/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "6ecd87795ce9385b", URL: "https://api.io.canada.ca/io-server/gc/news/en/v2?dept=canadaborderservicesagency&sort=publishedDate&orderBy=desc&publishedDate%3E=2021-07-23&pick=50&format=atom&atomtitle=Canada%20Border%20Services%20Agency", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "790aa0af2a27609d", URL: "https://www.law360.com/cybersecurity-privacy/rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "4427e1d6b0b00389", URL: "https://westseattleblog.com/feed/", Comments: "West Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "b634e23234e5a5a8", URL: "https://www.kbzk.com/sitemap.rss", Comments: "KBZK", Language: "en", Status: "active", Tier: 2},
+	{ID: "cfcc1469b4ad0ba4", URL: "https://stratford.dailyvoice.com/rss/", Comments: "Stratford", Language: "en", Status: "active", Tier: 2},
+	{ID: "cdb76a61627b64c8", URL: "http://feeds.asiabulletin.com/rss/bf053b50c46383e0", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "488f9cda4c29a297", URL: "https://www.daijiworld.com/rssfeed/rssfeed.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "c170b37bdd1b6a37", URL: "https://www.bangkokpost.com/rss/data/topstories.xml", Comments: "Bangkok", Language: "en", Status: "active", Tier: 2},
+	{ID: "76b8e8d7db5d2a72", URL: "http://www.dover-express.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e54c0af4fce760b1", URL: "https://www.toledoblade.com/rss/", Comments: "Toledo, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6bd5903b23fc4eea", URL: "https://stateimpact.npr.org/pennsylvania/feed/", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1bb2b651995d3acd", URL: "https://www.victoriabuzz.com/feed/", Comments: "Victoria, BC, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "4723e6468d6544a9", URL: "https://nationaldailyng.com/category/trending-stories/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "8dc8231b5fa9b97b", URL: "https://www.inforum.com/latest.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec00fec47d7f7ab9", URL: "https://www.worksopguardian.co.uk/rss", Comments: "Worksop, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f4c923cb6e2fb8f9", URL: "https://flaglerlive.com/feed/", Comments: "Flagler", Language: "en", Status: "active", Tier: 2},
+	{ID: "a6ca51048b0de95c", URL: "https://www.tag911.ae/news/local-news/feed.xml", Comments: "UAE", Language: "en", Status: "active", Country: "AE", Tier: 2},
+	{ID: "2c76acff3a2f94ac", URL: "https://www.beaufortcountynow.com/rss.php?id=98", Comments: "Beaufort County", Language: "en", Status: "active", Tier: 2},
+	{ID: "11ab144296aebf9d", URL: "https://www.namibian.com.na/feed/", Comments: "Namibian", Language: "en", Status: "active", Tier: 2},
+	{ID: "102f55b82f116195", URL: "https://www.wsls.com/arc/outboundfeeds/rss/category/news/?outputType=xml&size=10", Comments: "Roanoke, Southwest Virginia", Language: "en", Status: "active", Tier: 2},
+	{ID: "0ed9b8204754fdb7", URL: "https://news.stv.tv/section/highlands-islands/feed", Comments: "Highlands & Islands", Language: "en", Status: "active", Tier: 2},
+	{ID: "4bedac058fe6331b", URL: "https://somerset.dailyvoice.com/rss/", Comments: "Somerset", Language: "en", Status: "active", Tier: 2},
+	{ID: "3fe466e6837863f4", URL: "http://alerts.weather.gov/cap/nc.php?x=0", Comments: "North Carolina", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bcc88d3f1cc53b39", URL: "http://tribune.com.pk/pakistan/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "ae53e71072a292df", URL: "https://rthk9.rthk.hk/rthk/news/rss/e_expressnews_egreaterchina.xml", Comments: "Hong Kong, Greater China", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "b429fd6945e161e4", URL: "https://www.mymcmedia.org/category/police-and-fire/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "5837d57a0ccc17b9", URL: "http://www.uscirf.gov/rss.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a80919e32181e9d6", URL: "https://www.castanetkamloops.net/rss/page-3.xml", Comments: "Kamloops, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "94f0e63d596a034b", URL: "https://kggfradio.com/local-news/feed.xml", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a2db5ef7f1a7f571", URL: "https://www.fox47news.com/neighborhoods.rss", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ca9f000477466f82", URL: "https://maltadaily.mt/feed/", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "0f475a8b9dfdbd82", URL: "https://www.channelnewsasia.com/api/v1/rss-outbound-feed?_format=xml&category=6311", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "234544315485fc0e", URL: "https://www.dl-online.com/latest.rss", Comments: "Detroit Lakes, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "46e69a022e44756e", URL: "https://the-journal.com/feeds/all", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fa40aac4dd70114d", URL: "https://wnbc.com/news/local/feed/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "42e8c7141ffb2835", URL: "https://edition.channel5belize.com/feed/atom", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "da40fea985dff48e", URL: "http://denvergazette.com/search/?f=rss&t=article&c=news/public-safety&l=50&s=start_time&sd=desc", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "64b9539d1fcfe807", URL: "https://midutahradio.com/category/news/national-news/feed/", Comments: "Utah", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8d41291ef4dee437", URL: "https://www.heralddemocrat.com/feed/", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "86f7c54443546344", URL: "http://journalnow.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9b684a8e1d9db388", URL: "https://feeds.capi24.com/v1/Search/articles/news24/Africa/rss", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "19dd49d169dcfa15", URL: "https://www.passengerterminaltoday.com/feed", Language: "en", Status: "active", Tier: 2},
+	{ID: "97c0efa6d9fa9349", URL: "https://www.radionz.co.nz/rss/in-depth.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "50e697e5a294b9b9", URL: "https://loudoun.dailyvoice.com/rss/", Comments: "Loudoun County, VA, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8880e12c92cb86f3", URL: "https://www.nbcnewyork.com/news/national-international/feed/", Comments: "New York, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3ab02a9d988cc172", URL: "https://theappeal.org/tag/death-penalty/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a6562e5b633e7373", URL: "https://www.portsmouth.co.uk/rss", Comments: "Portsmouth, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a7a8485983289ee0", URL: "https://www.wtkr.com/wgnt.rss", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8c756065538150ca", URL: "http://amandala.com.bz/news/feed/", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "76fef6bcb73886c0", URL: "http://www.belfastlive.co.uk/rss.xml", Comments: "Belfast, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2f48d6799f2019a5", URL: "https://egyptian-gazette.com/feed/", Comments: "Egypt", Language: "en", Status: "active", Country: "EG", Tier: 2},
+	{ID: "227e45b221801108", URL: "http://www.bendigoadvertiser.com.au/rss.xml", Comments: "Bendigo, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "81e277e0e3fd9ee8", URL: "https://time.news/tag/israel/feed/", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "6edb2c28c0d5fe6a", URL: "https://www.ktpress.rw/feed/", Comments: "Rwanda", Language: "en", Status: "active", Country: "RW", Tier: 2},
+	{ID: "5821cdff96ba78e0", URL: "http://chronline.com/browse.rss", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "763a910ce0d4b1c2", URL: "https://www.hawaiitribune-herald.com/category/community/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5945fa54c7ccf205", URL: "https://www.nbc5.com/news/national-international/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "df4d6534302ffb1e", URL: "http://www.timesnews.net/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a740bd6abd77a76c", URL: "http://www.smh.com.au/rss/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e3b1358f9697d32f", URL: "https://express.co.uk/posts/rss/1/uk", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9ee967bf918229ae", URL: "https://thewest.com.au/news/wa/rss", Comments: "Western Australia", Language: "en", Status: "active", Tier: 2},
+	{ID: "96f7dc597c669d03", URL: "https://www.buxtonadvertiser.co.uk/rss", Comments: "Buxton, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "945085e91cb4dfb9", URL: "http://9news.com.au/rss", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "1a34da3a1f3c29cb", URL: "https://www.nationalworld.com/news/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "673dfd6148bfe929", URL: "http://feeds.feedburner.com/radioiowanews", Comments: "Iowa, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "eeb27af6e64783d3", URL: "http://www.swoknews.com/search/?f=rss&t=article&c=news/briefs&l=50&s=start_time&sd=desc", Comments: "USA, Southwest Oklahoma", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c373acc26e15b07e", URL: "https://wilton.dailyvoice.com/rss/", Comments: "Wilton, CT, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9ca97bcccfe8a026", URL: "https://www.westhawaiitoday.com/category/hawaii-news/feed/", Comments: "West Hawaii", Language: "en", Status: "active", Tier: 2},
+	{ID: "e1a20f741cdd51f3", URL: "https://www.abc.net.au/radio-australia/feed/9412332/podcast.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "5866f06350f8ffe1", URL: "https://english.alaraby.co.uk/rss.xml?current=/tag/1877/Abu_Omar_alShishani", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ca0569d96e56c559", URL: "https://news.azpm.org/f/rss/newsfeature/", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f9adc795933a8ea9", URL: "https://woay.com/news/state/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d87ce15c5a5d6720", URL: "https://whiteplains.dailyvoice.com/rss/", Comments: "White Plains, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9ae89cf2a657a531", URL: "http://www.nola.com/search/?f=rss&t=article&c=news/courts&l=50&s=start_time&sd=desc", Comments: "NOLA.com", Language: "en", Status: "active", Tier: 2},
+	{ID: "1743e74577245f60", URL: "https://www.fox47news.com/neighborhoods/delta-township-grand-ledge.rss", Comments: "Delta Township, Grand Ledge", Language: "en", Status: "active", Tier: 2},
+	{ID: "bb60f623a0929a37", URL: "https://www.thegardenisland.com/tag/editors-picks/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "346b57bdf7219bd9", URL: "https://www.kunr.org/npr-news.rss", Comments: "Nevada", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b941ae39deb3f169", URL: "https://dnyuz.com/category/news/feed/", Comments: "Dnyuz", Language: "en", Status: "active", Tier: 2},
+	{ID: "2e4cb30120aed5c8", URL: "https://news.stv.tv/section/north/feed", Comments: "Northern Scotland", Language: "en", Status: "active", Tier: 2},
+	{ID: "d8876ff2b1519490", URL: "http://www.independent.ie/irish-news/rss", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "0c0515c1fea760c4", URL: "https://www.dailymail.co.uk/news/belfast/index.rss", Comments: "Belfast", Language: "en", Status: "active", Tier: 2},
+	{ID: "703b0ea064ff5383", URL: "http://www.naharnet.com/tags/middle-east/en/feed.atom", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "29fee85453eb830e", URL: "https://www.thedenverchannel.com/news/world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c1ecf1516f3a99cd", URL: "https://www.parkrapidsenterprise.com/latest.rss", Comments: "Park Rapids", Language: "en", Status: "active", Tier: 2},
+	{ID: "f107c1cd359dcdd6", URL: "https://ariananews.af/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "90684c0742d11e24", URL: "https://www.centralmaine.com/news/feed/", Comments: "Central Maine", Language: "en", Status: "active", Tier: 2},
+	{ID: "c64999dfca1adfb3", URL: "https://hartford.dailyvoice.com/rss/", Comments: "Hartford, CT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "174ce6abbc0c24aa", URL: "https://www.pressherald.com/news/mainecrime/feed/", Comments: "Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "be6de7f0207e5713", URL: "https://www.africa-press.net/south-sudan/feed", Comments: "Africa, South Sudan, global", Language: "en", Status: "active", Country: "SS", Tier: 2},
+	{ID: "20319485f6205b82", URL: "https://www.withinnigeria.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "2572d1e9579ac4d0", URL: "http://www.thesunchronicle.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "The Sun Chronicle", Language: "en", Status: "active", Tier: 2},
+	{ID: "1c79099de5c879d3", URL: "http://jamaica-gleaner.com/feed/rss.xml", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "a07f2a4c38493553", URL: "https://www.military.com/rss-feeds/content?keyword=army&type=news", Language: "en", Status: "active", Tier: 2},
+	{ID: "41bbf612d8763601", URL: "https://www.centralwesterndaily.com.au/rss.xml", Comments: "Central Western Australia", Language: "en", Status: "active", Tier: 2},
+	{ID: "c88a456d80c2403a", URL: "https://greenburgh.dailyvoice.com/rss/", Comments: "Greenburgh, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5a1b9d31ad5cbc62", URL: "https://www.dailymail.co.uk/news/syria/index.rss", Comments: "Syria", Language: "en", Status: "active", Country: "SY", Tier: 2},
+	{ID: "6ce7b8232035a6b3", URL: "https://www.greenmatters.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "416e4cc51a67b124", URL: "https://www.newindianexpress.com/States/Karnataka/rssfeed/?id=175&getXmlFeed=true", Comments: "Karnataka, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "049631a184b1ec69", URL: "https://www.africa-press.net/tanzania/feed", Comments: "Tanzania, Africa", Language: "en", Status: "active", Country: "TZ", Tier: 2},
+	{ID: "1fcabf76e61715a2", URL: "https://www.ckom.com/feed", Comments: "Saskatoon, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "46b216ef0160ecb7", URL: "https://news.smh.com.au/rss/national.xml", Comments: "Sydney, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "df1fedab51503db4", URL: "https://www.standard.co.uk/news/uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "bbeaa9e1a266f323", URL: "https://www.japantoday.com/feed", Comments: "Japan, international", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "356ca950dc815a81", URL: "https://www.kbzk.com/news/local-news.rss", Comments: "Bozeman", Language: "en", Status: "active", Tier: 2},
+	{ID: "8f849ee022ad6d49", URL: "https://www.getsurrey.co.uk/?service=rss", Comments: "Surrey, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "76903f480f7bb269", URL: "https://worldofbuzz.com/feed/", Comments: "World, Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "ec1602f69707d057", URL: "https://www.fijitimes.com.fj/category/news/world/feed/", Comments: "Fiji, global", Language: "en", Status: "active", Country: "FJ", Tier: 2},
+	{ID: "dd88949782585692", URL: "https://www.dailymail.co.uk/news/tennessee/index.rss", Comments: "Tennessee", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f4261f321f49ff3a", URL: "https://www.environmental-expert.com/rss/news-8/monitoring-testing", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "88f098a318c5666b", URL: "http://www.tv6tnt.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4abeef5996834c29", URL: "https://garfield.dailyvoice.com/rss/", Comments: "Garfield, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "021e1ef29a071d21", URL: "https://nationwideradiojm.com/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "4cd2b7b4db977b8b", URL: "https://www.salemreporter.com/feed?format=rss", Comments: "Salem, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "adf1d725d62ab539", URL: "https://allafrica.com/tools/headlines/rdf/southernafrica/headlines.rdf", Comments: "Southern Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "396e6e13447f60af", URL: "http://feeds.bbci.co.uk/news/england/rss.xml", Comments: "England", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "16bb7232939cdcbf", URL: "https://www.ibtimes.co.uk/rss/uk", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ac28af014e1ae12e", URL: "http://www.sudbury.com/rss", Comments: "Sudbury, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "55f568e4ae9caee3", URL: "https://newsroom.ky.gov/AttorneyGeneral/_layouts/15/Fwk.Webparts.Agency.Ui/newslistfeed.aspx", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bcd980ff5f0b8877", URL: "https://www.kurv.com/category/national/feed/", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7bebb41ce9bd1aa8", URL: "https://www.kzyx.org/national-news.rss", Comments: "California, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5a4a7ed8c7e49e88", URL: "https://www.wrtv.com/news/local-news/crime.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5d28f4a354b34886", URL: "https://www.sentinelassam.com/feed/", Comments: "Assam (India)", Language: "en", Status: "active", Tier: 2},
+	{ID: "d5c9e463ee2cc44e", URL: "https://www.nbcbayarea.com/news/national-international/feed/", Comments: "Bay Area, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1fe70fc6cd07909f", URL: "https://northpassaic.dailyvoice.com/rss/", Comments: "North Passaic, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "97d98ebc59ed34be", URL: "https://www.parkrapidsenterprise.com/index.rss", Comments: "Park Rapids", Language: "en", Status: "active", Tier: 2},
+	{ID: "93527a81709cdef3", URL: "https://www.environmental-expert.com/rss/news-8/health-safety", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c07a87dd59e5d71e", URL: "http://english.sta.si/rss-0", Comments: "Slovenia", Language: "en", Status: "active", Country: "SI", Tier: 2},
+	{ID: "111832c7ef6a5824", URL: "https://www.inewsguyana.com/category/latest-news/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "57207bee7a5db9d6", URL: "http://news.met.police.uk/rss/current_news/66871", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "02711975f174088f", URL: "https://borneobulletin.com.bn/feed/", Comments: "Borneo, Brunei", Language: "en", Status: "active", Country: "BN", Tier: 2},
+	{ID: "8e46c0a73e075a53", URL: "https://www.northernirelandworld.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "941c7b74801b63a1", URL: "https://kion546.com/category/news/san-benito-county/feed/", Comments: "San Benito County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b3e6128478fa0518", URL: "https://feeds.feedburner.com/calcoastnews", Comments: "California's Central Coast", Language: "en", Status: "active", Tier: 2},
+	{ID: "c5e8d8690bcd0e45", URL: "https://thefrontierpost.com/category/global/feed/", Comments: "Pakistan, global", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "3c55b032362b249e", URL: "https://rss.wbur.org/wbur", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "4b48904f8a4ada7b", URL: "https://www.wmky.org/npr-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "19695a1ed21fa537", URL: "https://www.nwahomepage.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "403a0663abbd6f08", URL: "https://freewestmedia.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "59b50fdfdfde75fb", URL: "https://de.crimethinc.com/feed.json.atom", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5fe4007c4aaa4091", URL: "https://english.newstracklive.com/rss-feed/uttar-pradesh.xml", Comments: "Uttar Pradesh", Language: "en", Status: "active", Tier: 2},
+	{ID: "04f9e3848e7b53a0", URL: "http://feeds.australiannews.net/rss/88f7d0d02bea1b33", Comments: "Australian", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "9ee8bdd26d89f7db", URL: "https://www.postandcourier.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Charleston, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0370dc20e545d147", URL: "https://www.wigantoday.net/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "53f306ad1e181d83", URL: "https://wtmj.com/category/news/feed/", Comments: "Milwaukee", Language: "en", Status: "active", Tier: 2},
+	{ID: "77356b19d8897b81", URL: "https://www.berkeleyside.com/feed", Comments: "Berkeley, CA, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4fb09fd7ec8cfc64", URL: "https://www.nbc26.com/index.rss", Comments: "Green Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "64fb8f8db87cbd54", URL: "https://www.klkntv.com/category/lancaster/feed/", Comments: "Lancaster, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1c9f5d3e29ea944e", URL: "https://www.peninsuladailynews.com/feed/", Comments: "Washington State", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8c15a65dcdf67313", URL: "https://www.theguardian.com/world/russia/rss", Comments: "Russia", Language: "en", Status: "active", Country: "RU", Tier: 2},
+	{ID: "f0e0dccb0d68b0b6", URL: "http://www.illawarramercury.com.au/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "71ec599284ef0737", URL: "https://www.kxlh.com/news/military-matters.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5ec102a89e33051a", URL: "https://warontherocks.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "751f87d3c5236207", URL: "https://www.africa-press.net/seychelles/feed", Comments: "Seychelles", Language: "en", Status: "active", Country: "SC", Tier: 2},
+	{ID: "08870a8f68437e3f", URL: "https://www.chathamdailynews.ca/feed", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "ca5bce16bf705044", URL: "https://worldradio.ch/news/bitesize-news/feed.xml", Comments: "Swiss, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4bee37fdcbd1bd41", URL: "https://www.thecipherbrief.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ebd6c73daa69f864", URL: "https://www.wcbi.com/category/local-news/feed/", Comments: "Mississippi", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "17c15459df03338b", URL: "https://www.mybuckhannon.com/feed/", Comments: "West Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0da2fba418ed3e56", URL: "https://asianewsnetwork.net/feed/", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6d40e04a24f1c7b", URL: "https://feeds.feedburner.com/breitbart", Language: "en", Status: "active", Tier: 2},
+	{ID: "d49b1f4fcbe1fc5d", URL: "https://www.fbcnews.com.fj/feed/", Comments: "Fiji", Language: "en", Status: "active", Country: "FJ", Tier: 2},
+	{ID: "e542ad2200d74e2c", URL: "https://allafrica.com/tools/headlines/rdf/africa/headlines.rdf", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "881bc27e05d45480", URL: "https://feeds.capi24.com/v1/Search/articles/citypress/topstories/rss", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "6c9e8d8a8e35bce2", URL: "https://www.arlingtoncardinal.com/search/chicago+police+department/feed/rss2/", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "1b82ea4cdf8355a4", URL: "https://www.superiortelegram.com/latest.rss", Comments: "Wisconsin", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f86add8d7fb202a6", URL: "https://www.tucsonweekly.com/tucson/Rss.xml", Comments: "Tucson, AZ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4b5dcf8f60492ae6", URL: "https://mahwah.dailyvoice.com/rss/", Comments: "New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "932e49fbba0c3a47", URL: "https://lex18.com/sitemap.rss", Comments: "Lexington, KY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6549a4d92ad45537", URL: "http://www.cfnrfm.ca/category/news/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "f96db5bd4b5b5769", URL: "https://dailynorthwestern.com/feed/rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7345ee7fa5574f61", URL: "https://www.firerescue1.com/firefighting-rss-feeds/topics.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c968b03e0ac37fe6", URL: "https://www.wptv.com/news/region-st-lucie-county.rss", Comments: "St. Lucie County, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "af6bdf9caf52c969", URL: "https://midhudsonnews.com/feed/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "494b6262ef2e7b24", URL: "https://myrtlebeachsc.com/feed/", Comments: "South Carolina", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c3fd035f36644e5d", URL: "https://www.dailymail.co.uk/news/the-taliban/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3d86f92e0d3661b4", URL: "https://www.wptv.com/news/region-s-palm-beach-county.rss", Comments: "Florida, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2ecb1b0a71e3f5db", URL: "https://flaglerlive.com/category/index-php/feed/", Comments: "Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a467ca3a9a13e5c2", URL: "https://www.eastcoastdaily.in/category/special-news/feed", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b2b76eb025fe780b", URL: "http://tribune.com.pk/world/feed/", Comments: "South Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "dd0150756241c61d", URL: "https://www.wtvq.com/category/local-news/feed/", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "37c9de74c6dc085b", URL: "http://feeds.abcnews.com/abcnews/topstories", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cea254f08002e689", URL: "https://krdo.com/category/news/colorado-springs/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "903ba2e4c358dea1", URL: "https://feeds.feedburner.com/ClimateHome", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d14454912fdf68b", URL: "https://www.stuttgartdailyleader.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "67092ca495a1758e", URL: "http://www.wnyc.org/atomfeeds/tags/national_news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6e29c6f308c20c17", URL: "https://www.firemapchicago.net/feeds/posts/default?alt=rss", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "80ad23bae36c1e6c", URL: "https://smnewsnet.com/feed/", Comments: "Southern Maryland", Language: "en", Status: "active", Tier: 2},
+	{ID: "36fcd1d882c30583", URL: "https://www.yorkshireeveningpost.co.uk/news/rss", Comments: "Yorkshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7dc062859766aa6b", URL: "https://keyt.com/category/news/san-luis-obispo-county/feed/", Comments: "San Luis Obispo County", Language: "en", Status: "active", Tier: 2},
+	{ID: "d6dda78ebbd98783", URL: "https://www.thisdaylive.com/index.php/category/latest/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "179c714339b46e0e", URL: "https://bringmethenews.com/.rss/full/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "55335c31f6190769", URL: "http://www.northerndailyleader.com.au/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "ee428f918f7a2779", URL: "https://www.wtnh.com/feed/", Comments: "New Haven", Language: "en", Status: "active", Tier: 2},
+	{ID: "c7b0f554a16e84a6", URL: "https://5pillarsuk.com/category/united-kingdom-england/feed/", Comments: "UK, England", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "73e03a519c8f76f7", URL: "https://pullmanradio.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "c94a1c97a7e9e852", URL: "https://japan-forward.com/category/politics-security/feed/", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "a76f2ea082381d01", URL: "https://www.tmj4.com/news/national.rss", Comments: "Milwaukee", Language: "en", Status: "active", Tier: 2},
+	{ID: "559ff642ab070200", URL: "https://www.sapeople.com/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "f8b45cfc3a4a2547", URL: "https://www.thesierraleonetelegraph.com/feed/", Comments: "Sierra Leone", Language: "en", Status: "active", Country: "SL", Tier: 2},
+	{ID: "aeeee84792a9f66c", URL: "https://westbridgfordwire.com/news/news-nottingham/feed/", Comments: "West Bridgford, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f62b995a08d644ee", URL: "https://www.kxlh.com/news/montana-news.rss", Comments: "Montana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "71de98c7c18fdfe3", URL: "https://www.pressandjournal.co.uk/category/news/inverness/feed/", Comments: "Inverness, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "70726205e8a75821", URL: "https://www.dailymail.co.uk/news/south-dakota/index.rss", Comments: "South Dakota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e6884b17ac1711f0", URL: "https://www.latimes.com/world-nation/rss2.0.xml", Comments: "LA, World, Nation", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ae9e9d84d4afa410", URL: "https://www.bemidjipioneer.com/news.rss", Comments: "Bemidji, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "055c89f3474e7e99", URL: "https://www.kivitv.com/news/treasure-valley.rss", Comments: "Treasure Valley, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e3c1e0b8c289c2c9", URL: "https://tribune.com.pk/feed/home", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "c23cee065ab39709", URL: "https://www.fox47news.com/neighborhoods/mason-leslie.rss", Comments: "Mason, Leslie, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7dfb48e3eb233362", URL: "https://www.dailytrib.com/feed/", Comments: "USA, Royal Oak", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2c50031ef591b3ab", URL: "https://www.thehindu.com/news/international/feeder/default.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f87ccaf6c8800eba", URL: "https://www.wxyz.com/news.rss", Comments: "Detroit", Language: "en", Status: "active", Tier: 2},
+	{ID: "156be9c88a82bbbf", URL: "https://www.ktvq.com/news.rss", Comments: "Billings", Language: "en", Status: "active", Tier: 2},
+	{ID: "978cb8032d67a259", URL: "https://news.azpm.org/f/rss/news-articles/", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c99fc652e96263f2", URL: "https://www.grandforksherald.com/latest.rss", Comments: "Grand Forks, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "35c852e28b2d033d", URL: "https://townlift.com/feed/", Comments: "Park City", Language: "en", Status: "active", Tier: 2},
+	{ID: "0d33947e7a741bf2", URL: "http://www.earthquakenewstoday.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3c8b721083604db4", URL: "https://www.pressandjournal.co.uk/category/news/moray/feed/", Comments: "Moray, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "150056f6a870184c", URL: "https://www.castanet.net/rss/topheadlines.xml", Comments: "Castanet, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "c8309c96d1731528", URL: "https://minutemirror.com.pk/national/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "bd800c9c15e5d2fa", URL: "https://english.alaraby.co.uk/rss.xml?current=/node/86", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e93b3a2808e5157b", URL: "http://www.tapinto.net/articles.atom", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b83ab5998d5eedd6", URL: "https://www.fox47news.com/news/national.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1b88915d00066813", URL: "https://www.lemonde.fr/en/rss/une.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c0b7bc73e6a9a06d", URL: "https://theappeal.org/topics/policing/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "04237502f3dd9033", URL: "https://nassau.dailyvoice.com/rss/", Comments: "Nassau County, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e8c06ea1b6dffcf8", URL: "https://vinnews.com/category/breaking-news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "17990aebfd86a6bb", URL: "https://www.thetrentonline.com/category/news/featured/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c6f652b9d986bad9", URL: "https://www.dailymail.co.uk/news/the-netherlands/index.rss", Comments: "Netherlands", Language: "en", Status: "active", Country: "NL", Tier: 2},
+	{ID: "088f05fc1f8312ca", URL: "https://www.scottishdailyexpress.co.uk/news/rss.xml", Comments: "Scottish", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e1eaf476029f1e8c", URL: "https://news.antiwar.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5b99d5890ab775ed", URL: "http://feeds.abcnews.com/abcnews/usheadlines", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0e3420958a5915bb", URL: "https://durangoherald.com/feeds/all", Comments: "Durango, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7430e2aac0111839", URL: "https://www.kxlf.com/sitemap.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4c0aecd4ed6f7e27", URL: "https://www.nbcsandiego.com/news/investigations/feed/", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "08abec449559aa51", URL: "https://news.google.ca/atom?hl=en-CA&gl=CA&ceid=CA%3Aen&oc=11", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "8989fb3f367d9452", URL: "https://thefactnews.in/category/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e1a3ef3d97d8a2b0", URL: "https://www.newstalkzb.co.nz/news/rssfeed", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "3fe165f3d0f17883", URL: "https://www.thaiexaminer.com/feed/", Comments: "Thailand, Southeast Asia", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "ec81f8a9b181e17d", URL: "https://world-today-news.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d83073b8d8047533", URL: "https://www.pressherald.com/news/nationworld/feed/", Comments: "US, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bca1957c732a694d", URL: "http://www.mintpressnews.com/rss/", Language: "en", Status: "active", Tier: 2},
+	{ID: "24d5765b4e0c5359", URL: "https://thefrontierpost.com/category/top-stories/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "6e5002042827845f", URL: "https://islamabadpost.com.pk/category/pakistan/feed/", Comments: "Islamabad, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "b323981895824c35", URL: "https://www.laughlin.af.mil/desktopmodules/articlecs/rss.ashx?contenttype=1&site=129&category=5544%2c5545%2c5546%2c5547%2c11999%2c12060&max=20", Comments: "Laughlin AFB, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "48b1a23d66c53881", URL: "https://mg.co.za/feed", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "1bb335416d242e1d", URL: "https://www.namibiansun.com/rssFeed/121", Comments: "Namibia, Southern Africa", Language: "en", Status: "active", Country: "NA", Tier: 2},
+	{ID: "394cd7295f5e3fe9", URL: "https://keyt.com/category/news/fire/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "52207c53cd6c994b", URL: "https://www.torontosun.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "49537082b9a364e1", URL: "https://www.kxlh.com/sitemap.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "75e1e9212c4fae19", URL: "https://newsafricanow.com/category/tunisia/feed/", Comments: "Tunisia", Language: "en", Status: "active", Country: "TN", Tier: 2},
+	{ID: "4371afc22e63d9a0", URL: "https://www.bemidjipioneer.com/index.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "b598437650fcf0c0", URL: "http://feeds.caribbeanherald.com/rss/e5abd99746ab67f4", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "496d0d9d7b93d58c", URL: "https://www.irishcentral.com/feeds/topic-articles.atom?topic=northern-ireland", Comments: "Northern Ireland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9e51213fcc8dea76", URL: "http://www.7newsbelize.com/rss.php", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "7d838549b837046f", URL: "https://www.khaama.com/tag/nato-2/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "eb2756b0c9f27fc1", URL: "https://www.jdsupra.com/resources/syndication/docsRSSfeed.aspx?ftype=CriminalLaw&premium=1", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4e2b4114f0f5fa4f", URL: "http://www.lincolnshireecho.co.uk/rss.xml", Comments: "Lincolnshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "be26d3556f40f7c7", URL: "https://www.geo.tv/rss/1/0", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "40a26cf04ae8c858", URL: "https://www.dailypost.co.uk/news/north-wales-news/rss.xml", Comments: "North Wales, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ac99dcc48bd22b1a", URL: "https://worldisraelnews.com/feed/", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "3f4ca7c9ba19010e", URL: "https://barbadostoday.bb/feed/", Comments: "Barbados", Language: "en", Status: "active", Country: "BB", Tier: 2},
+	{ID: "bf38003e6128cff5", URL: "https://www.duluthnewstribune.com/latest.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "55e59dfb7bcbcfec", URL: "https://canadanewsmedia.ca/category/news/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "208d94ab748a9eb2", URL: "https://asiapacificreport.nz/category/rnz-pacific/feed/", Comments: "Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ad65fe2dbfb747a", URL: "http://www.leader-call.com/search/?f=rss&t=article&c=news/state&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "191bf59f3777a1a2", URL: "https://www.deccanchronicle.com/rss_feed/?sectionId=1", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "e96cf84579a963b0", URL: "https://wusfnews.wusf.usf.edu/tags/us-world.rss", Comments: "US, World", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2ec46c9889a88f0b", URL: "https://www.blackpoolgazette.co.uk/news/rss", Comments: "Blackpool, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4f02081be7a609b1", URL: "http://dailytrojan.com/category/news//feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "75ad2f4b459c7ec4", URL: "https://www.wglr.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce20262fd942b382", URL: "https://arynews.tv/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "c6f90a9298144484", URL: "https://www.dailymail.co.uk/news/paris/index.rss", Comments: "Paris, France", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "0b3f321a94e33619", URL: "https://www.atlanticcouncil.org/region/africa/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "7969365f09c7fd88", URL: "https://www.latestly.com/rss/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2a13993cb42e8fdd", URL: "https://mountvernon.dailyvoice.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3890205491a3844", URL: "http://feeds.britainnews.net/rss/fad6c6ce3bc72160", Comments: "Britain", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "18334ab21f58c460", URL: "https://www.tiranatimes.com/?feed=rss2", Comments: "Albania", Language: "en", Status: "active", Country: "AL", Tier: 2},
+	{ID: "276feb80b6c6ca1e", URL: "https://www.nbcphiladelphia.com/news/local/feed/", Comments: "Philadelphia, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "07e9081c056de34c", URL: "https://hudsoncountyview.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "13bdcaa75a60c7bc", URL: "http://www.rferl.org/api/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ee3fb4a726f8bd1", URL: "https://wbsm.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b8867e33884cd37", URL: "https://www.wtkr.com/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fce7029e12ddd6f2", URL: "https://www.africa-press.net/namibia/feed", Comments: "Namibia", Language: "en", Status: "active", Country: "NA", Tier: 2},
+	{ID: "37f141b349bbf8a7", URL: "http://feeds.birminghamstar.com/rss/0cd1f7701040892f", Language: "en", Status: "active", Tier: 2},
+	{ID: "08a08479142f27e1", URL: "https://www.qed.ng/category/news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c5284baff3641f4f", URL: "https://cdn-3.timesofoman.com/feed/", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "e504383553bf1d87", URL: "https://itsgoingdown.org/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "28d189ff6ca2d7d0", URL: "http://feeds.malaysiasun.com/rss/48cba686fe041718", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "3b965cc006a028a1", URL: "https://lehigh.dailyvoice.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "1a2954e42dc7c998", URL: "https://www.castanet.net/rss/page-1.xml", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "c00222713fe845cd", URL: "https://cdispatch.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "c702df8fe2e53641", URL: "https://theberkshires.dailyvoice.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "00f7fd88854ab47d", URL: "https://www.lep.co.uk/rss", Comments: "Leeds, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7955913e4dcb9361", URL: "https://www.arrivealive.co.za/RSS/RssWriteArriveAlive.aspx", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "fcab7f5038fac2f4", URL: "https://hongkongfp.com/feed/", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "42de7936fd1aaeeb", URL: "https://www.leicestermercury.co.uk/news/local-news/rss.xml", Comments: "Leicester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "63e08f6b65edd294", URL: "https://woay.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "6549a4d92ad45537", URL: "http://cfnrfm.ca/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9650ad150b3c58ff", URL: "https://www.inklingsnews.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "73f74004cec814ba", URL: "https://www.cheknews.ca/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "956a03be954828c6", URL: "https://www.wfyi.org/news/wfyi-news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "185b525a84463cc4", URL: "https://fox2now.com/feed/", Comments: "St. Louis", Language: "en", Status: "active", Tier: 2},
+	{ID: "7ba47926639cc1ed", URL: "https://rss.24-7pressrelease.com/rss/society_civil_rights.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3cc76ab2334a83c0", URL: "https://kesq.com/category/news/national-world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4275579b4ac81ee7", URL: "http://www.mylondon.news/rss.xml", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "ad99cb542eb432c3", URL: "http://www.lincolnshirelive.co.uk/rss.xml", Comments: "Lincolnshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7c54bcb45530f057", URL: "https://www.bolnews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "be7b0db2319b407d", URL: "https://www.kxlf.com/index.rss", Comments: "Bozeman, Butte, Helena", Language: "en", Status: "active", Tier: 2},
+	{ID: "0ec736bafbf3bf45", URL: "https://www.southend.gov.uk/rss/news", Comments: "Southend-on-Sea, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "68ffa392d0ec602f", URL: "https://www.thisdaylive.com/index.php?feed=rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "258ee3f233db1540", URL: "https://gothamist.com/index.rdf", Comments: "New York City", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7d2fe4cceca07f08", URL: "http://feeds.heraldglobe.com/rss/2411cd3571b4f088", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "123048c52ce7b2bd", URL: "https://www.nzherald.co.nz/arc/outboundfeeds/rss/section/news/?outputType=xml&_website=the-country", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "5a266550a6ce006d", URL: "https://www.examinerlive.co.uk/news/local-news/rss.xml", Comments: "Huddersfield, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "513bc472680855ac", URL: "https://www.atlanticcouncil.org/region/syria/feed/", Comments: "Syria", Language: "en", Status: "active", Country: "SY", Tier: 2},
+	{ID: "5b16deca829dd5b4", URL: "https://www.scmp.com/rss/91/feed", Comments: "South China", Language: "en", Status: "active", Tier: 2},
+	{ID: "782b66e19f1d8217", URL: "https://www.wtxl.com/news.rss", Comments: "Tallahassee, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2a6dd8bdac526b76", URL: "http://www.gloucestershirelive.co.uk/rss.xml", Comments: "Gloucestershire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4bf6510ee61d4ed8", URL: "https://intervention.ng/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c4d27f4d6c4abd71", URL: "http://www.benningtonbanner.com/search/?f=rss&t=article&c=local-news&l=50&s=start_time&sd=desc", Comments: "Bennington, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b02feb2557d13aa8", URL: "https://whdh.com/news/local/new-hampshire/feed/", Comments: "New Hampshire", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c695fada8d6fc9c7", URL: "https://www.953mnc.com/category/michigan/feed/", Comments: "Michigan, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f7dc9e7bec3dfd95", URL: "https://www.india.com/news/india/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "c08a6d59425c8cfc", URL: "https://www.essexlive.news/rss.xml", Comments: "Essex, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7c3892cbc8b6d175", URL: "https://www.postbulletin.com/news/local.rss", Comments: "Rochester, Minnesota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5310e461b5504deb", URL: "https://www.gazettelive.co.uk/news/?service=rss", Comments: "Teesside, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b6bed63540149d96", URL: "https://newsroom.ky.gov/GovernorBeshear/_layouts/15/Fwk.Webparts.Agency.Ui/newslistfeed.aspx", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "762a28684e8a3e72", URL: "https://rss.iol.io/iol/weekend-argus", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b01c6daa02891d30", URL: "https://newstextarea.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6870c2ba7fe9d678", URL: "https://newsblaze.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fbd8fa6126a45705", URL: "https://www.nbcconnecticut.com/news/national-international/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "177d20bf443c4b32", URL: "http://us.acrofan.com/rss/rss.php?gubun=news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "10a2b0a1c8913508", URL: "http://infotel.ca/rssfeed/kamloops", Comments: "Kamloops, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "a314910b80c052c4", URL: "https://yonkers.dailyvoice.com/rss/", Comments: "Yonkers, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "869a5345b63e5f51", URL: "https://www.koreatimes.co.kr/www/rss/nation.xml", Comments: "Korea", Language: "en", Status: "active", Country: "KR", Tier: 2},
+	{ID: "191bf59f3777a1a2", URL: "https://deccanchronicle.com/rss_feed/?sectionId=1", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "236c07005dde2173", URL: "https://www.yourcentralvalley.com/feed/", Comments: "Fresno, Central Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "f577d2da77884fcd", URL: "https://english.alaraby.co.uk/rss", Comments: "AlAraby", Language: "en", Status: "active", Tier: 2},
+	{ID: "52207c53cd6c994b", URL: "http://www.torontosun.com/rss", Comments: "Toronto", Language: "en", Status: "active", Tier: 2},
+	{ID: "6eb136ea8275b90d", URL: "https://www.kingstonist.com/feed/", Comments: "Kingston, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "688882f937271832", URL: "https://rss.iol.io/iol/news/south-africa", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "1e7483d8ddfad78b", URL: "https://www.dailymail.co.uk/news/ukraine/index.rss", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "46b32b7f5f78df40", URL: "http://drivebc.com/api/events/region/thomok?format=rss", Comments: "Thompson Okanagan region, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "5bd5d6baa2cf74d0", URL: "https://www.pressherald.com/news/feed/", Comments: "Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f0aca1e0daad7326", URL: "https://sggpnews.org.vn/rss/home.rss", Comments: "Vietnam", Language: "en", Status: "active", Country: "VN", Tier: 2},
+	{ID: "cbbb9fe066f02c97", URL: "https://www.fox47news.com/news/state.rss", Comments: "Wisconsin, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "478eee9cb21b0ce6", URL: "https://www.dailymail.co.uk/news/nebraska/index.rss", Comments: "Nebraska, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5c2b9304f1d4d524", URL: "https://www.nbcbayarea.com/news/local/climate-in-crisis/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "1173c79f3bb1e3ff", URL: "https://tennews.in/News/new-delhi-news/feed/", Comments: "New Delhi", Language: "en", Status: "active", Tier: 2},
+	{ID: "32bb03a4be17dde3", URL: "https://midutahradio.com/category/news/feed/", Comments: "Utah", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "54a434f0a09787da", URL: "https://www.jwire.com.au/feed/", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "475325be97093587", URL: "https://www.850wftl.com/category/featured-stories/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "0f7571eedd9bc108", URL: "https://nenow.in/category/north-east-news/feed", Comments: "North East India", Language: "en", Status: "active", Tier: 2},
+	{ID: "684e8c4cdff07fa6", URL: "https://globalnews.ca/calgary/feed/", Comments: "Calgary", Language: "en", Status: "active", Tier: 2},
+	{ID: "95404f6967294f41", URL: "http://www.namnewsnetwork.org/?feed=rss2&cat=215", Comments: "Non-Aligned Movement", Language: "en", Status: "active", Tier: 2},
+	{ID: "53d6d5395ffe7873", URL: "https://www.welivesecurity.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5c78c654fcd8e259", URL: "https://talkagblog.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d3d3e579a5b19606", URL: "https://newsroomodisha.com/category/featured/feed/", Comments: "Odisha, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7ce89c60dc52bd59", URL: "https://www.gloucestershirelive.co.uk/news/rss.xml", Comments: "Gloucestershire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8c80f6c9d3636802", URL: "https://www.houstonpublicmedia.org/topics/news/energy-environment/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c9dbf589b3805d5", URL: "https://gvwire.com/feed/", Comments: "Fresno, Central Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "dabfd050c3567deb", URL: "https://www.dailymail.co.uk/ushome/index.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "21b0ade09e92e763", URL: "https://www.koaa.com/news/digital-original.rss", Comments: "Colorado Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "f00849915e26b356", URL: "https://www.tricityrecordnm.com/feeds/news/", Comments: "Tri-City, New Mexico", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "beb5ed0db76967ff", URL: "https://cde.news/category/news/eur/mediterranean/feed/", Comments: "Mediterranean Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "53a89c9eafb9aea4", URL: "https://www.ynet.co.il/Integration/StoryRss3082.xml", Comments: "region", Language: "en", Status: "active", Tier: 2},
+	{ID: "e4564fe1f0b9f3f8", URL: "https://www.thenicheng.com/category/headlines/feed/", Comments: "Nicheng, China", Language: "en", Status: "active", Country: "CN", Tier: 2},
+	{ID: "eadfe1f8fb327ca1", URL: "https://www.corkbeo.ie/news/local-news/rss.xml", Comments: "Cork, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "56f34d66c471f19f", URL: "http://www.koreatimes.co.kr/www/rss/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d38a5c1dbd17b87", URL: "https://www.themorningsun.com/feed/", Comments: "Mount Pleasant, Michigan", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "94ef8408718378f2", URL: "https://www.pinejournal.com/latest.rss", Comments: "Cloquet, Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9d710e1f63de5ecc", URL: "https://1150wima.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "efcaf0bdac1665b6", URL: "https://english.khabarhub.com/category/national/feed/", Comments: "Nepal", Language: "en", Status: "active", Country: "NP", Tier: 2},
+	{ID: "99459ea425450a07", URL: "https://annearundel.dailyvoice.com/rss/", Comments: "Anne Arundel County, Maryland", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a466b703562f666d", URL: "https://portlandmercury.com/portland/Rss.xml", Comments: "Portland, Oregon", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6ae64aa20f7d614f", URL: "https://sacramento.cbslocal.com/category/news/feed/", Comments: "Sacramento, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e5427762644ef64a", URL: "https://asiapacificreport.nz/category/human-rights/feed/", Comments: "Asia Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "e088c69a54dceba7", URL: "https://www.thedenverchannel.com/news/front-range/denver.rss", Comments: "Denver, Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9ce7d5ab205b4d8e", URL: "https://www.sabcnews.com/sabcnews/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "680fa2da9e4b88bd", URL: "https://westseattleblog.com/category/west-seattle-news/feed/", Comments: "West Seattle, Washington", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "546fcbfa243af352", URL: "https://www.aspistrategist.org.au/?feed=rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "8eb5b65bb940505a", URL: "https://www.twincities.com/news/feed/", Comments: "TwinCities", Language: "en", Status: "active", Tier: 2},
+	{ID: "e96db8404046cdd1", URL: "https://www.wtxl.com/news/local-news.rss", Comments: "Tallahassee", Language: "en", Status: "active", Tier: 2},
+	{ID: "f87681b05637423c", URL: "http://news.google.com/news?hl=en&gl=us&q=greece&um=1&ie=UTF-8&output=rss", Comments: "Greece", Language: "en", Status: "active", Country: "GR", Tier: 2},
+	{ID: "3e933ebf6b149206", URL: "https://cmsedit.cbn.com/rss-cbn-articles-cbnnews.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8c9a6ab674464a20", URL: "http://www.euractiv.com/rss", Comments: "European Union", Language: "en", Status: "active", Tier: 2},
+	{ID: "863f4c1b266b9a44", URL: "https://www.sundayworld.com/news/world-news/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0c441fb0cb7da1d5", URL: "https://www.journal-advocate.com/feed/", Comments: "Sterling, Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "eb25f048f92e4173", URL: "https://www.centralmaine.com/morning-sentinel/feed/", Comments: "Central Maine", Language: "en", Status: "active", Tier: 2},
+	{ID: "a5cfd17493e9316c", URL: "https://nenow.in/feed", Comments: "North East India", Language: "en", Status: "active", Tier: 2},
+	{ID: "6eb91ea5ea9a913d", URL: "https://www.searchlight.vc/category/news/feed/", Comments: "St. Vincent and Grenadines", Language: "en", Status: "active", Country: "VC", Tier: 2},
+	{ID: "910495f005e12a84", URL: "http://inewsguyana.com/feed", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "4437bcccdc862272", URL: "https://www.africa-press.net/nigeria/feed", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "9ada6826da539531", URL: "https://rss.jpost.com/rss/rssfeedsinternational", Comments: "Jerusalem", Language: "en", Status: "active", Tier: 2},
+	{ID: "b772d5d9d09d4c75", URL: "https://times.mw/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "c1627ad87450c779", URL: "http://www.theadvocate.com/search/?f=rss&t=article&c=baton_rouge/news/communities&l=50&s=start_time&sd=desc", Comments: "Baton Rouge, Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e8794e2fa337140a", URL: "http://www.examinerlive.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "cc73785b8eee0ada", URL: "https://www.mirror.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "3af2c79d122b4830", URL: "https://www.thestranger.com/seattle/Rss.xml", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "7e7de2de0c0d73a3", URL: "https://www.ktnv.com/video/news.rss", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "fa75f78361b35330", URL: "https://bnonews.com/index.php/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8538c0d1875bc163", URL: "https://www.wigantoday.net/news/rss", Comments: "Wigan, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "30bf3290d7030ad7", URL: "https://www.pattayamail.com/latestnews/feed", Comments: "Pattaya, Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "53a89c9eafb9aea4", URL: "http://www.ynet.co.il/Integration/StoryRss3082.xml", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "ed198ac38ca9ed44", URL: "https://newsafricanow.com/category/nigeria/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "82152168e03f5370", URL: "https://www.wboy.com/feed/", Comments: "Clarksburg, Weston, Fairmont", Language: "en", Status: "active", Tier: 2},
+	{ID: "27fa2d869db1d68c", URL: "https://www.tori.ng/feed/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "93608bf934970520", URL: "https://www.thenicheng.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "d8a84358799bc959", URL: "https://www.reviewjournal.com/local/feed/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "9337b40098f22901", URL: "https://insiderpaper.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cf7fecd692781353", URL: "https://krocnews.com/feed/", Comments: "Rochester, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "73c9c85811382b81", URL: "https://guardiansun.co.bw/rssFeed/49", Comments: "Botswana", Language: "en", Status: "active", Country: "BW", Tier: 2},
+	{ID: "b1547f9778041750", URL: "https://theappeal.org/topics/national-newsletter/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "75d3e5ba93fdeed5", URL: "http://www.newsregister.com/index.php?rss=Article", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "37fbb4e2049c6505", URL: "https://asiapacificreport.nz/category/asia-report/indonesia/feed/", Comments: "Indonesia", Language: "en", Status: "active", Country: "ID", Tier: 2},
+	{ID: "3921374745114a5f", URL: "http://www.nigerianeye.com/feeds/posts/default?alt=rss", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "1eb6a1b08682d281", URL: "https://rss.jpost.com/rss/rssfeedsislamicterrorism", Comments: "Jerusalem", Language: "en", Status: "active", Tier: 2},
+	{ID: "c3cf85bb132ca627", URL: "https://www.krtv.com/news/military-matters.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "2743d0081a3cd2ad", URL: "http://feeds.longbeachstar.com/rss/5e6fa777ccec8778", Comments: "Long Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b7cf734c9f261cf", URL: "https://www.grandforksherald.com/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e6df74f223d20b47", URL: "https://www.thedickinsonpress.com/index.rss", Comments: "Dickinson, ND", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8f93918d152e636a", URL: "https://www.chronicle.co.zw/category/s6-demo-section/c37-top-stories/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "d8092dc74e68e5d4", URL: "https://www.leicestermercury.co.uk/news/leicester-news/rss.xml", Comments: "Leicester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "5ae93c882cb6bade", URL: "https://news.google.com/rss/search?q=%22rockets%22+OR+%22land+missiles%22+OR+%22missile%22+OR+%22sea+missiles%22+OR+%22sea+missile%22+OR+%22rocket+attack%22+OR+%22missile+attack%22+OR+%22rocket%22+OR+%22artillery%22+OR+%22lamissile%22+OR+%22missiles%22+OR+%22mortar%22&hl=en-US&gl=US&ceid=US:en", Language: "en", Status: "active", Tier: 2},
+	{ID: "69b630f64684c1ea", URL: "https://zizonline.com/feed/", Comments: "St. Kitts, Nevis", Language: "en", Status: "active", Tier: 2},
+	{ID: "55cde7432b0a98fe", URL: "https://www.abc15.com/news/region-phoenix-metro.rss", Comments: "Phoenix", Language: "en", Status: "active", Tier: 2},
+	{ID: "52c04635172ec983", URL: "https://www.mid-day.com/Resources/midday/rss/mumbai.xml", Comments: "Mumbai, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "d6abf2cabcee5926", URL: "https://thefactnews.in/category/haryana/feed/", Comments: "Haryana, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "0aafcb68a78f2fe2", URL: "https://www.thebatavian.com/rss2.xml", Comments: "NY", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f313df54283cec7", URL: "https://ifpnews.com/feed", Comments: "Iran", Language: "en", Status: "active", Country: "IR", Tier: 2},
+	{ID: "e7bb8ba32748fe7a", URL: "https://www.brantfordexpositor.ca/feed", Comments: "Brantford, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "4276eddd7c7f5cd8", URL: "https://feeds.24.com/articles/news24/topstories/rss", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "e4c666b0f86daff4", URL: "http://www.nigerianeye.com/feeds/posts/default", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "7d99967d05131d11", URL: "https://www.kbzk.com/news.rss", Comments: "Bozeman", Language: "en", Status: "active", Tier: 2},
+	{ID: "338a267a75d62a26", URL: "https://english.alaraby.co.uk/rss.xml?current=/home/morefavoritearticles/decf4548-bf61-4c5a-b638-967f4239eec7/feaf07a3-263f-41bc-96d0-d7983063ac2c/desktop", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f2cba4d06df8c71d", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/feed/", Comments: "Santa Cruz County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "eae5c7f346e8663b", URL: "https://www.dailymail.co.uk/news/sydney/index.rss", Comments: "Sydney, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "c75a75eef4dec8da", URL: "https://www.kentonline.co.uk/_api/rss/kent_online_news_feed.xml", Comments: "Kent", Language: "en", Status: "active", Tier: 2},
+	{ID: "fba3844ccafe544c", URL: "https://thejournal.ie/feed/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "5f425209ba5bcffc", URL: "http://southasiaviews.com/feed/", Comments: "South Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "38f84d0ece3acc16", URL: "https://globalnews.ca/kingston/feed/", Comments: "Kingston, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "0afa87560130c639", URL: "http://world.kbs.co.kr/rss/rss_news.htm?lang=e&id=In", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e4671d5d5b37bc56", URL: "https://www.canoncitydailyrecord.com/news/feed/", Comments: "Canon City, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "46e69a022e44756e", URL: "https://www.the-journal.com/feeds/all", Comments: "Martinsburg, WV", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "080c6c7f9f7eec59", URL: "https://midhudsonnews.com/category/news/feed/", Comments: "Mid Hudson, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "85b7fd2294de28e9", URL: "https://www.grimsbytelegraph.co.uk/in-your-area/rss.xml", Comments: "Grimsby, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ec6302a76567f038", URL: "https://www.jamestownsun.com/latest.rss", Comments: "Jamestown, ND", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "496822e186b74899", URL: "https://www.dailymail.co.uk/news/pennsylvania/index.rss", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0e807814643ae127", URL: "https://winknews.com/feed/", Comments: "Fort Myers, Naples", Language: "en", Status: "active", Tier: 2},
+	{ID: "e89babf02e3e1d6c", URL: "https://www.euronews.com/rss?level=program&name=world", Comments: "World", Language: "en", Status: "active", Tier: 2},
+	{ID: "887715c7b44b9e29", URL: "http://www.paducahsun.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Paducah", Language: "en", Status: "active", Tier: 2},
+	{ID: "df0d999d3a10e8df", URL: "http://english.alaraby.co.uk/english/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "94f2784d080ca431", URL: "https://www.wate.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5363d0873185f9fd", URL: "https://keyt.com/category/news/national-world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f5ff3f595968763", URL: "https://philadelphia.cbslocal.com/category/around-the-nation/feed/", Comments: "Philly, Nation", Language: "en", Status: "active", Tier: 2},
+	{ID: "87a9f1dd7d63111b", URL: "https://www.hawaiitribune-herald.com/category/nation-world-news/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3f75f96e40503b28", URL: "https://www.nbcphiladelphia.com/tag/new-jersey/feed/", Comments: "Philadelphia, New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e27edf283c6c41ed", URL: "http://feeds.feedburner.com/foxnews/latest", Language: "en", Status: "active", Tier: 2},
+	{ID: "81ba66e230ed3f60", URL: "https://the-journal.com/local-news", Comments: "Delaware", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b9882a3fe525d754", URL: "http://www.jordantimes.com/rss.xml", Comments: "Jordan", Language: "en", Status: "active", Country: "JO", Tier: 2},
+	{ID: "c1109d2c552669eb", URL: "https://www.nbcboston.com/investigations/feed/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "67a9e5fc5cafe47f", URL: "https://arktimes.com/feed", Comments: "Arkansas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6930587a0e1cc438", URL: "http://japantoday.com/feed/atom", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "be9e04fabca1fc43", URL: "http://www.lhrtimes.com/feed/", Comments: "Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "51c0d081b7f2c5d7", URL: "https://www.anews.com.tr/rss/anasayfa.xml", Comments: "Turkey", Language: "en", Status: "active", Country: "TR", Tier: 2},
+	{ID: "4273c63230dd433f", URL: "https://www.clickondetroit.com/arc/outboundfeeds/rss/category/news/national/?outputType=xml&size=10", Comments: "Detroit", Language: "en", Status: "active", Tier: 2},
+	{ID: "878cb804111c417c", URL: "https://www.thehindu.com/news/cities/Tiruchirapalli/feeder/default.rss", Comments: "Tiruchirapalli, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "35869550c336cd04", URL: "https://www.dailymail.co.uk/news/singapore/index.rss", Comments: "Singapore", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "271c3a0affa937b2", URL: "https://www.dailybulletin.com/location/california/los-angeles-county/feed/", Comments: "Los Angeles County", Language: "en", Status: "active", Tier: 2},
+	{ID: "b4dc8601605f0876", URL: "https://www.theguardian.com/uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "45d9186ad5ef740e", URL: "https://www.dailylocal.com/feed/", Comments: "Chester County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9b57ffc657b32544", URL: "https://www.africa.com/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "236b6e108e132c8f", URL: "https://www.independent.ng/feed/rss/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "b84f343c4e916b5e", URL: "https://fallriverreporter.com/feed/", Comments: "Fall River, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e49d1a49d5c76521", URL: "https://www.turnto23.com/sitemap.rss", Comments: "Bakersfield, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "99203fa29a90df9d", URL: "https://www.dailymail.co.uk/news/west-virginia/index.rss", Comments: "West Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6aec32e43afe3b3d", URL: "https://nehandaradio.com/feed/rss/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "20446d74678f04e6", URL: "https://jerseyeveningpost.com/feed/", Comments: "Jersey, Channel Islands", Language: "en", Status: "active", Country: "JE", Tier: 2},
+	{ID: "4e64226483d25688", URL: "https://www.omanobserver.om/rssFeed/13/28", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "3597755a5b9f631b", URL: "https://www.examinerlive.co.uk/news/rss.xml", Comments: "Huddersfield, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e6475658d2f29262", URL: "https://www.thesun.co.uk/topic/ukraine-war/feed/", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "5f89e60eab149e9c", URL: "https://www.readingeagle.com/tag/south-schuylkill-news/feed/", Comments: "Schuylkill, Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a9d67a756d03e9f4", URL: "http://alerts.weather.gov/cap/us.php?x=0", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e27edf283c6c41ed", URL: "https://feeds.feedburner.com/foxnews/latest", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9ae32e07d7d50878", URL: "https://www.centralmaine.com/news/mainecrime/feed/", Comments: "Central Maine, Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1f303f86ae7946b2", URL: "https://www.marineinsight.com/category/shipping-news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "9be93c6c37ec6098", URL: "https://www.wnyc.org/feeds/tags/news", Language: "en", Status: "active", Tier: 2},
+	{ID: "9e69485abdd67d73", URL: "https://www.nbcbayarea.com/tag/san-francisco/feed/", Comments: "San Francisco", Language: "en", Status: "active", Tier: 2},
+	{ID: "ff82fae6695e9726", URL: "https://newcanaan.dailyvoice.com/rss/", Comments: "New Canaan, Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fca33ed750b1bb74", URL: "https://www.pressenza.com/-/topic/environment-ecology/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c9464c5c8b2d625d", URL: "https://bne.eu/feed/", Comments: "Eastern Europe, Central Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "8a928c436c612d1a", URL: "http://www.berkshireeagle.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "Massachusetts", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0e58b125ce8efc1e", URL: "https://www.mercurynews.com/news/crime-public-safety/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "51798dfcd43231d3", URL: "https://www.radionz.co.nz/rss/ldr.xml", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "850b92ce0966d07f", URL: "https://www.sunderlandecho.com/rss", Comments: "Sunderland, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e6c4b44d09786540", URL: "http://jamaica-gleaner.com/feed/news.xml", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "33194486779cede5", URL: "https://thewest.com.au/rss", Comments: "Western Australia", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c58c4d1e19175de", URL: "https://globalnews.ca/hamilton/feed/", Comments: "Hamilton, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "b5ce719834978fef", URL: "http://www.wnyc.org/feeds/tags/national_news", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2525dc2f20f98a3a", URL: "https://www.warsintheworld.com/index.php/feed/rss2/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "019ef36f8534d31b", URL: "https://www.qed.ng/feed/", Comments: "Niger", Language: "en", Status: "active", Country: "NE", Tier: 2},
+	{ID: "63c23060a7ed564e", URL: "http://www.irishtimes.com/rss/irish-times-top-10-stories-1.4019566", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "c8af11f7a5f7bc48", URL: "https://news.smh.com.au/rss/feed.xml", Comments: "Sydney", Language: "en", Status: "active", Tier: 2},
+	{ID: "7b4fbc0c380b7f18", URL: "https://thedailyguardian.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0c10b7bfc902467", URL: "https://www.omanobserver.om/rssFeed/17/76", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "a214361952aebc80", URL: "https://www.lincolnshireworld.com/rss", Comments: "Lincolnshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "39643cff4fb7e897", URL: "https://www.sbsun.com/news/feed/", Comments: "San Bernardino", Language: "en", Status: "active", Tier: 2},
+	{ID: "829a782f42be345d", URL: "https://www.theoaklandpress.com/news/feed/", Comments: "Oakland", Language: "en", Status: "active", Tier: 2},
+	{ID: "713a100abb49c0fb", URL: "https://bernews.com/tag/weather/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "695add2dad68b0c0", URL: "https://asiapacificreport.nz/category/multimedia/feed/", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "ba3d7cd99e780484", URL: "https://radiofree.org/feed/atom/", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "bf990536d5c88589", URL: "https://smnewsnet.com/archives/category/top/feed/", Comments: "Southern Maryland", Language: "en", Status: "active", Tier: 2},
+	{ID: "c075e68469e0bb9f", URL: "https://deccanchronicle.com/rss_feed/?subsectionId=24", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "46d11a7b8d25cb2f", URL: "http://www.cheshire-live.co.uk/rss.xml", Comments: "Cheshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "3fda8ac71b1f0930", URL: "https://pajhwok.com/category/features/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "9ecfb36f799634e0", URL: "https://barbadostoday.bb/category/local-news/feed/", Comments: "Barbados", Language: "en", Status: "active", Country: "BB", Tier: 2},
+	{ID: "ffd95593c530e334", URL: "https://spectator.com.au/category/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f172654bd590a6a8", URL: "https://www.examinerlive.co.uk/news/uk-world-news/rss.xml", Comments: "UK, World", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "3d95343b262219d8", URL: "https://poundridge.dailyvoice.com/rss/", Comments: "Pound Ridge, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "81500cca5e743d35", URL: "https://www.mitchellrepublic.com/latest.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a548527c828397e3", URL: "https://www.africa-press.net/mauritius/feed", Comments: "Mauritius, Africa", Language: "en", Status: "active", Country: "MU", Tier: 2},
+	{ID: "dfa2b7fa4f1cdb5b", URL: "https://720thevoice.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8616ca44b2dd8a73", URL: "https://www.shorenewsnetwork.com/category/local-news/virginia-news/feed/", Comments: "Virginia, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "92ababae151db244", URL: "https://www.dailymail.co.uk/news/mexico/index.rss", Comments: "Mexico", Language: "en", Status: "active", Country: "MX", Tier: 2},
+	{ID: "587975b542550535", URL: "http://www.newswire.lk/feed/", Comments: "Sri Lanka", Language: "en", Status: "active", Country: "LK", Tier: 2},
+	{ID: "bf3536502c458d08", URL: "https://koacolorado.iheart.com/topic/national-news.rss/", Comments: "Colorado, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "26e51f2320351cc8", URL: "http://www.reviewjournal.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "45b3da0d84e34493", URL: "https://www.presstelegram.com/location/california/los-angeles-county/feed/", Comments: "Los Angeles County", Language: "en", Status: "active", Tier: 2},
+	{ID: "df54151646d4e426", URL: "https://www.publicradiotulsa.org/npr-national-news.rss", Comments: "Tulsa, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b05596fd9f08af38", URL: "https://thepattayanews.com/feed/", Comments: "Pattaya, Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "b4b967240163b1cd", URL: "https://theheraldghana.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "b9882a3fe525d754", URL: "https://jordantimes.com/rss.xml", Comments: "Jordan", Language: "en", Status: "active", Country: "JO", Tier: 2},
+	{ID: "75ad2f4b459c7ec4", URL: "https://wglr.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f7f9b21d5079066", URL: "https://opslens.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e8a042ec6054560", URL: "https://www.gdacs.org/xml/rss_eq_24h.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "37befa41df58b16d", URL: "https://globalnews.ca/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "22a702c783872373", URL: "https://eurasiantimes.com/category/europe/feed/", Comments: "Europe, Eurasia", Language: "en", Status: "active", Tier: 2},
+	{ID: "d0a507c72fd715f0", URL: "https://www.nbc30.com/investigations/nbc-ct-responds/feed/", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e90a69803232eaee", URL: "https://www.icrc.org/en/rss/region/africa", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "dab262ffa997ed68", URL: "https://somaliupdate.com/feed/", Comments: "Somalia", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "33edba9d4cd87646", URL: "https://www.khaama.com/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "16ce7a355cc0b9f0", URL: "https://www.arrivealive.co.za/RSS/RssWriteFromArriveAlive.aspx", Language: "en", Status: "active", Tier: 2},
+	{ID: "84f63d1dc4b8c640", URL: "https://feeds.feedburner.com/rnlatest", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cd258404a851e6d4", URL: "http://www.swissinfo.ch/service/eng/rssxml/latest-news/rss", Comments: "Switzerland, Europe", Language: "en", Status: "active", Country: "CH", Tier: 2},
+	{ID: "792138857c7f5eb2", URL: "http://www.smh.com.au/rss/national.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "196cecb644630a1d", URL: "https://www.ktvq.com/news/national-news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d43f7f6506ab1553", URL: "https://sowetanlive.co.za/rss/?publication=sowetan-live", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "ff68ad8b0f21f295", URL: "https://www.10news.com/news/local-news/san-diego-news.rss", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "17b63ea1ba3d1e90", URL: "https://www.kpax.com/news/crime-and-courts.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d18e5a34559c6b40", URL: "http://www.france24.com/en/top-stories/rss", Comments: "France", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "b82cc596e382e004", URL: "http://bernews.com/category/bermuda-news/feed", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "6acaed8cd1dc48a3", URL: "https://www.readingeagle.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "1b723ebd8812ba39", URL: "https://onscene.tv/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa16bc5c28ced193", URL: "https://www.katc.com/index.rss", Comments: "KATC", Language: "en", Status: "active", Tier: 2},
+	{ID: "cbf250b0cf26ec72", URL: "https://www.burnleyexpress.net/rss", Comments: "Burnley, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a1868e739de502ec", URL: "https://www.wmdt.com/category/news/feed/", Comments: "WMDT region", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ee61c3419ccc621", URL: "https://africafeeds.com/?feed=rss", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "36fb7ad04a5499c2", URL: "https://www.arlingtoncardinal.com/category/crime-alerts/crimes-notebook/crime-blog-b/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "97139b9938728238", URL: "https://www.fox7austin.com/rss/tags/us", Comments: "US, Texas, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "095455cc61859ee0", URL: "https://www.fox47news.com/neighborhoods/charlotte-eaton-rapids.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "6892a6478ad6abb7", URL: "https://www.FireRescue1.com/firefighting-rss-feeds/news.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "af47bc004d8cb9ed", URL: "https://www.perthnow.com.au/news/wa/feed", Comments: "Perth, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "9d937238863787bd", URL: "https://sierranewsonline.com/feed/", Comments: "Sierra", Language: "en", Status: "active", Tier: 2},
+	{ID: "42eadc7ec5b83517", URL: "https://www.fm104.ie/news/fm104-news/feed.xml", Comments: "Dublin", Language: "en", Status: "active", Tier: 2},
+	{ID: "5415ddcd4ecfaf9c", URL: "https://www.hellenicshippingnews.com/category/shipping-news/piracy-and-security-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "486e77e267ecf672", URL: "https://www.rcmp-grc.gc.ca/en/n/nl", Comments: "Newfoundland and Labrador", Language: "en", Status: "active", Tier: 2},
+	{ID: "345305b028f6e7d5", URL: "https://www.airlive.net/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "783ef26199fde335", URL: "https://indtoday.com/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "204c81e2c3cc4f98", URL: "https://www.thescottishsun.co.uk/news/scottish-news/feed/", Comments: "Scottish", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1f931277435c3511", URL: "https://www.sanpedrosun.com/feed/", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "c2d3afe9f5817e5a", URL: "https://www.nbclosangeles.com/news/california-news/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "45070c89494d10fb", URL: "https://www.dailymail.co.uk/news/sweden/index.rss", Comments: "Sweden", Language: "en", Status: "active", Country: "SE", Tier: 2},
+	{ID: "f2daf21a4deedf2e", URL: "https://www.dailymail.co.uk/news/illinois/index.rss", Comments: "Illinois", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b2e921538d9bca33", URL: "https://starofmysore.com/category/news/feed/", Comments: "Mysore, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "04dbe30ff3719845", URL: "https://www.doncasterfreepress.co.uk/news/rss", Comments: "Doncaster, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b82cc596e382e004", URL: "https://bernews.com/category/bermuda-news/feed", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "83ba1a294d7c858d", URL: "https://kion546.com/category/news/california/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4b8d11607cd416cd", URL: "https://www.kztv10.com/news/local-news.rss", Comments: "Corpus Christi", Language: "en", Status: "active", Tier: 2},
+	{ID: "79c3ba1817a18bac", URL: "https://mustsharenews.com/feed/atom/", Comments: "Singapore", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "e5eb834029a51fda", URL: "https://www.dailymail.co.uk/news/mi5/index.rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "fb2ff2fa1057d36f", URL: "https://cameroonnewsagency.com/feed/", Comments: "Cameroon", Language: "en", Status: "active", Country: "CM", Tier: 2},
+	{ID: "e6edf18df836b086", URL: "https://www.wmar2news.com/news/national.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4a973d280986ce8d", URL: "https://www.krtv.com/news.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "fa7c5a5667ad6ee0", URL: "https://www.radiofree.org/tag/water/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "daec88f48dc7a61d", URL: "https://www.wadenapj.com/latest.rss", Comments: "Wadena, Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "76e45f464615e167", URL: "https://www.kristv.com/news/local-news.rss", Comments: "Corpus Christi", Language: "en", Status: "active", Tier: 2},
+	{ID: "3570bec19b4876c6", URL: "https://www.wwaytv3.com/feed/", Comments: "Wilmington, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b41edabacbd31789", URL: "https://www.arlingtoncardinal.com/category/news-flash/fire-blog/feed/", Comments: "Arlington", Language: "en", Status: "active", Tier: 2},
+	{ID: "c9de05f2e8a511ed", URL: "https://www.nbcbayarea.com/tag/san-jose/feed/", Comments: "San Jose", Language: "en", Status: "active", Tier: 2},
+	{ID: "058b97b4271ca97f", URL: "http://mg.co.za/rss/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "ce2318a999b81327", URL: "https://keyt.com/category/news/santa-barbara-s-county/feed/", Comments: "Santa Barbara County", Language: "en", Status: "active", Tier: 2},
+	{ID: "9adc761412c2e82a", URL: "http://www.voanews.com/api/epiqq", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4b7340330038badc", URL: "https://www.wdiy.org/npr-news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "35eea5032070dd88", URL: "https://www.fingerlakes1.com/category/news/new-york-state/feed/", Comments: "Finger Lakes, New York State", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9b0578b921660849", URL: "https://www.fox6now.com/rss/category/news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b875ad45a858f057", URL: "https://deccanchronicle.com/rss_feed/?subsectionId=11", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ae0963dcfc778d56", URL: "https://usa.greekreporter.com/feed/", Comments: "USA, Greece", Language: "en", Status: "active", Country: "GR", Tier: 2},
+	{ID: "ffd07b821e73379a", URL: "https://thekashmiriyat.co.uk/category/regional/feed/", Comments: "Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b104941fe1221766", URL: "https://mustsharenews.com/feed/", Comments: "Singapore", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "796f875023ccf805", URL: "https://www.nbc10.com/news/local/feed/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "1f60a7761fde332f", URL: "https://durangoherald.com/local-news", Comments: "Durango, Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "034c1e20965c2bf0", URL: "https://www.ft.com/world/asia-pacific?format=rss", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "8cce14b8bd12ccbc", URL: "https://www.nbc4.com/news/national-international/feed/", Comments: "Washington", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fbec548029b27741", URL: "https://www.irishmirror.ie/news/rss.xml", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "f4bcf622e980117c", URL: "http://www.maltatoday.com.mt/rss/", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "2d2444f165104b04", URL: "https://rss.timesofmalta.com/", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "7e4e495b31da76b0", URL: "https://www.latestnigeriannews.com/feed/world/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f2b5f13c07853806", URL: "https://kdhlradio.com/feed/", Comments: "Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "971dd75fb659b31b", URL: "https://dnyuz.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c03ab9a5af101e95", URL: "https://www.fijitimes.com/category/news/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a45000d38d8e714b", URL: "https://www.orcadian.co.uk/feed/", Comments: "Orkney Islands, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9cbfaef62a91872f", URL: "https://www.scottishdailyexpress.co.uk/rss.xml", Comments: "Scottish", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "babbf9bf0c98cbbe", URL: "https://www.thepledge.ng/category/headline/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "f31a47bb32436b4e", URL: "https://www.nbcsandiego.com/news/investigations/nbc-7-responds/feed/", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "757579f85e84bd23", URL: "https://www.abcactionnews.com/news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "23b38e98c2239979", URL: "https://www.cbsnews.com/losangeles/latest/rss/main", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "8df88c7023a5a159", URL: "https://www.kpax.com/news/missoula-county.rss", Comments: "Missoula County, Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6f9846927b5d59f2", URL: "https://www.ktvh.com/news/helena-news.rss", Comments: "Helena, Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f3bd3c9acff4637e", URL: "https://metro.co.uk/news/uk/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "734cf879ffa01197", URL: "https://www.kbzk.com/index.rss", Comments: "Bozeman, Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c95ff22b555d4a5b", URL: "https://thebaynet.com/feed/", Comments: "Maryland", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8459807c161cda8a", URL: "http://feeds.malaysianews.net/rss/48cba686fe041718", Comments: "Malaysian", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "beedbf9b556f54cb", URL: "https://afloat.ie/marine-environment/marine-science?format=feed&type=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "31c9004c1bfe09f0", URL: "https://www.fox4now.com/sitemap.rss", Comments: "SW Florida", Language: "en", Status: "active", Tier: 2},
+	{ID: "694a5e0ec7960bad", URL: "https://www.atlanticcouncil.org/category/blogs/ukrainealert/feed/", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "f1b8e03e8610ad03", URL: "https://www.nbcconnecticut.com/tag/bridgeport/feed/", Comments: "Bridgeport, Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2b2d95d3dcc17669", URL: "https://www.deccanchronicle.com/rss_feed/?sectionId=112", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "0a6bfb1cc97cf5f2", URL: "https://www.nbcnewyork.com/tag/mta/feed/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3c823a838a41225a", URL: "https://earthquake.usgs.gov/earthquakes/feed/v1.0/summary/2.5_day.atom", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "16a6c87a45c271cc", URL: "http://www.chathamdailynews.ca/rss", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "945085e91cb4dfb9", URL: "https://www.9news.com.au/rss", Comments: "Australian", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "b9aed1dca1634bc4", URL: "https://www.tmj4.com/news.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "a867bac9e7a7d47c", URL: "https://www.universalhub.com/rss.xml", Comments: "Boston, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cd0aa09b1d813adb", URL: "https://www.tucsonweekly.com/tucson/Rss.xml?id=Staff", Comments: "Tucson, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "66c68aec2f1217bc", URL: "https://www.radiofree.org/category/sources/the-real-news-network/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9a41dfd5a87ff5f", URL: "http://www.bordermail.com.au/rss.xml", Comments: "border region, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "cec927236ac36800", URL: "https://www.thesun.co.uk/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c6558356d8d2e89a", URL: "https://www.ems1.com/ems-rss-feeds/recent-posts.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2920f872819b9294", URL: "https://www.wwaytv3.com/category/news/feed/", Comments: "Wilmington, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "302bcf8ca825ab1a", URL: "https://www.syracuse.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "Syracuse, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "397351fb1780ab54", URL: "https://www.tag911.ae/news/international-news/feed.xml", Comments: "UAE", Language: "en", Status: "active", Country: "AE", Tier: 2},
+	{ID: "97c1b149a26ca698", URL: "http://dentonrc.com/search/?f=rss&t=article&c=news/denton&l=50&s=start_time&sd=desc", Comments: "Denton, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a82428b9376aa1ac", URL: "https://gambakwe.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "b824474a675f3c5e", URL: "https://www.thecourier.co.uk/category/news/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ce382ee2c19afcf5", URL: "https://www.thepledge.ng/category/news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c883d6f338a91570", URL: "http://alerts.weather.gov/cap/mo.php?x=0", Comments: "Missouri, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a99b4416dd7599fe", URL: "https://www.clare.fm/feed/", Comments: "County Clare, Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "388d821c228a0f0e", URL: "https://wsvn.com/feed/", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "4ea9dc6bb362eed2", URL: "https://www.lakemchenryscanner.com/feed/", Comments: "Lake County, McHenry County, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ded727e3b11ac78d", URL: "https://kvia.com/category/news/texas/feed/", Comments: "Texas, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7e5cf06b20757216", URL: "https://nysun.com/rss/foreign.xml", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "61d368171b926041", URL: "https://www.kshb.com/news.rss", Comments: "Kansas City, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "86605d4c9bd51a82", URL: "https://www.shorenewsnetwork.com/category/local-news/d-c-news/feed/", Comments: "Washington D.C., USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "be8df839a92f4c4d", URL: "https://www.rnz.co.nz/rss/icymi.xml", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "ad1406e5f88bc29c", URL: "https://www.thecanary.co/uk/news/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4ab7d3fb226eb677", URL: "https://www.belfasttelegraph.co.uk/news/world-news/rss/", Comments: "Belfast, international", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d5e528a180c8d5c", URL: "https://www.mainepublic.org/npr-news.rss", Comments: "Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "20ff18f3d32eee55", URL: "http://www.sanpedrosun.com/accidents-and-disasters/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1996f5960f9ed647", URL: "https://asiapacificreport.nz/category/pacific-report/fiji/feed/", Comments: "Fiji, Asia Pacific", Language: "en", Status: "active", Country: "FJ", Tier: 2},
+	{ID: "e6c4b44d09786540", URL: "http://www.jamaica-gleaner.com/feed/news.xml", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "8814bad091a9b9cd", URL: "https://www.gedlingeye.co.uk/feed/", Comments: "Gedling, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2ab93803bd07ce81", URL: "https://www.dailybreeze.com/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7f0d6bd18e815b55", URL: "https://www.dailymail.co.uk/news/georgia/index.rss", Comments: "Georgia, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bf1f0a1ad1c58142", URL: "https://securityaffairs.co/wordpress/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d1bc7f57e4ae663a", URL: "https://www.echopress.com/latest.rss", Comments: "Alexandria, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1cbd7b809d61a2ed", URL: "https://www.sgvtribune.com/news/feed/", Comments: "San Gabriel Valley, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ad345a56a8867bcc", URL: "https://www.wjtv.com/feed/", Comments: "Jackson, MS", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cd139dd30dfb912c", URL: "https://www.mystateline.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "420154b5e04cf7d6", URL: "http://feeds.bbci.co.uk/news/world/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "52acdc9e80c70ac5", URL: "https://feeds.businessinsider.com/custom/all", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f8786136092f48f5", URL: "https://losalamosreporter.com/category/region/feed/", Comments: "Los Alamos, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fa1da1b1c41921e2", URL: "http://feeds.bruneinews.net/rss/1948b3b9eadbd541", Comments: "Brunei", Language: "en", Status: "active", Country: "BN", Tier: 2},
+	{ID: "bcf460942959c29f", URL: "https://kfiam640.iheart.com/topic/local-news.rss/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "cd88f627843e7a46", URL: "https://www.nepaldailylive.com/feed/", Comments: "Nepal", Language: "en", Status: "active", Country: "NP", Tier: 2},
+	{ID: "f51334673a0c16e8", URL: "https://www.dailymail.co.uk/news/cuba/index.rss", Comments: "Cuba", Language: "en", Status: "active", Country: "CU", Tier: 2},
+	{ID: "a4347275ff6b786e", URL: "https://rawstory.com/feeds/enviro-science.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a758c05254fe6bb1", URL: "https://www.koreatimes.co.kr/www/rss/world.xml", Comments: "Korea", Language: "en", Status: "active", Country: "KR", Tier: 2},
+	{ID: "4a3222fab4bcf53f", URL: "http://www.watoday.com.au/rss/national.xml", Comments: "Australia, Western Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "554b5ec64eda1c62", URL: "https://www.nbcsandiego.com/news/local/feed/", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "0e9f57391b9c95fe", URL: "https://www.dailycamera.com/local-news/feed/", Comments: "Colorado, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4bebef35560250c4", URL: "https://www.nbcmiami.com/tag/florida/feed/", Comments: "Miami, Florida, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b4cb063343afd715", URL: "https://www.globalcitizen.org/content/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "523e0825c1928be6", URL: "https://www.krtv.com/news/accident-crash.rss", Comments: "Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fd81bdbe054413d9", URL: "https://www.dailymail.co.uk/news/kentucky/index.rss", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "390b037a56ac2310", URL: "https://www.10news.com/index.rss", Comments: "San Diego, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b98f9aee1c9c42ec", URL: "https://www.bristolpost.co.uk/rss.xml", Comments: "Bristol", Language: "en", Status: "active", Tier: 2},
+	{ID: "b7fe59b104d058a5", URL: "https://euroweeklynews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c567e289252dd401", URL: "https://www.liverpoolecho.co.uk/news/rss.xml", Comments: "Liverpool", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b867f851e592930", URL: "https://www.omanobserver.om/rssFeed/55", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "f74ce31ad9e8727a", URL: "https://www.policeprofessional.com/region/london/feed/", Comments: "UK, London", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "dca46867eedc5583", URL: "https://www.keloland.com/feed/", Comments: "Sioux Falls", Language: "en", Status: "active", Tier: 2},
+	{ID: "4f1c26d7329daa08", URL: "https://www.mercurynews.com/location/california/bay-area/peninsula/feed/", Comments: "US, Bay Area", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ca0e2e72f899d24a", URL: "https://www.khaama.com/category/world/middle-east/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "d93ecb612fb3442a", URL: "https://www.dailymail.co.uk/news/pakistan/index.rss", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "0c4b3078f3385a5a", URL: "https://www.bangkokpost.com/rss/data/most-recent.xml", Comments: "Bangkok", Language: "en", Status: "active", Tier: 2},
+	{ID: "8b8b33dbb8d764d9", URL: "https://www.channelnewsasia.com/api/v1/rss-outbound-feed?_format=xml&category=6936", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "3a2101dd1f3225f2", URL: "https://www.montereyherald.com/news/feed/", Comments: "Monterey", Language: "en", Status: "active", Tier: 2},
+	{ID: "69cdd2ef1d458177", URL: "https://www.nbcboston.com/news/national-international/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fa4aa39deeee46b3", URL: "https://www.leeds-live.co.uk/?service=rss", Comments: "Leeds, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2fda6da25c8b9624", URL: "http://www.wiscnews.com/search/?f=rss&t=article&c=news/state-and-regional&l=50&s=start_time&sd=desc", Comments: "Wisconsin", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bf15a9742bd28092", URL: "https://www.maravipost.com/category/malawi-news/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "7393afa415807d6d", URL: "http://feeds.manilametro.com/rss/3e5bbccc730d258c", Comments: "Manila", Language: "en", Status: "active", Tier: 2},
+	{ID: "cab6f35aaac32781", URL: "https://reformer.com/search/?nsa=eedition&c=local-news&l=50&t=article&f=atom&alt=mrss&fulltext=complete", Comments: "Vermont", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ed7b9634937fc640", URL: "https://www.dailymail.co.uk/news/los-angeles/index.rss", Comments: "Los Angeles, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "abeca9395c7928fe", URL: "https://www.radiofree.org/category/sources/asia-pacific-report/feed/", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "dae44dce2c426a9d", URL: "https://www.iredellfreenews.com/feed/", Comments: "North Carolina", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2b2d95d3dcc17669", URL: "https://deccanchronicle.com/rss_feed/?sectionId=112", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "48916b1210853a5f", URL: "https://kxrb.com/feed/", Comments: "South Dakota, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e51eea4948bfa5a0", URL: "https://www.lex18.com/news/covering-your-world.rss", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "797682b097829b25", URL: "https://www.metro.co.uk/feed/", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8aeabbb88c8503f3", URL: "https://www.kgun9.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f8d554455fc63955", URL: "https://www.channelnewsasia.com/api/v1/rss-outbound-feed?_format=xml", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "e00735f5a25a6b48", URL: "https://www.plymouthherald.co.uk/news/rss.xml", Comments: "Plymouth", Language: "en", Status: "active", Tier: 2},
+	{ID: "f010bc53fb909209", URL: "https://www.crimeonline.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2716115701dc765d", URL: "https://www.ktnv.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bf8230e3564003ce", URL: "https://www.dailymail.co.uk/news/isis/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9b2383db193c41a0", URL: "https://time.news/tag/latest-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "21f60373b4e39839", URL: "https://www.wtxl.com/news/national-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "792138857c7f5eb2", URL: "https://www.smh.com.au/rss/national.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "9d7a8113b1b30622", URL: "https://egyptianstreets.com/feed/", Comments: "Egypt", Language: "en", Status: "active", Country: "EG", Tier: 2},
+	{ID: "74146a43c4422dd6", URL: "http://www.smh.com.au/rss/national/nsw.xml", Comments: "New South Wales, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "3c3c23a29e7ee121", URL: "https://www.dailystar.co.uk/news/world-news/rss.xml", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0be2511034d0be15", URL: "https://www.kalw.org/npr-news.rss", Comments: "San Francisco, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "909d4d5673259c42", URL: "https://www.pacificislandtimes.com/blog-feed.xml", Comments: "Pacific Islands", Language: "en", Status: "active", Tier: 2},
+	{ID: "93c4b870b73406bf", URL: "http://feeds.feedburner.com/urbanmilwaukee", Comments: "Milwaukee", Language: "en", Status: "active", Tier: 2},
+	{ID: "99a530561a74e2ef", URL: "https://www.wnmufm.org/tags/npr-news.rss", Comments: "Michigan, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "94832bb9dc6fef1f", URL: "https://www.africanexaminer.com/feed/atom/", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "5b3bc0df112fa970", URL: "https://o.canada.com/feed", Comments: "o.canada.com, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "34d5c367541e9e9c", URL: "https://www.kpcw.org/rss.xml", Comments: "Park City, Utah", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5260de07e14bc256", URL: "https://www.citizen.co.za/news/south-africa/courts/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "d30acd500a9ec7e0", URL: "https://www.kentlive.news/news/rss.xml", Comments: "Kent, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "256f1ed7f28c675b", URL: "https://thedailyrecord.com/feed/", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "88a6855fce8f068b", URL: "https://www.prisonersabroad.org.uk/handlers/rss.ashx?feed=1", Comments: "abroad", Language: "en", Status: "active", Tier: 2},
+	{ID: "9b0724a312a5bfc7", URL: "https://www.pressenza.com/-/region/asia/feed/", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "5e8d61f793ee1237", URL: "https://www.rokdrop.net/feed/", Comments: "North Korea", Language: "en", Status: "active", Country: "KP", Tier: 2},
+	{ID: "3c24079e849ad01a", URL: "https://www.statter911.com/category/videos/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f808fc5969773e9", URL: "https://www.newindianexpress.com/States/Kerala/rssfeed/?id=178&getXmlFeed=true", Comments: "Kerala, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "89824c563aed2078", URL: "http://rss.newser.com/rss/section/2.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8c98ed27d6d1e0c9", URL: "https://winnipegsun.com/category/news/crime/feed.xml", Comments: "Winnipeg", Language: "en", Status: "active", Tier: 2},
+	{ID: "239d0a25ec482176", URL: "https://fallriverreporter.com/category/latest/feed/", Comments: "Fall River, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "df5b974da0f95ac4", URL: "https://www.nationalobserver.com/front/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5f77b484d725a5d0", URL: "https://www.turnto23.com/news/national.rss", Comments: "Bakersfield, CA, national", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e54fe90c6024c1b2", URL: "http://www.cbsnews.com/latest/rss/main", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ba2d16ac4502475a", URL: "https://www.redbluffdailynews.com/news/feed/", Comments: "Red Bluff, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "910495f005e12a84", URL: "https://www.inewsguyana.com/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "5d261117a8a5cf7a", URL: "https://www.wizmnews.com/feed/", Comments: "La Crosse, WI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d19f12f229c0d420", URL: "https://www.nbcconnecticut.com/investigations/nbc-ct-responds/feed/", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b7153f602775e130", URL: "https://www.ibtimes.sg/rss/feed", Comments: "Singapore, global", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "4b2e2deecc681bf1", URL: "https://www.nbc10.com/news/national-international/changing-climate/feed/", Comments: "Philadelphia, Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "5cc6acfbe9c48ffe", URL: "http://www.watoday.com.au/rss/feed.xml", Comments: "Western Australia", Language: "en", Status: "active", Tier: 2},
+	{ID: "8728c1ff8ede685e", URL: "https://www.themoscowtimes.com/rss/city", Comments: "City", Language: "en", Status: "active", Tier: 2},
+	{ID: "8d61dde5fa58ca27", URL: "https://www.somersetlive.co.uk/news/rss.xml", Comments: "Somerset, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "6c32d41ad7299692", URL: "https://www.theblaze.com/feeds/feed.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0b31fae68bfcb359", URL: "https://www.dailymail.co.uk/news/oregon/index.rss", Comments: "Oregon", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "189a197cfdccf174", URL: "https://www.pressenza.com/tag/ukraine/feed/", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "56234ad25e98b9e9", URL: "http://www.4ni.co.uk/news-rss.aspx", Comments: "Northern Ireland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "32c439db5af9b529", URL: "https://www.examinerlive.co.uk/news/west-yorkshire-news/rss.xml", Comments: "West Yorkshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "bde28db5255726d5", URL: "https://www.govtech.com/news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6e9e809aa4fced5f", URL: "http://www.ri.gov/rss/1/pressrelease.rss", Comments: "Rhode Island", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "332d7da176be3667", URL: "https://www.inverness-courier.co.uk/_api/rss/inverness-news-feed.xml", Comments: "Inverness, Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7b61bae48e1f3b5f", URL: "https://www.dimsumdaily.hk/category/news/feed/", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "4bc6eb0e95c0b999", URL: "https://feeds.feedburner.com/ndtv-latestnews", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "535c8e47bbadf813", URL: "https://lebanon.dailyvoice.com/rss/", Comments: "Lebanon", Language: "en", Status: "active", Country: "LB", Tier: 2},
+	{ID: "71fd2a80ec3207a9", URL: "https://www.wtxl.com/index.rss", Comments: "Tallahassee, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e0e90f2e3ce20bc2", URL: "https://newstalkkzrg.com/feed/", Comments: "Springfield, MO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "76a4dc6a2f64e463", URL: "https://www.buryfreepress.co.uk/_api/rss/bury_news_feed.xml", Comments: "Bury, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "aba0ebf72876de40", URL: "http://www.dailyadvertiser.com.au/rss.xml", Comments: "Wagga Wagga, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "6af53d69de77ac4b", URL: "https://www.ibtimes.com.au/rss", Comments: "Australia, global", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "e21ddf212c38eda5", URL: "https://www.studyfinds.org/category/environment/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "a6d4ccf42b9b0b19", URL: "https://www.lancs.live/rss.xml", Comments: "Lancashire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d7e1b87ba8f57644", URL: "https://bucks.dailyvoice.com/rss/", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7d713609c5c5fe77", URL: "https://www.thesudburystar.com/category/news/feed.xml", Comments: "Sudbury, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "f3c1c7830408f14c", URL: "https://www.belfasttelegraph.co.uk/news/northern-ireland/rss/", Comments: "Northern Ireland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "45da57df0ded37d5", URL: "https://www.galwaybeo.ie/news/galway-news/rss.xml", Comments: "Galway, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "14971d610f845622", URL: "https://www.katc.com/news/national-news.rss", Comments: "Louisiana, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3deb947581f124c3", URL: "https://ethiopianmonitor.com/feed/", Comments: "Ethiopia", Language: "en", Status: "active", Country: "ET", Tier: 2},
+	{ID: "da638512552ef46f", URL: "https://english.newstracklive.com/rss-feed/maharastra.xml", Comments: "Maharashtra, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "bdadcbc021c743b3", URL: "https://www.993thex.com/category/local-news/feed/", Comments: "Wichita Falls, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4e0904dccc39f7cc", URL: "https://www.naijalivetv.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "db8465edb788e3ca", URL: "https://www.postbulletin.com/latest.rss", Comments: "Rochester, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7ae93a20746c3c35", URL: "https://www.dailypost.co.uk/news/local-news/rss.xml", Comments: "North Wales", Language: "en", Status: "active", Tier: 2},
+	{ID: "2aa69c190ef5465b", URL: "https://nypost.com/metro/feed/", Comments: "NYC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cdb6487e7ecb6e74", URL: "https://teaneck.dailyvoice.com/rss/", Comments: "Teaneck, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6bc262b9603a8fd0", URL: "https://www.wyomingpublicmedia.org/npr-news.rss", Comments: "Wyoming", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4817df347f3e7077", URL: "https://www.bellanaija.com/news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "131051f8edd2c014", URL: "http://bvi.org/rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "26225329032f4589", URL: "https://www.kedm.org/npr-news.rss", Comments: "Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6f313df54283cec7", URL: "https://ifpnews.com/feed/", Comments: "Iran", Language: "en", Status: "active", Country: "IR", Tier: 2},
+	{ID: "41b5494fa8c179d8", URL: "http://feeds.news24.com/articles/news24/SouthAfrica/rss", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "f71f5396d025d7ed", URL: "https://www.wuft.org/nation-world/feed", Comments: "Florida, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f88db4159dbf0126", URL: "https://www.sott.net/xml_engine/sott_talk_radio", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f2c4ffbe90838b71", URL: "https://www.kristv.com/news.rss", Comments: "Corpus Christi", Language: "en", Status: "active", Tier: 2},
+	{ID: "711b6f3e70651fea", URL: "https://www.pressherald.com/times-record/feed/", Comments: "Brunswick, ME", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5650225dbe3e8696", URL: "https://today.rtl.lu/rss/news", Comments: "Luxembourg", Language: "en", Status: "active", Country: "LU", Tier: 2},
+	{ID: "5b275a4508e96650", URL: "https://www.wshu.org/news.rss", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ee4a352acc59caf4", URL: "https://clarkstown.dailyvoice.com/rss/", Comments: "Clarkstown, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "82e30b5777a4a316", URL: "https://usa-newnews.com/category/new-news/feed/", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b8129c1305691f2f", URL: "https://edujandon.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b5c0d7a94940f356", URL: "https://www.buryfreepress.co.uk/_api/rss/suffolk_news_feed.xml", Comments: "Suffolk, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f89950b7bb841b4a", URL: "https://www.abc15.com/news/region-southeast-valley.rss", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3a0c8c6dde9a3ab5", URL: "https://www.dailypost.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c30c76d05eeb8971", URL: "http://english.pnn.ps/feed/", Comments: "Palestine", Language: "en", Status: "active", Country: "PS", Tier: 2},
+	{ID: "f442a326db763551", URL: "https://nationwideradiojm.com/category/news/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "0e2576b53cc07e44", URL: "http://www.news18.com/rss/world.xml", Comments: "India, global", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7fc89b9a3bdafb5b", URL: "https://english.khabarhub.com/feed/", Comments: "Nepal", Language: "en", Status: "active", Country: "NP", Tier: 2},
+	{ID: "f2f55b0f74b87a7c", URL: "https://news.azpm.org/f/rss/news-npr/", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ff930647fd6ecbb2", URL: "https://reliefweb.int/updates/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9d8e1c30f15f013d", URL: "https://www.omanobserver.om/rssFeed/3/38", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "420aba00f041b4d6", URL: "https://kelo.com/feed/?attachment_id=203157", Comments: "Sioux Falls, SD", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b8cf6e395fe243cf", URL: "http://tribune242.com/rss/headlines/local-news/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "3d53181cc2b7e5b8", URL: "https://www.aljazeera.com/Services/Rss/?PostingId=2007731105943979989", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "13369f509902ec63", URL: "https://www.jagonews24.com/en/rss/rss.xml", Comments: "Bangladesh", Language: "en", Status: "active", Country: "BD", Tier: 2},
+	{ID: "90f3a9481dcd5417", URL: "https://www.potomaclocal.com/feed/", Comments: "Potomac, MD/VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3f6f1e9104c2d024", URL: "https://www.victoriabuzz.com/category/news/feed/", Comments: "Victoria, BC", Language: "en", Status: "active", Tier: 2},
+	{ID: "44bfa629b1397a83", URL: "http://taiwanenglishnews.com/feed/", Comments: "Taiwan", Language: "en", Status: "active", Country: "TW", Tier: 2},
+	{ID: "e47144f0a95fc72a", URL: "https://www.ktoo.org/feed/", Comments: "Juneau, AK", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f26adc57c397b273", URL: "https://www.atlanticcouncil.org/issue/defense-technologies/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "b8cf6e395fe243cf", URL: "http://www.tribune242.com/rss/headlines/local-news/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "644eb2ec1c6b05f6", URL: "https://hubnetwork.in/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c32058fc87bf0400", URL: "https://ulstersullivan.dailyvoice.com/rss/", Comments: "Ulster, Sullivan County, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "83e98cf1c0899a58", URL: "http://en.mercopress.com/rss/", Comments: "South America, South America", Language: "en", Status: "active", Tier: 2},
+	{ID: "a612cca7665710d8", URL: "http://feeds.centralasiatimes.com/rss/929bcf2071e81801", Comments: "Central Asian", Language: "en", Status: "active", Tier: 2},
+	{ID: "912efd2b84313c16", URL: "https://lovinmalta.com/feed/", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "2454459a75deb57b", URL: "https://www.eastcoastdaily.in/category/news/feed", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "39a0308c53c2c14f", URL: "https://www.getsurrey.co.uk/news/surrey-news/rss.xml", Comments: "Surrey, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c887f6d840aa6f2b", URL: "https://www.navytimes.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "72eeb2361cc9aa45", URL: "https://www.japantoday.com/category/national/feed", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "0bc4d78a3d25db3f", URL: "https://www.thevoiceslu.com/feed/", Comments: "St. Lucia", Language: "en", Status: "active", Country: "LC", Tier: 2},
+	{ID: "d57b62a8ffec91ec", URL: "https://www.atlanticcouncil.org/region/russia/feed/", Comments: "Russia", Language: "en", Status: "active", Country: "RU", Tier: 2},
+	{ID: "2bb478627e04ab62", URL: "http://www.wcpo.com/news.rss", Comments: "Cincinnati, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d0668b2e1bcea987", URL: "https://www.bulatlat.com/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "5468661254199163", URL: "https://pascackvalley.dailyvoice.com/rss/", Comments: "Pascack Valley, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e95870477ca92de3", URL: "https://perilofafrica.com/feed", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "9985412d86e55c55", URL: "https://www.thegardenisland.com/tag/local/feed/", Comments: "Kauai, HI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "221b16507afd1338", URL: "https://www.czechjournal.cz/feed/", Comments: "Czech Republic", Language: "en", Status: "active", Country: "CZ", Tier: 2},
+	{ID: "5006825742a431c9", URL: "https://www.huffingtonpost.co.uk/rss/index.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d6c8450042d7ca1c", URL: "https://naturenews.africa/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "8998802cc8191aad", URL: "https://kimskashmir.com/feed/", Comments: "Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b55713b70d69b699", URL: "https://rss.24-7pressrelease.com/rss/government_security.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2041d9736ee12fcc", URL: "https://www.mtpr.org/tags/npr-news.rss", Comments: "Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "39f6a1403544c95a", URL: "http://alerts.weather.gov/cap/mzus.php?x=0", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "279ce92d6041c1f4", URL: "https://www.nbc26.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a9f342be86af5eef", URL: "https://alerts.weather.gov/cap/in.php?x=0", Comments: "Indiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "803b1f76797c939c", URL: "https://www.latestnigeriannews.com/feed/peoplesdaily/rss.xml", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "20194e2785ce2ae8", URL: "https://wreg.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "799ab69cd1b61fbd", URL: "https://www.cbsnews.com/latest/rss/cbs-news", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3b8bea62528ab5d1", URL: "https://www.sfgate.com/bayarea/feed/Bay-Area-News-429.php", Comments: "San Francisco Bay Area, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "80c4c0d907a0e137", URL: "https://www.atlanticcouncil.org/region/europe-eurasia/feed/", Comments: "Europe, Eurasia", Language: "en", Status: "active", Tier: 2},
+	{ID: "58b39331c5a06a71", URL: "https://www.military.com/rss-feeds/content?keyword=navy&type=news", Language: "en", Status: "active", Tier: 2},
+	{ID: "7e18268118b2b554", URL: "https://www.fox21online.com/feed/", Comments: "Duluth", Language: "en", Status: "active", Tier: 2},
+	{ID: "478a6a6d42856f82", URL: "http://www.wfmz.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "Allentown, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "29bc2e8bff4c21cc", URL: "https://www.nbc5.com/news/local/feed/", Comments: "Burlington", Language: "en", Status: "active", Tier: 2},
+	{ID: "867e82d53a0576e4", URL: "https://www.wtvr.com/news.rss", Comments: "Richmond", Language: "en", Status: "active", Tier: 2},
+	{ID: "a32d7b9841f8415b", URL: "https://timesofindia.indiatimes.com/rssfeedstopstories.cms", Comments: "Indian, global", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ccb3d002639d6aca", URL: "https://news.prairiepublic.org/news.rss", Comments: "North Dakota, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b1a758596a0b55c5", URL: "https://www.fox13now.com/news/local-news.rss", Comments: "Salt Lake City", Language: "en", Status: "active", Tier: 2},
+	{ID: "e8e499301d0302d6", URL: "https://www.latestnigeriannews.com/feed/thisday/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "ab750a2e8b38a2f6", URL: "https://www.scmp.com/rss/2/feed", Comments: "China, Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "f2e08aaba26980a6", URL: "https://thelead.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "4d085920fd57e455", URL: "https://www.radiofree.org/category/sources/vice-news-sources/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "aee64977d9636219", URL: "https://globalnews.ca/category/canada/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "48b1a23d66c53881", URL: "https://mg.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "e1fbe182ad15252e", URL: "https://minutemirror.com.pk/feed/", Comments: "Pakistani", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "8ba96769bbc7b2b8", URL: "https://www.policeprofessional.com/region/scotland/feed/", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ace82ce1f7cda40d", URL: "https://rss.jpost.com/rss/rssfeedsisraelnews.aspx", Comments: "Israel, Jerusalem", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "4860eb0066ad85ca", URL: "https://web.statetimes.in/feed/", Comments: "Jammu Kashmir", Language: "en", Status: "active", Tier: 2},
+	{ID: "085d9773b4932ab8", URL: "http://feeds.southeastasiapost.com/rss/303b19022816233b", Comments: "Southeast Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "26af89cd1e066ab0", URL: "http://www.dvidshub.net/rss/news", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e670d412c343e3b9", URL: "https://www.tori.ng/feed/world/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "27addc83aa8f52e9", URL: "http://feeds.israelherald.com/rss/f81a4d9d561822ee", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "5e3cba19c13ca43b", URL: "https://www.suchtv.pk/pakistan/general.html?format=feed&type=rss", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "07c5b32ee985d60b", URL: "http://feeds.iraqsun.com/rss/c31d0aaa23b24a75", Comments: "Iraq", Language: "en", Status: "active", Country: "IQ", Tier: 2},
+	{ID: "9e51213fcc8dea76", URL: "https://www.7newsbelize.com/rss.php", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "d8a62c4cced5e058", URL: "https://timesofoman.com/feed", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "72e606c1f39e6a8b", URL: "https://www.dailymail.co.uk/news/thailand/index.rss", Comments: "Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "581de3be08c10346", URL: "https://www.kzyx.org/npr-news.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "6fcc2e8393e58bfe", URL: "https://bedford.dailyvoice.com/rss/", Comments: "Bedford, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5bdec0d5833092ef", URL: "https://www.africa-press.net/gambia/feed", Comments: "Gambia", Language: "en", Status: "active", Country: "GM", Tier: 2},
+	{ID: "a5247dbe9544f9f7", URL: "https://www.mylondon.news/news/east-london-news/rss.xml", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "de6e1f5cc98037b5", URL: "https://www.severe-weather.eu/feed/", Comments: "EU", Language: "en", Status: "active", Tier: 2},
+	{ID: "603c41c7cd8c2cd5", URL: "https://wtmj.com/category/national/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "03ff09dd59a43197", URL: "https://newsday.co.tt/reporter/newsday_reporter/feed/", Comments: "Trinidad and Tobago", Language: "en", Status: "active", Country: "TT", Tier: 2},
+	{ID: "4f196a2c06f4d944", URL: "https://www.arnnewscentre.ae/news/international/feed.xml", Comments: "ARN News Centre, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a2f5c88405edb840", URL: "http://zeenews.india.com/rss/india-news.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "2eb5f8746704874f", URL: "https://news.azpm.org/f/rss/news-topical-border/", Language: "en", Status: "active", Tier: 2},
+	{ID: "30df12cd368b1aba", URL: "https://www.kcbx.org/npr-top-news.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "a4c74878424dd098", URL: "https://www.thehindu.com/news/feeder/default.rss", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7cf108f3a552dba4", URL: "https://feeds.breakingnews.ie/bntopstories", Language: "en", Status: "active", Tier: 2},
+	{ID: "b0037ccf2e21d8a3", URL: "https://www.dailymail.co.uk/news/new-mexico/index.rss", Comments: "New Mexico", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "75ad2f4b459c7ec4", URL: "http://wglr.com/category/news/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5ac7bc9bf12e2ed4", URL: "https://lagatar24.com/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "f75589a7b247aa35", URL: "https://www.inquisitr.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "562e0004012e42c0", URL: "https://www.arlingtoncardinal.com/category/news-flash/fd-notebook/fireblog-c/feed/", Comments: "Arlington", Language: "en", Status: "active", Tier: 2},
+	{ID: "891b92cb1737de56", URL: "https://ramapo.dailyvoice.com/rss/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3447c0c7a129322e", URL: "https://www.devonlive.com/news/devon-news/rss.xml", Comments: "Devon, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f7ed3be208f76410", URL: "https://wtop.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e1e84e2cd93ee1ef", URL: "https://www.news5cleveland.com/news/local-news.rss", Comments: "Cleveland, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2d5528e19c7cd2fa", URL: "https://www.nbc10.com/news/national-international/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8b34db0ebf9738d4", URL: "http://abc7chicago.com/feed/", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "316275f066c8a9c3", URL: "https://www.gulf-times.com/rssFeed/9", Comments: "Qatar", Language: "en", Status: "active", Country: "QA", Tier: 2},
+	{ID: "52b5c77acfc8bcf9", URL: "https://lovinmalta.com/news/feed/", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "bbeaa9e1a266f323", URL: "http://japantoday.com/feed", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "cadba9780edf5aa1", URL: "https://www.citizen.co.za/feed", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "94bffe304500f1e5", URL: "https://www.africanews.com/feed/rss?themes=news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6ceaa3ef15e55272", URL: "https://www.dailylocal.com/news/national-news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "874f9a9b8d14459c", URL: "https://www.iowapublicradio.org/news-from-npr.rss", Comments: "Iowa", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "28c7971c7947deac", URL: "https://www.thetrentonline.com/category/news/topnews/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "c75d63cdc5ab0cb8", URL: "http://abc7ny.com/feed/", Comments: "New York, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9e82ffb4116a291f", URL: "https://northrockland.dailyvoice.com/rss/", Comments: "North Rockland, New York, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1a03feca78d0897c", URL: "https://feeds.feedburner.com/ndtvnews-latest", Language: "en", Status: "active", Tier: 2},
+	{ID: "5e019258ba1067f7", URL: "https://www.klfy.com/feed/", Comments: "Lafayette", Language: "en", Status: "active", Tier: 2},
+	{ID: "12c458dbaf79ca6b", URL: "https://www.shorenewsnetwork.com/category/local-news-nj/new-jersey/north-jersey/feed/", Comments: "North Jersey", Language: "en", Status: "active", Tier: 2},
+	{ID: "85743c1b6f8c4658", URL: "http://www.unionleader.com/search/?q=&t=article&f=rss&bl=703197", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "efce3ee68f28cb19", URL: "https://www.castanetkamloops.net/rss/page-2.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c2469d136049f6fb", URL: "https://energeticcity.ca/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d9aa8ac81687b0a", URL: "https://www.goodnewsnetwork.org/category/news/earth/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4a84481122bc794b", URL: "https://www.fox47news.com/news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f7f2d23e61fd62bb", URL: "https://www.mitchellrepublic.com/news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e062f06570ad91f0", URL: "https://kajiado.co.ke/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "101db43bf4802ae1", URL: "https://odishatv.in/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1187ec66c9cce1e6", URL: "http://www.reformer.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "40cd880b16b6464c", URL: "https://www.srnnews.com/feed/?cat=4", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5e7245009c873a11", URL: "https://www.chroniclelive.co.uk/rss.xml", Comments: "Newcastle", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0f87c2f457f21b4", URL: "http://www.belfastdaily.co.uk/feed/", Comments: "Belfast", Language: "en", Status: "active", Tier: 2},
+	{ID: "a72e5e82c4e6495f", URL: "https://www.potomaclocal.com/news/feed/", Comments: "Potomac", Language: "en", Status: "active", Tier: 2},
+	{ID: "05931c3c9494cbdf", URL: "http://www.gmtoday.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "73158e29255ce044", URL: "https://theguildng.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "3893f8f2bf01b3bb", URL: "https://www.newstalkzb.co.nz/news/national/rssfeed", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "14aa4d7d9893b125", URL: "https://igberetvnews.com/feed/atom", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "cc68d6c6af9e5ac0", URL: "https://www.latimes.com/world-nation.rss", Comments: "World, Nation", Language: "en", Status: "active", Tier: 2},
+	{ID: "29bfefb051979ed5", URL: "https://vietnaminsider.vn/feed/", Comments: "Vietnam", Language: "en", Status: "active", Country: "VN", Tier: 2},
+	{ID: "3fe43169c433c370", URL: "https://nationaldailyng.com/category/news1613067580/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "25eda53892180b68", URL: "http://www.wnyc.org/atomfeeds/tags/news", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "313d5653c84f69a5", URL: "https://www.kjrh.com/sitemap.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fcc694ef5d4956dc", URL: "https://news.thevoicebw.com/feed/", Comments: "Botswana", Language: "en", Status: "active", Country: "BW", Tier: 2},
+	{ID: "0f49643aa3b6eebd", URL: "https://www.kxnet.com/feed/", Comments: "Bismarck, Minot, Dickinson", Language: "en", Status: "active", Tier: 2},
+	{ID: "f2a219576fc839e2", URL: "https://www.cityoftulsa.org/rss/", Comments: "Tulsa", Language: "en", Status: "active", Tier: 2},
+	{ID: "77366839815c40b7", URL: "https://www.telugu360.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "44e1973c863daf36", URL: "https://www.newzimbabwe.com/category/news/news-headlines/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "f42becaf94d010b9", URL: "https://www.mylondon.news/news/uk-world-news/rss.xml", Comments: "UK, London, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b51ce237741c98ce", URL: "https://www.ksat.com/arc/outboundfeeds/rss/category/news/?outputType=xml&size=10", Comments: "San Antonio", Language: "en", Status: "active", Tier: 2},
+	{ID: "492426fede63322c", URL: "http://www.somersetlive.co.uk/rss.xml", Comments: "Somerset, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "318679ad2118e2ce", URL: "https://allafrica.com/tools/headlines/rdf/latest/headlines.rdf", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "e9114e45710954ed", URL: "http://www.prurgent.com/news/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "75462572b5e9d61e", URL: "https://www.bolnews.com/viral/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "85ed298224579461", URL: "https://www.pattayamail.com/feed", Comments: "Pattaya, Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "85ac9287c29c0abd", URL: "https://dailypost.ng/metro/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "f56a0b35202a8ddb", URL: "https://www.mylondon.news/news/north-london-news/rss.xml", Comments: "North London", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5cedeaf267503b9", URL: "https://www.newzimbabwe.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "d838983b0e40ce7e", URL: "https://newsbook.com.mt/en/feed/", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "07fda1cacd838384", URL: "https://www.thecitizen.co.tz/service/rss/tanzania/2486622/feed.rss", Comments: "Tanzania", Language: "en", Status: "active", Country: "TZ", Tier: 2},
+	{ID: "6a278e0e066a32e6", URL: "https://www.echolive.ie/feed/404-EE_Cork_Lives.xml", Comments: "Cork, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "1fb57f63ebb016cb", URL: "https://scvnews.com/feed/", Comments: "Santa Clarita Valley, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "032610d66f4c2709", URL: "https://losalamosreporter.com/feed/", Comments: "Los Alamos, NM", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "291c1826fb139f4b", URL: "https://www.newsgater.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "498ce5358918bd5b", URL: "https://www.thehindu.com/news/national/telangana/feeder/default.rss", Comments: "Telangana, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ab27139574e31009", URL: "http://feeds.torontotelegraph.com/rss/03cb86c5037f9622", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8d6a03ea1e9981c4", URL: "https://www.10news.com/news.rss", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "44c9f34839d977b0", URL: "https://www.96fm.ie/news/96fm-news-and-sport/feed.xml", Comments: "Cork, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "9fa2ecbc06eafb52", URL: "https://tuoitrenews.vn/rss/rss/daily", Comments: "Vietnam", Language: "en", Status: "active", Country: "VN", Tier: 2},
+	{ID: "520410754c6ef72f", URL: "https://www.dailymail.co.uk/news/massachusetts/index.rss", Comments: "Massachusetts, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "eb6911c8bc855474", URL: "http://www.samoanews.com/rss.xml", Comments: "Samoa", Language: "en", Status: "active", Country: "WS", Tier: 2},
+	{ID: "6af25299065499b9", URL: "https://www.economist.com/international/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "84c3c31498565192", URL: "https://scandasia.com/feed/", Comments: "Scandinavia", Language: "en", Status: "active", Tier: 2},
+	{ID: "6549a4d92ad45537", URL: "https://www.cfnrfm.ca/category/news/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "dc8a052ca8256157", URL: "https://www.sltrib.com/arc/outboundfeeds/nation-world/?outputType=xml", Comments: "Salt Lake", Language: "en", Status: "active", Tier: 2},
+	{ID: "b987e7338bc3d37d", URL: "https://www.coastlive.com.au/news/local-news/feed.xml", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "cb2d7c2898ed8384", URL: "https://rss.24-7pressrelease.com/rss/ae_general.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "eacc70453ba7227c", URL: "https://www.moreradio.online/news/sussex-news/feed.xml", Comments: "Sussex, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "dcce0e88b51e8f50", URL: "https://www.thisdaylive.com/index.php/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "4a7eac9c1afa99ef", URL: "https://www.srnnews.com/feed/?cat=3", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c385d62b5a93207", URL: "https://www.thesun.ie/news/feed/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "eba3d37dc35d6005", URL: "https://yellowhammernews.com/category/news/feed/", Comments: "Alabama", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "375891124cc4c1b9", URL: "https://www.dailymail.co.uk/news/louisiana/index.rss", Comments: "Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2700970a0a049f2f", URL: "https://www.asdnews.com/rss-feed/?channelId=5", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9c22d0e6225dc61", URL: "https://medafricatimes.com/feed", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "cf535f47725fc52c", URL: "https://rawstory.com/feeds/us-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0bec9335b2fadf53", URL: "https://www.inquirer.net/feed", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "6549a4d92ad45537", URL: "http://www.cfnrfm.ca/category/news/feed", Comments: "Canadian", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "422e6b989456e65b", URL: "https://mynorthwest.com/local/?feed=rss-page", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ef22f1799faa222", URL: "https://www.independent.co.ug/feed/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "ac9cf46685e09ad2", URL: "https://www.novinite.com/services/news_rdf.php", Comments: "Bulgaria", Language: "en", Status: "active", Country: "BG", Tier: 2},
+	{ID: "2b01d98f39b77455", URL: "https://www.kelownanow.com/api/rss/89", Comments: "Kelowna, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "f3bd3c9acff4637e", URL: "https://www.metro.co.uk/news/uk/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "67c699959b0d1a80", URL: "https://nysun.com/rss/justice.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "303b29e1b4cf056e", URL: "https://thediplomat.com/category/asia-defense/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bfe6a5119adb5d84", URL: "https://www.dailymail.co.uk/news/new_zealand/index.rss", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "83a0f24ccc54d78c", URL: "https://www.nbc4.com/news/local/northern-virginia/feed/", Comments: "Northern Virginia, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a542c863a728bbdd", URL: "http://www.unionleader.com/search/?f=rss&t=article&c=weather&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "67688c581516ab98", URL: "https://wsvn.com/news/local/feed/", Comments: "WSVN 7News", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce6153e6123c531e", URL: "https://www.independent.co.uk/news/world/americas/rss", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "578478f8110f6207", URL: "https://www.omanobserver.om/rssFeed/55/40", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "a030a61188f2d738", URL: "https://www.clickorlando.com/arc/outboundfeeds/rss/category/news/?outputType=xml&size=10", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8f70de32ef3c99ce", URL: "https://www.eastbaytimes.com/latest-headlines/feed/", Comments: "East Bay, California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "762f158540a5f05a", URL: "https://www.eastcoastdaily.in/category/news/india/delhi/feed", Comments: "Delhi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "4205537a3ee64d09", URL: "https://www.coventrytelegraph.net/news/uk-world-news/rss.xml", Comments: "UK, World", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "99391947ff5162b7", URL: "https://www.thecanary.co/topics/justice/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "824c890c9c734176", URL: "https://www.click2houston.com/arc/outboundfeeds/rss/category/news/national/?outputType=xml&size=10", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fa586df58060de9d", URL: "https://thisweekinworcester.com/feed/", Comments: "Worcester, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f77807b573d303ca", URL: "https://calgarysun.com/rss", Comments: "Calgary, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "e2ff6b2d8fb90529", URL: "https://www.local10.com/arc/outboundfeeds/rss/category/news/national/?outputType=xml&size=10", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dad2127b99235738", URL: "http://www.iamexpat.nl/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "16784e8082c32ecf", URL: "https://en.baaghitv.com/feed/", Comments: "Pakistani", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "1e17a15c759902f3", URL: "https://www.coventrytelegraph.net/in-your-area/rss.xml", Comments: "Coventry, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4e3349957bb89807", URL: "https://www.dimsumdaily.hk/category/news/world/feed/", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "94b7eb98d1913849", URL: "https://kbnd.com/kbnd-news/local-news-feed/feed.xml", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "7db55c488f1c33de", URL: "https://www.thehindu.com/news/cities/Delhi/feeder/default.rss", Comments: "Delhi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "dcc6fa6e00637f31", URL: "https://www.mangalorean.com/feed/", Comments: "Mangalore, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ddad26d05935d199", URL: "https://www.japantoday.com/category/national/feed/atom", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "b8fef2bd53f0f292", URL: "https://www.eastbaytimes.com/news/feed/", Comments: "East Bay, California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1f6be5df72c35b88", URL: "https://timesofindia.indiatimes.com/rssfeeds/442002.cms", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "75d3e5ba93fdeed5", URL: "http://newsregister.com/index.php?rss=Article", Comments: "Ohio, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ae7d707fa2f00fd5", URL: "https://asiapacificreport.nz/category/pacific-report/papua-new-guinea/feed/", Comments: "Pacific region, Papua New Guinea", Language: "en", Status: "active", Country: "PG", Tier: 2},
+	{ID: "e2b0b7d4d3ecc9b1", URL: "https://moxie.foxnews.com/feedburner/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "066caf11653a99b2", URL: "https://www.wmdt.com/feed/", Comments: "Salisbury, Ocean City", Language: "en", Status: "active", Tier: 2},
+	{ID: "9fce9587b8784310", URL: "https://www.capecod.com/category/cape-wide-news/feed/", Comments: "Cape Cod, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c0ae7a8851950c5d", URL: "http://www.thisisexeter.co.uk/rss.xml", Comments: "Exeter, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "51978fe39ab5259c", URL: "https://www.irishtimes.com/rss/the-irish-times-news-1.1319192", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "e5d362f3e99294d6", URL: "https://www.midlands103.com/news/midlands-news/feed.xml", Comments: "Midlands region, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "4e4088e7a725586d", URL: "https://townlift.com/category/police/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d6f77750e1a9f0f", URL: "https://www.castanet.net/rss/page-48.xml", Comments: "Kelowna", Language: "en", Status: "active", Tier: 2},
+	{ID: "a75a16efc426e815", URL: "https://abc7news.com/feed/", Comments: "San Francisco Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "778126d6f5d1c3d5", URL: "https://egyptianstreets.com/feed/rss/", Comments: "Egypt", Language: "en", Status: "active", Country: "EG", Tier: 2},
+	{ID: "19d579d49ca5838c", URL: "http://www.dvidshub.net/rss/video", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "57b6b61bf805728a", URL: "https://www.castanet.net/rss/mostrecent.xml", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "b9bb02028573dc93", URL: "https://en.ammonnews.net/rss.php?type=news&id=4", Comments: "Jordan, Middle East", Language: "en", Status: "active", Country: "JO", Tier: 2},
+	{ID: "c883d6f338a91570", URL: "https://alerts.weather.gov/cap/mo.php?x=0", Comments: "Missouri, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d2216cc2f090f1f9", URL: "https://www.radiofree.org/tag/war/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "86adcb8e30ed6e8b", URL: "https://www.irishmirror.ie/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1487962d952433a0", URL: "https://news.google.com/rss/search?q=%22kidnapping%22+OR+%22captors%22+OR+%22abducts%22+OR+%22kidnaps%22+OR+%22abduct%22+OR+%22kidnappers%22+OR+%22kidnapped%22+OR+%22captivity%22+OR+%22abductors%22+OR+%22abductions%22+OR+%22kidnappings%22+OR+%22abducted%22&hl=en-US&gl=US&ceid=US:en", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "61188e63d15806eb", URL: "https://timesofsandiego.com/category/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "171d27f9f1b026bb", URL: "https://www.dailymail.co.uk/news/india/index.rss", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ec5b45239e69f064", URL: "https://abc13.com/feed/", Comments: "Houston", Language: "en", Status: "active", Tier: 2},
+	{ID: "2412ba23ba446b5a", URL: "https://www.kedm.org/npr-national-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "766ecb327b8eba45", URL: "http://www.hurriyetdailynews.com/rss.aspx", Comments: "Turkey", Language: "en", Status: "active", Country: "TR", Tier: 2},
+	{ID: "a740bd6abd77a76c", URL: "https://www.smh.com.au/rss/world.xml", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "47b1fd6585a2e89d", URL: "https://www.newburytoday.co.uk/_api/rss/newbury_news_feed.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b3de4b70aa9cdb6c", URL: "https://allafrica.com/tools/headlines/rdf/centralafrica/headlines.rdf", Comments: "Central Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "7f57c05421b8d3f2", URL: "https://news.google.com/rss/search?q=%22earthquake%22+OR+%22tremor%22+OR+%22magnitude%22+OR+%22seism%22+OR+%22quake%22&hl=en-US&gl=US&ceid=US:en", Language: "en", Status: "active", Tier: 2},
+	{ID: "ae1193b1ed178704", URL: "https://www.drivebc.ca/api/events/region/thomok?format=rss", Comments: "Thompson Okanagan region", Language: "en", Status: "active", Tier: 2},
+	{ID: "4467ba0ec51a02ef", URL: "https://www.loscerritosnews.net/category/crime-summay/feed/", Comments: "Los Cerritos", Language: "en", Status: "active", Tier: 2},
+	{ID: "b1c75c6777c97902", URL: "https://www.dailydemocrat.com/news/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a3cd31f9520a9336", URL: "https://home.nzcity.co.nz/rss/news.aspx?catid=985", Comments: "NZCity", Language: "en", Status: "active", Tier: 2},
+	{ID: "f3fb8266aa4e0a0c", URL: "https://www.thevindicator.com/rss.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0dc5a2c5bde4815b", URL: "https://wamu.org/feed/", Comments: "Washington DC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8fa86d6d29679b64", URL: "https://bahamaspress.com/feed/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "350d0127a17deac3", URL: "http://www.sanpedrosun.com/police-news/feed/", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "39d490d3a9d4a42d", URL: "https://baltimore.dailyvoice.com/rss/", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "f54b105b25c50320", URL: "https://www.ansa.it/english/english_rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "aafe017dd80b5d85", URL: "https://www.wrtv.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "16ce0ef0d0b84962", URL: "https://www.ozarkradionews.com/feed", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7fc89b9a3bdafb5b", URL: "https://english.khabarhub.com/feed", Comments: "Nepal", Language: "en", Status: "active", Country: "NP", Tier: 2},
+	{ID: "5ef302fe99e1d396", URL: "https://www.usgs.gov/news/all/feed", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1b51b8bb996e3209", URL: "https://justthenews.com/rss.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "487b74fafa24e21f", URL: "https://www.military.com/rss-feeds/content?keyword=air-force&type=news", Language: "en", Status: "active", Tier: 2},
+	{ID: "13db04764024ee4b", URL: "https://www.nbc26.com/sitemap.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "800c2fe581b6f924", URL: "https://www.sundayworld.com/news/rss/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "78eed48ebed6b015", URL: "https://www.alabamanews.net/feed/", Comments: "Alabama, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f0a96ba2c608170c", URL: "https://700wlw.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b421ac4f4a4309df", URL: "https://inewsource.org/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5ac3f97b3fa36cf9", URL: "https://www.dailymail.co.uk/news/indiana/index.rss", Comments: "Indiana, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ca4f15880fd40a05", URL: "https://www.stokesentinel.co.uk/news/uk-world-news/rss.xml", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1fcabf76e61715a2", URL: "http://www.ckom.com/feed", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "ef9d2dd359cb75c3", URL: "http://gazette.com/search/?f=rss&t=article&c=news/fire-environment&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "36d3f159f0e8a7c2", URL: "https://observatorial.com/category/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "31974c1c0bbf40b5", URL: "https://www.palestinechronicle.com/feed/", Comments: "Palestine", Language: "en", Status: "active", Country: "PS", Tier: 2},
+	{ID: "0eb30b5c0d5ec0c6", URL: "https://www.kpax.com/news.rss", Comments: "Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "80a495a64e7c5641", URL: "https://caymannewsservice.com/feed/", Comments: "Cayman Islands", Language: "en", Status: "active", Country: "KY", Tier: 2},
+	{ID: "0a4113a65a154352", URL: "https://baltimore.cbslocal.com/category/wjz-at-9/feed/", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "e73d61a9d1d0af61", URL: "https://edmontonsun.com/feed", Comments: "Edmonton", Language: "en", Status: "active", Tier: 2},
+	{ID: "15e3091c2a12a230", URL: "https://juneauempire.com/feed/", Comments: "Alaska", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "073fb8b80cb49305", URL: "https://news.google.com/rss?hl=en-US&gl=US&ceid=US%3Aen&oc=11", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a0f83fec4ad23bb3", URL: "https://feeds.feedburner.com/antiwarcom-original", Language: "en", Status: "active", Tier: 2},
+	{ID: "2848550b7fcce6ad", URL: "https://www.burnham-on-sea.com/category/news/feed/", Comments: "Burnham-On-Sea", Language: "en", Status: "active", Tier: 2},
+	{ID: "3c6985310d864fcd", URL: "https://www.mid-day.com/Resources/midday/rss/mumbai-crime-news.xml", Comments: "Mumbai", Language: "en", Status: "active", Tier: 2},
+	{ID: "bc6d2368929497d0", URL: "https://www.abccolumbia.com/feed/", Comments: "Columbia, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e0dfe09229e330a4", URL: "https://www.namibiansun.com/rssFeed/116", Comments: "Namibia", Language: "en", Status: "active", Country: "NA", Tier: 2},
+	{ID: "45b637fc41f39b96", URL: "https://www.wdef.com/category/local-news/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a5f30aa3cc3aa2fc", URL: "https://www.greeleytribune.com/news/feed/", Comments: "Greeley", Language: "en", Status: "active", Tier: 2},
+	{ID: "a9fae98f7a20e4c5", URL: "https://english.alaraby.co.uk/rss.xml?current=/analysisxyrabarberzpx%7B%7B%3A6%2A6%7D%7Dpx/ukraine-anti-imperialism-and-global-solidarity", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d3d59545869c733", URL: "https://en.shafaqna.com/category/africa/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "e8794e2fa337140a", URL: "https://www.examinerlive.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0586b64c5056a0b4", URL: "https://rss.nytimes.com/services/xml/rss/nyt/HomePage.xml", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "50afbaff66d4cde1", URL: "https://slate.com/feeds/all.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e107dd42e422ac56", URL: "https://www.newindianexpress.com/Cities/Vijayawada/rssfeed/?id=362&getXmlFeed=true", Comments: "Vijayawada, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "2ac21742d2b65b0c", URL: "https://atlantic.dailyvoice.com/rss/", Comments: "Atlantic", Language: "en", Status: "active", Tier: 2},
+	{ID: "398eb8e13af74683", URL: "https://www.radiofree.org/category/sources/human-rights-watch/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "44e26bef5887d6bf", URL: "https://opengov.sos.ri.gov/RSSFeed/Rss?feedType=1&entityID=0&filter1=&filter2=&Keywords=&FromDate=&ToDate=&RefineSearch=1&PublicBodies=", Language: "en", Status: "active", Tier: 2},
+	{ID: "1ccfd7b2e40c1f85", URL: "http://www.brantfordexpositor.ca/rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ab0f53b89b4bb31c", URL: "http://www.680news.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "e94fc8a58cee6586", URL: "https://www.wptv.com/sitemap.rss", Comments: "West Palm Beach, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "197a3d342dbea379", URL: "http://www.swissinfo.ch/service/eng/rssxml/top-news/rss", Comments: "Switzerland, Europe", Language: "en", Status: "active", Country: "CH", Tier: 2},
+	{ID: "3921374745114a5f", URL: "https://www.nigerianeye.com/feeds/posts/default?alt=rss", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "54b634bcef235b91", URL: "https://www.edinburghnews.scotsman.com/rss", Comments: "Edinburgh, Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "36400967e09f515f", URL: "https://nyc.streetsblog.org/feed/", Comments: "NYC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ead548320ca5e179", URL: "https://www.wral.com/news/rss/48/", Comments: "Raleigh, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4598ead8777f2130", URL: "https://www.galwaybeo.ie/rss.xml", Comments: "Galway, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "a7eedf8f195f8440", URL: "https://news.google.com/rss/search?q=%22volcanic+eruption%22+OR+%22ash+cloud%22+OR+%22volcano%22+OR+%22ash%22+OR+%22magma%22+OR+%22lava%22&hl=en-US&gl=US&ceid=US:en", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c68c52504b35049", URL: "https://cmp.breakingnews.ie/feed/ireland.rss", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "d918a2cd805e49ad", URL: "http://auburnpub.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Auburn, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "45ceca1e1e902693", URL: "http://www.berkshireeagle.com/search/?f=rss&t=article&c=news/community-news&l=50&s=start_time&sd=desc", Comments: "Berkshire", Language: "en", Status: "active", Tier: 2},
+	{ID: "14aa4d7d9893b125", URL: "http://igberetvnews.com/feed/atom", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "6ee3f6cebfbf6b0e", URL: "https://www.fox13now.com/news/fox-13-investigates.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "6eb480d5156930a4", URL: "https://www.arnnewscentre.ae/news/uae/feed.xml", Comments: "UAE", Language: "en", Status: "active", Country: "AE", Tier: 2},
+	{ID: "f3c661451480a4df", URL: "https://www.wptv.com/news/treasure-coast.rss", Comments: "Treasure Coast, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f4818d8d48e86e99", URL: "https://www.hulldailymail.co.uk/news/uk-world-news/rss.xml", Comments: "UK, world", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2815aac2497b4844", URL: "https://www.dailymail.co.uk/news/kansas/index.rss", Comments: "Kansas, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "10de23775fc2bcc4", URL: "https://www.ksby.com/news/world-news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c3d5a8149b09a9b", URL: "https://www.maritime-executive.com/articles.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f7d6378d37b13e83", URL: "https://afloat.ie/port-news/galway-harbour?format=feed&type=atom", Comments: "Galway, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "1d29b46874d603b8", URL: "https://www.nottinghampost.com/rss.xml", Comments: "Nottingham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b5b80f6a659a8be4", URL: "http://stomp.straitstimes.com/rss.xml", Comments: "Singapore", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "b0233ffa77671363", URL: "https://www.gzeromedia.com/feeds/feed.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f9356d43aed8e557", URL: "https://www.wtvr.com/news/international-news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6e9be45107e85adc", URL: "https://khybernews.tv/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "236585d1e8009fac", URL: "https://edmontonjournal.com/category/news/local-news/feed.xml", Comments: "Edmonton, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "25a624620fdafb8b", URL: "http://feeds.feedburner.com/ummid.com", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "3137977e1c975eb9", URL: "https://bangordailynews.com/feed/", Comments: "Bangor, Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0ca2171e7f50af1b", URL: "https://www.gazettelive.co.uk/rss.xml", Comments: "Teesside, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4d2133addf47e647", URL: "https://hampden.dailyvoice.com/rss/", Comments: "Hampden County, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "536faf4b20d9434e", URL: "https://www.grandforksherald.com/news.rss", Comments: "Grand Forks, ND", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2a6dd8bdac526b76", URL: "https://www.gloucestershirelive.co.uk/rss.xml", Comments: "Gloucestershire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "5967f07e26c2e21c", URL: "https://www.knau.org/npr-news.rss", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cf91fd2f64b2f412", URL: "https://www.studyfinds.org/category/earth/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cd5d12ebb30d8994", URL: "http://www.canterburytimes.co.uk/rss.xml", Comments: "Canterbury, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8c1a678a9105045c", URL: "https://radiofree.org/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "93ad27fd2e588a92", URL: "https://www.gazettelive.co.uk/?service=rss", Comments: "Teesside, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "82ec13d6ffd140f9", URL: "https://www.kenyans.co.ke/feeds/news?_wrapper_format=html", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "f900f6eba082676f", URL: "https://www.houstonpublicmedia.org/rsslatest.xml", Comments: "Houston", Language: "en", Status: "active", Tier: 2},
+	{ID: "9b01f27fa87349d9", URL: "https://www.radiofree.org/category/sources/un-news-sources/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "34d8057482e83055", URL: "https://rye.dailyvoice.com/rss/", Comments: "Rye, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7fed9e7dad2e5000", URL: "https://www.sherwoodparknews.com/feed", Comments: "Sherwood Park, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "1bb70feaae64d783", URL: "http://www.brisbanetimes.com.au/rss/national.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "d4b77294b31ab9b3", URL: "https://www.southpunjabnews.com/feeds/posts/default?alt=rss", Comments: "South Punjab, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "1dd802ecc19bc183", URL: "https://www.thezimbabwemail.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "7d74fd471b7a8d11", URL: "https://www.thesun.co.uk/news/us-news/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d0c0ac0bc08cfe89", URL: "https://www.atlanticcouncil.org/issue/national-security/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "76220f3b94b3ea89", URL: "https://www.latestnigeriannews.com/feed/blueprint/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "500f256d4cba0d01", URL: "https://rss.dw.com/rdf/rss-en-all", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4475c2617c6061cf", URL: "https://www.centralmaine.com/kennebec-journal/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ef2d362afa76459f", URL: "https://patersontimes.com/feed/", Comments: "Paterson", Language: "en", Status: "active", Tier: 2},
+	{ID: "d391e27ebe7dbf1e", URL: "https://townlift.com/category/town-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "7d5c81998e396599", URL: "https://www.lancs.live/news/uk-world-news/rss.xml", Comments: "UK, world", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "de132dc66b75dd0f", URL: "https://vietnaminsider.vn/feed/atom/", Comments: "Vietnam", Language: "en", Status: "active", Country: "VN", Tier: 2},
+	{ID: "f5a28bab149bdee6", URL: "https://www.pressandjournal.co.uk/category/news/aberdeen-aberdeenshire/feed/", Comments: "Aberdeen", Language: "en", Status: "active", Tier: 2},
+	{ID: "5b0bc685dac3a94a", URL: "http://www.naturalnews.com/feedlyrss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "08bc86e636a19408", URL: "https://www.firerescue1.com/firefighting-rss-feeds/recent-posts.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "044726d8934a7b0e", URL: "https://www.thedenverchannel.com/news/local-news.rss", Comments: "Denver, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4433510b6ef90e27", URL: "https://asiapacificreport.nz/category/pacific-report/new-zealand/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "987864ba4d0f32d7", URL: "https://globalnews.ca/lethbridge/feed/", Comments: "Lethbridge", Language: "en", Status: "active", Tier: 2},
+	{ID: "bcead9001aa861fe", URL: "https://usa-newnews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "521f1064b465d23c", URL: "https://www.41nbc.com/category/featured/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "a89cd6ef5dcaf4e6", URL: "https://torontosun.com/category/news/local-news/feed.xml", Comments: "Toronto", Language: "en", Status: "active", Tier: 2},
+	{ID: "f3fc83bac18f26db", URL: "https://www.netnewsledger.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b7d1bacf43101d06", URL: "https://www.gloucestershirelive.co.uk/news/gloucester-news/rss.xml", Comments: "Gloucestershire", Language: "en", Status: "active", Tier: 2},
+	{ID: "4ed6b1dd94138bd2", URL: "https://www.dailymail.co.uk/news/victoria-australia/index.rss", Comments: "Victoria, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "b4b131890f48d41a", URL: "https://www.coastaldigest.com/taxonomy/term/26/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bc98ec58a4e13052", URL: "https://www.glasgowworld.com/rss", Comments: "Glasgow", Language: "en", Status: "active", Tier: 2},
+	{ID: "a281991d3e4bf28b", URL: "http://feeds.tennesseedaily.com/rss/209dc06dd4a5e0e7", Comments: "Tennessee", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c7c8be257452f57b", URL: "https://www.dailymail.co.uk/news/utah/index.rss", Comments: "Utah", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2b52cfb4d8af5c38", URL: "https://www.sudanspost.com/feed/", Comments: "South Sudan", Language: "en", Status: "active", Country: "SS", Tier: 2},
+	{ID: "251b0f88455ef9ed", URL: "https://www.hawaiitribune-herald.com/category/hawaii-news/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fcce4d8d80250b19", URL: "https://www.srnnews.com/feed/?cat=8", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "3cfcd4356b97b3ec", URL: "https://medicalxpress.com/rss-feed/breaking/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec1bd6733d3b427a", URL: "https://www.wctrib.com/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "26b74c1d53e66c66", URL: "https://gnnhd.tv/rss/latest", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a9c3738938aa6858", URL: "https://www.pasadenastarnews.com/location/california/los-angeles-county/feed/", Comments: "Los Angeles County", Language: "en", Status: "active", Tier: 2},
+	{ID: "594722f5c78ddac9", URL: "https://www.kccu.org/world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a97673dcdb985e3e", URL: "https://www.skooknews.com/feeds/posts/default", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "bffafbb98e9d7068", URL: "https://burlington.dailyvoice.com/rss/", Comments: "Burlington", Language: "en", Status: "active", Tier: 2},
+	{ID: "14223be4731e4fa6", URL: "https://bronxville.dailyvoice.com/rss/", Comments: "Bronxville", Language: "en", Status: "active", Tier: 2},
+	{ID: "b80c2a967b3a2a4d", URL: "https://www.directrelief.org/topic/health/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7a410862e2ad8322", URL: "https://dnyuz.com/category/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f8622adbad5e6ece", URL: "https://www.horndiplomat.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "19df9b2f4346e637", URL: "https://www.thedickinsonpress.com/news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2f2792ee4d4715e7", URL: "https://stluciatimes.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3e8b07e5cba6be3b", URL: "https://www.dailymail.co.uk/news/finland/index.rss", Comments: "Finland", Language: "en", Status: "active", Country: "FI", Tier: 2},
+	{ID: "d135a59e08526f84", URL: "https://www.ammoland.com/category/firearms/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "45e9d79e43c3f6c8", URL: "https://www.newschannel5.com/sitemap.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "529e9dd46713cedc", URL: "https://www.news5cleveland.com/index.rss", Comments: "Cleveland", Language: "en", Status: "active", Tier: 2},
+	{ID: "a888e349cd87f860", URL: "https://www.eyeradio.org/feed/", Comments: "South Sudan", Language: "en", Status: "active", Country: "SS", Tier: 2},
+	{ID: "5abf093bc172bc7a", URL: "https://rss.24-7pressrelease.com/rss/medical_emergency.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "30876363dcad8813", URL: "https://e.vnexpress.net/rss/world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e8278a562ac10c28", URL: "https://www.thesun.co.uk/health/news-health/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3258f519ffa3fda7", URL: "https://www.ksby.com/news.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8d9ba7e9947d74ef", URL: "https://wtvr.com/sitemap.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "09f42316e11f4538", URL: "http://www.fairfieldsuntimes.com/search/?f=rss&t=article&c=news/national&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "767d0aac21922e85", URL: "https://alerts.weather.gov/cap/tx.php?x=0", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c3daed3af847ce14", URL: "https://www.thailandnews.co/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "00c512fc7847e84e", URL: "https://www.theguardian.com/uk/environment/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "46c4433706054ad1", URL: "https://www.wxyz.com/news/local-news.rss", Comments: "Detroit", Language: "en", Status: "active", Tier: 2},
+	{ID: "59355d9daa4c3921", URL: "http://www.walesonline.co.uk/rss.xml", Comments: "Wales", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "07592aa3620f56f6", URL: "https://energeticcity.ca/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "5b5047d5838562cc", URL: "http://feeds.phoenixherald.com/rss/caf48823f1822eb3", Comments: "Phoenix", Language: "en", Status: "active", Tier: 2},
+	{ID: "1f9f9a4302f10784", URL: "https://www.airforcetimes.com/arc/outboundfeeds/rss/?outputType=xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "2fc195e63140376d", URL: "https://vinnews.com/category/breaking-news/jewish/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0de65adf42550579", URL: "https://cananewsonline.com/main/feed/", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "17ca66edec24daa2", URL: "http://www.france24.com/en/asia-pacific/rss", Comments: "Asia-Pacific, France", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "a888e349cd87f860", URL: "https://eyeradio.org/feed/", Comments: "South Sudan", Language: "en", Status: "active", Country: "SS", Tier: 2},
+	{ID: "71380ab422906caf", URL: "https://ktvz.com/category/news/national-world/feed/", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "fa560841e869f223", URL: "https://jknewstoday.com/category/news/jammu-kashmir/feed/", Comments: "Jammu & Kashmir, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "93f7b142a355c615", URL: "https://www.arabnews.com/rss.xml", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "99263290386c251f", URL: "https://www.theguardian.com/us/rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "efcb05808cdf5b0f", URL: "http://www.columbiagorgenews.com/search/?f=rss&t=article&c=whitesalmonenterprise/news&l=50&s=start_time&sd=desc", Comments: "Columbia Gorge", Language: "en", Status: "active", Tier: 2},
+	{ID: "926e82744da0c8a1", URL: "https://newslivetv.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "240b68fcf8dd6ed9", URL: "https://tribuneonlineng.com/category/top-news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "0218e9220d38f146", URL: "http://feeds.theuknews.com/rss/15665b944045da4a", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8bfa3148a2f57f13", URL: "https://www.wkbw.com/news.rss", Comments: "Buffalo", Language: "en", Status: "active", Tier: 2},
+	{ID: "09168a551bc84fc2", URL: "https://vinnews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "eda3f12440d8853e", URL: "https://www.thezimbabwean.co/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "566a39e9169052a8", URL: "https://www.castanetkamloops.net/rss/page-7.xml", Comments: "Kamloops, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "72cb0e24fc574311", URL: "https://www.ft.com/world/uk?format=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e38a5049106ffe49", URL: "http://feeds.news24.com/articles/News24/World/rss", Comments: "South Africa, world", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "8ece45b5d029dc53", URL: "https://www.asdnews.com/rss-feed/?channelId=68", Language: "en", Status: "active", Tier: 2},
+	{ID: "7312277973328309", URL: "https://vancouversun.com/category/news/feed.xml", Comments: "Vancouver", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ed6a96827b4b7ec", URL: "https://www.twincities.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "63a59a042bc3c4ac", URL: "http://www.wollondillyadvertiser.com.au/rss.xml", Comments: "Wollondilly, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "688ed281f3cdb2ba", URL: "https://www.warsintheworld.com/?x=feed:rss2", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2fa0dacbbe799852", URL: "https://www.dailylocal.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a78d6b96ad061cf4", URL: "https://www.nbcsandiego.com/news/california/feed/", Comments: "San Diego, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d5ef129407cc52cc", URL: "https://www.ksat.com/arc/outboundfeeds/rss/tags_slug/crime/?outputType=xml&size=10", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "957a1f543d949381", URL: "https://hampshirefranklin.dailyvoice.com/rss/", Comments: "Hampshire", Language: "en", Status: "active", Tier: 2},
+	{ID: "7f74bcd8dcd4a010", URL: "https://www.thedickinsonpress.com/latest.rss", Comments: "Dickinson", Language: "en", Status: "active", Tier: 2},
+	{ID: "8fe82ca73c50ec07", URL: "https://www.krtv.com/news/great-falls-news.rss", Comments: "Great Falls, Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "592f661e5ee01bbd", URL: "https://briarcliff.dailyvoice.com/rss/", Comments: "Briarcliff", Language: "en", Status: "active", Tier: 2},
+	{ID: "31c27ac4e704ec31", URL: "https://nation.com.pk/rss/regional", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "46ae468a16e0b984", URL: "https://feeds.breakingnews.ie/bnworld", Comments: "Ireland, World", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "308610465fc04aab", URL: "https://pasoroblesdailynews.com/feed/", Comments: "Paso Robles", Language: "en", Status: "active", Tier: 2},
+	{ID: "0bdbc09e2fb661f8", URL: "http://gazette.com/search/?f=rss&t=article&c=weather&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "669f28ee23ab30bc", URL: "https://english.newstracklive.com/rss-feed/world-news.xml", Comments: "India, World", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "f6fad7c27440b8bb", URL: "http://www.aljazeera.com/xml/rss/all.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "be3ddc52e8d02f6b", URL: "https://indtoday.com/category/top-stories/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "8686c4711d295097", URL: "https://www.lincolnshirelive.co.uk/news/lincoln-news/rss.xml", Comments: "Lincolnshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "96e1ade11bd11598", URL: "https://www.nbcdfw.com/weather/weather-connection/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3148f78923c10a75", URL: "https://www.newindianexpress.com/World/rssfeed/?id=171&getXmlFeed=true", Comments: "New Delhi", Language: "en", Status: "active", Tier: 2},
+	{ID: "bcbab01c4d9892c6", URL: "https://www.khaama.com/tag/killed/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "4b88c6220d4a5f51", URL: "https://cde.news/category/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "0240157bf5401b69", URL: "https://www.dailymail.co.uk/news/fiji/index.rss", Comments: "Fiji", Language: "en", Status: "active", Country: "FJ", Tier: 2},
+	{ID: "41b4c883ebd95898", URL: "https://japantoday.com/category/world/feed/atom", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "297f261e8f9bc669", URL: "https://www.lutontoday.co.uk/rss", Comments: "Luton, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "36c3ed38e9944ed0", URL: "https://www.kurv.com/feed/", Comments: "Texas, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e7ad042f502e94ca", URL: "https://www.dailymail.co.uk/news/california/index.rss", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6662519a7e856270", URL: "https://www.cjme.com/feed", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "e27d0327a6ad508a", URL: "https://www.krtv.com/news/crime-and-courts.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "de9efd4612f9a5a0", URL: "https://www.wwaytv3.com/category/top-stories/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b89013bad18f69c", URL: "https://www.koaa.com/news/covering-colorado.rss", Comments: "Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5dd678915f765d47", URL: "https://www.lakemchenryscanner.com/category/news/lake-county/feed/", Comments: "Lake County", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce4dcc81b22082cc", URL: "https://www.850wftl.com/category/national-news-desk/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a466b703562f666d", URL: "https://www.portlandmercury.com/portland/Rss.xml", Comments: "Portland", Language: "en", Status: "active", Tier: 2},
+	{ID: "35d818ea739a9a4d", URL: "https://www.euractiv.com/feed/", Comments: "European", Language: "en", Status: "active", Tier: 2},
+	{ID: "175d724508cb88ac", URL: "https://globalnews.ca/saskatoon/feed/", Comments: "Saskatoon", Language: "en", Status: "active", Tier: 2},
+	{ID: "d860ca47d7bdce4f", URL: "http://feeds.feedburner.com/PublicHealthNewswire", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9977cf7d4fcba891", URL: "https://deccanchronicle.com/rss_feed/?subsectionId=23", Comments: "India, Andhra Pradesh", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "255bf522c58e4d44", URL: "https://patriotla.iheart.com/topic/national-news.rss/", Comments: "Patriot LA, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e57660e81524ecd3", URL: "https://www.the-sun.com/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "50ec96b279aa6c22", URL: "http://bernews.com/category/accidents-and-fires/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b3e2b7b97a38d383", URL: "https://dailypost.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "e9f21df8d3c9a69b", URL: "https://www.wishtv.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d460f6175701fbca", URL: "https://asiatimes.com/feed", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "79854761fef16f79", URL: "https://www.harboroughmail.co.uk/rss", Comments: "Harborough, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "fc971caebcb18866", URL: "https://www.wptv.com/news.rss", Comments: "West Palm Beach, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "16d32eaec36a7217", URL: "https://www.arabnews.pk/rss.xml", Comments: "Pakistan, Arab", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "8f657149756738ae", URL: "https://sheridanmedia.com/feed/", Comments: "Sheridan, WY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "70a2f630724acd07", URL: "https://www.minnpost.com/feed/", Comments: "Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a7dc1f44ea3a2298", URL: "https://www.belfasttelegraph.co.uk/news/rss/", Comments: "Belfast, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d8b0fdfb74396234", URL: "http://www.abc.net.au/radio/programs/am/feed/8486620/podcast.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "2d69c55364a01e00", URL: "https://www.cheknews.ca/feed/", Comments: "Victoria, BC", Language: "en", Status: "active", Tier: 2},
+	{ID: "b68d240969b45558", URL: "https://visayandailystar.com/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "f3f40b175c59dcf8", URL: "https://www.mangalorean.com/mangalorean-news/local-news/feed/", Comments: "Mangalore, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "d013ddc8cc48d890", URL: "https://www.laprogressive.com/.rss/full/war-and-peace", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7ea009ddb3ab974b", URL: "https://www.yorkshireeveningpost.co.uk/rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6be216db4627f76", URL: "https://www.ft.com/world?format=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c6a35ef19aa5df50", URL: "http://www.examiner.com.au/rss.xml", Comments: "Launceston, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "9909b44173c19851", URL: "https://www.indiatoday.in/rss/1206577", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "fa40aac4dd70114d", URL: "https://www.wnbc.com/news/local/feed/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5fac1ea0919b966e", URL: "https://tarrytown.dailyvoice.com/rss/", Comments: "Tarrytown, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "079f99793e632301", URL: "https://www.randomlengthsnews.com/feed", Comments: "Long Beach, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ddbb8a0b138f2b1e", URL: "https://www.atlanticcouncil.org/issue/peacekeeping-and-peacebuilding/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7de2276ef22f0ac7", URL: "https://whdh.com/regional/feed/", Comments: "New England", Language: "en", Status: "active", Tier: 2},
+	{ID: "39e87e9e44ebd1b1", URL: "http://www.620ckrm.com/category/ckrm-news/feed/", Comments: "Regina, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "524e3ed7d0903aa1", URL: "https://maltadaily.mt/category/news/feed/", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "c3c653f79cb86467", URL: "https://miami.cbslocal.com/category/news/feed/", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "719b38581fa76253", URL: "http://wellington.scoop.co.nz/?cat=6062&feed=rss2", Comments: "Wellington, New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "1f418ddee5da32c0", URL: "https://www.wkyufm.org/news.rss", Comments: "Western Kentucky", Language: "en", Status: "active", Tier: 2},
+	{ID: "6243438ea2f4423e", URL: "https://kashmirobserver.net/news/local-news/feed/", Comments: "Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "471249f68dcfd7e1", URL: "https://www.blackpoolgazette.co.uk/rss", Comments: "Blackpool, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "57207bee7a5db9d6", URL: "https://news.met.police.uk/rss/current_news/66871", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "3174231391afdb51", URL: "https://reformer.com/search/?nsa=eedition&c=community-news&l=50&t=article&f=atom&alt=mrss&fulltext=complete", Comments: "Southern Vermont", Language: "en", Status: "active", Tier: 2},
+	{ID: "971f8a208bd378fb", URL: "https://www.panews.com/category/news/feed/", Comments: "Port Arthur, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "babc356c1dc0e609", URL: "https://www.northantstelegraph.co.uk/rss", Comments: "Northamptonshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e50add66a15d4ced", URL: "https://coyotegulch.blog/category/colorado-water/bureau-of-reclamation/feed/", Comments: "Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5cc93e7569d2722d", URL: "http://rss.upi.com/news/news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fba3844ccafe544c", URL: "http://www.thejournal.ie/feed/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "ba7f19cb6fb14cc2", URL: "https://mississippitoday.org/feed/", Comments: "Mississippi", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "820109910bdf8cee", URL: "http://www.canberratimes.com.au/rss.xml", Comments: "Canberra", Language: "en", Status: "active", Tier: 2},
+	{ID: "a0231fe99f2b8f75", URL: "https://www.castanet.net/rss/page-2.xml", Comments: "Kelowna, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "758247e6e0f6a01b", URL: "https://www.nevadaappeal.com/rss/headlines/", Comments: "Carson City, NV", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5b50a40864dcbd10", URL: "https://www.dailynews.com/location/california/los-angeles-county/feed/", Comments: "Los Angeles County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9b3d3c9c6684f179", URL: "https://www.oneidadispatch.com/feed/", Comments: "Oneida, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dfe14f298a6642b1", URL: "https://www.bostonherald.com/feed/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "434922b6b07d6dfa", URL: "https://rss.jpost.com/rss/rssfeedsheadlines.aspx", Comments: "Israel, Middle East", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "cdac00eb2b88be56", URL: "https://www.dailymail.co.uk/news/met-police/index.rss", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "bb97f0db4e8c1e63", URL: "http://www.timesofmalta.com/rss", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "5579d280e64492f6", URL: "https://ktbb.com/post/?feed=rss2", Comments: "Lubbock, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2dd4c2458a6d2889", URL: "https://www.wkar.org/wkar-news.rss", Comments: "East Lansing, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "168cdacb2899734e", URL: "https://www.wbrz.com/feeds/rssfeed.cfm?category=58&cat_name=News", Comments: "Baton Rouge, LA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fae3e677f68a75fa", URL: "https://www.independent.co.uk/news/world/europe/rss", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa482d1cbf632412", URL: "https://tribune.com.pk/feed/pakistan", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "d0ee5123111b4500", URL: "http://www.cornwalllive.com/rss.xml", Comments: "Cornwall, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ed1ab78226b69354", URL: "https://www.rttnews.com/RSS/Todaystop.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "761b7ff647de957f", URL: "https://mmnews.tv/category/national/feed/", Comments: "national", Language: "en", Status: "active", Tier: 2},
+	{ID: "2394291cde29e6b9", URL: "https://www.ksby.com/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f386d579de4362a6", URL: "https://www.thepledge.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "b720a6bb0ca687cf", URL: "http://feeds.orlandoecho.com/rss/88746c23afcdd0e0", Comments: "Orlando", Language: "en", Status: "active", Tier: 2},
+	{ID: "69228ac05cba43c1", URL: "https://www.delcotimes.com/news/national-news/feed/", Comments: "national", Language: "en", Status: "active", Tier: 2},
+	{ID: "0f81740c28d5e29c", URL: "https://www.delcotimes.com/news/pennsylvania-news/feed/", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "456df578e347d7f4", URL: "https://www.dailymail.co.uk/news/gold-coast/index.rss", Comments: "Gold Coast, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "a381fd91d4e0acbb", URL: "https://www.dailymail.co.uk/news/western-australia/index.rss", Comments: "Western Australia", Language: "en", Status: "active", Tier: 2},
+	{ID: "85a11dd48493f405", URL: "https://koaa.com/news.rss", Comments: "Colorado, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "eaa0d1b4e0c74130", URL: "https://www.yorkshirepost.co.uk/rss", Comments: "Yorkshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a2159b78cb3505e3", URL: "https://www.boholchronicle.com.ph/feed/", Comments: "Bohol, Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "0e2d94a11bbbd5c0", URL: "https://businessday.ng/tag/bdothernews/feed/", Comments: "Nigeria, global", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "23f5619e639989a3", URL: "https://www.rferl.org/api/zbgvmtet_tmt", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "27c1fa5603308c08", URL: "http://feeds.calcuttanews.net/rss/3529af50c55152d2", Comments: "Calcutta", Language: "en", Status: "active", Tier: 2},
+	{ID: "7b71c1dd83046f8b", URL: "https://www.malaysianow.com/feed/", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "1f3bc3aa99b0493a", URL: "http://feeds.indonesianews.net/rss/f9295dc05093c851", Comments: "Indonesia", Language: "en", Status: "active", Country: "ID", Tier: 2},
+	{ID: "bc62ffdbc6231424", URL: "https://www.dailymail.co.uk/news/washington-dc/index.rss", Comments: "Washington DC, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2ee7cb96c8ca82fa", URL: "https://themedialine.org/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "5ad2108a9dd53e7d", URL: "https://www.latestly.com/rss.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "fdba6e3c82f4f8f5", URL: "https://wsvn.com/news/local/broward/feed/", Comments: "Broward County, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3a834bed7bb3e781", URL: "https://endtimeheadlines.org/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "db871ad7c8303750", URL: "https://www.westernadvocate.com.au/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "0d0d4433312f2e06", URL: "https://www.doncasterfreepress.co.uk/rss", Comments: "Doncaster, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7754975465050d0d", URL: "http://demerarawaves.com/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "9b01bf56dec0a68a", URL: "https://www.nbcphiladelphia.com/news/national-international/changing-climate/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "14424fff0a779778", URL: "https://www.nbcmiami.com/?rss=y", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "04d209bfc12f9cab", URL: "https://alerts.weather.gov/cap/ne.php?x=0", Comments: "Nebraska, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "109e125400dfbe5b", URL: "https://oyoinsight.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "f944122a10886707", URL: "https://kashmirobserver.net/feed/", Comments: "Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "87a622f7faf9170d", URL: "https://www.pennlive.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4773594d0085b5d8", URL: "https://blockclubchicago.org/feed/", Comments: "Chicago, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7de8c54fdd93486a", URL: "https://www.capitalfm.co.ke/news/feed/", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "7804d43f2596a1e4", URL: "http://feeds.bangladeshsun.com/rss/ba01aa42514dc37d", Comments: "Bangladesh", Language: "en", Status: "active", Country: "BD", Tier: 2},
+	{ID: "6486a451026c677b", URL: "http://www.manchestereveningnews.co.uk/rss.xml", Comments: "Manchester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d2a8960e3fcb2a9f", URL: "https://thenewamerican.com/us/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8c83e419641ed4da", URL: "https://www.fox13now.com/index.rss", Comments: "Salt Lake City", Language: "en", Status: "active", Tier: 2},
+	{ID: "b99b6db1a2a4cb3b", URL: "https://www.reviewjournal.com/local/local-nevada/feed/", Comments: "Nevada, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "718c3879763876a5", URL: "https://york.dailyvoice.com/rss/", Comments: "York, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6d9ef169b67efbfb", URL: "http://igberetvnews.com/feed", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "80e4f390894f12e6", URL: "https://www.wlns.com/feed/", Comments: "Lansing", Language: "en", Status: "active", Tier: 2},
+	{ID: "80b2885963c110e2", URL: "https://www.dailystar.co.uk/news/rss.xml", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "3c27b7abeabb37aa", URL: "http://feeds.clevelandstar.com/rss/e703c5c9aef0ff62", Comments: "Cleveland, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1689ad5ffea9bcf5", URL: "https://thecommonsenseshow.com/css-breaking-news.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "47e55a5caf3cad29", URL: "https://www.peterboroughtoday.co.uk/news/rss", Comments: "Peterborough, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "eeb4ae0bf965ba9f", URL: "https://www.dailymail.co.uk/news/wisconsin/index.rss", Comments: "Wisconsin, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2c9ed962c12eb045", URL: "https://www.nbc5i.com/news/national-international/feed/", Comments: "national, international", Language: "en", Status: "active", Tier: 2},
+	{ID: "b24651f812196d02", URL: "https://stamford.dailyvoice.com/rss/", Comments: "Stamford, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "98dc719326dd22cb", URL: "https://rmn.ph/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "9bd7c301941f5560", URL: "https://www.dglobe.com/latest.rss", Comments: "Minnesota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "81bffbf2a2694a14", URL: "https://news.gov.bc.ca/feed", Comments: "British Columbia, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "f1df0511b6592381", URL: "https://www.scmp.com/rss/318198/feed", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "bae7779d5e533e09", URL: "https://www.atlanticcouncil.org/issue/disinformation/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "fef219ce74074b01", URL: "https://www.atlanticcouncil.org/issue/human-rights/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc438a06437ae58f", URL: "http://www.phillyvoice.com/feed/tag/crime/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "b6c7f430ca10a14a", URL: "http://www.channelnewsasia.com/rss/latest_cna_asiapac_rss.xml", Comments: "Asia Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "ab0f86a735baaae8", URL: "http://feeds.middleeaststar.com/rss/940f2bfd509e743b", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "574b782b01bf6fde", URL: "https://www.radiofree.org/category/sources/propublica/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0b5c1293d19f07e", URL: "https://www.cgtn.com/subscribe/rss/section/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c870952d4861df3", URL: "https://www.ibtimes.co.uk/rss/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "68a039e4c5411195", URL: "https://www.dailymail.co.uk/news/japan/index.rss", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "9f59d42c4e2fe852", URL: "https://www.anarchistfederation.net/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "786fc2a99a6d26e3", URL: "https://mindanaoexaminernewspaper.blogspot.com/feeds/posts/default?alt=rss", Comments: "Mindanao, Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "d25c559996650ff1", URL: "https://www.breakingnews.ie/feed/ireland.rss", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "4b8c3a4dd6913f80", URL: "https://voanews.com/api/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5ae0a910354b12e", URL: "http://gazette.com/search/?f=rss&t=article&c=news/us-world&l=50&s=start_time&sd=desc", Comments: "Colorado Springs, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ae931bdc4e21bf0c", URL: "https://www.arnnewscentre.ae/en/news/international/feed.xml", Comments: "UAE, Middle East", Language: "en", Status: "active", Country: "AE", Tier: 2},
+	{ID: "73ef9c902ee69415", URL: "https://www.foreigndesknews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bd45a9c23b945826", URL: "https://www.nprillinois.org/tags/nation-world.rss", Comments: "Illinois", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dd54857351fecd8a", URL: "https://rss.24-7pressrelease.com/rss/society_human_rights.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bd58bdcbb22a4e16", URL: "https://www.omanobserver.om/rssFeed/13/26", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "cf0a0d8805c6e354", URL: "https://thediplomat.com/topics/security/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "af83c013a5018c60", URL: "https://wbznewsradio.iheart.com/topic/national-news.rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c22d30f6da09cfc5", URL: "https://www.dailymail.co.uk/news/greece/index.rss", Comments: "Greece", Language: "en", Status: "active", Country: "GR", Tier: 2},
+	{ID: "1e0597d23a006235", URL: "https://www.thescottishsun.co.uk/news/world-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "34520c3ed1221e21", URL: "https://www.delcotimes.com/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b7c73de083c0a146", URL: "https://www.metro.co.uk/news/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "410fd6ee2b7d28df", URL: "https://www.belfastlive.co.uk/news/rss.xml", Comments: "Belfast", Language: "en", Status: "active", Tier: 2},
+	{ID: "22e72cea0b070b25", URL: "https://www.cornwallnewswatch.com/feed/", Comments: "Cornwall", Language: "en", Status: "active", Tier: 2},
+	{ID: "a12811b479079cc5", URL: "https://www.watoday.com.au/rss/environment.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "962c2bcd09d68424", URL: "https://www.dailymail.co.uk/news/berlin/index.rss", Comments: "Berlin", Language: "en", Status: "active", Tier: 2},
+	{ID: "0e3420958a5915bb", URL: "https://www.durangoherald.com/feeds/all", Comments: "Durango, Colorado, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6e29c6f308c20c17", URL: "http://www.firemapchicago.net/feeds/posts/default?alt=rss", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "539cfaa2df152a64", URL: "https://pajhwok.com/category/migration/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "45b2579093beb442", URL: "https://www.newsfetchers.com/feeds/posts/default", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4dc0186232204b7e", URL: "https://www.mid-day.com/Resources/midday/rss/india-news.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "9062f968eea04360", URL: "https://www.omanobserver.om/rssFeed/17", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "40dcc5ed2fb38d78", URL: "http://972mag.com?feed=rss2", Comments: "Israel, Palestine", Language: "en", Status: "active", Country: "PS", Tier: 2},
+	{ID: "ffd95593c530e334", URL: "https://www.spectator.com.au/category/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e609469f17290416", URL: "https://www.corkbeo.ie/news/rss.xml", Comments: "Cork, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "fd52ea06386f15d4", URL: "https://www.greeleytribune.com/news/colorado-news/feed/", Comments: "Greeley, Colorado, Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9480bcf12015adf8", URL: "https://northwestdutchess.dailyvoice.com/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d460f6175701fbca", URL: "https://asiatimes.com/feed/", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "d305877737b67a89", URL: "https://znsbahamas.com/feed/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "d895f49f4073aa72", URL: "https://www.warwickshireworld.com/news/rss", Comments: "Warwickshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9754200903424b3a", URL: "https://www.newindianexpress.com/States/Andhra-Pradesh/rssfeed/?id=173&getXmlFeed=true", Comments: "Andhra Pradesh, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "45138eca654eb95f", URL: "https://www.atlanticcouncil.org/region/indo-pacific/feed/", Comments: "Indo-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "4e67667c497e2ee9", URL: "https://www.mymcmedia.org/category/neighborhoods/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0baebc0bd5e0dc2b", URL: "http://missoulian.com/search/?f=rss&t=article&c=news/state-and-regional&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "433b1b972cf14bb2", URL: "https://www.dailymail.co.uk/news/birmingham/index.rss", Comments: "Birmingham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "885199611e5b67c8", URL: "https://ctmirror.org/news/feed/", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e9cf070e3b00a647", URL: "https://newatlas.com/military/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "41749fdd15eb6afa", URL: "https://www.rcmp-grc.gc.ca/en/n/ns", Comments: "Nova Scotia", Language: "en", Status: "active", Tier: 2},
+	{ID: "67092ca495a1758e", URL: "https://www.wnyc.org/atomfeeds/tags/national_news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "da3ea719fff3429d", URL: "https://www.mercurynews.com/location/california/bay-area/east-bay/alameda-county/feed/", Comments: "Alameda County, CA, California, United States", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "93d595b26f008f1b", URL: "https://www.goexpress.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "4fdd59d25dcbac94", URL: "https://asiapacificreport.nz/category/asia-report/feed/", Comments: "Asia-Pacific region", Language: "en", Status: "active", Tier: 2},
+	{ID: "e960d3238fa5a792", URL: "http://www.highland-news.co.uk/_api/rss/inverness-news-feed.xml", Comments: "Inverness, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4362aeb53e2f6002", URL: "http://www.djournal.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Tupelo", Language: "en", Status: "active", Tier: 2},
+	{ID: "f421dd9a5ebdbd4c", URL: "https://mountpleasant.dailyvoice.com/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8989291d319ed9bd", URL: "https://www.thebatavian.com/tags/news/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3053bc600c0b78e8", URL: "http://feeds.vietnamnews.net/rss/fc849e836694179b", Comments: "Vietnam", Language: "en", Status: "active", Country: "VN", Tier: 2},
+	{ID: "6ec4c9e31d18222f", URL: "https://mandynews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "002d5c67445f2fd0", URL: "https://ottawasun.com/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "584e0934ac97cb16", URL: "https://www.dailymail.co.uk/news/canada/index.rss", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "8cbc9f791c57f380", URL: "https://www.deccanchronicle.com/rss_feed/?subsectionId=13", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "43988b55ff87347a", URL: "http://feedpress.me/InsideAppalachia", Comments: "Appalachia", Language: "en", Status: "active", Tier: 2},
+	{ID: "686b0d2eb852273d", URL: "https://www.the-sun.com/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "776b6dbc9bdb8072", URL: "https://fairfax.dailyvoice.com/rss/", Comments: "Fairfax", Language: "en", Status: "active", Tier: 2},
+	{ID: "921ecae55422975e", URL: "https://www.falkirkherald.co.uk/rss", Comments: "Falkirk, Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "43d85251fbc539ac", URL: "https://mynorthwest.com/feed", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "d85224eff2a60baa", URL: "https://www.dailyheraldtribune.com/feed", Comments: "Canadian", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "acdbf80d6979d6df", URL: "https://guyanatimesgy.com/category/news/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "6930587a0e1cc438", URL: "https://www.japantoday.com/feed/atom", Comments: "Japan, global", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "686e8f1d13c9a3b4", URL: "https://www.centralmaine.com/tag/police-log/feed/", Comments: "Central Maine", Language: "en", Status: "active", Tier: 2},
+	{ID: "4a8b30b6ce3fd76f", URL: "https://monmouth.dailyvoice.com/rss/", Comments: "Monmouth", Language: "en", Status: "active", Tier: 2},
+	{ID: "ffaab262af1c1f41", URL: "https://www.3newsnow.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e9586bb81a4ef8f4", URL: "https://www.wptv.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b69459142cb3b00f", URL: "https://www.radiofree.org/category/sources/alt-news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "a0ff29cb81616a7c", URL: "https://www.sgvtribune.com/location/california/los-angeles-county/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "650c4f0d5f425e02", URL: "https://www.thetelegraph.com/rss/feed/News-RSS-Feed-1978.php", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c17e81d282705fb6", URL: "https://union.dailyvoice.com/rss/", Comments: "Union", Language: "en", Status: "active", Tier: 2},
+	{ID: "2982a545a1c73891", URL: "https://www.fox13now.com/sitemap.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "f296dd1750a4cfbc", URL: "https://www.castanetkamloops.net/rss/page-1.xml", Comments: "Canada, Kamloops", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "ee230cad637a3b09", URL: "https://chicago.suntimes.com/rss/crime/index.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c7d874e442a553c", URL: "https://www.kxxv.com/hometown/texas.rss", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "440be8f9e47480d4", URL: "https://www.wrtv.com/sitemap.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e1a83feaddfbbe2c", URL: "https://www.pasadenastarnews.com/feed/", Comments: "Pasadena", Language: "en", Status: "active", Tier: 2},
+	{ID: "9baa54be8137b1c9", URL: "https://lyndhurst.dailyvoice.com/rss/", Comments: "Lyndhurst", Language: "en", Status: "active", Tier: 2},
+	{ID: "35a56d30037a9315", URL: "https://www.nbc30.com/news/national-international/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0d499568628b9800", URL: "https://www.jerseyshoreonline.com/feed/", Comments: "New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9be93c6c37ec6098", URL: "http://www.wnyc.org/feeds/tags/news", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d00c7c26deffe8c0", URL: "https://www.nbclosangeles.com/news/national-international/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "33a3e52ce797072d", URL: "https://www.thecitizen.co.tz/service/rss/tanzania/2486626/feed.rss", Comments: "Tanzania", Language: "en", Status: "active", Country: "TZ", Tier: 2},
+	{ID: "90701ae661c9a354", URL: "https://www.thecourier.co.uk/category/news/fife/feed/", Comments: "UK, Fife", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f75d55e9d6e106a6", URL: "http://www.princewilliamtimes.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "Prince William", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7248434917aeca3", URL: "https://www.latestnigeriannews.com/feed/newtelegraph/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "e1ba961c40eb1afc", URL: "https://signalscv.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b1ede3099ec97a8e", URL: "https://thefactnews.in/category/national/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "8f8be410f3501726", URL: "http://www.iraqhurr.org/api/", Comments: "Iraq", Language: "en", Status: "active", Country: "IQ", Tier: 2},
+	{ID: "babedb763f39adc1", URL: "https://www.deccanchronicle.com/rss_feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "38f6fede447c808c", URL: "https://fm.kuac.org/npr-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f3845e2f05c19fe1", URL: "http://feeds.northernirelandnews.com/rss/7e2fe997846a08dc", Comments: "Northern Ireland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e58e7aeca87ea5c9", URL: "https://www.thecanary.co/uk/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7439c4bd86c54b0e", URL: "https://www.fox7austin.com/rss/category/local-news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "73ef9c902ee69415", URL: "https://foreigndesknews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "422440f85edc1654", URL: "https://www.simcoereformer.ca/feed", Comments: "Simcoe", Language: "en", Status: "active", Tier: 2},
+	{ID: "3369ca6a65eb4754", URL: "https://www.kxlh.com/index.rss", Comments: "Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "584f9776337db3b7", URL: "https://www.americadigital.com/feed", Comments: "Latin America", Language: "en", Status: "active", Tier: 2},
+	{ID: "cf98a36577dcc750", URL: "https://www.saultstar.com/feed/", Comments: "Sault Star, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "e3c36950675cfe96", URL: "https://northernhighlands.dailyvoice.com/rss/", Comments: "Northern Highlands", Language: "en", Status: "active", Tier: 2},
+	{ID: "50dd37096f039e93", URL: "https://thekashmiriyat.co.uk/feed/", Comments: "Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "9f5f5c9b4ce22078", URL: "https://www.villages-news.com/category/news/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "08f57f4569d18798", URL: "https://www.latestly.com/rss/india.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "21473a976a7ec986", URL: "https://www.thescarboroughnews.co.uk/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "14c7d45f794c60d3", URL: "https://www.rediff.com/rss/newshead.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "68e32ab2713d33ff", URL: "https://www.kawc.org/npr-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fe5492869aa7dfdc", URL: "https://thestarphoenix.com/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "4bf64abb65968dba", URL: "https://globalupfront.com/section/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ff574a42db09965", URL: "https://bestnewsgh.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "d8f57a442d4dca93", URL: "http://www.thisiscornwall.co.uk/rss.xml", Comments: "Cornwall, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2822279d8e62c356", URL: "http://www.simcoereformer.ca/rss", Comments: "Simcoe, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "da4c45021aecdb11", URL: "http://www.nytimes.com/services/xml/rss/nyt/africa.xml", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "b0a57b7269f719c0", URL: "https://www.mercurynews.com/location/california/bay-area/feed/", Comments: "Bay Area, Mercury News", Language: "en", Status: "active", Tier: 2},
+	{ID: "59d0048a48f6f4b4", URL: "http://feeds.kualalumpurtimes.com/rss/48cba686fe041718", Comments: "Malaysian", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "14aa4d7d9893b125", URL: "http://www.igberetvnews.com/feed/atom", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "e332d06d71f4b078", URL: "https://edmontonjournal.com/category/news/crime/feed.xml", Comments: "Edmonton", Language: "en", Status: "active", Tier: 2},
+	{ID: "841347fa056fea6f", URL: "http://www.wcpo.com/news/national.rss", Comments: "Cincinnati", Language: "en", Status: "active", Tier: 2},
+	{ID: "1a30fbd20f6c01fc", URL: "https://www.malaysianow.com/section/news/feed/", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "8cf1997cf64e202c", URL: "https://dayakdaily.com/feed/", Comments: "Malaysia, Borneo", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "58ca3f0ba23a72a6", URL: "https://www.eastbaytimes.com/news/crime-public-safety/feed/", Comments: "East Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "c7f2d827e112aae9", URL: "https://www.chattanoogan.com/Breaking-News/feed.rss", Comments: "Chattanooga", Language: "en", Status: "active", Tier: 2},
+	{ID: "14ef0cda80c7d6ae", URL: "https://mybrytfmonline.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "afd9d90f50b6c5f4", URL: "https://themoscowtimes.com/rss/news", Comments: "Moscow", Language: "en", Status: "active", Tier: 2},
+	{ID: "e2f0ee070065b90f", URL: "http://jonesbahamas.com/feed/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "879087f6389fe64f", URL: "https://www.jamestownsun.com/news.rss", Comments: "Jamestown, North Dakota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "10808c789f5fb921", URL: "https://www.newindianexpress.com/States/Telangana/rssfeed/?id=174&getXmlFeed=true", Comments: "Telangana", Language: "en", Status: "active", Tier: 2},
+	{ID: "9977cf7d4fcba891", URL: "https://www.deccanchronicle.com/rss_feed/?subsectionId=23", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "da2899f730adc887", URL: "https://losalamosreporter.com/category/los-alamos-county/feed/", Comments: "Los Alamos County, New Mexico", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8bee3c27f570a3b7", URL: "https://sundiatapost.com/feed/", Comments: "Niger", Language: "en", Status: "active", Country: "NE", Tier: 2},
+	{ID: "1b9cfaba6e33d1ba", URL: "https://www.sciotopost.com/feed/", Comments: "Scioto County, Ohio", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "41fcfc00a93c7192", URL: "https://de.flightaware.com/squawks/rss/general/24_hours/new", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8e8437ce528e5365", URL: "https://journalrecord.com/category/news-headlines/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "01c19964e7eb6203", URL: "https://www.virginradiodubai.com/trending/international-news/feed.xml", Comments: "Dubai, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "79085145be7d7cd9", URL: "https://www.wivb.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0d24f37f1ab5d09", URL: "https://www.ktoo.org/category/news/alaska/feed/", Comments: "Alaska", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bc3ea3c118028834", URL: "https://guyanatimesgy.com/feed", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "979adcfffd403653", URL: "https://www.bristolpost.co.uk/news/rss.xml", Comments: "Bristol, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f63a49d4e9937015", URL: "http://pointblanknews.com/pbn/category/news/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c6aad045348a3dd7", URL: "https://cmp.breakingnews.ie/feed/world.rss", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "2f6479b84ff4a862", URL: "https://www.duluthnewstribune.com/index.rss", Comments: "Duluth, Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9db09463d4516e2a", URL: "http://www.timminspress.com/rss", Comments: "Timmins, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "13b5666106df0e39", URL: "https://www.dublinlive.ie/news/rss.xml", Comments: "Dublin", Language: "en", Status: "active", Tier: 2},
+	{ID: "0e1acb94ee3581c4", URL: "https://www.kjrh.com/news/national.rss", Comments: "Tulsa", Language: "en", Status: "active", Tier: 2},
+	{ID: "ead548320ca5e179", URL: "http://www.wral.com/news/rss/48/", Comments: "Raleigh, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "30ccd8fbf15b20ab", URL: "https://www.dailymail.co.uk/news/black-lives-matter/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "29c6e3b06a04a3a0", URL: "https://authorityngr.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "a14844a3e8feb975", URL: "http://azdailysun.com/search/?f=rss&t=article&c=news/national&l=50&s=start_time&sd=desc", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f733221ea631c3ed", URL: "https://www.wfla.com/feed/", Comments: "Tampa Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "f2f9f81a5260d1b5", URL: "https://www.birminghamtimes.com/feed/", Comments: "Birmingham", Language: "en", Status: "active", Tier: 2},
+	{ID: "dbbf8b77708a5ad8", URL: "http://infotel.ca/rssfeed/kelowna", Comments: "Kelowna, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "0cd74ac6a61a83fe", URL: "https://www.kxlf.com/news.rss", Comments: "Butte, Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9f97aac6f828cb2e", URL: "http://feeds.pakistannews.net/rss/8c3d7d78943a99c7", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "df371cf67e4fd42a", URL: "https://www.nbcboston.com/?rss=y", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ece46fdb0f9a472", URL: "https://thediplomat.com/regions/south-asia/feed", Comments: "South Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "e76419e4a719f94f", URL: "https://www.abc15.com/sitemap.rss", Comments: "Phoenix", Language: "en", Status: "active", Tier: 2},
+	{ID: "fb2ca7c144ee2f59", URL: "https://www.staradvertiser.com/tag/world-news/feed/", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "6fb9ab82757fb01d", URL: "https://www.birminghampost.co.uk/rss.xml", Comments: "Birmingham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1cafa71922d4ca97", URL: "https://www.koreatimes.co.kr/www/rss/northkorea.xml", Comments: "North Korea", Language: "en", Status: "active", Country: "KP", Tier: 2},
+	{ID: "d9e194fd1d18289d", URL: "https://fortlee.dailyvoice.com/rss/", Comments: "Fort Lee, New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dea3a6e1b014e994", URL: "https://www.wmar2news.com/sitemap.rss", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "f560806d751d2766", URL: "https://kfor.com/feed/", Comments: "Oklahoma City", Language: "en", Status: "active", Tier: 2},
+	{ID: "cb2bf7af2d37aa5f", URL: "https://www.omanobserver.om/rssFeed/home-page", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "e92415e300314847", URL: "https://ustimetoday.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d64e8e7939bada5e", URL: "https://www.banburyguardian.co.uk/rss", Comments: "Banbury, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "bf4eeb19131a8d49", URL: "http://www.phillyvoice.com/feed/tag/courts/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "337373326ef65c81", URL: "https://www.rttnews.com/RSS/breakingnews.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1698fee4b5cce63e", URL: "https://www.johansen.se/blog/category/news-in-english/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "be9e04fabca1fc43", URL: "https://www.lhrtimes.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c2c359d1bfbaac1", URL: "http://perrynewspapers.com/?feed=rss2", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8cb05a9d8ad63721", URL: "https://www.oyetimes.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "106efa466e20a26c", URL: "https://www.thestranger.com/seattle/Rss.xml?section=40878902", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "445454858e9d66f2", URL: "https://www.timescall.com/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3d88fe7bec8ce2d", URL: "https://wgntv.com/feed/", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "c141aef82d51c7be", URL: "http://www.jewishledger.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "715ea61e76cf879e", URL: "https://fox59.com/feed/", Comments: "Indianapolis", Language: "en", Status: "active", Tier: 2},
+	{ID: "521582a4892bac89", URL: "http://www.watoday.com.au/rss/national/western-australia.xml", Comments: "Western Australia", Language: "en", Status: "active", Tier: 2},
+	{ID: "6a79639f6e910659", URL: "https://www.euractiv.com/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1bbe51dc5dfc4175", URL: "https://starofmysore.com/feed/", Comments: "Mysore, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "a6d4ccf42b9b0b19", URL: "http://www.lancs.live/rss.xml", Comments: "Lancashire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "415c91896c1397cd", URL: "https://www.dailytribune.com/feed/", Comments: "Royal Oak", Language: "en", Status: "active", Tier: 2},
+	{ID: "32055d9307c9f12d", URL: "https://www.zmescience.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "67a7fe4296d5cd11", URL: "http://news.met.police.uk/rss/source/60923/image", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ab279ca219f3e0f2", URL: "https://wokingham.today/category/featured/feed/", Comments: "Wokingham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "55f5ab5c77491fc8", URL: "https://www.eastidahonews.com/feed/", Comments: "Eastern Idaho", Language: "en", Status: "active", Tier: 2},
+	{ID: "320c156aefd4ed94", URL: "https://www.nbcwashington.com/news/local/feed/", Comments: "Washington", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "650c49359206369c", URL: "https://www.wpri.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4316897615a6daad", URL: "https://www.luxtimes.lu/rss/en", Comments: "Luxembourg", Language: "en", Status: "active", Country: "LU", Tier: 2},
+	{ID: "4f9eec64f21a604f", URL: "https://www.loscerritosnews.net/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "68fc32e7d1ecd561", URL: "http://voiceofvienna.org/feed/", Comments: "Vienna, Austria", Language: "en", Status: "active", Country: "AT", Tier: 2},
+	{ID: "f8161944dd90466e", URL: "https://www.dailymail.co.uk/news/poland/index.rss", Comments: "Poland", Language: "en", Status: "active", Country: "PL", Tier: 2},
+	{ID: "5e512a9d1ad68d78", URL: "https://www.crosstimbersgazette.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "2dfd17f5fad02a11", URL: "http://www.nugget.ca/rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "16b023cbea043654", URL: "https://www.getsurrey.co.uk/rss.xml", Comments: "Surrey, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "12dbec3de090681b", URL: "https://www.smdp.com/category/news/feed", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e11b64c2f15b2369", URL: "https://bernews.com/category/crime/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "f723efb8f0c0e61a", URL: "http://feeds.azerbaijannews.net/rss/929bcf2071e81801", Comments: "Azerbaijan", Language: "en", Status: "active", Country: "AZ", Tier: 2},
+	{ID: "4801525f7a31cd09", URL: "https://www.namibian.com.na/rssfeed.php", Comments: "Namibian", Language: "en", Status: "active", Tier: 2},
+	{ID: "0ca2171e7f50af1b", URL: "http://www.gazettelive.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "253ed0f12591ddb7", URL: "https://www.bostonherald.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e69b4e9cfe6ece9c", URL: "http://rss.newser.com/rss/section/1.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "18a4c89e02dc1c90", URL: "https://www.indiatoday.in/rss/1206509", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "cc699a0b556b8ab6", URL: "https://www.tampafp.com/category/local-news/feed/", Comments: "Tampa, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f99f29f0ebfdcf27", URL: "https://www.gdacs.org/xml/rss_7d.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "59b510a71c3b3a69", URL: "https://follow.it/eurasiareview?action=followPub", Comments: "Eurasia", Language: "en", Status: "active", Tier: 2},
+	{ID: "19d7f9c5dc7c7591", URL: "http://www.thisisleicestershire.co.uk/rss.xml", Comments: "Leicestershire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "61a24deaa89447c2", URL: "https://www.northern-times.co.uk/_api/rss/northern-times-news.xml", Comments: "Northern Times (UK)", Language: "en", Status: "active", Tier: 2},
+	{ID: "74b30499969c5a3b", URL: "https://www.africa-press.net/zimbabwe/feed", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "c00707b78ab28f69", URL: "https://www.ksby.com/weather/fire-watch.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "232c513d680f6eb5", URL: "https://www.klkntv.com/category/news/feed/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "ab76b53fbd34f047", URL: "https://www.capetownetc.com/news/feed/", Comments: "Cape Town", Language: "en", Status: "active", Tier: 2},
+	{ID: "e8259584564a5e3e", URL: "http://www.startribune.com/rss/?sf=1&s=/", Comments: "Minneapolis", Language: "en", Status: "active", Tier: 2},
+	{ID: "649dfdd252d5ee45", URL: "https://eng.lsm.lv/rss/?lang=en&catid=21987", Comments: "Latvia", Language: "en", Status: "active", Country: "LV", Tier: 2},
+	{ID: "e100da8d0d40b9c5", URL: "https://africafeeds.com/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "74275444935bcb56", URL: "https://thethaiger.com/feed", Comments: "Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "c23d937dcaccbfae", URL: "https://www.warsintheworld.com/index.php/feed/atom/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8eddc871aaf83713", URL: "https://howard.dailyvoice.com/rss/", Comments: "Howard County", Language: "en", Status: "active", Tier: 2},
+	{ID: "e701e42da872f641", URL: "https://www.tampafp.com/feed/", Comments: "Tampa", Language: "en", Status: "active", Tier: 2},
+	{ID: "f419e3c2b5c15a6d", URL: "http://www.theguardian.com.au/feed/rss", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "96833165d90bb14d", URL: "http://www.nottinghammd.com/feed/", Comments: "Maryland, Nottingham MD", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "da2e1618691d1396", URL: "https://eurasiantimes.com/category/south-asia/feed/", Comments: "South Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "23ff85abcb09412d", URL: "https://calgaryherald.com/feed/", Comments: "Calgary", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0b0cf47f167e552", URL: "http://www.stuff.co.nz/rss/", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "cbae2525a2c82f0a", URL: "https://mckoysnews.com/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "32308afae7b42c57", URL: "https://newsd.in/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "4e19a845d9bf508f", URL: "https://www.wtkr.com/news/crime.rss", Comments: "Hampton Roads, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "041bc001781c765e", URL: "https://cananewsonline.com/main/category/news/full-news/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "a7306a3b188aa362", URL: "https://www.srnnews.com/feed/?cat=9", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a6d4c95e9e4a2b66", URL: "https://moxie.foxnews.com/feedburner/national.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "04d209bfc12f9cab", URL: "http://alerts.weather.gov/cap/ne.php?x=0", Comments: "Nebraska, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a045a27995296f33", URL: "https://www.telemundo62.com/tag/pensilvania/feed/", Comments: "Philadelphia, Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1de0f6f8e8f6aa38", URL: "https://www.inforum.com/index.rss", Comments: "North Dakota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9ef2209f01ab51f9", URL: "https://www.ieyenews.com/category/ilocal/feed/", Comments: "Cayman Islands", Language: "en", Status: "active", Country: "KY", Tier: 2},
+	{ID: "f39b7abde1d9f9bb", URL: "https://www.wtvr.com/news/crime-and-justice.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cc48e1cd09cc4dca", URL: "https://ossining.dailyvoice.com/rss/", Comments: "US, Ossining", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "690c8ecb7a4499be", URL: "https://www.wsws.org/rss/en.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "18454bc78e8e28fb", URL: "https://www.observer.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "53e068e987c09807", URL: "https://www.thegardenisland.com/category/hawaii-news/feed/", Comments: "Kauai", Language: "en", Status: "active", Tier: 2},
+	{ID: "efb58cb92c1cf064", URL: "https://www.kentlive.news/rss.xml", Comments: "Kent", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc4bc00f4aef4ac9", URL: "https://eastdevonnews.co.uk/feed/", Comments: "East Devon", Language: "en", Status: "active", Tier: 2},
+	{ID: "b2e093af7daa0ad6", URL: "https://northsalem.dailyvoice.com/rss/", Comments: "US, North Salem", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bcf2788bc15ed42d", URL: "https://www.thesun.ie/news/world-news/feed/", Comments: "Irish, global", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "edc73add40050a44", URL: "https://www.rtcwashoe.com/feed/", Comments: "Washoe County, Nevada, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0d749492a5294d8c", URL: "https://www.atlanticcouncil.org/issue/terrorism/feed/", Comments: "Atlantic Council, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5a8bb43aec457634", URL: "https://www.newsamericasnow.com/feed/", Comments: "Caribbean, Latin America", Language: "en", Status: "active", Tier: 2},
+	{ID: "70c3cf914cc829f9", URL: "https://iafrica.com/feed/", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "f84d6152c58e8ded", URL: "https://www.localsyr.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "529e404cd6659fbf", URL: "https://cde.news/category/news/eur/europe-western-europe/feed/", Comments: "Europe, Western Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "fec0060070b0bc74", URL: "https://globalnews.ca/barrie/feed/", Comments: "Barrie", Language: "en", Status: "active", Tier: 2},
+	{ID: "2bb478627e04ab62", URL: "https://www.wcpo.com/news.rss", Comments: "Cincinnati", Language: "en", Status: "active", Tier: 2},
+	{ID: "f52c28b7dd475aee", URL: "https://woay.com/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "c8cbb306b52cb660", URL: "https://thediplomat.com/regions/oceania-region/feed", Comments: "Oceania", Language: "en", Status: "active", Tier: 2},
+	{ID: "4008fe20d7e44580", URL: "https://emerging-europe.com/feed/", Comments: "Eastern Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7d6068a02a1246a", URL: "https://tribune.com.pk/feed/latest", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "87ec2a1b1310a1d9", URL: "http://theweek.com/rss.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "a9f342be86af5eef", URL: "http://alerts.weather.gov/cap/in.php?x=0", Comments: "Indiana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f5720b1ea3eda21e", URL: "https://www.metro.co.uk/news/us/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1c2ecae29c0e222f", URL: "https://zeta.com.pa/ingles.feed?type=atom", Comments: "Panama", Language: "en", Status: "active", Country: "PA", Tier: 2},
+	{ID: "a09b5bdb4078e179", URL: "https://www.kgun9.com/sitemap.rss", Comments: "Tucson", Language: "en", Status: "active", Tier: 2},
+	{ID: "34a81af81077a118", URL: "https://www.dailymail.co.uk/articles.rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a765026db2c4768d", URL: "https://abc17news.com/category/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "17225ff3e7fb89d8", URL: "https://telanganatoday.com/india/feed", Comments: "India, Telangana", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "5e7245009c873a11", URL: "http://www.chroniclelive.co.uk/rss.xml", Comments: "Newcastle, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a7a5a363b9d6b202", URL: "https://denver.cbslocal.com/category/news/feed/", Comments: "Denver, Denver, Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "52af5cd9affd2eb2", URL: "https://www.westhawaiitoday.com/category/nation-world-news/feed/", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "ebdbe7bde68ae767", URL: "https://www.nbclosangeles.com/news/california-wildfires/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "75c580c75990f4e0", URL: "https://charlestoncitypaper.com/category/blotter/feed/", Comments: "Charleston", Language: "en", Status: "active", Tier: 2},
+	{ID: "02004036fcfcab89", URL: "https://www.atlanticcouncil.org/region/ukraine/feed/", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "6f35bf77ad2fbc9b", URL: "https://www.wcbe.org/npr-news.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "cb3da4ba605b19e0", URL: "https://thediplomat.com/category/tokyo-report/feed", Comments: "Tokyo, Japan, East Asia", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "4eb13d7cb67e5081", URL: "https://wsvn.com/news/us-world/feed/", Comments: "US, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "38e140e72d010344", URL: "https://www.dailymail.co.uk/news/brazil/index.rss", Comments: "Brazil", Language: "en", Status: "active", Country: "BR", Tier: 2},
+	{ID: "73763c598d1ac7ea", URL: "https://www.wptv.com/index.rss", Comments: "West Palm Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "e50816765338a167", URL: "https://www.nbcwashington.com/news/local/changing-climate/feed/", Comments: "Washington", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "afccde20cbe09873", URL: "https://www.eastmojo.com/world/feed/", Comments: "Northeast India, world", Language: "en", Status: "active", Tier: 2},
+	{ID: "cf25d1706195bde2", URL: "http://www.chronicle.co.zw/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "acaeb578396596bb", URL: "https://thecitizenng.com/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "4a5378e44014a8e7", URL: "https://www.orissapost.com/international-news/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "da4faf7a8bbc4fc8", URL: "https://worldabcnews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cadba9780edf5aa1", URL: "https://citizen.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "15f2795780a9ce5f", URL: "https://insiderpaper.com/topic/news/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f8984162b27bd76c", URL: "https://www.latestnigeriannews.com/feed/guardian/rss.xml", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "7c3d5a8149b09a9b", URL: "http://www.maritime-executive.com/articles.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "52c9362598c601c0", URL: "https://www.gov.uk/government/organisations/environment-agency.atom", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b5cc4bd9deb4abc8", URL: "https://www.arabnews.pk/taxonomy/term/5/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "f7bfa1017595b576", URL: "https://www.atlanticcouncil.org/issue/security-defense/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b36abfa166b4f798", URL: "https://www.dailymail.co.uk/news/met_office/index.rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9242480a74155370", URL: "https://www.khaama.com/category/world/europe/feed/", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "f71f5396d025d7ed", URL: "https://www.wuft.org/nation-world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8153089f850e650e", URL: "https://www.news-herald.com/feed/", Comments: "area", Language: "en", Status: "active", Tier: 2},
+	{ID: "cb5c65d420cc198f", URL: "https://www.thedefensepost.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "f824ffcfa6e53092", URL: "https://www.dailymail.co.uk/news/maine/index.rss", Comments: "Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "89566cc510b23ca9", URL: "https://english.mathrubhumi.com/cmlink/news-1.7280507", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5b32592ec3bc1433", URL: "https://rss.24-7pressrelease.com/rss/computer_security.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f99f7508d5b20058", URL: "https://www.prisonersabroad.org.uk/handlers/rss.ashx?feed=1&IDBlogGroup=10641441-022a-4a8f-9796-f59dff644077", Comments: "abroad", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec5b45239e69f064", URL: "http://abc13.com/feed/", Comments: "Houston", Language: "en", Status: "active", Tier: 2},
+	{ID: "d076e128d597834f", URL: "https://english.newstracklive.com/rss-feed/latest-news.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "5abca495e843a922", URL: "http://theprovince.com/rss", Comments: "Vancouver", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c6ff908c44b6263", URL: "https://www.fox13now.com/news/national-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1d71a74ecfbb4d76", URL: "https://kmch.com/feed/", Comments: "Iowa", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ff77a02ee4e07ad5", URL: "https://41nbc.com/feed", Comments: "Macon, GA", Language: "en", Status: "active", Country: "GA", Tier: 2},
+	{ID: "239066c7a504bf85", URL: "https://bromsgrovestandard.co.uk/rss-feed/", Comments: "Bromsgrove, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "bb4dec8fbf231fe3", URL: "https://wicklownews.net/feed/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "d8876ff2b1519490", URL: "https://www.independent.ie/irish-news/rss/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "6484f8eee7bbb97c", URL: "https://www.atlanticcouncil.org/region/north-africa/feed/", Comments: "North Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "32c0b37c34ed5464", URL: "https://americanmilitarynews.com/category/featured/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce74f248dab1c2e6", URL: "https://www.thesun.co.uk/news/uknews/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7dc12fbb51909c0b", URL: "https://www.redbluffdailynews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a2f5c88405edb840", URL: "https://zeenews.india.com/rss/india-news.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "af8c5824e82c8913", URL: "https://time.news/feed/atom/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6cc9151d12f60ff8", URL: "https://www.dailymail.co.uk/news/tfl/index.rss", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "66ca343f32a261ca", URL: "https://www.nbc5.com/investigations/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "377f317f071f0f52", URL: "https://www.radionz.co.nz/rss/national.xml", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "713a100abb49c0fb", URL: "http://bernews.com/tag/weather/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "b85872773bbd90ff", URL: "https://nunatsiaq.com/region/nunavut/feed/", Comments: "Nunavut, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "7ea82b37fd3cb19f", URL: "https://www.10news.com/sitemap.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "5185c221d89c0790", URL: "https://www.observer24.com.na/feed/", Comments: "Namibia", Language: "en", Status: "active", Country: "NA", Tier: 2},
+	{ID: "8a57e3af2a1d0743", URL: "https://news.smh.com.au/rss/environment.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "970bf584efb824e3", URL: "https://www.kenyans.co.ke/feeds/news", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "717da6b5106ec29a", URL: "https://www.rawstory.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e414e0dff7e0597f", URL: "https://www.arlingtoncardinal.com/feed/", Comments: "Arlington Heights, IL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "db7e12131c865000", URL: "https://www.dailymail.co.uk/news/spain/index.rss", Comments: "Spain", Language: "en", Status: "active", Country: "ES", Tier: 2},
+	{ID: "932e49fbba0c3a47", URL: "https://www.lex18.com/sitemap.rss", Comments: "Lexington, KY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b7fe59b104d058a5", URL: "https://www.euroweeklynews.com/feed/", Comments: "European", Language: "en", Status: "active", Tier: 2},
+	{ID: "3efbe4fbad7ecec3", URL: "http://feeds.skynews.com/feeds/rss/home.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "771d31ee480a07dc", URL: "https://www.daily-sun.com/rss.xml", Comments: "Bangladesh", Language: "en", Status: "active", Country: "BD", Tier: 2},
+	{ID: "3ae9354f8060ac65", URL: "https://english.khabarhub.com/category/news-2/feed/", Comments: "Nepal", Language: "en", Status: "active", Country: "NP", Tier: 2},
+	{ID: "3d022f00ba048c69", URL: "https://winnipegsun.com/feed", Comments: "Winnipeg", Language: "en", Status: "active", Tier: 2},
+	{ID: "18b5cc9016a7957b", URL: "https://www.navaltoday.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "223410d2131257d2", URL: "https://telanganatoday.com/telangana/feed", Comments: "Telangana, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "711722276464cbc3", URL: "https://www.thesouthernreporter.co.uk/rss", Comments: "Scottish Borders", Language: "en", Status: "active", Tier: 2},
+	{ID: "fa55bc9feffeb135", URL: "https://www.santacruzsentinel.com/local-news/feed/", Comments: "Santa Cruz", Language: "en", Status: "active", Tier: 2},
+	{ID: "61c417b121f1b812", URL: "https://bernews.com/category/environment/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "ef33340458f88c00", URL: "https://www.dailymail.co.uk/news/headlines/index.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "e8e51a0a8fd6a0ff", URL: "http://www.dailyrecord.co.uk/rss.xml", Comments: "UK, Scottish", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ea2a80d3fa8b2d6b", URL: "http://feeds.bbci.co.uk/news/world/middle_east/rss.xml", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "e5e75f824eb0b36c", URL: "https://www.londonworld.com/rss", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "fe54a09e6f7ca406", URL: "https://www.essexlive.news/news/essex-news/rss.xml", Comments: "Essex, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d0c755dd6b73eb2b", URL: "https://www.radiofree.org/category/sources/the-intercept/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "5b7fb96ae09fd60e", URL: "https://www.850wftl.com/feed/", Comments: "Ft. Lauderdale", Language: "en", Status: "active", Tier: 2},
+	{ID: "35bc0c2c61097d63", URL: "https://www.wwaytv3.com/category/local/feed/", Comments: "Wilmington, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0f82d1fc7bd91828", URL: "https://www.nbc4.com/investigations/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "207f60862765b2ee", URL: "https://www.startribune.com/local/index.rss2", Comments: "Minneapolis", Language: "en", Status: "active", Tier: 2},
+	{ID: "bbde2d0b912450a8", URL: "https://www.civilbeat.org/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1689ca4179961ca4", URL: "https://www.cbsnews.com/boston/latest/rss/main", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "aba5df90c1581a9a", URL: "https://www.news5cleveland.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "530df501c27367d4", URL: "https://english.newstracklive.com/rss-feed/delhi.xml", Comments: "Delhi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "42234703b75c5d3a", URL: "https://columbiagreene.dailyvoice.com/rss/", Comments: "Columbia County, NY, Greene County, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e8e51a0a8fd6a0ff", URL: "https://www.dailyrecord.co.uk/rss.xml", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "26e51f2320351cc8", URL: "https://www.reviewjournal.com/rss", Comments: "US, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "39202d211c3397f2", URL: "https://www.thestar.co.uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "44d0c4689feb7765", URL: "https://mynorthwest.com/category/national/feed/", Comments: "US, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bf20369301593abe", URL: "https://www.thescottishsun.co.uk/news/us-news/feed/", Comments: "Scottish", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "41b4c883ebd95898", URL: "https://www.japantoday.com/category/world/feed/atom", Comments: "Japan, global", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "48a6bcb3524ed085", URL: "https://www.ocregister.com/feed/", Comments: "Orange County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "eee586603542cfe1", URL: "https://independent.ng/category/breaking-news/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "5f17315dade32106", URL: "https://www.reporterherald.com/feed/", Comments: "Loveland", Language: "en", Status: "active", Tier: 2},
+	{ID: "f65c853eb10cba0c", URL: "https://theglobalherald.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cdba92ecf263c0cf", URL: "https://www.thebatavian.com/rss.xml", Comments: "Batavia, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f92b25194a5b4b5d", URL: "https://www.koaa.com/news/national-world-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dff3c12e5b62cf1c", URL: "https://www.indiatvnews.com/rssnews/topstory.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "3908b3b62d37be81", URL: "https://www.taipeitimes.com/xml/index.rss", Comments: "Taiwan", Language: "en", Status: "active", Country: "TW", Tier: 2},
+	{ID: "b37f2cafe5c054b9", URL: "http://www.abc.net.au/news/feed/51120/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "0404baa9dbc7e157", URL: "https://www.newindianexpress.com/Nation/rssfeed/?id=170&getXmlFeed=true", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "69230b65ddcf5588", URL: "https://www.nbcmiami.com/news/local/?rss=y", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "5a187bb510dfa9b1", URL: "https://www.loscerritosnews.net/category/statewide-news/feed/", Comments: "California, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "548814b64ca352ee", URL: "https://www.arlingtoncardinal.com/category/crime-alerts/crimes-notebook/crime-blog-c/feed/", Comments: "Arlington Heights, IL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "656db91183579435", URL: "https://triblive.com/feed/", Comments: "Pittsburgh", Language: "en", Status: "active", Tier: 2},
+	{ID: "136d7e6daf95a045", URL: "https://manchesterinklink.com/feed/", Comments: "Manchester, NH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ba971af81b51043d", URL: "https://wflaorlando.iheart.com/topic/national-news.rss/", Comments: "Orlando", Language: "en", Status: "active", Tier: 2},
+	{ID: "c151e52f465c181d", URL: "https://www.fox4now.com/news.rss", Comments: "Fort Myers, Naples", Language: "en", Status: "active", Tier: 2},
+	{ID: "f137699d824f1d4e", URL: "https://whdh.com/feed/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "bc3ea3c118028834", URL: "https://guyanatimesgy.com/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "e02afe03b6d469a9", URL: "https://www.dailymail.co.uk/news/maryland/index.rss", Comments: "Maryland", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dfb307457c1b0eb2", URL: "https://www.shorenewsnetwork.com/category/news-by-relevance/top-headlines/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "820aae5fca98b08c", URL: "https://www.breakingnews.ie/feed/all.rss", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "99a01dbb8ab14245", URL: "https://www.echolive.ie/feed/395-EE_Top_news.xml", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "86caa62fefd3ca57", URL: "https://www.independent.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "a92192eddcd0f5ca", URL: "https://www.atlanticcouncil.org/issue/security-partnerships/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "089526cd7163f5b6", URL: "https://www.thesundaily.my/rss/home", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "83a5727f5d0d5ecf", URL: "https://www.dailymail.co.uk/news/asia/index.rss", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "342ba734edb7e24f", URL: "https://www.dailymail.co.uk/news/delaware/index.rss", Comments: "Delaware", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "00b3c4110cfaac62", URL: "https://windsorstar.com/category/news/local-news/feed.xml", Comments: "Windsor, ON", Language: "en", Status: "active", Tier: 2},
+	{ID: "1f6fc2ee61b704ea", URL: "https://townlift.com/category/top-stories/feed/", Comments: "Park City, UT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3151800162740a27", URL: "https://wkvi.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "03003d70d7ccefe9", URL: "http://feeds.bbci.co.uk/news/world/latin_america/rss.xml", Comments: "Latin America", Language: "en", Status: "active", Tier: 2},
+	{ID: "41a0aca6b38f0966", URL: "https://www.theage.com.au/rss/national.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "6bcea50d85081978", URL: "https://searchlight.vc/searchlight/feed/", Comments: "St. Vincent and Grenadines, global", Language: "en", Status: "active", Country: "VC", Tier: 2},
+	{ID: "22092e41191da5bc", URL: "https://www.dailymail.co.uk/news/arkansas/index.rss", Comments: "Arkansas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9696aa3c47072fa6", URL: "https://www.nbcnewyork.com/tag/russia-ukraine-war/feed/", Comments: "Russia, Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "9f5ef87caa111883", URL: "http://www.sott.net/xml_engine/signs_rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c0a0fc8aaddc8d2", URL: "https://www.click2houston.com/arc/outboundfeeds/rss/category/news/local/?outputType=xml&size=10", Comments: "Houston", Language: "en", Status: "active", Tier: 2},
+	{ID: "1fc7cd22dc0127ee", URL: "https://www.glasgowlive.co.uk/rss.xml", Comments: "Glasgow", Language: "en", Status: "active", Tier: 2},
+	{ID: "cbfc13558640894d", URL: "https://www.atlanticcouncil.org/issue/defense-policy/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "b22f7b0d69a8f1b8", URL: "https://kenyagist.com/feed", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "02387be36fca47c4", URL: "https://nuclear-news.net/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3122e1b74e252de", URL: "https://ktla.com/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "5d3b465e1a482a3d", URL: "https://cpj.org/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "bd146689a4a4e04b", URL: "https://www.dailyliberal.com.au/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "279c972e2fd13db5", URL: "https://www.atlanticcouncil.org/issue/rule-of-law/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "dfb2802953a38116", URL: "https://www.thejournal.ie/category/irish/feed/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "32af5bc9c247369b", URL: "http://www.reformer.com/search/?f=rss&t=article&c=local-news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "f2466cd4479557e5", URL: "https://www.dailypost.co.uk/in-your-area/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d9af637c9e9d0080", URL: "https://globalvoices.org/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a427f6335642c62f", URL: "https://www.popville.com/feed/", Comments: "Popville (Washington D.C. area)", Language: "en", Status: "active", Tier: 2},
+	{ID: "8af6ed2376f6b5da", URL: "https://www.fbcnews.com.fj/section/news/feed/", Comments: "Fiji", Language: "en", Status: "active", Country: "FJ", Tier: 2},
+	{ID: "e1105c8d1a5ce5a8", URL: "https://www.poandpo.com/rss-news/", Comments: "PoandPo", Language: "en", Status: "active", Tier: 2},
+	{ID: "0b856b5170ec4205", URL: "https://www.pressandjournal.co.uk/category/news/highlands-islands/feed/", Comments: "Highlands & Islands (Scotland)", Language: "en", Status: "active", Tier: 2},
+	{ID: "86caa62fefd3ca57", URL: "https://independent.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "22c16b839072aadb", URL: "https://www.fox13now.com/news.rss", Comments: "Salt Lake City, United States", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0caed157a2d7a308", URL: "https://www.theglobeandmail.com/arc/outboundfeeds/rss/category/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d150df2954c2f1f", URL: "https://www.armytimes.com/arc/outboundfeeds/rss/?outputType=xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "f51bb485cdb4b58a", URL: "https://www.getwestlondon.co.uk/rss.xml", Comments: "West London", Language: "en", Status: "active", Tier: 2},
+	{ID: "e24f709b2bfe48f2", URL: "https://townlift.com/category/news/feed/", Comments: "TownLift", Language: "en", Status: "active", Tier: 2},
+	{ID: "18895e6609b38749", URL: "https://www.newsletter.co.uk/news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1302aba91152482c", URL: "https://feeds.feedburner.com/indybay/newswire", Comments: "Indybay", Language: "en", Status: "active", Tier: 2},
+	{ID: "85ebebec334428f6", URL: "https://www.fbcnews.com.fj/section/world/feed/", Comments: "Fiji", Language: "en", Status: "active", Country: "FJ", Tier: 2},
+	{ID: "e34174cd1dc16141", URL: "https://nehandaradio.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "70e96ad81192d2a7", URL: "https://midjersey.news/category/state-of-nj/feed/", Comments: "New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8b36a95bd2d83660", URL: "https://www.irishexaminer.com/feed/560-munster_rss_summary.xml", Comments: "Munster, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "ed1a2e4f64910c24", URL: "https://www.wtvq.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9e697a0efb1eee87", URL: "http://feeds.skynews.com/feeds/rss/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c4d72d58bd17a206", URL: "https://www.watoday.com.au/rss/world.xml", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "6c17e03b40c04d1e", URL: "https://www.nbcbayarea.com/news/local/feed/", Comments: "Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "5a6b445dba6b78ba", URL: "https://www.coventrytelegraph.net/rss.xml", Comments: "Coventry, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "82fa69661ee0258f", URL: "https://www.laurinburgexchange.com/feed", Comments: "Laurinburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "b487a012c8fc75a3", URL: "https://pajhwok.com/category/world-news/feed/", Comments: "Afghanistan, region", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "a57d9127d7adddb0", URL: "http://www.northdevonjournal.co.uk/rss.xml", Comments: "North Devon, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8382329dd327b74a", URL: "https://nation.cymru/feed/", Comments: "Nation.Cymru (Wales)", Language: "en", Status: "active", Tier: 2},
+	{ID: "976f96c6072be776", URL: "https://www.wmar2news.com/index.rss", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "0a83b76e9fc060de", URL: "https://feeds.feedburner.com/ndtvnews-india-news", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b517b85843bb0bf6", URL: "https://www.theeastafrican.co.ke/rss.xml", Comments: "The East African, East Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "a461a02bf5082a97", URL: "https://www.newindianexpress.com/Cities/Thiruvananthapuram/rssfeed/?id=184&getXmlFeed=true", Comments: "Thiruvananthapuram, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "df15e872bc197f58", URL: "https://coyotegulch.blog/category/colorado-water/infrastructure/feed/", Comments: "Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "07592aa3620f56f6", URL: "https://www.energeticcity.ca/feed/", Comments: "Energetic City (Canada)", Language: "en", Status: "active", Tier: 2},
+	{ID: "2997db49a2fe441c", URL: "https://globalnews.ca/category/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "dbfd1bc0b0350f2f", URL: "https://allafrica.com/tools/headlines/rdf/eastafrica/headlines.rdf", Comments: "East Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "cc73785b8eee0ada", URL: "http://www.mirror.co.uk/rss.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "d0ea67df202b94e1", URL: "https://www.news4jax.com/arc/outboundfeeds/rss/category/news/local/?outputType=xml&size=10", Comments: "Jacksonville, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3814f39cd7e05809", URL: "https://calgarysun.com/feed/", Comments: "Calgary, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "cb86c14738cb9592", URL: "https://www.stokesentinel.co.uk/news/rss.xml", Comments: "Stoke-on-Trent, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "35a6ffc53ebb7acb", URL: "https://cmp.breakingnews.ie/feed/all.rss", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "3121c0ba35984595", URL: "https://www.newsday.com/api/rss/recent", Language: "en", Status: "active", Tier: 2},
+	{ID: "21dec4f4a698eca3", URL: "https://www.thedenverchannel.com/news/crime.rss", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "a72831d5435c28fc", URL: "https://www.newsletter.co.uk/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c985507721e70e6", URL: "https://feeds.feedburner.com/NewsFetchers", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "362bc87e4d11ef65", URL: "https://www.newsofthearea.com.au/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0bd2405c92a5e798", URL: "https://www.dublinlive.ie/news/dublin-news/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "38afd4467b4e63d6", URL: "https://www.dailymail.co.uk/news/israel/index.rss", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "e0df979959e976da", URL: "https://www.armaghi.com/feed", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "b98f9aee1c9c42ec", URL: "http://www.bristolpost.co.uk/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "567d21b0242d23a9", URL: "https://www.ukiahdailyjournal.com/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ed790d3ea237f353", URL: "http://feeds.feedburner.com/missourinews", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "69f7b3e7dd23b6b2", URL: "https://www.nbcdfw.com/news/national-international/feed/", Comments: "DFW", Language: "en", Status: "active", Tier: 2},
+	{ID: "c0ce2544a077e341", URL: "http://www.southcoastregister.com.au/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5a547f78858ae4d4", URL: "http://www.nwitimes.com/search/?f=rss&t=article&c=weather&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "358c399d0bf9030f", URL: "https://www.shorenewsnetwork.com/category/local-news/new-york-news/feed/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0d0bb51851c7081e", URL: "https://rss.jpost.com/rss/rssallnews", Comments: "Israel, Jerusalem", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "b0e6853360c87b68", URL: "https://www.kxxv.com/brazos.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ca5bce16bf705044", URL: "https://www.worldradio.ch/news/bitesize-news/feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7815b5c68612abaa", URL: "https://www.thereporter.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "428618978efcdb57", URL: "http://moldova.europalibera.org/api/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2d69c55364a01e00", URL: "https://cheknews.ca/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "beb914f305f4817b", URL: "https://thediplomat.com/category/asean-beat/feed", Comments: "ASEAN", Language: "en", Status: "active", Tier: 2},
+	{ID: "9620b4a16a445dd0", URL: "https://www.denverpost.com/latest-headlines/feed/", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "5cc93e7569d2722d", URL: "https://rss.upi.com/news/news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3a63c37a8a74a12b", URL: "https://www.france24.com/en/africa/rss", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "f8eff8e98e7fbf70", URL: "https://www.krtv.com/news/montana-and-regional-news.rss", Comments: "Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "41eedef7054c21db", URL: "https://westorange.dailyvoice.com/rss/", Comments: "West Orange", Language: "en", Status: "active", Tier: 2},
+	{ID: "959b6888bcb91405", URL: "https://www.shorenewsnetwork.com/category/local-news-nj/new-jersey/south-jersey-news/feed/", Comments: "South Jersey", Language: "en", Status: "active", Tier: 2},
+	{ID: "238254cb75936922", URL: "http://www.croydonadvertiser.co.uk/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "adfc89f6fd18c6b2", URL: "https://www.zimlive.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "3de5ca8f553939d9", URL: "https://englewood.dailyvoice.com/rss/", Comments: "Englewood", Language: "en", Status: "active", Tier: 2},
+	{ID: "020a271e05d96fb8", URL: "https://1061fmtalk.iheart.com/topic/national-news.rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "30362dfc46f78c21", URL: "https://english.alaraby.co.uk/rss.xml?current=/tag/10785/border.", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c2699b0fda4a73ce", URL: "https://www.dublinlive.ie/news/?service=rss", Comments: "Dublin", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea5af35ca058177d", URL: "https://www.newindianexpress.com/Cities/Bengaluru/rssfeed/?id=182&getXmlFeed=true", Comments: "Bengaluru", Language: "en", Status: "active", Tier: 2},
+	{ID: "a16cec21e7834c02", URL: "https://www.ksby.com/news/local-news.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "54e09049f9e99194", URL: "https://www.dailymail.co.uk/news/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c1ff49493d825099", URL: "https://www.ukrinform.net/rss/block-lastnews", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "8d95e74c65b7b9af", URL: "https://www.dailymail.co.uk/news/leeds/index.rss", Comments: "Leeds, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "68a02052dd620152", URL: "https://rssfeeds.timesofoman.com/feed", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "11cebb101f4a7dfa", URL: "https://telanganatoday.com/hyderabad/feed", Comments: "Telangana, Hyderabad, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "1f60a7761fde332f", URL: "https://www.durangoherald.com/local-news", Comments: "Durango", Language: "en", Status: "active", Tier: 2},
+	{ID: "c85a3ffe957d057d", URL: "https://www.the-sun.com/news/us-news/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d66bfc3310762bb7", URL: "https://www.omanobserver.om/rssFeed/17/77", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "cf535f47725fc52c", URL: "https://www.rawstory.com/feeds/us-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "088968045a737ac0", URL: "http://www.malawivoice.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "0c38b0d47225d373", URL: "https://www.dailymail.co.uk/news/adelaide/index.rss", Comments: "Adelaide, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "f556d996d73df4ec", URL: "https://frontline.thehindu.com/feeder/default.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "8d210c52b04618ab", URL: "https://www.katc.com/news/around-acadiana.rss", Comments: "Acadiana", Language: "en", Status: "active", Tier: 2},
+	{ID: "ede78a14a461d170", URL: "https://www.egyptindependent.com/feed/", Comments: "Egypt", Language: "en", Status: "active", Country: "EG", Tier: 2},
+	{ID: "31168d19db8b4e31", URL: "https://www.freerepublic.com/tag/corruption/feed.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5ed167e1d9ca44c5", URL: "https://www.dailymail.co.uk/news/italy/index.rss", Comments: "Italy", Language: "en", Status: "active", Country: "IT", Tier: 2},
+	{ID: "75354fe486591fb1", URL: "https://www.mintpressnews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa7c566edc11f11f", URL: "https://www.dailymail.co.uk/news/articles.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bbeaa9e1a266f323", URL: "https://japantoday.com/feed", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "d65ae4675e9b44f6", URL: "https://allafrica.com/tools/headlines/rdf/northafrica/headlines.rdf", Comments: "North Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "1248ed1720ac8833", URL: "https://www.firemapchicago.net/feeds/posts/default", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "c154664009b6b2b2", URL: "https://en.setopati.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "087f8ac367210890", URL: "https://chicago.suntimes.com/rss/metro-state/index.xml", Comments: "Chicago, Illinois", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "71a588dc479df0f8", URL: "https://www.incpak.com/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "25eda53892180b68", URL: "https://www.wnyc.org/atomfeeds/tags/news", Comments: "NYC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "59567df1e4667576", URL: "https://www.thedenverchannel.com/news/national.rss", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "05ab7b9cd1a648da", URL: "https://radaronline.com/true-crime/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3c0524bc4303a254", URL: "https://makkahnewspaper.com/rssFeed/41", Comments: "Makkah, Saudi Arabia", Language: "en", Status: "active", Country: "SA", Tier: 2},
+	{ID: "02658fd7881379bf", URL: "https://www.manchestereveningnews.co.uk/news/uk-news/rss.xml", Comments: "UK, Manchester", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "eaafab5c21c8d292", URL: "https://www.scottishdailyexpress.co.uk/?service=rss", Comments: "Scotland, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e0b0cf47f167e552", URL: "https://www.stuff.co.nz/rss", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "bfdc38ac0b9ed5bd", URL: "http://guyanachronicle.com/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "21cd027f7d4ce22d", URL: "https://www.france24.com/en/rss", Comments: "France, global", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "6d5af51ac189f0da", URL: "https://rhisac.org/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ae22c68a3b39dce", URL: "https://www.mylondon.news/?service=rss", Comments: "London, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "fa9345efad62fc58", URL: "http://feeds.feedburner.com/alabamawx", Comments: "Alabama", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8eb4f42a6625090b", URL: "https://www.rcmp-grc.gc.ca/en/n/yk", Comments: "Yukon", Language: "en", Status: "active", Tier: 2},
+	{ID: "643279ffbe9aef61", URL: "https://coyotegulch.blog/category/colorado-water/water-pollution/feed/", Comments: "Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6093815adb711242", URL: "https://www.cleveland.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "region", Language: "en", Status: "active", Tier: 2},
+	{ID: "d0d5ccc8457a43af", URL: "https://www.shorenewsnetwork.com/category/news-by-relevance/us-and-world-news/feed/", Comments: "US, World", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "94a46b91377e09ad", URL: "https://www.suchtv.pk/world.html?format=feed&type=atom", Comments: "Pakistan, global", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "3e48ee5e56ef96fc", URL: "https://www.mangalorean.com/agency-news/feed/", Comments: "Mangalore", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea18630afca85e5c", URL: "https://www.dailymail.co.uk/news/chicago/index.rss", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "d260fab43557f875", URL: "https://leadership.ng/nigeria-news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c4c8b383153debe2", URL: "https://www.dailymail.co.uk/news/hong-kong/index.rss", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "6019a09ef07ff3c3", URL: "https://www.breakingnews.ie/feed/world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f95ea7509a54a4e6", URL: "https://english.alaraby.co.uk/rss.xml?current=/node/101", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7353a74a6bc8ee0d", URL: "https://breakingdefense.com/full-rss-feed/?v=2", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a087537bf7005534", URL: "https://www.mercurynews.com/latest-headlines/feed/", Comments: "San Jose, CA, Bay Area", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "94c3f581ca557855", URL: "http://malaysiansmustknowthetruth.blogspot.com/feeds/posts/default", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ccf143b9dd8f0e91", URL: "https://www.fox4now.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f3aef6100fd0caaa", URL: "https://www.krbd.org/feed/", Comments: "Ketchikan, Alaska, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7807d9f5377c6d63", URL: "https://today.rtl.lu/rss/headlines", Comments: "Luxembourg", Language: "en", Status: "active", Country: "LU", Tier: 2},
+	{ID: "11f1231fcb66733e", URL: "https://www.newmarketjournal.co.uk/_api/rss/bury_news_feed.xml", Comments: "Bury St Edmunds, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9429ce84b2494507", URL: "https://greece.greekreporter.com/feed/", Comments: "Greece", Language: "en", Status: "active", Country: "GR", Tier: 2},
+	{ID: "0e4bc815370e2fa4", URL: "https://www.atlanticcouncil.org/region/latin-america/feed/", Comments: "Latin America", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c1ae4b8128c367c", URL: "https://woodradio.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f5631cc05984dfe1", URL: "https://www.euronews.com/rss?level=vertical&name=my-europe", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "458be99098f09936", URL: "https://www.nbcconnecticut.com/news/local/feed/", Comments: "Connecticut, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "153cdde2ee092039", URL: "https://kfyi.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "77daed4d70d9c78e", URL: "https://www.ktvh.com/sitemap.rss", Comments: "Montana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0a897eeb78c0ede6", URL: "https://voanews.com/api/zqbomekvi_", Language: "en", Status: "active", Tier: 2},
+	{ID: "8b8d8e4330175303", URL: "https://www.dailybreeze.com/location/california/los-angeles-county/feed/", Comments: "Los Angeles County, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e65e2db3e092bd81", URL: "https://www.rnz.co.nz/rss/in-depth.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "ed825d5203c0d6fd", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128838597.cms", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "4cada0d968d9e8f8", URL: "https://www.fox4now.com/news/state.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d769175ecd619738", URL: "http://feeds.manilanews.net/rss/3e5bbccc730d258c", Comments: "Manila", Language: "en", Status: "active", Tier: 2},
+	{ID: "db822025c4aae292", URL: "https://www.darkreading.com/rss.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "dac56806c50989ed", URL: "https://www.examiner.co.uk/?service=rss", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "daa650556e59b668", URL: "https://www.voanews.com/api/zjboveytit", Language: "en", Status: "active", Tier: 2},
+	{ID: "e4ae54a01ce50c52", URL: "https://feeds.feedburner.com/hiiraan/VzqE", Comments: "Somalia", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "c2881d2e986e0d05", URL: "https://www.dailycamera.com/news/feed/", Comments: "Boulder, Colorado, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c3a968006bf81507", URL: "https://www.bostonherald.com/news/feed/", Comments: "Boston, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "66612abed7fec51d", URL: "https://localnews8.com/category/news/crime-tracker/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "af720d16caf5bf11", URL: "https://wdef.com/feed/", Comments: "Chattanooga, Tennessee, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2a45315ca6d3f2c1", URL: "https://www.dailymail.co.uk/news/queensland/index.rss", Comments: "Queensland, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "305f1dff836d276c", URL: "https://www.lpm.org/news.rss", Comments: "Louisville, KY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0a851f688864eb0c", URL: "https://torontosun.com/feed", Comments: "Toronto, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "dd0150756241c61d", URL: "http://www.wtvq.com/category/local-news/feed", Comments: "Lexington, Kentucky, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0e3f25661e6a0e2e", URL: "https://globalnews.ca/world/feed/", Comments: "global, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "d6e4596c71612818", URL: "https://www.nbcphiladelphia.com/?rss=y", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "ceb816d54c6484e9", URL: "http://feeds.bbci.co.uk/news/world/us_and_canada/rss.xml", Comments: "US, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "289f2655b5cc0340", URL: "http://english.palinfo.com/feed", Comments: "Palestine", Language: "en", Status: "active", Country: "PS", Tier: 2},
+	{ID: "6746c0e50d62e877", URL: "https://www.staradvertiser.com/category/breaking-news/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5505c5f015c7e376", URL: "https://kion546.com/category/news/santa-cruz-county/feed/", Comments: "Santa Cruz County", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f9f7b73caf85a00", URL: "https://www.canyon-news.com/feed", Comments: "Canyon", Language: "en", Status: "active", Tier: 2},
+	{ID: "a8de0cba6f5239ea", URL: "https://www.stokesentinel.co.uk/rss.xml", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c92decb1a4f1ee2", URL: "https://english.alaraby.co.uk/rss.xml?current=/homepage", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "86a69f7fce97e09a", URL: "https://www.portlandmercury.com/portland/Rss.xml?section=17527564", Comments: "Portland", Language: "en", Status: "active", Tier: 2},
+	{ID: "419026681dda1a6f", URL: "https://www.cbsnews.com/chicago/latest/rss/main", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "02940df65bc881dd", URL: "https://www.yorkshirepost.co.uk/news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "96b7e82148f0279c", URL: "https://www.rferl.org/api/zviipeojip", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "20b54eceedbf3354", URL: "https://thediplomat.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "70dc8bc909005d14", URL: "https://maribyrnonghobsonsbay.starweekly.com.au/feed/", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "bc1957aa31ab35e3", URL: "https://losalamosreporter.com/category/environment/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f935b0be2cdabc44", URL: "https://eurasiantimes.com/category/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f540917ba9f027f4", URL: "https://www.loscerritosnews.net/category/city-news/feed/", Comments: "local city", Language: "en", Status: "active", Tier: 2},
+	{ID: "67ed31a7371f0327", URL: "https://www.dailymail.co.uk/news/iraq/index.rss", Comments: "Iraq", Language: "en", Status: "active", Country: "IQ", Tier: 2},
+	{ID: "9cfcd03033005cf0", URL: "https://www.dailymail.co.uk/news/dubai/index.rss", Comments: "Dubai", Language: "en", Status: "active", Tier: 2},
+	{ID: "31c2cbc13f82bc46", URL: "https://www.latestnigeriannews.com/feed/premiumtimes/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "8cbc9f791c57f380", URL: "https://deccanchronicle.com/rss_feed/?subsectionId=13", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b6c9066e02a41c98", URL: "https://newsroomodisha.com/category/world/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b3fbc2a201ec224c", URL: "https://dailynews.co.zw/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "a65cd5cc1559f9a6", URL: "https://jerseyeveningpost.com/category/morenews/worldnews/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "84009843a7a236ed", URL: "https://wsvn.com/tag/trending/feed/", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "46d11a7b8d25cb2f", URL: "https://www.cheshire-live.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "07a65f81c4f1ccb2", URL: "http://www.asianews.it/en.xml", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "57feac1e9bc2a1db", URL: "https://www.newstalkzb.co.nz/news/world/rssfeed", Comments: "New Zealand, global", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "46985dd7f624d4a4", URL: "https://adams.dailyvoice.com/rss/", Comments: "Adams County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b2fdb64085f473c0", URL: "https://nuclear-news.net/category/1-nuclear-issues/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0e661e83db1cc4fb", URL: "http://winnipegsun.com/rss", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "8114604373ff33af", URL: "https://en.shafaqna.com/category/middle-east/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "9e618f3803251bf5", URL: "https://www.miltonkeynes.co.uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "345c864f7901bc3d", URL: "https://www.newstalkzb.co.nz/news/crime/rssfeed", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "15faf692c98736d5", URL: "https://www.nbcbayarea.com/tag/contra-costa-county/feed/", Comments: "Local Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "cdd3f43daf0c605a", URL: "https://www.theyeshivaworld.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce7c603c374b36a7", URL: "http://www.police.govt.nz/rss.xml", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "005685032845e662", URL: "https://www.nbcsandiego.com/?rss=y", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3c38b10de2663871", URL: "https://www.abc15.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c0151057f7de9372", URL: "https://www.denverpost.com/news/feed/", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "0bf2d300e84df4d0", URL: "https://telanganatoday.com/news/feed", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "cd5900c74840da10", URL: "https://www.independent.ie/regionals/dublin/rss/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "8a291b7be236c969", URL: "https://home.nzcity.co.nz/rss/news.aspx?catid=977", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "87cb00eb97a62792", URL: "https://www.durangoherald.com/feeds/local-news", Comments: "Durango", Language: "en", Status: "active", Tier: 2},
+	{ID: "5982344e7b88e019", URL: "http://world.kbs.co.kr/rss/rss_news.htm?lang=e", Comments: "Korea", Language: "en", Status: "active", Country: "KR", Tier: 2},
+	{ID: "3ae6ecd631d73dfb", URL: "https://www.mylondon.news/news/?service=rss", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "8abd7df5c7398b06", URL: "https://710wor.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5a9d3fe05f99d9bf", URL: "http://foreignpolicy.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "326be0674120797c", URL: "https://www.whittierdailynews.com/feed/", Comments: "Whittier", Language: "en", Status: "active", Tier: 2},
+	{ID: "cbc2aa1573ed500e", URL: "https://www.somersetlive.co.uk/in-your-area/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "078add7ae22e5fe7", URL: "http://rss.newser.com/rss/section/3.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0d62891a5a28e415", URL: "https://www.sbsun.com/feed/", Comments: "San Bernardino", Language: "en", Status: "active", Tier: 2},
+	{ID: "7795d7a28f092371", URL: "https://www.wnbc.com/news/local/crime-and-courts/feed/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7b887e3ec66816e0", URL: "https://metro.co.uk/tag/london/feed/", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "68587e42ce8917f7", URL: "https://abc7.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7495db59c468fb34", URL: "https://upstract.com/feed/news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f74f688eb02565a2", URL: "https://www.dailymail.co.uk/news/connecticut/index.rss", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1f931277435c3511", URL: "http://www.sanpedrosun.com/feed/", Comments: "San Pedro", Language: "en", Status: "active", Tier: 2},
+	{ID: "ba515f678b675c7b", URL: "https://www.defenseworld.net/category/air/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4cbf660076ce9957", URL: "https://www.statter911.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7fde4dc0db3d72a5", URL: "http://www.inklingsnews.com/feed/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5b0bc685dac3a94a", URL: "https://www.naturalnews.com/feedlyrss.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "4119a074a7e80c6e", URL: "https://en.mynewsne.com/category/big-story/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b7097abfcf8326d8", URL: "https://www.searchlight.vc/searchlight/news/feed/", Comments: "St. Vincent and the Grenadines", Language: "en", Status: "active", Tier: 2},
+	{ID: "ad99cb542eb432c3", URL: "https://www.lincolnshirelive.co.uk/rss.xml", Comments: "Lincolnshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c1a8277d1b484a63", URL: "http://feeds.newyorkstatesman.com/rss/e8d88d4b9a8be96b", Comments: "New York State", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9d8376b16bc690e6", URL: "https://www.fairobserver.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6370392bcd8c8d01", URL: "https://www.newyorkupstate.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "upstate New York", Language: "en", Status: "active", Tier: 2},
+	{ID: "4206367cf94926cb", URL: "https://www.nbc5.com/news/national-international/changing-climate/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "252b9d12a6b961ea", URL: "https://www.ksat.com/arc/outboundfeeds/rss/category/news/national/?outputType=xml&size=10", Comments: "KSAT", Language: "en", Status: "active", Tier: 2},
+	{ID: "efa1a7a5da9517ca", URL: "https://norwalk.dailyvoice.com/rss/", Comments: "Norwalk, Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a1f99563c03ca5cd", URL: "https://www.sandiegouniontribune.com/news.rss", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "2d0eea4037566e9f", URL: "https://www.galwaydaily.com/feed/", Comments: "Galway, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "03d84299aa90ca68", URL: "https://asiapacificreport.nz/category/pacific-report/feed/", Comments: "Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "aea584a901aa0aec", URL: "https://www.shorenewsnetwork.com/category/news/ocean-county-news-community/feed/", Comments: "Ocean County, New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "821843d609ab82e7", URL: "https://www.dailymail.co.uk/news/minnesota/index.rss", Comments: "Minnesota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "585daecc103bab5a", URL: "https://www.glasgowlive.co.uk/news/rss.xml", Comments: "Glasgow, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "76fef6bcb73886c0", URL: "https://www.belfastlive.co.uk/rss.xml", Comments: "Belfast, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "38c2eeba4c8a1e7b", URL: "https://www.trentonian.com/feed/", Comments: "Trenton", Language: "en", Status: "active", Tier: 2},
+	{ID: "f19d735cc0bc00c0", URL: "https://southernmarylandchronicle.com/feed/", Comments: "Southern Maryland", Language: "en", Status: "active", Tier: 2},
+	{ID: "622997174c070dff", URL: "https://feeds.breakingnews.ie/bnireland", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "87ec2a1b1310a1d9", URL: "https://theweek.com/rss.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ea7d1565ae38d60", URL: "https://feeds.feedburner.com/cumbriacracknews?format=xml", Comments: "Cumbria, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a9d67a756d03e9f4", URL: "https://alerts.weather.gov/cap/us.php?x=0", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "db70a4a2f9655ce7", URL: "https://telanganatoday.com/feed", Comments: "Telangana, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "b5e7ceaaf4557423", URL: "http://www.odt.co.nz/news/feed", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "2b0203906b2138ee", URL: "https://kfiam640.iheart.com/topic/national-news.rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3c1b1911da75300", URL: "https://www.theguardian.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6fad7c27440b8bb", URL: "https://www.aljazeera.com/xml/rss/all.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "596b0ab345ccb1ce", URL: "https://www.thenews.com.pk/rss/1/4", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "492426fede63322c", URL: "https://www.somersetlive.co.uk/rss.xml", Comments: "Somerset, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4a2682395b107b26", URL: "https://www.redlandsdailyfacts.com/news/feed/", Comments: "Redlands, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8e546291da083a65", URL: "https://feeds.feedburner.com/dnlatest", Comments: "Dominican Republic", Language: "en", Status: "active", Country: "DO", Tier: 2},
+	{ID: "5d3f23101e798c26", URL: "https://www.fox7austin.com/rss/category/news", Comments: "Austin", Language: "en", Status: "active", Tier: 2},
+	{ID: "644ff80fccb734c1", URL: "https://www.arabnews.pk/taxonomy/term/3/feed", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "c38c1dc3ad846224", URL: "https://katc.com/sitemap.rss", Comments: "Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a51934ad530fc82a", URL: "https://wtmj.com/category/homepage-showcase/feed/", Comments: "Milwaukee", Language: "en", Status: "active", Tier: 2},
+	{ID: "f0a0acbf9092f393", URL: "https://www.sunstar.com.ph/api/v1/collections/home.rss", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "2c289230dd3152cb", URL: "http://www.grimsbytelegraph.co.uk/rss.xml", Comments: "Grimsby, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4ac49af9c08fe6da", URL: "https://www.radiofree.org/tag/climate/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bd561ccb32b8c983", URL: "https://www.latestnigeriannews.com/feed//rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "1f93cfcb6a8f5773", URL: "https://www.2oceansvibe.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce9b43d0b79f198e", URL: "https://cananewsonline.com/main/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5fbd095206f97f7c", URL: "https://www.irishexaminer.com/feed/500-courtandcrime.xml", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "e9e9e0a6ee8a159e", URL: "https://rss.hiiraan.com/rss.xml", Comments: "Somalia, East Africa", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "6207f9dcc0ddd6bd", URL: "https://abc30.com/feed/", Comments: "Fresno, Visalia", Language: "en", Status: "active", Tier: 2},
+	{ID: "349839ab88fbdc04", URL: "https://timesofindia.indiatimes.com/rssfeedmostrecent.cms", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "f10b43d68e91ff2f", URL: "https://wtmj.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6930587a0e1cc438", URL: "https://japantoday.com/feed/atom", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "1cd629b180e949a4", URL: "https://www.reviewjournal.com/news/feed/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "dd91667f444d5de5", URL: "https://www.ugstandard.com/feed/rss/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "b76d52be830c942f", URL: "https://www.nbcdfw.com/?rss=y", Comments: "Dallas-Fort Worth", Language: "en", Status: "active", Tier: 2},
+	{ID: "f3eb08f054056cbf", URL: "https://www.motherjones.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "91b310628f255c93", URL: "https://www.reviewjournal.com/crime/feed/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "7cafdcf031259e7c", URL: "https://www.africanews.com/feed/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "69413ee3038d4cc0", URL: "https://www.kztv10.com/index.rss", Comments: "Corpus Christi", Language: "en", Status: "active", Tier: 2},
+	{ID: "050dcb1793b916b2", URL: "https://abc17news.com/category/news/jefferson-city/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "3fce00c3e249b137", URL: "http://pointblanknews.com/pbn/feed/atom/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "7226a1a6a41c501b", URL: "https://www.dailymail.co.uk/news/portland/index.rss", Comments: "Portland, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a3800bb4d3259b95", URL: "https://moxie.foxnews.com/google-publisher/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c54bcb45530f057", URL: "https://bolnews.com/feed", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "c9f03fc6e2c72b72", URL: "https://nysun.com/rss/Allarticle.xml", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "78f6d8beb01d9586", URL: "https://1450wkip.iheart.com/topic/national-news.rss/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f42602e5f9ee8d32", URL: "https://www.atlanticcouncil.org/issue/energy-environment/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "b2ff0f88bf7af44b", URL: "https://www.chroniclelive.co.uk/news/uk-news/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "743b6f461ca90adb", URL: "https://nigerianbulletin.com/forums/metro-news.4/index.rss", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "6b366ffe85384887", URL: "https://cebudailynews.inquirer.net/feed", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "7b8e92fe00b5ec66", URL: "https://www.amnesty.org/en/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bf550b64dc1c453e", URL: "https://www.ocregister.com/news/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "483952488898098c", URL: "https://time.news/tag/palestine/feed/", Comments: "Palestine", Language: "en", Status: "active", Country: "PS", Tier: 2},
+	{ID: "ba4e02218d0894e6", URL: "https://www.2oceansvibe.com/category/world/feed/", Comments: "South Africa, global", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "40febd8b1eb0443d", URL: "https://www.oyetimes.com/category/news/feed", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce6353c925531582", URL: "https://www.khaama.com/category/world/us-canada/feed/", Comments: "Afghanistan, US, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "6df28acba745f95f", URL: "https://www.sundayworld.com/news/irish-news/rss/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "2c423ea51bcbe41e", URL: "https://www.cbsnews.com/latest/rss/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "66e46d43af851cf0", URL: "http://allafrica.com/tools/headlines/rdf/nigeria/headlines.rdf", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "80c21fa18fe320ea", URL: "https://www.reviewjournal.com/feed/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "edac0aadd1eaebe3", URL: "https://pulse.ncpolicywatch.org/category/news/environment/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "c80887c93c55c60f", URL: "https://eurasiantimes.com/category/americas/feed/", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "a75a16efc426e815", URL: "http://abc7news.com/feed/", Comments: "California, Bay Area", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "41f69036466cf3af", URL: "https://www.mindanews.com/category/top-stories/feed/", Comments: "Mindanao", Language: "en", Status: "active", Tier: 2},
+	{ID: "b5953aee56ec2020", URL: "https://www.kpq.com/feed/", Comments: "Washington State", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "25ccd02b59373707", URL: "https://rss.jpost.com/rss/rssfeedsiran", Comments: "Jerusalem, Iran", Language: "en", Status: "active", Country: "IR", Tier: 2},
+	{ID: "77aba330e2cb9e83", URL: "https://dailyguidenetwork.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "6468c7713d4f61cc", URL: "https://www.kivitv.com/news/state.rss", Comments: "Idaho", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2555c19c1b76070a", URL: "https://thenationonlineng.net/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "e0c1c357d09bc43d", URL: "https://thehill.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dc918c1426a3d6b4", URL: "https://www.voa.gov/api/zobo_egviy", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0c1052ac4df65aa7", URL: "https://feeds.feedburner.com/ieworld", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c2f02029895f34cd", URL: "https://www.wkbw.com/news/national.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0908a4fa40764c67", URL: "https://www.scmp.com/rss/318199/feed", Comments: "China", Language: "en", Status: "active", Country: "CN", Tier: 2},
+	{ID: "30b8c50e3f9dbdd1", URL: "https://kesq.com/category/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8909f1d9793d4493", URL: "http://www.adaderana.lk/rss.php", Comments: "Sri Lanka", Language: "en", Status: "active", Country: "LK", Tier: 2},
+	{ID: "ec98438189d45c34", URL: "https://www.rferl.org/api/zpiire-gir", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6eddbfdc28d0c086", URL: "http://www.news18.com/rss/india.xml", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "a1bcd66f8b5af8de", URL: "https://en.prothomalo.com/feed/", Comments: "Bangladesh", Language: "en", Status: "active", Country: "BD", Tier: 2},
+	{ID: "07a65f81c4f1ccb2", URL: "https://www.asianews.it/en.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "53b32fbb339836fe", URL: "https://www.nbcnewyork.com/news/local/crime-and-courts/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "66304dc77ddf509e", URL: "http://feeds.skynews.com/feeds/rss/uk.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "18034346603f013b", URL: "https://www.france24.com/en/europe/rss", Comments: "France, Europe", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "5c30934e7b8cf7b1", URL: "https://www.gdacs.org/xml/rss_eq_48h_low.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "516890d1ce5d044b", URL: "https://www.dailymail.co.uk/news/germany/index.rss", Comments: "Germany", Language: "en", Status: "active", Country: "DE", Tier: 2},
+	{ID: "d8a62c4cced5e058", URL: "https://timesofoman.com/feed/", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "c66c98b66555bfb7", URL: "http://www.brisbanetimes.com.au/rss/environment.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1810b60f34289982", URL: "https://www.anews.com.tr/rss/world.xml", Comments: "Turkish", Language: "en", Status: "active", Country: "TR", Tier: 2},
+	{ID: "e57fbb39a9851db2", URL: "https://www.dailymail.co.uk/wires/index.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "7795d7a28f092371", URL: "https://wnbc.com/news/local/crime-and-courts/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "420154b5e04cf7d6", URL: "https://feeds.bbci.co.uk/news/world/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b989b10a63b6abd", URL: "http://bernews.com/category/bermuda-news/crime/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "f21bdf8df05733da", URL: "https://www.reviewjournal.com/news/nation-and-world/feed/", Comments: "US, world", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2c289230dd3152cb", URL: "https://www.grimsbytelegraph.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4950b05584128a1c", URL: "https://www.arabnews.com/cat/2/rss.xml", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "027aa6c0e93d07c5", URL: "https://ktrh.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f57c08e3f6f5a7ba", URL: "https://whatsupnewp.com/feed/", Comments: "Newport", Language: "en", Status: "active", Tier: 2},
+	{ID: "62475b10c3b55b40", URL: "https://www.rcmp-grc.gc.ca/en/n/sk", Comments: "Canada, Saskatchewan", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "c07d773436fe0c18", URL: "https://nehandaradio.com/category/news/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "d08deb96de6c9226", URL: "https://www.therakyatpost.com/feed/", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "15cb9c433eabb3b6", URL: "https://www.dailymail.co.uk/news/mostread/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "51e1c259c17093cf", URL: "https://losalamosreporter.com/category/uncategorized/feed/", Comments: "Los Alamos, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "214e6bfe1cf8b395", URL: "https://news.google.com/atom?hl=en-US&gl=US&ceid=US%3Aen&oc=11", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4268bdc7e946e8ce", URL: "https://www.dailymail.co.uk/news/brisbane/index.rss", Comments: "Brisbane, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "1885d3b5bfdb61c1", URL: "https://www.newzimbabwe.com/category/news/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "0e661e83db1cc4fb", URL: "https://winnipegsun.com/rss", Comments: "Winnipeg", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec862737d26cc6df", URL: "https://mybrytfmonline.com/category/top-stories/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "1651c3a217d6321e", URL: "https://wwnc.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "26fef57d147dd443", URL: "https://feeds.skynews.com/feeds/rss/us.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2ed432d386d87376", URL: "https://www.liverpoolecho.co.uk/rss.xml", Comments: "Liverpool", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c4df494c9152bd1", URL: "https://www.donegaldaily.com/category/news/feed/", Comments: "Donegal, Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "acb7809bd17c0195", URL: "https://www.india.com/news/world/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "0ad5178a68e4f171", URL: "http://www.theage.com.au/rss/world.xml", Comments: "Australian, international", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "19d6e6f7f135526b", URL: "https://www.dailymail.co.uk/news/brighton/index.rss", Comments: "Brighton", Language: "en", Status: "active", Tier: 2},
+	{ID: "9857d318700c86ae", URL: "https://www.theage.com.au/rss/feed.xml", Comments: "Australian", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "77e49f75ef5f4831", URL: "https://moxie.foxnews.com/google-publisher/us.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5d11a49533c4d044", URL: "https://www.citywatchla.com/index.php/cw/los-angeles?format=feed&type=rss", Comments: "Los Angeles, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3e13ab58269f11a9", URL: "https://www.morningjournal.com/location/ohio/lorain-county/feed/", Comments: "Lorain County, Ohio, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2fa8208ecd0bf091", URL: "https://www.ksby.com/news/national-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "23d1afb680ed39d6", URL: "https://www.therakyatpost.com/category/news/feed/", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "46865b8acac574fa", URL: "https://www.dailymail.co.uk/news/virginia/index.rss", Comments: "Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "328cf082be56debe", URL: "https://www.citywatchla.com/index.php/cw/los-angeles?format=feed&type=atom", Comments: "Los Angeles, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bf51e0802594446e", URL: "https://www.cbsnews.com/sanfrancisco/latest/rss/main", Comments: "San Francisco", Language: "en", Status: "active", Tier: 2},
+	{ID: "8f0b5269e8610e8f", URL: "https://www.essexlive.news/news/rss.xml", Comments: "Essex", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec99718ac0548686", URL: "https://time.news/tag/middle-east/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "260d3545e159814c", URL: "https://kion546.com/category/news/national-world/feed/", Comments: "US, world", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e9b85b1f4a71a309", URL: "https://www.khaama.com/tag/afghanistan/feed/", Comments: "afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "9d4b170f7076cacc", URL: "https://haitiantimes.com/feed/", Comments: "Haiti", Language: "en", Status: "active", Country: "HT", Tier: 2},
+	{ID: "411f4175ec73c02c", URL: "https://www.latestnigeriannews.com/feed/tribune/rss.xml", Comments: "Niger", Language: "en", Status: "active", Country: "NE", Tier: 2},
+	{ID: "0a125136e7fcf095", URL: "https://www.solacebase.com/feed/", Comments: "Niger", Language: "en", Status: "active", Country: "NE", Tier: 2},
+	{ID: "c4455437ae0e898d", URL: "https://woay.com/tag/raleigh/feed/", Comments: "Raleigh", Language: "en", Status: "active", Tier: 2},
+	{ID: "c73513894056f20a", URL: "https://www.sundayworld.com/news/breaking-news/rss/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "e8c7f7003a3acd98", URL: "https://tennews.in/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "48774f202c0c20f5", URL: "https://www.nbcchicago.com/news/local/feed/", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "d5010cd34cf1a626", URL: "https://www.dailymail.co.uk/news/wyoming/index.rss", Comments: "Wyoming", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "82db579be9272e1f", URL: "https://allafrica.com/tools/headlines/rdf/westafrica/headlines.rdf", Comments: "West Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "71a3834a9e02f490", URL: "http://www.highlandradio.com/feed/", Comments: "Highland, Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "b5680b0e01d39a67", URL: "https://english.alaraby.co.uk/rss.xml?current=/node/94", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b5a8402a4e4002ca", URL: "https://time.news/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a19e0864fe6f38a4", URL: "https://www.denverpost.com/feed/", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "dfc000453f18e252", URL: "http://nypost.com/feed/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f538cd369313a02f", URL: "https://wbznewsradio.iheart.com/topic/local-news.rss/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec81f8a9b181e17d", URL: "http://world-today-news.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d74b2c469b7c884", URL: "https://themazatlanpost.com/feed/", Comments: "Mazatlan", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7db94d57f681818", URL: "https://hongkongfp.com/category/top-level-cat-pick-one/feed/", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "5d6b3cd51fbf97e6", URL: "https://www.shorenewsnetwork.com/category/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac67780a6d0861c5", URL: "http://www.washingtonexaminer.com/index.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "11c098593229bae7", URL: "https://www.ugstandard.com/feed/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "510459fb44d8443d", URL: "https://www.dailymail.co.uk/news/manchester/index.rss", Comments: "Manchester", Language: "en", Status: "active", Tier: 2},
+	{ID: "afaa11543f56127f", URL: "https://www.pasadenastarnews.com/news/feed/", Comments: "Pasadena", Language: "en", Status: "active", Tier: 2},
+	{ID: "36c720b13de8e8ac", URL: "https://www.nbcdfw.com/news/local/texas-news/feed/", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f4e1c7776115ce8f", URL: "https://www.woodtv.com/feed/", Comments: "Grand Rapids, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b58d6f5152b55c2c", URL: "https://trinidad.us/aggregator/rss/3", Comments: "Trinidad, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e27c51b9ec19c1e2", URL: "https://www.birminghammail.co.uk/black-country/rss.xml", Comments: "Black Country, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f39a1af847107ba6", URL: "https://www.luxtimes.lu/rss/en/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c2df9f6e2046f035", URL: "https://www.dailymail.co.uk/news/melbourne/index.rss", Comments: "Melbourne", Language: "en", Status: "active", Tier: 2},
+	{ID: "512cd7031be66a18", URL: "https://www.dailymail.co.uk/news/missouri/index.rss", Comments: "Missouri", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6ba90ac53378736d", URL: "https://www.newindianexpress.com/Cities/Delhi/rssfeed/?id=340&getXmlFeed=true", Comments: "Delhi", Language: "en", Status: "active", Tier: 2},
+	{ID: "ee2c416fee501cd1", URL: "https://www.kpbs.org/news/international.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "60a868744d7e1212", URL: "https://alexandria.dailyvoice.com/rss/", Comments: "Alexandria, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8311183c7f6ce84a", URL: "https://www.thenews.com.pk/rss/1/2", Comments: "Pakistan, region", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "7dd0f702f50876c1", URL: "https://www.kxxv.com/index.rss", Comments: "Waco, Central Texas", Language: "en", Status: "active", Tier: 2},
+	{ID: "0646b42ae3d3363f", URL: "https://www.dailymail.co.uk/news/afghanistan/index.rss", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "20446d74678f04e6", URL: "http://jerseyeveningpost.com/feed", Comments: "Jersey", Language: "en", Status: "active", Country: "JE", Tier: 2},
+	{ID: "29cd69499368dfa0", URL: "https://dauphin.dailyvoice.com/rss/", Comments: "Dauphin County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6ace0d955bad111a", URL: "https://standard.gm/feed/", Comments: "Standard Gambia, Gambia", Language: "en", Status: "active", Country: "GM", Tier: 2},
+	{ID: "bfdc38ac0b9ed5bd", URL: "https://guyanachronicle.com/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "945085e91cb4dfb9", URL: "http://www.9news.com.au/rss", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "d373c360d5e3778e", URL: "https://montgomerymd.dailyvoice.com/rss/", Comments: "Montgomery County, MD", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a018e60855003478", URL: "https://www.wbiw.com/category/local/feed/", Comments: "Bedford, IN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "add2b38b09cc71c2", URL: "https://wrko.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "469ec8251cc8ae6c", URL: "https://www.cbsnews.com/pittsburgh/latest/rss/main", Comments: "Pittsburgh", Language: "en", Status: "active", Tier: 2},
+	{ID: "1f5598492dc97c0c", URL: "https://www.redlandsdailyfacts.com/feed/", Comments: "Redlands", Language: "en", Status: "active", Tier: 2},
+	{ID: "71768f733d1183ab", URL: "https://globalnews.ca/canada/feed/", Comments: "Canada, global", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "ca505c7b593439eb", URL: "https://www.alberta.ca/NewsRoom/newsroom.cfm?numDaysBack=365&deptID=0", Comments: "Alberta, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "43c616e962557a0e", URL: "https://www.mercurynews.com/news/feed/", Comments: "Bay Area, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2ff58938632e62c6", URL: "https://www.voanews.com/api/zobo_egviy", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d55b761008278592", URL: "https://www.omanobserver.om/rssFeed/13", Comments: "Oman, Middle East", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "b8f03c62b40eb8b2", URL: "https://hongkongfp.com/category/topics/feed/", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "6635d4bbc694ee37", URL: "https://mynewsla.com/feed", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "466cf3d2da6bf65e", URL: "https://www.ktvh.com/news/great-falls-news.rss", Comments: "Great Falls, Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6c21fffc332e4ae1", URL: "https://www.abc6.com/category/rhode-island/feed/", Comments: "Rhode Island", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "752a876c2803f1ef", URL: "https://www.dailymail.co.uk/news/michigan/index.rss", Comments: "Michigan, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "52981fb641299cd4", URL: "https://www.kgun9.com/news/local-news.rss", Comments: "Tucson", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ee3b51f27f2e509", URL: "https://www.timesheraldonline.com/location/california/solano-county/vallejo/feed/", Comments: "California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "349839ab88fbdc04", URL: "http://timesofindia.indiatimes.com/rssfeedmostrecent.cms", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "e4ac59cf1d23fe90", URL: "https://sentinelcolorado.com/feed/", Comments: "Colorado, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4cd9ce800672a281", URL: "https://www.nytimes.com/svc/collections/v1/publish/https://www.nytimes.com/section/us/rss.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "85b766570382d0cd", URL: "https://www.nbcchicago.com/news/national-international/changing-climate/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5837d57a0ccc17b9", URL: "https://www.uscirf.gov/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7e5da81afef7333", URL: "https://nationalinsightnews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "56492e93af91dc57", URL: "https://torontosun.com/category/news/feed.xml", Comments: "Toronto", Language: "en", Status: "active", Tier: 2},
+	{ID: "1a35db3cdb92e04b", URL: "https://feeds.capi24.com/v1/Search/articles/news24/SouthAfrica/rss", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "06892029a70f0574", URL: "https://thebhutanese.bt/feed/", Comments: "Bhutan", Language: "en", Status: "active", Country: "BT", Tier: 2},
+	{ID: "689ec71472192bcd", URL: "http://www.asiaone.com/rss-feed/2", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "d460f6175701fbca", URL: "https://www.asiatimes.com/feed", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "f4dd499f91ba21eb", URL: "https://woai.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4d3edbeccfa23c54", URL: "https://globalnews.ca/environment/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "80718f95a28cc3d6", URL: "https://mingooland.com/category/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cc6be469bd34fd6c", URL: "https://time.news/tag/breaking-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c70af2e58ea4d63c", URL: "https://mtkisco.dailyvoice.com/rss/", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "13994c87aab97a12", URL: "https://coyotegulch.blog/category/colorado-water/colorado-river-basin/feed/", Comments: "Colorado River basin", Language: "en", Status: "active", Tier: 2},
+	{ID: "21542341c71e8c58", URL: "https://globalnews.ca/regina/feed/", Comments: "Regina", Language: "en", Status: "active", Tier: 2},
+	{ID: "9bf04eed36bf05ae", URL: "https://knst.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2b338b9b1be7ac76", URL: "https://www.nbcchicago.com/investigations/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c84891d81b1282c", URL: "https://www.atlanticcouncil.org/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2317b0fb2d00e05d", URL: "https://www.premiumtimesng.com/feed", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "b462f98af73f2ec3", URL: "https://www.wkbn.com/feed/", Comments: "Youngstown, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "13b737a750774e46", URL: "https://www.belfastlive.co.uk/news/belfast-news/?service=rss", Comments: "Belfast", Language: "en", Status: "active", Tier: 2},
+	{ID: "463ce36aea440cd0", URL: "https://www.hartlepoolmail.co.uk/news/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "17903251c6dfba2f", URL: "https://www.europeaninterest.eu/category/world/feed/", Comments: "European, World", Language: "en", Status: "active", Tier: 2},
+	{ID: "e92e6c69c12809f7", URL: "https://feeds.capi24.com/v1/Search/articles/news24/topstories/rss", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "5a8a60f93de5a52c", URL: "https://globalnews.ca/montreal/feed/", Comments: "Montreal", Language: "en", Status: "active", Tier: 2},
+	{ID: "dce6ac6dc1006c1b", URL: "http://www.dailystar.co.uk/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "dac7899452921816", URL: "https://www.belfastlive.co.uk/news/?service=rss", Comments: "Belfast", Language: "en", Status: "active", Tier: 2},
+	{ID: "bc80a5a334a15f60", URL: "https://www.zimbabwesituation.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "68fd4ba478c3018d", URL: "https://www.cheshire-live.co.uk/news/chester-cheshire-news/rss.xml", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "36b2e0f4463434d3", URL: "http://whiterocklakeweekly.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ab40aec462b9c1ad", URL: "http://www.telegraph.co.uk/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a52a5726d27a7356", URL: "https://www.3newsnow.com/sitemap.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "af54e8e31715cd4a", URL: "https://www.arlingtoncardinal.com/category/crime-alerts/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "c04fa8b5d45aac8b", URL: "https://philadelphia.cbslocal.com/category/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "c41512e0cc73828c", URL: "https://timesofoman.com/feed/roundup", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "32bb6f72615caefa", URL: "https://www.reporterherald.com/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8e414710303e0e25", URL: "https://www.freightwaves.com/borderlands-mexico/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bc9a66bd0df5f47b", URL: "https://lancaster.dailyvoice.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9837df3fd18bf3e7", URL: "http://feeds.bbci.co.uk/news/uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7afdc0e929eabe98", URL: "https://www.dailymail.co.uk/news/london/index.rss", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "00e5c172a7655c77", URL: "http://www.smh.com.au/rss/feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7bc03a9d8bb88e1c", URL: "https://www.rawstory.com/feeds/all-video.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "0f3cd24a5d7f67e0", URL: "http://www.thetruthaboutguns.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f72fb87110be9e41", URL: "https://www.dailymail.co.uk/news/colorado/index.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "82433c745ebb2496", URL: "https://www.shorenewsnetwork.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "318679ad2118e2ce", URL: "http://allafrica.com/tools/headlines/rdf/latest/headlines.rdf", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "a12eb7877af32c78", URL: "https://www.ocregister.com/location/california/orange-county/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec3ccea69ad2e2dc", URL: "https://www.asdnews.com/rss-feed/?channelId=67", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5ba0354865d613cd", URL: "https://www.times-standard.com/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "2e4e5b28a5a633d2", URL: "https://www.rawstory.com/feeds/hot-off-the-wires.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9857d318700c86ae", URL: "http://www.theage.com.au/rss/feed.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f7c4abd53c592f7", URL: "https://www.nbcboston.com/tag/boston/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "743b6f461ca90adb", URL: "https://www.nigerianbulletin.com/forums/metro-news.4/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0c678dbdda5fdab5", URL: "http://6abc.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d5ad2e2808446ae2", URL: "http://theparisnews.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "35d7cb8f7abf069b", URL: "https://kashmirobserver.net/news/top-stories/feed/", Comments: "Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "19871d26f931ea5d", URL: "https://www.dailymail.co.uk/news/new-hampshire/index.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b54c4468e0c10abf", URL: "https://www.nzherald.co.nz/arc/outboundfeeds/rss/section/news/?outputType=xml&_website=northern-advocate", Language: "en", Status: "active", Tier: 2},
+	{ID: "dcd1966710b9a8cb", URL: "http://www.leicestermercury.co.uk/rss.xml", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "472af7e223bf6065", URL: "https://www.ksat.com/arc/outboundfeeds/rss/category/news/local/?outputType=xml&size=10", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9aa325ce467eebf", URL: "https://www.wmra.org/tags/npr-news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f80001d1919ca3c8", URL: "https://www.owensoundsuntimes.com/feed", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "1f8c77992281b9f3", URL: "https://www.khaama.com/tag/pakistan/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "dfdeee464d4e9200", URL: "https://pix11.com/feed/", Comments: "New York City, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "22642f49b336df4f", URL: "https://www.presstelegram.com/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cb365ec09806c28a", URL: "https://www.nzherald.co.nz/arc/outboundfeeds/rss/section/news/?outputType=xml&_website=whanganui-chronicle", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "c2bc0696ada46066", URL: "https://www.dailymail.co.uk/news/edinburgh/index.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "50bd691bc904682e", URL: "https://www.cbsnews.com/latest/rss/60-minutes", Language: "en", Status: "active", Tier: 2},
+	{ID: "eb409e19893e1635", URL: "http://klewtv.com/news/local.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ab8e752aeaba548c", URL: "http://vancouversun.com/feed/", Comments: "Vancouver", Language: "en", Status: "active", Tier: 2},
+	{ID: "72df8a15bde5fb04", URL: "http://www.standard.co.uk/news/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "df1fedab51503db4", URL: "http://www.standard.co.uk/news/uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d3d4786e98b861e7", URL: "http://www.abc.net.au/news/feed/48320/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "be3ad440335206f4", URL: "http://en.mercopress.com/rss/international", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5fd2036ee3ec1eac", URL: "http://www.timesofisrael.com/feed/", Comments: "Israel, Middle East", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "cf32418db95822c0", URL: "http://www.abc.net.au/news/feed/50990/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "792283a9172ae6ea", URL: "http://feeds.feedburner.com/daily-express-uk-news?format=xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b45a174755f0b494", URL: "https://www.middleeastmonitor.com/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "33d8597df2eb22c4", URL: "http://feeds.feedburner.com/ndtvnews-world-news?format=xml", Comments: "India, global", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "1e80cf8c89db74a3", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128936835.cms", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "58a4df9f71c6266e", URL: "http://feeds.bbci.co.uk/news/world/rss.xml?edition=uk", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac9cf46685e09ad2", URL: "http://www.novinite.com/services/news_rdf.php", Comments: "Bulgaria", Language: "en", Status: "active", Country: "BG", Tier: 2},
+	{ID: "ace2ce9c3d599ce2", URL: "http://www.theborneopost.com/news/sarawak/feed/", Comments: "Sarawak, Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "cda7371bad35aff7", URL: "https://www.irishmirror.ie/news/irish-news/crime/?service=rss", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "40a74d287562be72", URL: "https://en.trend.az/scaucasus/index.rss", Comments: "Caucasus", Language: "en", Status: "active", Tier: 2},
+	{ID: "0e11e010363528ee", URL: "http://www.thehindu.com/news/national/?service=rss", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "6daff5bd82a91be2", URL: "http://wwmt.com/news/nation-world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4a8f69b96233291f", URL: "http://www.news.gov.hk/en/categories/law_order/html/articlelist.rss.xml", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "4a3222fab4bcf53f", URL: "https://www.watoday.com.au/rss/national.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "1d7ca001eccc13a9", URL: "http://www.thehindu.com/news/cities/Delhi/?service=rss", Comments: "Delhi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "cadba9780edf5aa1", URL: "http://citizen.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "dbc9ebffc554c4c0", URL: "http://www.abc.net.au/news/feed/53408/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "e5f82d246009c7b7", URL: "http://www.ibtimes.co.uk/rss/africa", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "632defcd2c775cfb", URL: "http://www.asiaone.com/rss-feed/5", Comments: "Asia, world", Language: "en", Status: "active", Tier: 2},
+	{ID: "05191245c1c630f8", URL: "http://www.arabnews.com/cat/3/rss.xml", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "f7dfd0b4ae815644", URL: "http://en.rfi.fr/asia-pacific/rss", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "c1ab044d7f6e05b6", URL: "http://wtop.com/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3122e1b74e252de", URL: "http://ktla.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "153c0a24ee0d4713", URL: "http://fox8.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "dab7b93f2a15be73", URL: "http://rss.iol.io/iol/news/crime-courts", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "bc7c594eefe49a7c", URL: "http://world.kbs.co.kr/rss/rss_news.htm?lang=e&id=IK", Comments: "Korean Peninsula", Language: "en", Status: "active", Tier: 2},
+	{ID: "b4605a14da63582a", URL: "http://feeds.feedburner.com/daily-express-world-news?format=xml", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "a11441c984def92d", URL: "http://www.abc.net.au/news/feed/50042/rss.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "3a63c37a8a74a12b", URL: "http://www.france24.com/en/africa/rss", Comments: "Africa, France", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "eb0e55e2cc28a74a", URL: "http://www.baynews9.com/content/news/baynews9/feeds/rss.html/local-news.html", Comments: "Tampa Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "b1f95a7d4c1111df", URL: "https://vancouversun.com/category/news/crime/feed/", Comments: "Vancouver", Language: "en", Status: "active", Tier: 2},
+	{ID: "26fef57d147dd443", URL: "http://feeds.skynews.com/feeds/rss/us.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6819068cf791356a", URL: "http://www.abc.net.au/news/feed/46182/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "e4ef94ffcf116737", URL: "http://klewtv.com/news/nation-world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f28db4f16e554db3", URL: "https://www.siasat.com/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "245c9a7689673f4d", URL: "http://www.harwichandmanningtreestandard.co.uk/news/harwich_manningtree_news/rss/", Comments: "Harwich, Manningtree, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "fda373d884b0bba3", URL: "https://wreg.com/tag/local/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ff1e1a32221b10b9", URL: "https://africa.cgtn.com/feed", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1e2db4d51046bc2", URL: "http://rss.dw.com/rdf/rss-en-ger", Comments: "Germany", Language: "en", Status: "active", Country: "DE", Tier: 2},
+	{ID: "b88521e62c9a5e4e", URL: "https://www.pri.org/stories/feed/everything", Language: "en", Status: "active", Tier: 2},
+	{ID: "eb744ff92f9f5324", URL: "https://rss.upi.com/news/tn_int.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1301ba2181ad9191", URL: "http://rssfeeds.khou.com/khou/local&x=1", Comments: "Houston", Language: "en", Status: "active", Tier: 2},
+	{ID: "ef08fca1aacb0c9f", URL: "https://www.birminghammail.co.uk/news/uk-news/?service=rss", Comments: "Birmingham", Language: "en", Status: "active", Tier: 2},
+	{ID: "48555818b9558cf5", URL: "http://www.abc.net.au/news/feed/52498/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "bea0fee2e146db40", URL: "https://www.theguardian.com/world/americas/rss", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "48a500c54e2a3989", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/national.html", Language: "en", Status: "active", Tier: 2},
+	{ID: "1bb3d98ae05b1bd2", URL: "https://ottawacitizen.com/category/news/feed/", Comments: "Ottawa", Language: "en", Status: "active", Tier: 2},
+	{ID: "18cbe2eded0d0105", URL: "https://www.yahoo.com/news/rss/world/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0ecf2e44dc321dd0", URL: "http://www.latimes.com/world/africa/rss2.0.xml", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "60ad1a3af7c05a2c", URL: "http://cyprus-mail.com/feed/", Comments: "Cyprus", Language: "en", Status: "active", Country: "CY", Tier: 2},
+	{ID: "e83d83c500ec9d57", URL: "http://www.wral.com/rss/48", Comments: "Raleigh", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0da17347a46af9d", URL: "https://www.manchestereveningnews.co.uk/news/greater-manchester-news/?service=rss", Comments: "Manchester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "64ebeb23700055d6", URL: "https://www.siasat.com/category/news/bangalore/feed/", Comments: "Bangalore", Language: "en", Status: "active", Tier: 2},
+	{ID: "f59a414d46f1d4b9", URL: "http://www.pakistankakhudahafiz.com/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "158d54065aa34f84", URL: "http://www.latimes.com/world/middleeast/rss2.0.xml", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "4950b05584128a1c", URL: "http://www.arabnews.com/cat/2/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "32dbd3c641d5a229", URL: "https://globalnews.ca/halifax/feed/", Comments: "Halifax", Language: "en", Status: "active", Tier: 2},
+	{ID: "f258169fd3bc4a46", URL: "http://aa.com.tr/en/rss/default?cat=asia-pacific", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "f2e19f34460262dc", URL: "https://www.cidrap.umn.edu/news/178636/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "38752d66ef91859b", URL: "http://www.latimes.com/world/rss2.0.xml", Comments: "LA", Language: "en", Status: "active", Country: "LA", Tier: 2},
+	{ID: "9d0d0fae69a1418d", URL: "http://www.standard.co.uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7999eb46bc1aab45", URL: "https://www.thezimbabwean.co/category/news/zimbabwe-news/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "b57404b5f189df69", URL: "http://www.harwichandmanningtreestandard.co.uk/news/rss/", Comments: "Harwich, Manningtree, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7e5e9937623402e1", URL: "https://www.theguardian.com/world/asia/rss", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "952f9a7f91c39425", URL: "http://rss.nytimes.com/services/xml/rss/nyt/AsiaPacific.xml", Comments: "Asia Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c423ea51bcbe41e", URL: "http://www.cbsnews.com/latest/rss/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "06e3c9d8f72e0b89", URL: "http://www.latimes.com/world/asia/rss2.0.xml?lang=en", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "db74eec5b09b27b8", URL: "https://wsbt.com/news/local.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "058b97b4271ca97f", URL: "https://mg.co.za/rss", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "e5fbe1611da83570", URL: "http://feeds.bbci.co.uk/news/world/us_and_canada/rss.xml?edition=int", Comments: "US, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "a2bc1ea1635a17fd", URL: "http://www.thestar.com.my/rss/news/world/", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "017475ddaa1b18a9", URL: "http://www.thehindu.com/?service=rss", Comments: "India, global", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "eb84250011f894ba", URL: "http://minnesota.cbslocal.com/category/news/feed/", Comments: "Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1d1d2ee1d8f71d6e", URL: "http://www.dnaindia.com/feeds/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bc1a1c02b8864436", URL: "https://www.channelnewsasia.com/rssfeeds/8395986", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ae672b8f4f6b275", URL: "http://www.wardheernews.com/category/somali-news-and-politics/feed/", Comments: "Somalia", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "dff59af9bbe8cb79", URL: "http://english.almanar.com.lb/cat/news/world/rss", Comments: "Lebanon, Middle East, world", Language: "en", Status: "active", Country: "LB", Tier: 2},
+	{ID: "fe28dc21a9694254", URL: "http://ktla.com/category/news/nationworld/feed/", Comments: "Nation & World", Language: "en", Status: "active", Tier: 2},
+	{ID: "1936dd2f683f999c", URL: "https://nypost.com/news/feed/", Comments: "NY", Language: "en", Status: "active", Tier: 2},
+	{ID: "9754200903424b3a", URL: "http://www.newindianexpress.com/States/Andhra-Pradesh/rssfeed/?id=173&getXmlFeed=true", Comments: "Andhra Pradesh, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "c66d6e87fe356cfe", URL: "http://wpde.com/news/nation-world.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "c3c9ceb6b6eb3a83", URL: "https://www.jpost.com/Rss/RssFeedsMiddleEastNews.aspx", Comments: "Middle East, Jerusalem", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b677f7fdedf187d", URL: "http://en.abna24.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "558d4ef572e6a79c", URL: "https://www.nbcdfw.com/news/local/?rss=y&embedThumb=y&summary=y", Comments: "Dallas, Fort Worth", Language: "en", Status: "active", Tier: 2},
+	{ID: "5f5c52465b9ddd02", URL: "http://www.asiaone.com/rss-feed/4", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "efb2f1bd2be6a980", URL: "http://www.baynews9.com/content/news/baynews9/feeds/rss.html/world.html", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "91d599ff328a3f64", URL: "http://rss.newser.com/rss/section/13.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dda95ef88c85ba43", URL: "http://www.latimes.com/world/afghanistan-pakistan/rss2.0.xml", Comments: "Afghanistan, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "6a28ce01e9c34f22", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/hernando.html", Comments: "Hernando County", Language: "en", Status: "active", Tier: 2},
+	{ID: "41bc7a7e33984dde", URL: "https://www.brisbanetimes.com.au/rss/feed.xml", Comments: "Brisbane", Language: "en", Status: "active", Tier: 2},
+	{ID: "a4c8a743833d5f71", URL: "https://ca.news.yahoo.com/rss", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "00e5c172a7655c77", URL: "https://www.smh.com.au/rss/feed.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "0de65adf42550579", URL: "http://cananewsonline.com/main/feed/", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "3908b3b62d37be81", URL: "http://www.taipeitimes.com/xml/index.rss", Comments: "Taipei", Language: "en", Status: "active", Tier: 2},
+	{ID: "6207f9dcc0ddd6bd", URL: "http://abc30.com/feed/", Comments: "Fresno", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1afea53880056f5", URL: "http://english.almanar.com.lb/cat/news/world/europe/rss", Comments: "Lebanon, Europe", Language: "en", Status: "active", Country: "LB", Tier: 2},
+	{ID: "c53cec5f01f8db21", URL: "http://rss.nytimes.com/services/xml/rss/nyt/Americas.xml", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "2230a4b1caca6ee0", URL: "http://news3lv.com/news/nation-world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a5038ee50acdf2e8", URL: "http://www.heraldscotland.com/news/homenews/rss/", Comments: "Scottish", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4bb7b687762262fe", URL: "http://en.mercopress.com/rss/falkland-islands", Comments: "Falkland Islands", Language: "en", Status: "active", Country: "FK", Tier: 2},
+	{ID: "df6da87e86423fc8", URL: "http://www.khaama.com/category/afghanistan/feed", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "adf9e96a09a363ae", URL: "http://www.latimes.com/world/mexico-americas/rss2.0.xml", Comments: "Mexico, Americas", Language: "en", Status: "active", Country: "MX", Tier: 2},
+	{ID: "55e9ede97931243c", URL: "http://cbs2iowa.com/news/nation-world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "325cd4cbf7f5e845", URL: "https://heavy.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f54b105b25c50320", URL: "http://www.ansa.it/english/english_rss.xml", Comments: "Italy", Language: "en", Status: "active", Country: "IT", Tier: 2},
+	{ID: "003f61ddbc939658", URL: "https://pajhwok.com/feed/", Comments: "Afghanistan, Asia", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "55d6998dc8130643", URL: "http://rss.nytimes.com/services/xml/rss/nyt/World.xml", Comments: "NY", Language: "en", Status: "active", Tier: 2},
+	{ID: "8a4623d0d1189967", URL: "https://www.algemeiner.com/category/news/israel/feed/", Comments: "Israel, Middle East", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "b0fe82d173f3d51f", URL: "http://www.ibtimes.co.uk/rss/americas", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "9415fc2aa7eb9cf2", URL: "http://wpde.com/news/local-and-state.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "71cbdf0dc77f704f", URL: "http://www.irinnews.org/taxonomy/term/879/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "25244837cae1c2fe", URL: "http://feeds2.feedburner.com/time/world", Comments: "World", Language: "en", Status: "active", Tier: 2},
+	{ID: "28787fb7aafa6fb2", URL: "https://www.siasat.com/category/news/hyderabad/feed/", Comments: "Hyderabad, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ca0e2e72f899d24a", URL: "http://www.khaama.com/category/world/middle-east/feed", Comments: "Middle East, Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "68875f5e90bdf827", URL: "http://en.rfi.fr/europe/rss", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "b8c0655dfb64e462", URL: "http://www.independent.co.uk/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "96f45a8681fd1b1a", URL: "http://www.thesundaily.my/rss/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea5af35ca058177d", URL: "http://www.newindianexpress.com/Cities/Bengaluru/rssfeed/?id=182&getXmlFeed=true", Comments: "Bengaluru, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "8096bc12019529e5", URL: "http://www.wral.com/news/rss/142/", Comments: "Raleigh, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "98c72ca628e1ec31", URL: "https://www.capitalfm.co.ke/news/section/africa/feed/", Comments: "Kenya, Africa", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "0665f244f0d2034b", URL: "https://en.168.am/feed", Comments: "168.am", Language: "en", Status: "active", Tier: 2},
+	{ID: "34e49d1bdf2847a2", URL: "https://wgno.com/category/news/feed/", Comments: "New Orleans", Language: "en", Status: "active", Tier: 2},
+	{ID: "869a5345b63e5f51", URL: "http://www.koreatimes.co.kr/www/rss/nation.xml", Comments: "Korea", Language: "en", Status: "active", Country: "KR", Tier: 2},
+	{ID: "dc4ac2b0dffed417", URL: "https://www.ketr.org/news.rss", Comments: "Northeast Texas", Language: "en", Status: "active", Tier: 2},
+	{ID: "886fe34b1f7aa63d", URL: "https://en.trend.az/casia/index.rss", Comments: "Central Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "0a3b92e31a18a9b6", URL: "https://themoscowtimes.com/feeds/moscow.xml", Comments: "Moscow", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f808fc5969773e9", URL: "http://www.newindianexpress.com/States/Kerala/rssfeed/?id=178&getXmlFeed=true", Comments: "Kerala, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "152b47f0f53cf96d", URL: "http://kfoxtv.com/news/local.rss", Comments: "El Paso", Language: "en", Status: "active", Tier: 2},
+	{ID: "c44397cdb567c725", URL: "https://rss.upi.com/news/top_news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e4124ac864963989", URL: "http://www.thestar.com.my/rss/news/nation/", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "b7153f602775e130", URL: "http://www.ibtimes.sg/rss/feed", Comments: "Singapore, world", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "894f82a0165f9bf4", URL: "https://www.dailyrecord.co.uk/news/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a241afbefa60c631", URL: "http://www.newsweek.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c870952d4861df3", URL: "http://www.ibtimes.co.uk/rss/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0881f10abac5a9f4", URL: "https://www.ibtimes.co.in/rss/feed", Comments: "India, world", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "e923a7fae6ea2682", URL: "https://www.ctpost.com/rss/feed/News-1331.php", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b680ccf3c5dc27fa", URL: "https://www.dailyrecord.co.uk/news/uk-world-news/?service=rss", Comments: "UK, world", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1c6e93ddfce18c84", URL: "https://www.siasat.com/category/news/delhi/feed/", Comments: "Delhi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "57f6c93069160720", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/pinellas.html", Comments: "Pinellas County, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bf275ab754588c42", URL: "http://www.theborneopost.com/news/sabah/feed/", Comments: "Sabah, Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "841cc9cfa493a11a", URL: "http://rssfeeds.firstcoastnews.com/wtlv/firstcoastnews-topstories&x=1", Comments: "Jacksonville, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "adfb8b62575b4a61", URL: "https://www.theeastafrican.co.ke/service/rss/tea/1289142/feed.rss", Comments: "East African", Language: "en", Status: "active", Tier: 2},
+	{ID: "0efd2deb33bc39e6", URL: "http://www.latimes.com/world/worldnow/rss2.0.xml", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "e639a395e483c476", URL: "http://www.helsinkitimes.fi/finland/finland-news/domestic.feed?type=rss", Comments: "Helsinki, Finland", Language: "en", Status: "active", Country: "FI", Tier: 2},
+	{ID: "970df6d910559bc7", URL: "http://www.khaama.com/category/world/asia/feed", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "56719f2187bfa58a", URL: "https://www.irinnews.org/taxonomy/term/884/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7430112dc4831ce3", URL: "http://keprtv.com/news/nation-world.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "96fcf84c0b3340db", URL: "https://www.walesonline.co.uk/news/?service=rss", Comments: "Wales", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ad066a7626793171", URL: "http://nj1015.com/feed/", Comments: "New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e25cde0ac0dc2bdc", URL: "https://www.irishmirror.ie/news/irish-news/?service=rss", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "3c921e99d3d29fb2", URL: "https://www.dailyrecord.co.uk/news/crime/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "3148f78923c10a75", URL: "http://www.newindianexpress.com/World/rssfeed/?id=171&getXmlFeed=true", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "e05343cbc663f15e", URL: "https://www.rferl.org/api/z$qimetkiy", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b0d58798e273e217", URL: "https://www.jpost.com/Rss/RssFeedsIsraelNews.aspx", Comments: "Israel, Middle East", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "0e2576b53cc07e44", URL: "https://www.news18.com/rss/world.xml", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "bb20ff982df9860b", URL: "http://kdvr.com/feed/", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "2e13987b9d8c565a", URL: "https://www.mysanantonio.com/default/feed/local-news-176.php", Comments: "San Antonio", Language: "en", Status: "active", Tier: 2},
+	{ID: "a55ab4441b0ce347", URL: "http://www.abc.net.au/news/feed/54242/rss.xml", Comments: "Australia, global", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "df1bc4720f392180", URL: "https://www.standardmedia.co.ke/rss/world.php", Comments: "Kenya, global", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "bd1ef72ef36159fe", URL: "https://www.channelstv.com/category/world-news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c758a2cc98a5e19e", URL: "http://rss.nytimes.com/services/xml/rss/nyt/MiddleEast.xml", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "bfdf4f14e2416499", URL: "http://www.chronicle.co.zw/category/s6-demo-section/c45-international-news/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "447625d9a5df9902", URL: "http://www.theborneopost.com/feed/", Comments: "Borneo", Language: "en", Status: "active", Tier: 2},
+	{ID: "a7e269cc73ed823e", URL: "http://rssfeeds.11alive.com/wxia-home&x=1", Comments: "Atlanta", Language: "en", Status: "active", Tier: 2},
+	{ID: "82db579be9272e1f", URL: "http://allafrica.com/tools/headlines/rdf/westafrica/headlines.rdf", Comments: "West Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "ee8babf822dda4af", URL: "https://en.trend.az/world/index.rss", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "41802bf0bd7c199d", URL: "https://www.voanews.com/api/zq$omekvi_", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e9a8554e9bd5a4c", URL: "https://www.channelnewsasia.com/rssfeeds/8396082", Comments: "world, Singapore", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "f64486b37b114eeb", URL: "https://www.siasat.com/category/news/india/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ac1a89bea4d02c11", URL: "http://m.en.rfi.fr/africa/rss", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d04e1d62dc7b9b3", URL: "https://themoscowtimes.com/feeds/world.xml", Comments: "Moscow, world", Language: "en", Status: "active", Tier: 2},
+	{ID: "ff0ec1df0ef25434", URL: "http://nj1015.com/category/new-jersey-news/feed/", Comments: "New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a18180b22f6cd300", URL: "https://www.straitstimes.com/news/world/rss.xml", Comments: "Singapore", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "3a5bb93cff985522", URL: "http://www.israelnationalnews.com/Rss.aspx?act=.1", Comments: "Israel, Middle East", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "7e7d695630e85a31", URL: "https://www.irishmirror.ie/news/world-news/?service=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "467c4e6f712ad33d", URL: "https://www.liverpoolecho.co.uk/news/uk-world-news/?service=rss", Comments: "Liverpool, UK, World", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0f66680d39ac77e1", URL: "https://www.herald.co.zw/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "0e92e168687b19ff", URL: "https://www.birminghammail.co.uk/news/midlands-news/?service=rss", Comments: "Birmingham, Midlands, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "337229fe3aa5240a", URL: "http://www.heraldscotland.com/news/rss/", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2423c5a3cdf3ff36", URL: "https://www.seattletimes.com/world/feed/", Comments: "Seattle, world", Language: "en", Status: "active", Tier: 2},
+	{ID: "0a6fc2796b3c3df5", URL: "https://www.voanews.com/api/zj$oveytit", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "377f317f071f0f52", URL: "http://www.radionz.co.nz/rss/national.xml", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "d2c398d86bb7ce3d", URL: "https://www.brisbanetimes.com.au/rss/world.xml", Comments: "Brisbane", Language: "en", Status: "active", Tier: 2},
+	{ID: "2777c835749cf866", URL: "http://www.standard.co.uk/news/london/rss", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "e2a5361e74448e93", URL: "http://www.newindianexpress.com/Cities/Hyderabad/rssfeed/?id=183&getXmlFeed=true", Comments: "Hyderabad, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7f02301519d142ec", URL: "https://www.thesouthafrican.com/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "8d2e85b75f9e4709", URL: "https://www.straitstimes.com/news/asia/rss.xml", Comments: "Singapore", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "10808c789f5fb921", URL: "http://www.newindianexpress.com/States/Telangana/rssfeed/?id=174&getXmlFeed=true", Comments: "Telangana, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7b746ad0d35d33fd", URL: "https://ottawacitizen.com/category/news/local-news/feed/", Comments: "Ottawa", Language: "en", Status: "active", Tier: 2},
+	{ID: "24469072682e1f35", URL: "http://rssfeeds.khou.com/khou/texasnews&x=1", Comments: "Houston, Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b9ead741e691ebe0", URL: "https://www.algemeiner.com/category/news/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ec7695d4458693f", URL: "http://www.japantimes.co.jp/feed/", Comments: "Japan, Asia", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "70379f0d45a7ee23", URL: "https://www.mid-day.com/Resources/midday/rss/world-news.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "630ecbbb5c0ee671", URL: "http://www.abc.net.au/news/feed/52764/rss.xml", Comments: "Australia, Asia, Asia-Pacific", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "7bbb2ff9b58800bb", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/polk.html", Comments: "Polk County, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6a1dcac41be4a2ab", URL: "https://cphpost.dk/?feed=rss2", Comments: "Copenhagen, Denmark", Language: "en", Status: "active", Country: "DK", Tier: 2},
+	{ID: "d5b46360804033b8", URL: "https://vancouversun.com/category/news/world/feed/", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "709dc87f19d28380", URL: "https://www.thesun.co.uk/news/worldnews/feed/", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9aa76a4d45e6e75d", URL: "https://www.expressnews.com/rss/feed/News-918.php", Comments: "San Antonio", Language: "en", Status: "active", Tier: 2},
+	{ID: "5dddd9c1753d89e3", URL: "http://feeds.news24.com/articles/news24/Africa/rss", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc67872d3a4d390d", URL: "http://www.heraldscotland.com/news/world_news/rss/", Comments: "Scotland, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d7b20aa8b790147a", URL: "http://www.sundaynews.co.zw/category/local/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "1c83d4ae7c2d6259", URL: "https://www.manilatimes.net/news/feed/", Comments: "Manila", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea53d3874be6c6d9", URL: "https://wwmt.com/news/local.rss", Comments: "Kalamazoo, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2a1fba66f8535565", URL: "https://cbs2iowa.com/news/local.rss", Comments: "Iowa", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2a8109579812cfe9", URL: "http://en.rfi.fr/general/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "821152b45b8a186c", URL: "https://www.rte.ie/news/rss/news-headlines.xml", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "ad1b2c8bc097cfea", URL: "http://mesquitelocalnews.com/category/news/local-news/feed/", Comments: "Mesquite, Nevada", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a13a6ba567472bb5", URL: "https://wwwnc.cdc.gov/eid/rss/ahead-of-print.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c612aabc93b4290", URL: "https://globalnews.ca/edmonton/feed/", Comments: "Edmonton, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "068ad1020935d2ca", URL: "https://www.voanews.com/api/zoripegtim", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f107c1cd359dcdd6", URL: "http://ariananews.af/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "81e4bc1eba9208a3", URL: "http://rss.dw.com/rdf/rss-en-asia", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "c97271bf57b92578", URL: "http://feeds.news24.com/articles/news24/TopStories/rss", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "ed0795c6a779016c", URL: "https://wpde.com/news/local.rss", Comments: "Florence, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "06e08bd38e44826c", URL: "http://www.chronicle.co.zw/category/s6-demo-section/c38-local-news/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "416e4cc51a67b124", URL: "http://www.newindianexpress.com/States/Karnataka/rssfeed/?id=175&getXmlFeed=true", Comments: "Karnataka, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "a274d847a77cd1d2", URL: "http://aa.com.tr/en/rss/default?cat=middle-east", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "673f27338693bfd7", URL: "http://zeenews.india.com/rss/world-news.xml", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "a62252add4c57e61", URL: "http://wreg.com/category/news/feed/", Comments: "Memphis", Language: "en", Status: "active", Tier: 2},
+	{ID: "e46cf9762dfc3332", URL: "http://www.msnbc.com/feeds/latest", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8ac892384d737776", URL: "http://www.abc.net.au/news/feed/54702/rss.xml", Comments: "Australia, Asia, Asia-Pacific", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "78c21b6ef8bb2ecc", URL: "https://www.manchestereveningnews.co.uk/news/uk-news/?service=rss", Comments: "Manchester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "036184b3abc05913", URL: "http://kdvr.com/category/news/feed/", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "36e49e04635ac495", URL: "https://www.capitalfm.co.ke/news/section/county-news/feed/", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "f1b57a80fa395f57", URL: "https://www.algemeiner.com/category/news/middle-east/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "fae3e677f68a75fa", URL: "http://www.independent.co.uk/news/world/europe/rss", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "02a507a94c2b26b7", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/citrus.html", Comments: "Citrus County, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "80bc787c7b8fe759", URL: "https://www.coventrytelegraph.net/news/?service=rss", Comments: "Coventry, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ddfb90bd4e409e97", URL: "http://news3lv.com/news/local.rss", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "776983f1be0d004e", URL: "http://rssfeeds.khou.com/khou/crime&x=1", Comments: "Houston", Language: "en", Status: "active", Tier: 2},
+	{ID: "38bea6dd9fb10a59", URL: "http://rss.dw.com/rdf/rss-en-world", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "54ae384e638ed7e7", URL: "http://www.newindianexpress.com/Cities/Kochi/rssfeed/?id=185&getXmlFeed=true", Comments: "Kochi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "dbfd1bc0b0350f2f", URL: "http://allafrica.com/tools/headlines/rdf/eastafrica/headlines.rdf", Comments: "East Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "468fb307c4b98390", URL: "https://www.capitalfm.co.ke/news/section/world/feed/", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "3b39c3624dc8289a", URL: "https://www.thesun.co.uk/news/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "71aa26aedaca5e84", URL: "https://thewhistler.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "87337beb67602dbc", URL: "https://bulawayo24.com/rss/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "6ad32cae92c1f703", URL: "http://rss.nytimes.com/services/xml/rss/nyt/Europe.xml", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f0b077a7ee2811a", URL: "https://www.ctpost.com/rss/feed/Police-1332.php", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ecbf7bfb0ced86c", URL: "https://www.voanews.com/api/z-$otevtiq", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2d4416da672acc60", URL: "http://www.france24.com/en/americas/rss", Comments: "Americas, France", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "adf1d725d62ab539", URL: "http://allafrica.com/tools/headlines/rdf/southernafrica/headlines.rdf", Comments: "Southern Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "7f155929c1b8e9e4", URL: "https://www.mirror.co.uk/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "6eddbfdc28d0c086", URL: "https://www.news18.com/rss/india.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "970e3375fa766510", URL: "https://wsbt.com/news/regional.rss", Comments: "South Bend, IN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8877eeac252592f1", URL: "https://www.straitstimes.com/news/singapore/rss.xml", Comments: "Singapore", Language: "en", Status: "active", Country: "SG", Tier: 2},
+	{ID: "350d0127a17deac3", URL: "https://www.sanpedrosun.com/police-news/feed/", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "51830b296aa0e67e", URL: "https://www.naija.ng/rss/all.rss", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "0a2fa43d5b128609", URL: "https://www.premiumtimesng.com/category/news/top-news/feed", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "bee27a880eea101f", URL: "https://www.theage.com.au/rss/national/victoria.xml", Comments: "Victoria, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "1feab05b148e5495", URL: "https://www.coventrytelegraph.net/news/uk-world-news/?service=rss", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "54f245c6e7f96d89", URL: "https://www.voanews.com/api/zr$opeuvim", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6ebce27982923105", URL: "https://www.seattletimes.com/nation/feed/", Comments: "US, Seattle", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ab4fc41be3f9b5b8", URL: "http://www.khaama.com/category/world/feed", Comments: "Afghan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "e542ad2200d74e2c", URL: "http://allafrica.com/tools/headlines/rdf/africa/headlines.rdf", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "df027c272391074c", URL: "https://www.siasat.com/category/news/world/feed", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "65a49a0a8aa3fc97", URL: "https://www.pakistantoday.com.pk/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "07b354e07af62cf9", URL: "https://www.standardmedia.co.ke/rss/kenya.php", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "227770f4f1cca289", URL: "https://tribune.com.pk/pakistan/punjab/feed/", Comments: "Pakistan, Punjab", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "b9eac53bde83205d", URL: "https://www.siasat.com/category/crime/crime-and-accident/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "c46247ac72c00cef", URL: "https://www.voanews.com/api/zo$o_egviy", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "66c874f6b5a22547", URL: "http://rss.nytimes.com/services/xml/rss/nyt/Africa.xml", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "8f5b2970b84810dd", URL: "https://www.mynews13.com/content/news/cfnews13/feeds/rss.html/national.html", Comments: "Orlando, FL, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "006730fcd02336ae", URL: "http://feeds.bbci.co.uk/news/world/africa/rss.xml", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "7f01aed5ea049347", URL: "http://kfoxtv.com/news/nation-world.rss", Comments: "El Paso", Language: "en", Status: "active", Tier: 2},
+	{ID: "89dede5c1a1396d7", URL: "https://www.dailyrecord.co.uk/news/scottish-news/?service=rss", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a461a02bf5082a97", URL: "http://www.newindianexpress.com/Cities/Thiruvananthapuram/rssfeed/?id=184&getXmlFeed=true", Comments: "Thiruvananthapuram, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "af0ce61da22eed65", URL: "https://citizen.co.za/category/news/news-world/feed/", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "9319ac81cc79d386", URL: "http://kdvr.com/category/news/nationalworld-news/feed/", Comments: "Denver, national, world", Language: "en", Status: "active", Tier: 2},
+	{ID: "0c62e0fa049c133d", URL: "http://www.theborneopost.com/news/nation/feed/", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "036221dc22c1222b", URL: "http://puntlandpost.net/news-in-english/feed/", Comments: "Somalia, East Africa", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "dcc9c0886e3aa023", URL: "https://themoscowtimes.com/feeds/russia.xml", Comments: "Russia", Language: "en", Status: "active", Country: "RU", Tier: 2},
+	{ID: "51a954bdb86e81c9", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/manatee.html", Comments: "Manatee County, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "763db926ff66b72b", URL: "https://www.mysanantonio.com/rss/feed/Texas-News-918.php", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d888b9ee92653307", URL: "http://www.japantimes.co.jp/news/feed/", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "47193b30f353945c", URL: "https://riotimesonline.com/brazil-news/category/rio-de-janeiro/feed/", Comments: "Rio de Janeiro, Brazil", Language: "en", Status: "active", Country: "BR", Tier: 2},
+	{ID: "a5571ac4fbdb00e5", URL: "http://en.mehrnews.com/rss/tp/577", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "b3e89b2d7b6072a5", URL: "http://guardian.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "33f0db457c8011b0", URL: "http://rssfeeds.11alive.com/wxia-local&x=1", Comments: "Atlanta", Language: "en", Status: "active", Tier: 2},
+	{ID: "efb2f1bd2be6a980", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/world.html", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "41f69ec101458091", URL: "https://globalnews.ca/bc/feed/", Comments: "British Columbia", Language: "en", Status: "active", Tier: 2},
+	{ID: "67f6cf68e0d97e3b", URL: "https://www.nbcchicago.com/news/local/?rss=y&embedThumb=y&summary=y", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "3d0a520344740fe2", URL: "http://en.mehrnews.com/rss/tp/583", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "16d219ce36af8ca4", URL: "http://aa.com.tr/en/rss/default?cat=europe", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "8e1e39cb0d28ef3c", URL: "https://www.scmp.com/rss/3/feed", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "33edba9d4cd87646", URL: "http://www.khaama.com/feed", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "488865909c3360b6", URL: "https://en.trend.az/feeds/index.rss", Comments: "Azerbaijan", Language: "en", Status: "active", Country: "AZ", Tier: 2},
+	{ID: "720387db4753fcd8", URL: "http://www.bta.bg/en/rss/free", Comments: "Bulgaria, Eastern Europe", Language: "en", Status: "active", Country: "BG", Tier: 2},
+	{ID: "bb97f0db4e8c1e63", URL: "https://www.timesofmalta.com/rss", Comments: "Malta, Mediterranean region", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "d7cd904dadc31e7a", URL: "http://keprtv.com/news/local.rss", Comments: "KEPR", Language: "en", Status: "active", Tier: 2},
+	{ID: "cd955bfe7b9dc552", URL: "http://feeds.mexicostar.com/rss/4b980140662cfc90", Comments: "Mexico", Language: "en", Status: "active", Country: "MX", Tier: 2},
+	{ID: "80e9336f717b44b9", URL: "http://icelandmonitor.mbl.is/rss/", Comments: "Iceland, Nordic region", Language: "en", Status: "active", Country: "IS", Tier: 2},
+	{ID: "e05e8f4e2cec3918", URL: "https://www.channelstv.com/category/local/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "4ffb3e8220b757a7", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/pasco.html", Comments: "Pasco County, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "34623029b6cf6dab", URL: "http://thenationonlineng.net/category/news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "91e62958353a0b26", URL: "https://www.walesonline.co.uk/news/uk-news/?service=rss", Comments: "Wales, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9b26e1d13b4ecb6c", URL: "http://www.latimes.com/world/europe/rss2.0.xml", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "0def46e91aa7a3db", URL: "http://en.mehrnews.com/rss/tp/578", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e66affeed5d53f97", URL: "https://vancouversun.com/category/news/national/feed/", Comments: "Vancouver, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "c7765fff69255782", URL: "https://www.liverpoolecho.co.uk/?service=rss", Comments: "Liverpool, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4ba8d51fedab369f", URL: "https://www.mynews13.com/content/news/cfnews13/feeds/rss.html/local-headlines.html", Comments: "Orlando, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "841bd3d3c4d161f1", URL: "https://www.irishtimes.com/rss/irishtimesworldfeed-1.1321046", Comments: "world", Language: "en", Status: "active", Tier: 2},
+	{ID: "0ff5eb2e0ef0e988", URL: "https://www.coventrytelegraph.net/news/coventry-news/?service=rss", Comments: "Coventry, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "6ba90ac53378736d", URL: "http://www.newindianexpress.com/Cities/Delhi/rssfeed/?id=340&getXmlFeed=true", Comments: "Delhi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "26ce8cc41ec80db2", URL: "https://www.mirror.co.uk/news/world-news/?service=rss", Comments: "UK, world", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "f21790860f2e1221", URL: "http://theeagleonline.com.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "753f01fe4243a4cf", URL: "http://www.dnaindia.com/feeds/india.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "f4fe74fbddda49cc", URL: "https://rss.upi.com/news/tn_us.rss", Comments: "Tennessee", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c874d3cb459dc178", URL: "https://ottawacitizen.com/category/news/national/feed/", Comments: "Canada, Ottawa", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "804ee9d210135752", URL: "https://www.azernews.az/feed.php", Comments: "Azerbaijan, Caucasus region", Language: "en", Status: "active", Country: "AZ", Tier: 2},
+	{ID: "f33e1c79fe22d83b", URL: "http://rss.dw.com/rdf/rss-en-eu", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "72bddc5b8c89c2af", URL: "https://www.premiumtimesng.com/category/foreign/feed", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "26509310bc7b3bfc", URL: "http://www.newindianexpress.com/Cities/Chennai/rssfeed/?id=181&getXmlFeed=true", Comments: "Chennai, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "999cba7c04647714", URL: "https://chicago.suntimes.com/rss/news/index.xml", Comments: "Chicago, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ef7392013eee3b93", URL: "https://www.dabangasudan.org/en/feed", Comments: "Dabanga Sudan, Sudan, East Africa", Language: "en", Status: "active", Country: "SD", Tier: 2},
+	{ID: "fc417c8b52f0a968", URL: "http://ariananews.af/category/world/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "0e335dc834bb63ee", URL: "https://www.theguardian.com/world/africa/rss", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "67bce5763b2bb509", URL: "http://www.thesundaily.my/rss/local", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "71f56336ad35d095", URL: "https://vancouversun.com/category/news/local-news/feed/", Comments: "Vancouver", Language: "en", Status: "active", Tier: 2},
+	{ID: "78c2667f1734c336", URL: "http://www.philstar.com/rss/headlines", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "1af0ccf0500715b4", URL: "http://allafrica.com/tools/headlines/rdf/somalia/headlines.rdf", Comments: "Somalia, East Africa", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "16bb7232939cdcbf", URL: "http://www.ibtimes.co.uk/rss/uk", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "05e3a69d43ff80cd", URL: "https://www.thezimbabwean.co/category/news/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "89cfdf9eba2af0e2", URL: "http://www.dnaindia.com/feeds/delhi.xml", Comments: "Delhi, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "bcc88d3f1cc53b39", URL: "https://tribune.com.pk/pakistan/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "1bb70feaae64d783", URL: "https://www.brisbanetimes.com.au/rss/national.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "c870f7047069d964", URL: "http://ariananews.af/category/latest-news/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "38c9306a51c15539", URL: "http://www.wardheernews.com/category/global-news-and-politics/feed/", Comments: "Somalia, East Africa", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "15cc87ac44200d1f", URL: "https://en.trend.az/iran/index.rss", Comments: "Iran", Language: "en", Status: "active", Country: "IR", Tier: 2},
+	{ID: "de16843c63703ad8", URL: "http://www.cbsnews.com/latest/rss/us", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3b6fa9083fa99931", URL: "http://www.latimes.com/rss2.0.xml", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "eb0e55e2cc28a74a", URL: "https://www.baynews9.com/content/news/baynews9/feeds/rss.html/local-news.html", Language: "en", Status: "active", Tier: 2},
+	{ID: "dfed4466a2aa82b1", URL: "https://www.rferl.org/api/zvoi_eogiy", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6dad1d793178434f", URL: "https://splash247.com/category/region/middle-east/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "8f93918d152e636a", URL: "http://www.chronicle.co.zw/category/s6-demo-section/c37-top-stories/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "b3de4b70aa9cdb6c", URL: "http://allafrica.com/tools/headlines/rdf/centralafrica/headlines.rdf", Comments: "Central Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "6fe9434aa1fd48ce", URL: "http://www.france24.com/en/middle-east/rss", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "0324603c83ddd460", URL: "https://www.mynews13.com/content/news/cfnews13/feeds/rss.html/world.html", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9e7b4369e92bafc9", URL: "https://globalnews.ca/toronto/feed/", Comments: "Toronto", Language: "en", Status: "active", Tier: 2},
+	{ID: "52377486594f2e09", URL: "https://www.rferl.org/api/ztoiyeigiv", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b0778a0e7b048b92", URL: "https://www.alaraby.co.uk/english/rss", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce6153e6123c531e", URL: "http://www.independent.co.uk/news/world/americas/rss", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "0fd59bb0494f532e", URL: "https://www.channelstv.com/feed/", Comments: "Nigeria, African", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c15507c99e370901", URL: "http://rss.nzherald.co.nz/rss/xml/nzhrsscid_000000001.xml", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "0404baa9dbc7e157", URL: "http://www.newindianexpress.com/Nation/rssfeed/?id=170&getXmlFeed=true", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "46d4c05424c65c36", URL: "https://www.irishmirror.ie/news/?service=rss", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "873d701646b67342", URL: "http://en.rfi.fr/middle-east/rss", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "ad31e435be85c2a6", URL: "https://www.nbcconnecticut.com/news/local/?rss=y", Comments: "Connecticut, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d65ae4675e9b44f6", URL: "http://allafrica.com/tools/headlines/rdf/northafrica/headlines.rdf", Comments: "North Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "9242480a74155370", URL: "http://www.khaama.com/category/world/europe/feed", Comments: "Afghan, Europe", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "d78902ef0c908a62", URL: "https://globalnews.ca/okanagan/feed/", Comments: "Canada, Okanagan", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "744810f92fde2763", URL: "https://www.capitalfm.co.ke/news/section/kenya/feed/", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "7a83769dfc86ec15", URL: "http://aa.com.tr/en/rss/default?cat=world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "168cdacb2899734e", URL: "http://www.wbrz.com/feeds/rssfeed.cfm?category=58&cat_name=News", Comments: "Baton Rouge, Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4b6a403044531bdb", URL: "https://myfox8.com/news/feed/", Comments: "North Carolina", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5cc6acfbe9c48ffe", URL: "https://www.watoday.com.au/rss/feed.xml", Comments: "Western Australia, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "3617a503b758f8f6", URL: "http://dailycaller.com/section/world/feed/", Comments: "world, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4ceb5624903941f1", URL: "http://www.radionz.co.nz/rss/pacific.xml", Comments: "Pacific region", Language: "en", Status: "active", Tier: 2},
+	{ID: "536438b61351f692", URL: "https://www.kyivpost.com/feed", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "37fba6fb111ec035", URL: "https://globalnews.ca/winnipeg/feed/", Comments: "Winnipeg, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "2dab5b0c7432f6ea", URL: "https://www.premiumtimesng.com/category/news/headlines/feed", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "ebe7ceff705b1481", URL: "http://www.philstar.com/rss/breakingnews", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "d2bffc590b8b7702", URL: "http://rss.dw.com/rdf/rss-en-top", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6d7be55f54e6b92b", URL: "https://news.un.org/feed/subscribe/en/news/all/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "18034346603f013b", URL: "http://www.france24.com/en/europe/rss", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "8bd087aa9c26f796", URL: "http://www.radionz.co.nz/rss/country.xml", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "5cdda9391e028d7c", URL: "https://splash247.com/category/region/asia/feed/", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "347f799265192be6", URL: "http://aa.com.tr/en/rss/default?cat=africa", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "de334f08bfe0c963", URL: "http://feeds.gothamistllc.com/gothamist05", Comments: "NYC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "820109910bdf8cee", URL: "https://www.canberratimes.com.au/rss.xml", Comments: "Canberra, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "b9882a3fe525d754", URL: "http://jordantimes.com/rss.xml", Comments: "Jordan", Language: "en", Status: "active", Country: "JO", Tier: 2},
+	{ID: "7328cf4530e92f20", URL: "https://thefrontierpost.com/feed/", Comments: "Pakistan, region", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "82a46c49786a9208", URL: "https://www.radiohc.cu/en/feed", Comments: "Cuba, Havana, international", Language: "en", Status: "active", Country: "CU", Tier: 2},
+	{ID: "1652c9eccb64dc38", URL: "http://www.peoplesdailyng.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "bfdc38ac0b9ed5bd", URL: "https://guyanachronicle.com/feed", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "67e5360074b09873", URL: "https://www.kenyan-post.com/rss.xml", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "2400dc70dcb26b4a", URL: "http://www.younewsng.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "91fa981cfb2bb831", URL: "https://www.thenewspaper.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "ac45427777c7f6a8", URL: "http://theinsider.ug/index.php/feed/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "1ff814dacbaa2af6", URL: "http://www.zambianobserver.com/feed/", Comments: "Zambia", Language: "en", Status: "active", Country: "ZM", Tier: 2},
+	{ID: "c03c5c852ee91a3e", URL: "http://theinsider.ug/index.php/feed/atom/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "26d81e8d41b1d0fc", URL: "https://www.zehabesha.com/feed/", Comments: "Zehabesha, Ethiopia", Language: "en", Status: "active", Country: "ET", Tier: 2},
+	{ID: "e797d6c6bf00693b", URL: "http://feeds.feedburner.com/TheLiberianTimesNEWS", Comments: "Liberia", Language: "en", Status: "active", Country: "LR", Tier: 2},
+	{ID: "593233a8e4945549", URL: "http://www.saba-news.com/feed/", Comments: "Yemen", Language: "en", Status: "active", Country: "YE", Tier: 2},
+	{ID: "78b64d0d50bbf714", URL: "http://jamaica-star.com/rss.xml", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "f0aca1e0daad7326", URL: "http://sggpnews.org.vn/rss/home.rss", Comments: "Vietnam", Language: "en", Status: "active", Country: "VN", Tier: 2},
+	{ID: "271b60e17a1bf7a5", URL: "http://feeds.barbadosnews.net/rss/c4b8d580569c8adf", Comments: "Barbados", Language: "en", Status: "active", Country: "BB", Tier: 2},
+	{ID: "3b99c34b0730a8c6", URL: "http://feeds.barcelonanews.net/rss/943ff37d8bcb6116", Comments: "Barcelona, Spain", Language: "en", Status: "active", Country: "ES", Tier: 2},
+	{ID: "93f7b142a355c615", URL: "http://www.arabnews.com/rss.xml", Comments: "Saudi Arabia", Language: "en", Status: "active", Country: "SA", Tier: 2},
+	{ID: "ede78a14a461d170", URL: "http://www.egyptindependent.com/feed/", Comments: "Egypt", Language: "en", Status: "active", Country: "EG", Tier: 2},
+	{ID: "a53c6eccab047d78", URL: "http://davaotoday.com/main/feed/", Comments: "Davao, Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "d980fb643c0dbda0", URL: "http://www.qatar-gulfnews.com/feed/", Comments: "Qatar", Language: "en", Status: "active", Country: "QA", Tier: 2},
+	{ID: "a4f19a60e5751b07", URL: "http://timescaribbeanonline.com/feed/", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "4f151672733975a7", URL: "http://www.newsdzezimbabwe.co.uk/rss.xml", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "bf7226d5ddd4746b", URL: "http://www.nowgrenada.com/feed/", Comments: "Grenada", Language: "en", Status: "active", Country: "GD", Tier: 2},
+	{ID: "f9e61ce774b5b16d", URL: "http://feeds.examiner.ie/ieireland", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "893822ab0859a248", URL: "https://jis.gov.jm/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "b89f273106769478", URL: "https://www.thaiexaminer.com/thai-news-foreigners/category/thailand/feed/", Comments: "Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "3aa353b264edff1c", URL: "http://www.newsdzezimbabwe.co.uk/atom.xml", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "2da7dba964f28ff2", URL: "http://www.newsdzezimbabwe.co.uk/feeds/posts/default?alt=rss", Comments: "Zimbabwe, Southern Africa", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "5f5fe7f0fc0601ac", URL: "http://dutchcaribbeannews.com/feed/", Comments: "Dutch Caribbean, Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "0a28148c281915da", URL: "https://www.caymancompass.com/feed/", Comments: "Cayman Islands", Language: "en", Status: "active", Country: "KY", Tier: 2},
+	{ID: "85ed298224579461", URL: "http://www.pattayamail.com/feed", Comments: "Thailand, Pattaya", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "ed59043b40f43f23", URL: "http://www.sundaynews.co.zw/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "aa4aff5ee65f9891", URL: "https://zwnews.com/category/latest-zimbabwe-news/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "bf808124fbd64df8", URL: "http://rjrnewsonline.com/feed/news", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "c83841469066c3f3", URL: "https://theinvestigatornews.com/feed/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "29e34e00c6f9d725", URL: "http://feeds.macaunews.net/rss/8d8fbf819e12707b", Comments: "Macau", Language: "en", Status: "active", Country: "MO", Tier: 2},
+	{ID: "274a08b45c8a0f36", URL: "http://feeds.sierraleonenews.net/rss/8fcd2bb0346b8627", Comments: "Sierra Leone", Language: "en", Status: "active", Country: "SL", Tier: 2},
+	{ID: "326b81d9ff9a5a11", URL: "http://awate.com/feed/", Comments: "Eritrea", Language: "en", Status: "active", Country: "ER", Tier: 2},
+	{ID: "fe8f2644a0138704", URL: "http://ghheadlines.com/rss", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "17aa7564d363f626", URL: "http://www.dailyalert.org/rss/index.xml", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "f8b45cfc3a4a2547", URL: "http://www.thesierraleonetelegraph.com/feed/", Comments: "Sierra Leone", Language: "en", Status: "active", Country: "SL", Tier: 2},
+	{ID: "96e997e961cb4389", URL: "http://feeds.haitinews.net/rss/8d0a8b42a4163d9f", Comments: "Haiti", Language: "en", Status: "active", Country: "HT", Tier: 2},
+	{ID: "8fa86d6d29679b64", URL: "http://bahamaspress.com/feed/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "0959eed3362dd7bf", URL: "http://feeds.vietnamtribune.com/rss/fc849e836694179b", Comments: "Vietnam", Language: "en", Status: "active", Country: "VN", Tier: 2},
+	{ID: "8c852385277a23f1", URL: "http://www.nigerianeye.com/atom.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "60cc611ded62c8df", URL: "http://feeds.feedburner.com/Nigerianeye", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "2555c19c1b76070a", URL: "http://thenationonlineng.net/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "25ad877f31dc4dfd", URL: "http://www.jamaicainquirer.com/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "aeffeadb4abb28e3", URL: "http://www.enca.com/rss.xml", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "0ad1ffe9810dec2f", URL: "https://www.iwnsvg.com/feed/", Comments: "St. Vincent and Grenadines, Caribbean", Language: "en", Status: "active", Country: "VC", Tier: 2},
+	{ID: "f4bcf622e980117c", URL: "https://www.maltatoday.com.mt/rss/", Comments: "Malta", Language: "en", Status: "active", Country: "MT", Tier: 2},
+	{ID: "d6345da1d1f80bed", URL: "http://www.insiderzim.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "8eeaad4cd221a829", URL: "http://www.talamua.com/feed/", Comments: "Samoa", Language: "en", Status: "active", Country: "WS", Tier: 2},
+	{ID: "f7aa9e4537a9c613", URL: "http://www.dominicagazette.com/feed/", Comments: "Dominica", Language: "en", Status: "active", Country: "DM", Tier: 2},
+	{ID: "dcce0e88b51e8f50", URL: "http://www.thisdaylive.com/index.php/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "38d73113d5df8c09", URL: "http://manicapost.co.zw/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "5f1c7152b481034f", URL: "http://www.nigerianeye.com/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "bc80a5a334a15f60", URL: "http://www.zimbabwesituation.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "f53f091667e93617", URL: "http://saharareporters.com/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "353380bc7fef2c15", URL: "http://zambianeye.com/feed/", Comments: "Zambia", Language: "en", Status: "active", Country: "ZM", Tier: 2},
+	{ID: "963608a819066d81", URL: "http://www.kenyan-post.com/atom.xml", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "afdf7c89202f2bc6", URL: "http://www.times.co.sz/feed/index.rss", Comments: "Swaziland", Language: "en", Status: "active", Country: "SZ", Tier: 2},
+	{ID: "3ebc7a3af23a9f47", URL: "http://moderntokyotimes.com/feed/", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "56d76cb1d287709d", URL: "http://www.interaksyon.com/feed/", Comments: "Philippine", Language: "en", Status: "active", Tier: 2},
+	{ID: "0bc4d78a3d25db3f", URL: "http://thevoiceslu.com/feed/", Comments: "St. Lucia", Language: "en", Status: "active", Country: "LC", Tier: 2},
+	{ID: "8aca7b1e97e6b05c", URL: "http://feeds.cambodiantimes.com/rss/c7e1014a94f7e43b", Comments: "Cambodia", Language: "en", Status: "active", Country: "KH", Tier: 2},
+	{ID: "eda3f12440d8853e", URL: "http://www.thezimbabwean.co/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "c8e2514774d675ce", URL: "http://www.stluciachronicle.com/feed/", Comments: "St. Lucia", Language: "en", Status: "active", Country: "LC", Tier: 2},
+	{ID: "90fb2add99273b14", URL: "http://www.today.az/rss.php", Comments: "Azerbaijan", Language: "en", Status: "active", Country: "AZ", Tier: 2},
+	{ID: "2ee7cb96c8ca82fa", URL: "http://www.themedialine.org/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "b4b967240163b1cd", URL: "http://theheraldghana.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "50e0edce1a50a6c2", URL: "http://bernews.com/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "befab8159069052e", URL: "http://www.munster-express.ie/feed/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "b7cde768429d8604", URL: "http://www.stvincenttribune.com/feed/", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "72824c6a2b651896", URL: "http://pointblanknews.com/pbn/category/other-news/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "ec17c6ec92179249", URL: "http://www.times.co.sz/feed/index.atom", Comments: "Eswatini", Language: "en", Status: "active", Country: "SZ", Tier: 2},
+	{ID: "52680be7104c2d25", URL: "https://www.bajanreporter.com/feed/", Comments: "Barbados", Language: "en", Status: "active", Country: "BB", Tier: 2},
+	{ID: "b3e2b7b97a38d383", URL: "http://dailypost.ng/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "3641dad92c9ce887", URL: "http://www.scoop.co.nz/storyindex/index.rss?s.c=SC", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "8bf1db63cdad8e46", URL: "http://feeds.amsterdamnews.net/rss/7221e65f4b29300e", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c17cf40de84dc499", URL: "http://lovefm.com/feed/atom/", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "72089483322453e8", URL: "http://www.sundaymail.co.zw/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "9284263fc45a7ba1", URL: "http://feeds.kualalumpurnews.net/rss/48cba686fe041718", Comments: "Malaysian", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "2f972b90f6f41c29", URL: "http://www.scoop.co.nz/storyindex/index.rss?s.c=AK", Comments: "Auckland Region, New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "893822ab0859a248", URL: "http://jis.gov.jm/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "6ef24611d91c06d4", URL: "http://www.worldtribune.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f4afe5083ce08f5", URL: "http://feeds.jakartanews.net/rss/3b16857a51cb629f", Comments: "Jakarta", Language: "en", Status: "active", Tier: 2},
+	{ID: "1747a47a51429dd4", URL: "http://urhobotoday.com/?feed=rss2", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "e7b794099476d900", URL: "http://www.faceofmalawi.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "5cf5e644cff1fef7", URL: "http://feeds.feedburner.com/khaama", Comments: "Afghan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "427a4154e3c6f9e1", URL: "http://feeds.philippinesnews.net/rss/0267775d6f1c3971", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "3b8154cc6daa1249", URL: "http://feeds.nigeriasun.com/rss/8db1f72cde37faf3", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "ca1065e94da731bb", URL: "http://feeds.bangladeshnews.net/rss/ba01aa42514dc37d", Comments: "Bangladesh", Language: "en", Status: "active", Country: "BD", Tier: 2},
+	{ID: "c7cf381750709a3a", URL: "http://feeds.tajikistannews.net/rss/929bcf2071e81801", Comments: "Tajikistan", Language: "en", Status: "active", Country: "TJ", Tier: 2},
+	{ID: "cf7e130519b3542e", URL: "http://www.stkittsgazette.com/feed/", Comments: "St. Kitts and Nevis", Language: "en", Status: "active", Country: "KN", Tier: 2},
+	{ID: "678812c7f08e8ca0", URL: "http://www.anphoblacht.com/feed", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "1dba81f904779f3b", URL: "http://caribbeanetroundup.com/feed/", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "9f8c6afe34bace4e", URL: "https://bulawayo24.com/feeds-rss-rss.rss", Comments: "Bulawayo, Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "4e22429fbc997d27", URL: "http://realnewsmagazine.net/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "af863a982dc47ecc", URL: "https://www.sanpedrosun.com/environment/feed/", Comments: "San Pedro, Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "ba0f931eb767a6ec", URL: "http://feeds.newzealandnews.net/rss/14d7eba4e435d135", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "4959e91aa83b755d", URL: "https://www.breakingbelizenews.com/feed/", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "1767968c62134a58", URL: "https://feeds.feedburner.com/TheSanPedroSun", Comments: "San Pedro, Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "7640cb17a9e4e414", URL: "https://www.caribdirect.com/feed/", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "fa2bc6e3f29956e1", URL: "http://feeds.laosnews.net/rss/a6670896145a3ae3", Comments: "Laos", Language: "en", Status: "active", Country: "LA", Tier: 2},
+	{ID: "41b9e62cc1abbf68", URL: "http://feeds.centralasianews.net/rss/929bcf2071e81801", Comments: "Central Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "15207e78b40d7969", URL: "http://www.freemalaysiatoday.com/feed/", Comments: "Malaysian", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "e87758d956c868d3", URL: "http://www.samarnews.com/samarnews.xml", Comments: "Somali", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "02711975f174088f", URL: "http://borneobulletin.com.bn/feed/", Comments: "Brunei", Language: "en", Status: "active", Country: "BN", Tier: 2},
+	{ID: "4cd7d01b185074d7", URL: "http://rjrnewsonline.com/feed/all", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "d9aebcafa7c00044", URL: "https://www.limerickpost.ie/feed/", Comments: "Limerick", Language: "en", Status: "active", Tier: 2},
+	{ID: "47f85352452c8dae", URL: "http://feeds.ugandanews.net/rss/faaba65027d16d8c", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "4e6fc7e9fab2e461", URL: "http://feeds.caribbeannews.net/rss/e5abd99746ab67f4", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "892820ac96675284", URL: "http://jamaicans.com/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "ea3dea5dfee2167a", URL: "http://www.monitor.co.ug/rss.xml", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "20ff18f3d32eee55", URL: "https://www.sanpedrosun.com/accidents-and-disasters/feed/", Comments: "San Pedro, Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "4067b54000d31430", URL: "https://www.brisbanetimes.com.au/rss/national/queensland.xml", Comments: "Queensland", Language: "en", Status: "active", Tier: 2},
+	{ID: "fbdb75f048b1f384", URL: "https://www.omaha.com/search/?f=rss&c=weather", Comments: "Omaha", Language: "en", Status: "active", Tier: 2},
+	{ID: "7032fc53c65af74b", URL: "http://fox2now.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e9467cf2ef0a5dd8", URL: "http://www.kmaland.com/search/?f=rss&t=article&c=news/local_news", Comments: "Sioux City", Language: "en", Status: "active", Tier: 2},
+	{ID: "6271737d872b5dd4", URL: "https://dc.gov/node/*/newsroom", Comments: "Washington DC, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d585fc3afdc22dc7", URL: "https://feeds.feedblitz.com/kxtv/news", Comments: "Sacramento, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e8639046a89181fa", URL: "https://www.clickondetroit.com/arc/outboundfeeds/rss/category/news/?outputType=xml&size=10", Comments: "Detroit", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d1ffeeb38587069", URL: "https://www.pressherald.com/category/news/feed/", Comments: "Portland, ME", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b5ad3ed44e7d7326", URL: "https://www.newschannel5.com/news.rss", Comments: "Nashville", Language: "en", Status: "active", Tier: 2},
+	{ID: "7202b313c6cac8c6", URL: "https://spectrumlocalnews.com/services/contentfeed.nc%7ccharlotte%7cnews.landing.rss", Comments: "Charlotte", Language: "en", Status: "active", Tier: 2},
+	{ID: "486b8c16cb15f103", URL: "https://feeds.feedblitz.com/cbs8/news", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "5ce1de96f00c3b77", URL: "https://www.phillymag.com/news/feed/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "0754c09d180b9bb4", URL: "https://www.portlandmercury.com/portland/Rss.xml?section=22100", Comments: "Portland, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5fb2d43e2ca1116d", URL: "https://www.mydallaspost.com/feed", Comments: "Dallas", Language: "en", Status: "active", Tier: 2},
+	{ID: "94ca56e43cff05ea", URL: "https://www.sacurrent.com/sanantonio/Rss.xml", Comments: "San Antonio", Language: "en", Status: "active", Tier: 2},
+	{ID: "d9e446ad83e95e68", URL: "https://www.boston.com/tag/local-news/feed", Comments: "Boston, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "48336044075c9398", URL: "https://www.dallascitynews.net/author/city-of-dallas/feed", Comments: "Dallas", Language: "en", Status: "active", Tier: 2},
+	{ID: "922850efe897cfcc", URL: "https://floridanewswire.com/FLA/florida-news/feed/", Comments: "Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8a6c0ae85734f59f", URL: "https://www.eastbayexpress.com/oakland/Rss.xml?section=1063824", Comments: "Oakland", Language: "en", Status: "active", Tier: 2},
+	{ID: "f22811d7b0eb3d22", URL: "https://rssfeeds.wkyc.com/wkyc/local", Comments: "Cleveland", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c99e5f459a0739f", URL: "http://rssfeeds.wfaa.com/wfaa/home", Comments: "Dallas, Fort Worth, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "21f0c00b61d0cfae", URL: "https://www.voiceofsandiego.org/feed/", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "0db18a69d84a80b8", URL: "https://www.amny.com/news/feed/", Comments: "New York City", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f6eda3bb3c134ee4", URL: "https://lasvegasweekly.com/feeds/headlines/news/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "16f74e05540aa4f2", URL: "https://gothamist.com/feed", Comments: "New York City", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5aa60178c52cb14d", URL: "https://www.kron4.com/news/bay-area/feed", Comments: "San Francisco Bay Area, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "30620fbe95df182f", URL: "https://www.myjournalcourier.com/default/feed/News-RSS-Feed-1973.php", Comments: "Jacksonville, IL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3c14413e4fec7c8d", URL: "https://www.wavy.com/news/local-news/feed/", Comments: "Hampton Roads", Language: "en", Status: "active", Tier: 2},
+	{ID: "6c4f679e264f2e52", URL: "https://cltv.com/feed/", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "b87b196a0d76e78d", URL: "http://rssfeeds.wcnc.com/wcnc/home", Comments: "Charlotte", Language: "en", Status: "active", Tier: 2},
+	{ID: "706eb6b8370a8f7f", URL: "https://www.coloradodaily.com/feed/", Comments: "Boulder, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "423adc45408d0cfb", URL: "https://www.sandiegoreader.com/rss/headlines/", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "2bb21502020030c0", URL: "http://rssfeeds.kgw.com/kgw/Crime", Comments: "Portland, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dfa26bc9742b6dce", URL: "https://whyy.org/feed/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "ab5d13965cc03ce0", URL: "https://www.austindailyherald.com/feed/", Comments: "Austin, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2e5f47c85ac626fc", URL: "https://news.wttw.com/feed", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "ee93eb5d71460b7c", URL: "https://gazette.com/search/?q=&t=article&l=25&d=&d1=&d2=&s=start_time&sd=desc&c%5B%5D=news/local&f=rss&_ga=2.202676881.141844704.1554247030-292582302.1554247030", Comments: "Colorado Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "c75d63cdc5ab0cb8", URL: "https://abc7ny.com/feed/", Comments: "US, New York City", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0e67f510de93343a", URL: "http://rssfeeds.12news.com/kpnx/local&x=1", Comments: "Phoenix, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8800ed82ff0f9b05", URL: "https://phl17.com/feed/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7717ffe487d89ad", URL: "https://timesofsandiego.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "85a11dd48493f405", URL: "https://www.koaa.com/news.rss", Comments: "Colorado Springs, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9c85014d14badada", URL: "https://www.wtkr.com/news.rss", Comments: "Norfolk, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2634f4304d661b19", URL: "https://www.abc15.com/news/region-central-southern-az/tucson.rss", Comments: "Tucson", Language: "en", Status: "active", Tier: 2},
+	{ID: "bfb13985f11bf424", URL: "https://www.dailyherald.com/rss/feed/?feed=news_top5", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9a2966770e3af17f", URL: "https://www.nysun.com/rss.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "63214705a3575d23", URL: "https://www.houstonpublicmedia.org/feed/", Comments: "Houston", Language: "en", Status: "active", Tier: 2},
+	{ID: "081fe446dbff2eb6", URL: "https://www.abc15.com/news/region-southeast-valley/mesa.rss", Comments: "Phoenix Suburbs, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c192c64231d1a6b8", URL: "https://www.nbclosangeles.com/news/local/feed", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "320c156aefd4ed94", URL: "https://www.nbcwashington.com/news/local/feed", Comments: "Washington D.C.", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c8e8f94d96d3a0b6", URL: "https://www.nbcnewyork.com/news/local/?rss=y", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c9fad4f85e2c2435", URL: "https://www.kusi.com/feed/", Comments: "San Diego, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b7a24397e9793888", URL: "http://rssfeeds.wusa9.com/WUSA-news", Comments: "Washington D.C.", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2b763401ed7402e7", URL: "https://www.nbclosangeles.com/?rss=y", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c2aef7232775487", URL: "https://fox5sandiego.com/feed/", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "70f5db752903ce82", URL: "https://www.phillyvoice.com/feed/section/news/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "797618bfff664b8d", URL: "https://www.countynewscenter.com/news/rss", Comments: "San Diego County", Language: "en", Status: "active", Tier: 2},
+	{ID: "6a3b7965f5f4994b", URL: "https://www.arklatexhomepage.com/feed/", Comments: "Shreveport", Language: "en", Status: "active", Tier: 2},
+	{ID: "e47144f0a95fc72a", URL: "http://www.ktoo.org/feed/", Comments: "Juneau, Alaska", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5a50ef8117213570", URL: "http://rapidcityjournal.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Rapid City", Language: "en", Status: "active", Tier: 2},
+	{ID: "37a3fd2be2b23e37", URL: "https://www.fairbanksalaska.us/news/rss", Comments: "Fairbanks, Alaska", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bd8d3bedb0ee0bf0", URL: "https://www.westernslopenow.com/feed/", Comments: "Western Colorado", Language: "en", Status: "active", Tier: 2},
+	{ID: "4b4fae151245ff45", URL: "https://www.wbtw.com/home/feed/", Comments: "Florence, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8b8dbd30e1aafedb", URL: "https://www.wane.com/feed/", Comments: "Fort Wayne", Language: "en", Status: "active", Tier: 2},
+	{ID: "15e3091c2a12a230", URL: "https://www.juneauempire.com/feed/", Comments: "Juneau, Alaska", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f0a796abcfd57b38", URL: "https://www.counton2.com/feed/", Comments: "Charleston, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3dce77b96910ce94", URL: "https://whnt.com/news/huntsville/feed/", Comments: "Huntsville", Language: "en", Status: "active", Tier: 2},
+	{ID: "c86b2906cae614d8", URL: "https://www.wkrg.com/feed/", Comments: "Mobile, AL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0202463c7c4c5f01", URL: "https://pittsburgh.cbslocal.com/category/news/feed/", Comments: "Pittsburgh", Language: "en", Status: "active", Tier: 2},
+	{ID: "ca2c6830517b95f9", URL: "https://www.koin.com/news/feed", Comments: "Portland, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0b7145b372fd9720", URL: "https://www.dailycamera.com/feed/", Comments: "Boulder, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "83cae71d5db21187", URL: "https://public.govdelivery.com/topics/USDHSCG_177/feed.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6e1007a6a5826c1b", URL: "https://whotv.com/category/news/feed/", Comments: "Des Moines", Language: "en", Status: "active", Tier: 2},
+	{ID: "08263046a595625e", URL: "https://www.wjbf.com/news/crime-news/feed/", Comments: "Augusta, GA", Language: "en", Status: "active", Country: "GA", Tier: 2},
+	{ID: "1538ee9d272754a6", URL: "https://oilcity.news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "cd632470f942cccb", URL: "https://www.localsyr.com/news/local-news/feed/", Comments: "Syracuse", Language: "en", Status: "active", Tier: 2},
+	{ID: "eaca0437e5f09091", URL: "https://www.rdrnews.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Roseburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "51c891b28a30b18e", URL: "https://www.mypanhandle.com/feed/", Comments: "Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cfed881c320a168a", URL: "https://www.myhighplains.com/feed/", Comments: "Amarillo, High Plains", Language: "en", Status: "active", Tier: 2},
+	{ID: "b00ddaaee5ae9495", URL: "https://www.yourerie.com/feed/", Comments: "Erie, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "64aa7e70e065b6cd", URL: "https://www.pressofatlanticcity.com/search/?c=news/local&f=rss&l=50&s=start_time&sd=desc&t=article", Comments: "Atlantic City", Language: "en", Status: "active", Tier: 2},
+	{ID: "72d7141867166eb6", URL: "https://www.saratogian.com/feed/", Comments: "Saratoga Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "173260db104ac2a9", URL: "https://www.abc15.com/news/region-southeast-valley/gilbert.rss", Comments: "Gilbert", Language: "en", Status: "active", Tier: 2},
+	{ID: "dcfa196aef9f7411", URL: "https://www.rochesterfirst.com/feed/", Comments: "Rochester, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cb05737bd59a120e", URL: "https://www.yakimaherald.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Yakima", Language: "en", Status: "active", Tier: 2},
+	{ID: "218fe578383acd4c", URL: "https://www.mytwintiers.com/feed/", Comments: "PA, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2f8b1d1d5998198e", URL: "https://www.wsav.com/feed/", Comments: "Savannah", Language: "en", Status: "active", Tier: 2},
+	{ID: "17e9843fc1cc6eb7", URL: "https://www.wivb.com/news/local-news/app-feed", Comments: "Buffalo", Language: "en", Status: "active", Tier: 2},
+	{ID: "91b97bb7130e47b3", URL: "http://rssfeeds.kgw.com/kgw/local", Comments: "Portland, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0e807814643ae127", URL: "https://www.winknews.com/feed/", Comments: "Fort Myers, Naples", Language: "en", Status: "active", Tier: 2},
+	{ID: "c4ef6fc5aa6b427b", URL: "https://www.newsadvance.com/search/?q=&t=article&l=10&d=&d1=&d2=&s=start_time&sd=desc&c=news/local*&f=rss", Comments: "Lynchburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "3af1d1174a69fc91", URL: "https://www.wavy.com/app-feed/", Comments: "Hampton Roads", Language: "en", Status: "active", Tier: 2},
+	{ID: "c41bc92633a068eb", URL: "https://www.abc15.com/news/region-northeast-valley/scottsdale.rss", Comments: "Scottsdale, AZ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0e756c038ac56693", URL: "https://www.wtnh.com/news/connecticut/hartford/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "3a559567914059cb", URL: "https://www.statecollege.com/feed/", Comments: "State College", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3c7a8f8ea9dd94c", URL: "https://abc11.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ecc5ef8048ab11aa", URL: "https://www.abc15.com/news/region-southeast-valley/tempe.rss", Comments: "Tempe", Language: "en", Status: "active", Tier: 2},
+	{ID: "41a0fd5e5e48ec1f", URL: "https://www.wric.com/news/local-news/feed/", Comments: "Richmond", Language: "en", Status: "active", Tier: 2},
+	{ID: "91c9ff59958927e4", URL: "http://rssfeeds.13newsnow.com/wvec/local", Comments: "Hampton Roads", Language: "en", Status: "active", Tier: 2},
+	{ID: "6805424965aff17c", URL: "https://feeds.feedburner.com/the-columbian-local-headlines", Comments: "Vancouver, WA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5c5bb0c94f447f10", URL: "https://www.wkrg.com/northwest-florida/feed/", Comments: "Northwest Florida", Language: "en", Status: "active", Tier: 2},
+	{ID: "801a7e3d19093aca", URL: "https://www.binghamtonhomepage.com/feed/", Comments: "Binghamton", Language: "en", Status: "active", Tier: 2},
+	{ID: "ffeeadd76f572d89", URL: "http://rssfeeds.krem.com/krem/headlines/local", Comments: "Spokane", Language: "en", Status: "active", Tier: 2},
+	{ID: "e98cf6e6c061824e", URL: "https://bismarcktribune.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Bismarck", Language: "en", Status: "active", Tier: 2},
+	{ID: "db60eadcf603a54f", URL: "https://www.westernslopenow.com/news/local-news/feed/", Comments: "Western Colorado", Language: "en", Status: "active", Tier: 2},
+	{ID: "6e2e63bbf42abe29", URL: "https://www.ocregister.com/location/california/orange-county/irvine/feed/", Comments: "Irvine, Orange County", Language: "en", Status: "active", Tier: 2},
+	{ID: "915a2804a463c9d1", URL: "https://dailyvoice.com/connecticut/danbury/rss/", Comments: "Danbury, CT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "475eb76dac44d6ad", URL: "https://www.mrt.com/news/feed/news-1437.php", Comments: "Midland, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1dab595125acc622", URL: "https://www.broomfieldenterprise.com/feed/", Comments: "Broomfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "7652c9f3d0c4fcc1", URL: "https://spmetrowire.com/feed", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f50dc42ad35f6cf", URL: "https://ktla.com/tag/corona/feed/", Comments: "city", Language: "en", Status: "active", Tier: 2},
+	{ID: "c6f29a532b922fa3", URL: "https://www.wowktv.com/feed/", Comments: "Charleston, Huntington, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8a95f5b4f7d7d9cc", URL: "https://fredericksburg.today/feed/", Comments: "Fredericksburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "406db21588accbb2", URL: "https://www.sebastiandaily.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e70e9cb4cf93552b", URL: "https://www.timescall.com/feed/", Comments: "Longmont", Language: "en", Status: "active", Tier: 2},
+	{ID: "33ca4f2ec064fa56", URL: "http://savannahpd.org/category/uncategorized/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "7ee1f732e8044270", URL: "http://muskegontribune.com/category/news/feed/", Comments: "Muskegon", Language: "en", Status: "active", Tier: 2},
+	{ID: "e54c0af4fce760b1", URL: "https://www.toledoblade.com/rss", Comments: "Toledo", Language: "en", Status: "active", Tier: 2},
+	{ID: "34eafa3fa7d1a6c3", URL: "https://ktla.com/tag/santa-ana/feed", Comments: "city", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b5d1783b4e5ea8e", URL: "http://feeds.feedburner.com/SpaceCoastDaily", Comments: "Space Coast, Florida, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c466fe2681e481d1", URL: "https://www.ksnt.com/feed/", Comments: "Topeka", Language: "en", Status: "active", Tier: 2},
+	{ID: "746d1a49d5d31a2d", URL: "https://www.nbcdfw.com/news/local/feed/", Comments: "Dallas, Fort Worth", Language: "en", Status: "active", Tier: 2},
+	{ID: "bc7b23d136d186e1", URL: "https://www.wrbl.com/feed/", Comments: "Columbus, GA, Auburn, AL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0d7e7f816a6c9177", URL: "https://madriverunion.com/category/news/feed/", Comments: "Humboldt County", Language: "en", Status: "active", Tier: 2},
+	{ID: "0c7e30028b8abfb1", URL: "https://www.wjbf.com/feed/", Comments: "Augusta", Language: "en", Status: "active", Tier: 2},
+	{ID: "f3c99e7e24f7cd29", URL: "https://www.ocregister.com/location/california/orange-county/santa-ana/feed/", Comments: "Orange County, Santa Ana", Language: "en", Status: "active", Tier: 2},
+	{ID: "95512b1071c7393c", URL: "https://keyt.com/category/news/santa-maria-north-county/feed/", Comments: "Santa Maria, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c84c5738c5f9f8de", URL: "https://ktla.com/tag/oxnard/feed/", Comments: "Oxnard", Language: "en", Status: "active", Tier: 2},
+	{ID: "1a5da949cc816f03", URL: "https://www.wnct.com/feed", Comments: "Greenville, New Bern, Jacksonville", Language: "en", Status: "active", Tier: 2},
+	{ID: "6069869f1269e95d", URL: "https://www.fourstateshomepage.com/feed", Comments: "MO, KS, OK, AR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "aa5110cb2430e360", URL: "https://www.centralillinoisproud.com/news/local-news/feed", Comments: "Central Illinois", Language: "en", Status: "active", Tier: 2},
+	{ID: "8b34db0ebf9738d4", URL: "https://abc7chicago.com/feed/", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "677ee389b37a793e", URL: "https://www.kvrr.com/feed/", Comments: "Fargo, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "530ac5766db061e3", URL: "https://www.ocregister.com/location/california/orange-county/mission-viejo/feed/", Comments: "Mission Viejo, Orange County", Language: "en", Status: "active", Tier: 2},
+	{ID: "e562a5e657846d41", URL: "https://www.forsythnews.com/rss/", Comments: "Forsyth County", Language: "en", Status: "active", Tier: 2},
+	{ID: "029f3d7976e7068a", URL: "https://www.montereyherald.com/feed/", Comments: "Monterey, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ee89b0a5e7ff48b6", URL: "https://www.rlsmedia.com/breaking-news.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "baec2fa2d7c20c8c", URL: "https://www.wate.com/news/local-news/feed", Comments: "Knoxville", Language: "en", Status: "active", Tier: 2},
+	{ID: "ff77a02ee4e07ad5", URL: "https://41nbc.com/feed/", Comments: "Macon", Language: "en", Status: "active", Tier: 2},
+	{ID: "88540d4b0a5564b9", URL: "http://newsantaana.com/?feed=rss", Comments: "Santa Ana", Language: "en", Status: "active", Tier: 2},
+	{ID: "7d229c5e4abea7e3", URL: "https://www.woodtv.com/news/grand-rapids/feed/", Comments: "Grand Rapids", Language: "en", Status: "active", Tier: 2},
+	{ID: "c073bbad1316f072", URL: "https://whnt.com/category/news/madison/feed/", Comments: "Madison", Language: "en", Status: "active", Tier: 2},
+	{ID: "365988c2096a5d2b", URL: "https://mtstandard.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Butte", Language: "en", Status: "active", Tier: 2},
+	{ID: "4552beab5ebc9eef", URL: "https://www.fox16.com/news/local-news/feed/", Comments: "Little Rock", Language: "en", Status: "active", Tier: 2},
+	{ID: "4dee2cc7c51feb9e", URL: "https://ktla.com/tag/hesperia/feed/", Comments: "Hesperia", Language: "en", Status: "active", Tier: 2},
+	{ID: "b987f4890d62f042", URL: "https://www.gainesvilletimes.com/news/rss/", Comments: "Gainesville", Language: "en", Status: "active", Tier: 2},
+	{ID: "cc27fd3344633938", URL: "https://krdo.com/category/news/crime/pueblo-county-crime/feed/", Comments: "Pueblo County", Language: "en", Status: "active", Tier: 2},
+	{ID: "541a448467eae093", URL: "https://ktla.com/tag/riverside/feed/", Comments: "city", Language: "en", Status: "active", Tier: 2},
+	{ID: "b4ab92d47c3eb6c6", URL: "http://flintbeat.com/feed/", Comments: "Flint", Language: "en", Status: "active", Tier: 2},
+	{ID: "d8dbbd55d76ab0e9", URL: "https://www.bristowbeat.com/browse.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d90116ab5e39f035", URL: "https://www.pantagraph.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Bloomington", Language: "en", Status: "active", Tier: 2},
+	{ID: "1a3a05ecd2e8f5ce", URL: "https://hyannisnews.com/feed/", Comments: "Hyannis", Language: "en", Status: "active", Tier: 2},
+	{ID: "2727d7665bf8a4b9", URL: "http://capecoddaily.com/news/feed/", Comments: "Cape Cod", Language: "en", Status: "active", Tier: 2},
+	{ID: "0306cd0ab9c37d96", URL: "https://www.wkrg.com/mobile-county/feed/", Comments: "Mobile County", Language: "en", Status: "active", Tier: 2},
+	{ID: "551b645a35a9ae58", URL: "https://www.dailybulletin.com/location/california/san-bernardino-county/foothill-cities/rancho-cucamonga/feed/", Comments: "Rancho Cucamonga", Language: "en", Status: "active", Tier: 2},
+	{ID: "6d4563a7fedcc652", URL: "https://www.wnct.com/local-news/jacksonville/feed/", Comments: "Jacksonville, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "719eaf3f26a1c6f0", URL: "https://www.wcia.com/feed/", Comments: "Champaign, Springfield, Decatur", Language: "en", Status: "active", Tier: 2},
+	{ID: "a5522e4d29c7d3ff", URL: "https://www.nwahomepage.com/news/feed/", Comments: "Northwest Arkansas", Language: "en", Status: "active", Tier: 2},
+	{ID: "e274e0fbaee76bb6", URL: "https://doverpolice.org/category/news/feed/", Comments: "Dover", Language: "en", Status: "active", Tier: 2},
+	{ID: "3258a1a60d0c34e3", URL: "https://www.wjhl.com/feed", Comments: "Tri-Cities", Language: "en", Status: "active", Tier: 2},
+	{ID: "c9ac5345c811c936", URL: "https://www.independent.com/feed/", Comments: "US, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a86a6030a99ff9f3", URL: "https://www.kget.com/feed/", Comments: "Bakersfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "8c68a8e40b4e89a6", URL: "https://fox59.com/news/indynews/feed/", Comments: "Indianapolis", Language: "en", Status: "active", Tier: 2},
+	{ID: "5990f749a9ee198a", URL: "https://www.dailybulletin.com/location/california/los-angeles-county/inland-empire/ontario/feed/", Comments: "Ontario, Inland Empire", Language: "en", Status: "active", Tier: 2},
+	{ID: "3728f307eb101017", URL: "https://www.wowktv.com/app-feed", Comments: "Charleston, Huntington", Language: "en", Status: "active", Tier: 2},
+	{ID: "b7e144469d4913b7", URL: "https://www.camaspostrecord.com/feed/", Comments: "Camas-Washougal", Language: "en", Status: "active", Tier: 2},
+	{ID: "db9b6961bd126ad2", URL: "http://firststateupdate.com/feed/", Comments: "Delaware", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "211bfcf62725b640", URL: "https://opelikaobserver.com/feed/", Comments: "Opelika", Language: "en", Status: "active", Tier: 2},
+	{ID: "1c7e94f5d3f6cee7", URL: "https://www.mypanhandle.com/news/local-news/feed/", Comments: "Panhandle, Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4d79129efb816410", URL: "https://www.mystateline.com/news/local-news/app-feed/", Comments: "Rockford", Language: "en", Status: "active", Tier: 2},
+	{ID: "39dbeb456e3f713a", URL: "https://bayshore.greaterlongisland.com/feed", Comments: "Bayshore, Long Island", Language: "en", Status: "active", Tier: 2},
+	{ID: "48fa35712daad08b", URL: "https://www.theeagle.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "College Station", Language: "en", Status: "active", Tier: 2},
+	{ID: "9dc1f9e5731b380f", URL: "https://www.millburysutton.com/feed/", Comments: "Millbury, Sutton", Language: "en", Status: "active", Tier: 2},
+	{ID: "69289c2053e46111", URL: "https://www.vvng.com/category/news/feed", Comments: "Victor Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "babe5a468da3d98b", URL: "https://www.owensborotimes.com/feed/", Comments: "Owensboro", Language: "en", Status: "active", Tier: 2},
+	{ID: "c8ba09e467be0787", URL: "https://www.nwitimes.com/search/?app=editorial&q=&t=article&l=10&d=&d1=&d2=&s=start_time&sd=desc&fl=urgent&f=rss", Comments: "Northwest Indiana", Language: "en", Status: "active", Tier: 2},
+	{ID: "9163f4fbf581039a", URL: "https://www.newstimes.com/rss/feed/News-1461.php", Comments: "Danbury", Language: "en", Status: "active", Tier: 2},
+	{ID: "b611a2cc7b1db939", URL: "https://www.eastbaytimes.com/location/brentwood/feed/", Comments: "Brentwood, East Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "553fd61992246095", URL: "https://www.kark.com/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "26797fe9e2ef181c", URL: "https://ktla.com/tag/irvine/feed/", Comments: "Irvine", Language: "en", Status: "active", Tier: 2},
+	{ID: "7d99466040a207c6", URL: "https://www.times-standard.com/feed/", Comments: "Eureka, California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a50d3208bd7bc066", URL: "https://www.heraldnet.com/tag/marysville/feed/", Comments: "Marysville", Language: "en", Status: "active", Tier: 2},
+	{ID: "64e497c57cf4ac23", URL: "https://www.wtnh.com/news/connecticut/fairfield/feed/", Comments: "Fairfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "24391ea9f0612243", URL: "http://www.sjodaily.com/feed/", Comments: "San Joaquin Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c6ee6d13610cbe1", URL: "https://valdostatoday.com/category/news-2/local/feed/", Comments: "Valdosta", Language: "en", Status: "active", Tier: 2},
+	{ID: "af720d16caf5bf11", URL: "https://wdef.com/feed", Comments: "Chattanooga", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ba74d537b1754e3", URL: "https://www.localdvm.com/news/local-news/app-feed", Comments: "Washington D.C.", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a182e565eafd0c8c", URL: "https://fox8.com/tag/toledo-news/feed/", Comments: "Toledo", Language: "en", Status: "active", Tier: 2},
+	{ID: "165c759ba4a59ab1", URL: "https://dailyvoice.com/connecticut/fairfield/rss/", Comments: "Fairfield County", Language: "en", Status: "active", Tier: 2},
+	{ID: "a32e52d9c52721ac", URL: "https://www.kpax.com/index.rss", Comments: "Montana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c8866da8741d94d1", URL: "https://www.wtrf.com/news/feed/", Comments: "Wheeling, Steubenville", Language: "en", Status: "active", Tier: 2},
+	{ID: "3309bac597767b5d", URL: "https://www.unionleader.com/search/?q=&t=article&f=rss&bl=703206", Comments: "Manchester", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e997d452a4754d7", URL: "https://wnymedia.net/feed/", Comments: "Western New York", Language: "en", Status: "active", Tier: 2},
+	{ID: "ad2fc5075dc7db1c", URL: "https://wtop.com/local/maryland/montgomery-county/feed/", Comments: "Montgomery County, Maryland", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2b38f1cbcd5c1707", URL: "https://wtop.com/local/feed/", Comments: "Washington D.C.", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b061f4c502d6cdb0", URL: "https://www.ocregister.com/location/california/orange-county/costa-mesa/feed", Comments: "Costa Mesa, Orange County", Language: "en", Status: "active", Tier: 2},
+	{ID: "a708b8b80c8e6f87", URL: "https://feeds.feedblitz.com/WUSA-local", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b4acead00cba7b5", URL: "https://fox5sandiego.com/news/local-news/feed/", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "342168dd3b63fea6", URL: "https://www.wdtn.com/feed", Comments: "Dayton, Springfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "09b3b963977709bd", URL: "https://fox8.com/tag/dayton-news/feed/", Comments: "Dayton", Language: "en", Status: "active", Tier: 2},
+	{ID: "2591163332d0453e", URL: "https://mecktimes.com/feed/", Comments: "Mecklenburg County", Language: "en", Status: "active", Tier: 2},
+	{ID: "e9218d45707a3da6", URL: "https://wtop.com/local/dc/feed/", Comments: "Washington D.C.", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6a351f8c96ce7540", URL: "https://www.sandiegouniontribune.com/local.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "52f51a6ca4bc129d", URL: "https://www.wcpo.com/news/local-news.rss", Comments: "Cincinnati", Language: "en", Status: "active", Tier: 2},
+	{ID: "179c6e1b1fd7adc2", URL: "https://www.wane.com/news/local-news/feed", Comments: "Fort Wayne", Language: "en", Status: "active", Tier: 2},
+	{ID: "de9b47f7d579b51e", URL: "https://www.sltrib.com/arc/outboundfeeds/news/?outputType=xml", Comments: "Salt Lake City", Language: "en", Status: "active", Tier: 2},
+	{ID: "3454d8653ca86a06", URL: "https://www.pressofatlanticcity.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Atlantic City", Language: "en", Status: "active", Tier: 2},
+	{ID: "f8186d5a064dde5e", URL: "https://www.wspa.com/feed/", Comments: "Spartanburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "53da30f167e225de", URL: "https://www.cbs17.com/app-feed/", Comments: "Raleigh", Language: "en", Status: "active", Tier: 2},
+	{ID: "788f0e6926dc4f63", URL: "https://www.kveo.com/news/feed/", Comments: "Laredo", Language: "en", Status: "active", Tier: 2},
+	{ID: "f66da2ce9643913b", URL: "https://foxnewssouthtexas.com/category/news/feed/", Comments: "South Texas", Language: "en", Status: "active", Tier: 2},
+	{ID: "8863a7205f50db9d", URL: "https://www.kristv.com/index.rss", Comments: "Corpus Christi", Language: "en", Status: "active", Tier: 2},
+	{ID: "23a32d3c01fe3bee", URL: "https://www.ozarksfirst.com/feed/", Comments: "Springfield, MO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4addbc98745110ba", URL: "https://www.brproud.com/feed/", Comments: "Baton Rouge", Language: "en", Status: "active", Tier: 2},
+	{ID: "7d3afca3ca2193d5", URL: "https://www.mychamplainvalley.com/feed/", Comments: "Burlington, Plattsburgh", Language: "en", Status: "active", Tier: 2},
+	{ID: "df541ee8067e87bc", URL: "http://billingsgazette.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Billings", Language: "en", Status: "active", Tier: 2},
+	{ID: "3afdfa4a3cad0f7e", URL: "https://ktvz.com/feed/", Comments: "Bend, Oregon", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "592a2058bd8b52e2", URL: "https://oilcity.news/category/crime/feed/", Comments: "Casper", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c52e0548d14079f", URL: "https://www.theadvocate.com/search/?f=rss&t=article&c=baton_rouge/news/crime_police&l=50&s=start_time&sd=desc", Comments: "Baton Rouge", Language: "en", Status: "active", Tier: 2},
+	{ID: "bcc1675e79898e6c", URL: "https://trib.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Casper", Language: "en", Status: "active", Tier: 2},
+	{ID: "5aa60178c52cb14d", URL: "https://www.kron4.com/news/bay-area/feed/", Comments: "Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "e50bba9b460574c8", URL: "https://www.cbs42.com/feed/", Comments: "Birmingham", Language: "en", Status: "active", Tier: 2},
+	{ID: "a6e14cfcf0d0c922", URL: "https://www.birminghamtimes.com/category/local/local-news/feed", Comments: "Birmingham", Language: "en", Status: "active", Tier: 2},
+	{ID: "231a4e716ab979b9", URL: "https://www.khon2.com/local-news/feed", Comments: "Honolulu", Language: "en", Status: "active", Tier: 2},
+	{ID: "a0b8302395fab358", URL: "http://feeds.honolulunews.net/rss/a5a14b1515940e55", Comments: "Honolulu", Language: "en", Status: "active", Tier: 2},
+	{ID: "2544bf05d87f1152", URL: "https://www.mywabashvalley.com/feed/", Comments: "Terre Haute", Language: "en", Status: "active", Tier: 2},
+	{ID: "5a9a078728a9e7e1", URL: "https://nbcpalmsprings.com/feed/", Comments: "Palm Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "d4d6e824c44d74e4", URL: "https://wvrecord.com/stories.rss", Comments: "West Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5a1f5ac20ca9bf11", URL: "https://www.bigcountryhomepage.com/news/feed/", Comments: "Abilene", Language: "en", Status: "active", Tier: 2},
+	{ID: "2fb451c875b97f72", URL: "https://www.conchovalleyhomepage.com/news/local-news/feed/", Comments: "San Angelo", Language: "en", Status: "active", Tier: 2},
+	{ID: "06dd8e3710f25d4c", URL: "https://www.ourmidland.com/news/feed/News-1356.php", Comments: "Midland, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ce955c7308014b88", URL: "https://www.siouxlandproud.com/feed/", Comments: "Sioux City", Language: "en", Status: "active", Tier: 2},
+	{ID: "7497b35ab6df32ae", URL: "https://www.wxxv25.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "503aa6e037b6045c", URL: "https://www.3newsnow.com/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b89426cdcf43fb3e", URL: "https://www.mycentraloregon.com/feed/", Comments: "Bend", Language: "en", Status: "active", Tier: 2},
+	{ID: "43d85251fbc539ac", URL: "https://mynorthwest.com/feed/", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "23ff85abcb09412d", URL: "https://calgaryherald.com/feed", Comments: "Calgary, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "3d022f00ba048c69", URL: "https://winnipegsun.com/feed/", Comments: "Winnipeg, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "3e892b8ff5d5a242", URL: "https://www.tmj4.com/news/local-news.rss", Comments: "Milwaukee", Language: "en", Status: "active", Tier: 2},
+	{ID: "5b04b3c4b5adbc98", URL: "http://www.florencedailynews.com/category/news/feed", Comments: "Florence, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fa38296884cb6726", URL: "https://www.jpost.com/rss/rssfeedsheadlines.aspx", Comments: "Jerusalem", Language: "en", Status: "active", Tier: 2},
+	{ID: "718604a17c463058", URL: "https://www.fox46.com/crime-and-public-safety/feed/", Comments: "Charlotte", Language: "en", Status: "active", Tier: 2},
+	{ID: "98f0f890cde138b9", URL: "https://www.fox46.com/news/local-news/feed/", Comments: "Charlotte", Language: "en", Status: "active", Tier: 2},
+	{ID: "fff69277dff003e7", URL: "https://whdh.com/news/local/feed/", Comments: "Boston, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "06767a51769a5334", URL: "https://news365.co.za/category/accidents/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "7757c0d84227d1c9", URL: "https://news365.co.za/category/local-news/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "48a6e949a5db2907", URL: "https://iontb.com/category/news/feed/", Comments: "Tampa Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "bbd79e893ce6589b", URL: "http://feeds.hongkongnews.net/rss/b82693edf38ebff8", Comments: "Hong Kong", Language: "en", Status: "active", Country: "HK", Tier: 2},
+	{ID: "6441f328fc7722f9", URL: "https://nation.com.pk/rss/peshawar", Comments: "Peshawar, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "a3f16351053cb14a", URL: "https://nation.com.pk/rss/islamabad", Comments: "Islamabad, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "6b2e3e6f3abdd85f", URL: "https://nation.com.pk/rss/national", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "135f6d3c7b29c792", URL: "https://nation.com.pk/rss/karachi", Comments: "Karachi, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "e476ab183bf08253", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128839596.cms", Comments: "World", Language: "en", Status: "active", Tier: 2},
+	{ID: "88ffd09bf26d23b9", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128833038.cms", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "187756d58e5d8f19", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128816011.cms", Comments: "US, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "22ac94c5de953543", URL: "https://timesofindia.indiatimes.com/rssfeeds/2950623.cms", Comments: "India, global", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "84ff2c9649cb0811", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128821153.cms", Comments: "Rest of World, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b3195fb010808c3", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128830821.cms", Comments: "China, global", Language: "en", Status: "active", Country: "CN", Tier: 2},
+	{ID: "0dc319e6770c946f", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128821991.cms", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "7bd01f262a0d53b2", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128819658.cms", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "651eb2bc56a4da7a", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128817995.cms", Comments: "Canada, global", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "dde9b2a02f9f78a5", URL: "https://timesofindia.indiatimes.com/rssfeeds/-2128816762.cms", Comments: "Africa, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "74146a43c4422dd6", URL: "https://www.smh.com.au/rss/national/nsw.xml", Comments: "NSW, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "0db8816954cf01fa", URL: "https://alliancetimes.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d276acfe514035e", URL: "https://www.turnto23.com/news.rss", Comments: "Bakersfield, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9ebee9718785ea87", URL: "https://www.kget.com/news/local-news/feed/", Comments: "Bakersfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f43b93927035982", URL: "https://www.dublinlive.ie/news/dublin-news/?service=rss", Comments: "Dublin, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "52c7dba6bb6c3f48", URL: "https://www.theolivepress.es/spain-news/category/andalucia-news/malaga/feed", Comments: "Malaga, Spain", Language: "en", Status: "active", Country: "ES", Tier: 2},
+	{ID: "b8f66dc70dc1abe4", URL: "https://www.wtvq.com/category/news/local-news/feed/", Comments: "Lexington", Language: "en", Status: "active", Tier: 2},
+	{ID: "06ba1ca07ad0627f", URL: "https://www.wtvq.com/category/news/state-news/feed/", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6acdfcde0474bddd", URL: "https://www.sheffieldtelegraph.co.uk/news/rss", Comments: "Sheffield, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "3ace767b6901978a", URL: "http://rockfordscanner.com/feed/", Comments: "Rockford", Language: "en", Status: "active", Tier: 2},
+	{ID: "7ecc5234dbca845d", URL: "https://www.khon2.com/top-stories/feed", Comments: "Honolulu", Language: "en", Status: "active", Tier: 2},
+	{ID: "4b7fa2abbd5c7433", URL: "https://www.khon2.com/international/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "95754dad11b14255", URL: "https://www.ksnt.com/news/local-news/feed/", Comments: "Topeka", Language: "en", Status: "active", Tier: 2},
+	{ID: "d92ff99539065ad5", URL: "https://www.ksnt.com/news/kansas/feed/", Comments: "Kansas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1169cbb3fffaa177", URL: "https://www.wavy.com/news/crime/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "f7d1e1a27541339c", URL: "https://www.wric.com/news/virginia-news/feed/", Comments: "Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8ea66daa36211fd2", URL: "https://www.wric.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "640d5b62049013b9", URL: "https://hamptonvapolice.org/feed", Comments: "Hampton, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a70c499a6f5395d7", URL: "https://www.news-gazette.com/search/?f=rss&t=article&c=news/local/courts-police-fire&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6e9806799cc93e34", URL: "https://komonews.com/news/local.rss", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "d0945239b1c80f24", URL: "https://abc3340.com/news/local.rss", Comments: "Birmingham", Language: "en", Status: "active", Tier: 2},
+	{ID: "90e9be6971b7b286", URL: "https://weartv.com/news/local.rss", Comments: "Pensacola", Language: "en", Status: "active", Tier: 2},
+	{ID: "e2468ec7b7f45e33", URL: "https://mynbc15.com/news/local.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "2cb033c5b3ca6e2c", URL: "http://utv44.com/news/local.rss", Comments: "Utica", Language: "en", Status: "active", Tier: 2},
+	{ID: "d0823d7c939c34e5", URL: "https://katv.com/news/local.rss", Comments: "Little Rock", Language: "en", Status: "active", Tier: 2},
+	{ID: "f0e4d999cd12b8a0", URL: "https://bakersfieldnow.com/news/local.rss", Comments: "Bakersfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "40984e4cf2c8003b", URL: "https://www.8newsnow.com/news/local-news/feed/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "430be8d0ee692145", URL: "https://krcrtv.com/news/local.rss", Comments: "Redding", Language: "en", Status: "active", Tier: 2},
+	{ID: "1a26c3fd3ca7e345", URL: "https://kmph.com/news/local.rss", Comments: "Fresno", Language: "en", Status: "active", Tier: 2},
+	{ID: "570e655b99097fd9", URL: "https://wjla.com/news/local.rss", Comments: "Washington DC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "585bc5dd302bc57c", URL: "https://cbs12.com/news/local.rss", Comments: "West Palm Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "8aa92e109537e0fb", URL: "https://wgxa.tv/news/local.rss", Comments: "Macon", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1e62ccaa606df3c", URL: "https://kdsm17.com/news/local.rss", Comments: "Pittsburg, KS", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4b36704922976997", URL: "https://idahonews.com/news/local.rss", Comments: "Boise", Language: "en", Status: "active", Tier: 2},
+	{ID: "eb409e19893e1635", URL: "https://klewtv.com/news/local.rss", Comments: "Lewiston", Language: "en", Status: "active", Tier: 2},
+	{ID: "f12c8b53b07059d6", URL: "https://newschannel20.com/news/local.rss", Comments: "Springfield, IL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bdaebc19297ed20e", URL: "https://foxillinois.com/news/local.rss", Comments: "Champaign", Language: "en", Status: "active", Tier: 2},
+	{ID: "b3496bc81654523f", URL: "http://foxkansas.com/news/local.rss", Comments: "Wichita", Language: "en", Status: "active", Tier: 2},
+	{ID: "761d862420280d69", URL: "https://wgme.com/news/local.rss", Comments: "Portland, ME", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "992d63158352ea27", URL: "https://fox23maine.com/news/local.rss", Comments: "Portland, ME", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "26839a536fa7f24e", URL: "https://foxbaltimore.com/news/local.rss", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b6101f23c18ba94", URL: "https://upnorthlive.com/news/local.rss", Comments: "Traverse City", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b8679ec456be1e0", URL: "https://www.kbsi23.com/feed/", Comments: "Twin Falls", Language: "en", Status: "active", Tier: 2},
+	{ID: "e5ae860c7f80ce61", URL: "https://krcgtv.com/news/local.rss", Comments: "Columbia", Language: "en", Status: "active", Tier: 2},
+	{ID: "a8937887ddd98536", URL: "https://khqa.com/news/local.rss", Comments: "Quincy", Language: "en", Status: "active", Tier: 2},
+	{ID: "67a906447ccada59", URL: "https://ktvo.com/news/local.rss", Comments: "Kirksville", Language: "en", Status: "active", Tier: 2},
+	{ID: "31ed4eb723716dcf", URL: "https://abcstlouis.com/news/local.rss", Comments: "St. Louis", Language: "en", Status: "active", Tier: 2},
+	{ID: "0d1542d0e46e9967", URL: "https://nbcmontana.com/news/local.rss", Comments: "Missoula", Language: "en", Status: "active", Tier: 2},
+	{ID: "38ad7db8a0dee837", URL: "https://nebraska.tv/news/local.rss", Comments: "Hastings", Language: "en", Status: "active", Tier: 2},
+	{ID: "833c26fedbcaf9bd", URL: "https://foxnebraska.com/news/local.rss", Comments: "Lincoln", Language: "en", Status: "active", Tier: 2},
+	{ID: "b2797980cbe79dd6", URL: "https://mynews4.com/news/local.rss", Comments: "Reno", Language: "en", Status: "active", Tier: 2},
+	{ID: "ddfb90bd4e409e97", URL: "https://news3lv.com/news/local.rss", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "79e695caf5aeb3de", URL: "https://13wham.com/news/local.rss", Comments: "Rochester, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "422d302c53334c31", URL: "https://foxrochester.com/news/local.rss", Comments: "Rochester, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "399bf7a4e96e533e", URL: "https://cnycentral.com/news/local.rss", Comments: "Syracuse", Language: "en", Status: "active", Tier: 2},
+	{ID: "dd27a587ee5c292e", URL: "https://wlos.com/news/local.rss", Comments: "Asheville", Language: "en", Status: "active", Tier: 2},
+	{ID: "8559d02b14a29b4e", URL: "https://wcti12.com/news/local.rss", Comments: "New Bern", Language: "en", Status: "active", Tier: 2},
+	{ID: "f57b9c3aba016f13", URL: "http://abc45.com/news/local.rss", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "cbd0e6fb024e1336", URL: "https://local12.com/news/local.rss", Comments: "Cincinnati", Language: "en", Status: "active", Tier: 2},
+	{ID: "a0708451505d752c", URL: "https://abc6onyourside.com/news/local.rss", Comments: "Columbus, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "26a7a64b83a873af", URL: "https://myfox28columbus.com/news/local.rss", Comments: "Columbus, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c9535d90044495a6", URL: "https://dayton247now.com/news/local.rss", Comments: "Dayton", Language: "en", Status: "active", Tier: 2},
+	{ID: "8d6f926938eae8ca", URL: "https://wtov9.com/news/local.rss", Comments: "Steubenville", Language: "en", Status: "active", Tier: 2},
+	{ID: "080261c26fd9a5bf", URL: "https://nbc24.com/news/local.rss", Comments: "Toledo", Language: "en", Status: "active", Tier: 2},
+	{ID: "5c7e0fc420f073b6", URL: "https://okcfox.com/news/local.rss", Comments: "Oklahoma City", Language: "en", Status: "active", Tier: 2},
+	{ID: "a103c0e979695a5d", URL: "https://ktul.com/news/local.rss", Comments: "Tulsa", Language: "en", Status: "active", Tier: 2},
+	{ID: "122111986ca3bb7a", URL: "https://kval.com/news/local.rss", Comments: "Eugene", Language: "en", Status: "active", Tier: 2},
+	{ID: "0939825c1a2ef361", URL: "https://nbc16.com/news/local.rss", Comments: "Eugene", Language: "en", Status: "active", Tier: 2},
+	{ID: "aafcf1bcf6a6cf48", URL: "https://ktvl.com/news/local.rss", Comments: "Medford", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5cf93004ab34831", URL: "https://katu.com/news/local.rss", Comments: "Portland, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "12c879f11f1ab638", URL: "https://local21news.com/news/local.rss", Comments: "Harrisburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0a19d77fece9840", URL: "https://wjactv.com/news/local.rss", Comments: "Johnstown", Language: "en", Status: "active", Tier: 2},
+	{ID: "9b89b16e1a41e20f", URL: "https://fox56.com/news/local.rss", Comments: "Wilkes-Barre", Language: "en", Status: "active", Tier: 2},
+	{ID: "22fba4df31f8bfef", URL: "https://turnto10.com/news/local.rss", Comments: "Providence", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d44df7f6c495823", URL: "https://abcnews4.com/news/local.rss", Comments: "Charleston, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "de0aa5aeb1c4b9db", URL: "https://wach.com/news/local.rss", Comments: "Columbia, SC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e398861a73cbde8c", URL: "https://newschannel9.com/news/local.rss", Comments: "Syracuse", Language: "en", Status: "active", Tier: 2},
+	{ID: "7fd8a6edda7e8db0", URL: "https://ktxs.com/news/local.rss", Comments: "Abilene", Language: "en", Status: "active", Tier: 2},
+	{ID: "bc434e6c9235735d", URL: "https://abc7amarillo.com/news/local.rss", Comments: "Amarillo", Language: "en", Status: "active", Tier: 2},
+	{ID: "18675cfefa00a228", URL: "https://cbsaustin.com/news/local.rss", Comments: "Austin", Language: "en", Status: "active", Tier: 2},
+	{ID: "482e0f7c1572c0b1", URL: "https://fox4beaumont.com/news/local.rss", Comments: "Beaumont", Language: "en", Status: "active", Tier: 2},
+	{ID: "b030317ac63b0135", URL: "https://kfdm.com/news/local.rss", Comments: "Beaumont", Language: "en", Status: "active", Tier: 2},
+	{ID: "ebdca4e4c19f5050", URL: "https://cbs4local.com/news/local.rss", Comments: "Laredo", Language: "en", Status: "active", Tier: 2},
+	{ID: "152b47f0f53cf96d", URL: "https://kfoxtv.com/news/local.rss", Comments: "El Paso", Language: "en", Status: "active", Tier: 2},
+	{ID: "7877d2ac524b3dba", URL: "https://news4sanantonio.com/news/local.rss", Comments: "San Antonio", Language: "en", Status: "active", Tier: 2},
+	{ID: "888c8caf1553fdba", URL: "https://foxsanantonio.com/news/local.rss", Comments: "San Antonio", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1cb405fe72efcf9", URL: "https://kutv.com/news/local.rss", Comments: "Salt Lake City", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f9fb5def9b83614", URL: "http://kmyu.tv/news/local.rss", Comments: "St. George", Language: "en", Status: "active", Tier: 2},
+	{ID: "f147555a797374ee", URL: "https://wset.com/news/local.rss", Comments: "Lynchburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "519e1fa91d3751d0", URL: "https://kimatv.com/news/local.rss", Comments: "Yakima", Language: "en", Status: "active", Tier: 2},
+	{ID: "0f62a03b1563cec2", URL: "https://wchstv.com/news/local.rss", Comments: "Charleston, Huntington", Language: "en", Status: "active", Tier: 2},
+	{ID: "627864d9a2b90b50", URL: "https://fox11online.com/news/local.rss", Comments: "Green Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc4de7977e3db1b7", URL: "http://fox47.com/news/local.rss", Comments: "Lansing", Language: "en", Status: "active", Tier: 2},
+	{ID: "6965895f6304f235", URL: "https://www.thelascopress.com/feed", Comments: "Lasco", Language: "en", Status: "active", Tier: 2},
+	{ID: "236e647a75d2fdf7", URL: "https://riotimesonline.com/brazil-news/category/brazil/feed", Comments: "Brazil", Language: "en", Status: "active", Country: "BR", Tier: 2},
+	{ID: "17af2c4c89934c47", URL: "https://riotimesonline.com/brazil-news/category/mercosur/feed/", Comments: "Mercosur region, South America, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fbcb92d526f87485", URL: "https://newarkdepolicepress.com/feed/", Comments: "Newark, DE", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5c25f7637f83481e", URL: "https://fox4kc.com/news/feed/", Comments: "Kansas City", Language: "en", Status: "active", Tier: 2},
+	{ID: "d26669044551e425", URL: "https://www.thaiexaminer.com/thai-news-foreigners/category/crime-in-thailand/feed/", Comments: "Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "c8aeeb74e2c84adf", URL: "https://sanfrancisco.cbslocal.com/category/news/feed/", Comments: "San Francisco", Language: "en", Status: "active", Tier: 2},
+	{ID: "14b0f196136b06f3", URL: "https://sanfrancisco.cbslocal.com/category/news/local/feed/", Comments: "San Francisco", Language: "en", Status: "active", Tier: 2},
+	{ID: "0769ff2115c97027", URL: "https://mauinow.com/category/maui_news/feed/", Comments: "Maui", Language: "en", Status: "active", Tier: 2},
+	{ID: "cefa7a5597d3de0d", URL: "https://mauinow.com/feed/", Comments: "Maui", Language: "en", Status: "active", Tier: 2},
+	{ID: "0dcdddaa35dd34bd", URL: "https://www.westhawaiitoday.com/feed/", Comments: "West Hawaii", Language: "en", Status: "active", Tier: 2},
+	{ID: "95c7eaf9be02ad19", URL: "https://bigislandnow.com/feed/", Comments: "Hawaii, Big Island", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c7d09ce7ffdb43cb", URL: "https://bigislandnow.com/category/news/feed/", Comments: "Hawaii, Big Island", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "79674703ceef703a", URL: "https://howzitkohala.com/category/crime/feed/", Comments: "Kohala, Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ab850d1a0f274986", URL: "https://southafricatoday.net/feed", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "21163924bf3091ff", URL: "https://southafricatoday.net/category/south-africa-news/feed", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "32970bafc10eb293", URL: "https://southafricatoday.net/category/south-africa-news/eastern-cape/feed", Comments: "South Africa, Eastern Cape", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "a3aeea8dc5c8d7c2", URL: "https://southafricatoday.net/category/south-africa-news/mpumalanga/feed", Comments: "South Africa, Mpumalanga", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "cb505ec3762241b7", URL: "https://southafricatoday.net/category/south-africa-news/limpopo/feed", Comments: "South Africa Limpopo", Language: "en", Status: "active", Tier: 2},
+	{ID: "c09992d59d72f4e2", URL: "https://southafricatoday.net/category/south-africa-news/north-west/feed", Comments: "South Africa, North West", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "ce5989988286615d", URL: "https://southafricatoday.net/category/south-africa-news/gauteng/feed", Comments: "South Africa, Gauteng", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "e4f4c6a5c0b12c6a", URL: "https://southafricatoday.net/category/south-africa-news/kwazulu-natal/feed", Comments: "South Africa KwaZulu-Natal", Language: "en", Status: "active", Tier: 2},
+	{ID: "e69e03cbc74ca9df", URL: "https://southafricatoday.net/category/south-africa-news/free-state/feed/", Comments: "South Africa, Free State", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "e5c4d2b45326d41b", URL: "https://southafricatoday.net/category/south-africa-news/northern-cape/feed", Comments: "South Africa, Northern Cape", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "95b8a89e50793791", URL: "https://southafricatoday.net/category/south-africa-news/western-cape/feed", Comments: "South Africa, Western Cape", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "3f459f595dbfe461", URL: "https://ktla.com/tag/riverside-police-department/feed/", Comments: "Los Angeles, Riverside", Language: "en", Status: "active", Tier: 2},
+	{ID: "cc09a10bf36cf890", URL: "https://bladenonline.com/feed", Comments: "Bladen County, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "03c2329f94f5d351", URL: "https://www.sentinelsource.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Keene, NH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "03de71a9ce181d31", URL: "https://boston.cbslocal.com/category/news/local/feed/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "b34074ee959d8859", URL: "https://boston.cbslocal.com/category/news/feed/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "5aeb5e1568dba7ca", URL: "https://sanbenito.com/news/crime/feed", Comments: "San Benito County", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ca02fcd616f7f37", URL: "https://live-kion546.pantheonsite.io/category/news/san-benito-county/feed", Comments: "San Benito County", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c8a90e653cf9278", URL: "https://www.madillrecord.net/taxonomy/term/1/feed", Comments: "Madill", Language: "en", Status: "active", Tier: 2},
+	{ID: "c20595fcf0fef716", URL: "https://www.kmmo.com/category/local-news/feed/", Comments: "Sedalia, MO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "65a0f1f8d8df5e6a", URL: "https://auburnexaminer.com/category/news/feed/", Comments: "Auburn", Language: "en", Status: "active", Tier: 2},
+	{ID: "be49f2bc3830b14c", URL: "https://www.auburn-reporter.com/feed/", Comments: "Auburn", Language: "en", Status: "active", Tier: 2},
+	{ID: "eef2f89ad751c8a5", URL: "https://www.ktsm.com/local/feed", Comments: "El Paso", Language: "en", Status: "active", Tier: 2},
+	{ID: "08e1a4e7360f01f2", URL: "https://www.nbcnewyork.com/news/national-international/?rss=y", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1ecbd67ef02dea8a", URL: "https://eurekasentinel.com/category/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b9fd84edb0df1dd", URL: "https://www.rochesterfirst.com/crime/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "363d74440b5de795", URL: "https://www.sfexaminer.com/search/?f=rss", Comments: "SF Examiner", Language: "en", Status: "active", Tier: 2},
+	{ID: "19aedb00b7c6629e", URL: "https://sfist.com/rss/", Comments: "San Francisco", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e4ea0139c8ad500", URL: "https://missionlocal.org/category/covering-the-police/feed/", Comments: "San Francisco, Mission District", Language: "en", Status: "active", Tier: 2},
+	{ID: "148df2d4238626b6", URL: "https://ithacavoice.com/category/crime-courts/feed/", Comments: "Ithaca, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "859821f74c66bf8b", URL: "https://www.woodtv.com/news/allegan-county/feed/", Comments: "Allegan County", Language: "en", Status: "active", Tier: 2},
+	{ID: "d7e5e7fc5cdebb18", URL: "https://www.woodtv.com/news/barry-county/feed/", Comments: "Barry County", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ac8e41847c4e90e", URL: "https://www.woodtv.com/news/kent-county/feed/", Comments: "Kent County", Language: "en", Status: "active", Tier: 2},
+	{ID: "7baef0555c5c4656", URL: "https://www.woodtv.com/news/muskegon-county/feed/", Comments: "Muskegon County", Language: "en", Status: "active", Tier: 2},
+	{ID: "867f7366d3c574d9", URL: "https://www.woodtv.com/news/ionia-county/feed/", Comments: "Ionia County", Language: "en", Status: "active", Tier: 2},
+	{ID: "8a6421406a44621b", URL: "https://www.woodtv.com/news/ottawa-county/feed/", Comments: "Ottawa County", Language: "en", Status: "active", Tier: 2},
+	{ID: "91590568bfe2603c", URL: "https://www.woodtv.com/news/montcalm-county/feed/", Comments: "Montcalm County", Language: "en", Status: "active", Tier: 2},
+	{ID: "71c6ffe128800fd7", URL: "https://www.kfiz.com/category/local/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "c7dd27635c90697e", URL: "https://www.radioplusinfo.com/category/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9866756ecac49205", URL: "https://www.wearegreenbay.com/news/local-news/feed", Comments: "GreenBay", Language: "en", Status: "active", Tier: 2},
+	{ID: "1945418aadd0df3b", URL: "https://www.theoaklandpress.com/news/crime-public-safety/feed/", Comments: "Oakland", Language: "en", Status: "active", Tier: 2},
+	{ID: "117b89b24b724a8f", URL: "http://myfox8.com/feed/", Comments: "Greensboro", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e8a8e708e3ae9e8", URL: "https://www.counton2.com/news/local-news/feed/", Comments: "Charleston", Language: "en", Status: "active", Tier: 2},
+	{ID: "ad14d830b6f0da7c", URL: "https://www.counton2.com/news/local-news/berkeley-county-news/feed", Comments: "Berkeley County", Language: "en", Status: "active", Tier: 2},
+	{ID: "29af30edb7b672e3", URL: "https://www.counton2.com/news/local-news/charleston-county-news/feed", Comments: "Charleston County", Language: "en", Status: "active", Tier: 2},
+	{ID: "0bd1d32873908331", URL: "https://www.counton2.com/news/local-news/dorchester-county-news/feed/", Comments: "Dorchester County", Language: "en", Status: "active", Tier: 2},
+	{ID: "b6d7044e1a0d83c4", URL: "https://www.counton2.com/news/local-news/georgetown-county-news/feed", Comments: "Georgetown County", Language: "en", Status: "active", Tier: 2},
+	{ID: "225c65300de65042", URL: "https://www.counton2.com/news/local-news/colleton-county-news/feed/", Comments: "Colleton County", Language: "en", Status: "active", Tier: 2},
+	{ID: "d952260cfcae39fa", URL: "https://www.counton2.com/news/crime/feed", Comments: "Charleston", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ef4e72409994ec5", URL: "https://bnonews.com/index.php/category/usnews/feed", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fd41200c49260547", URL: "https://bnonews.com/index.php/category/world/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cdfde4ae9707d102", URL: "https://www.borderreport.com/regions/california/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "290595a2588a8643", URL: "https://www.borderreport.com/regions/texas/feed/", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c6daf8c140366914", URL: "https://www.borderreport.com/regions/mexico/feed/", Comments: "Mexico", Language: "en", Status: "active", Country: "MX", Tier: 2},
+	{ID: "fec17ee28c21d0e7", URL: "https://havanatimes.org/category/latin-america/feed", Comments: "Havana, Latin America", Language: "en", Status: "active", Tier: 2},
+	{ID: "087c7c3f49bb5a4b", URL: "https://newsday.co.tt/feed/", Comments: "Trinidad and Tobago", Language: "en", Status: "active", Country: "TT", Tier: 2},
+	{ID: "7a4d50bfed6fb8b3", URL: "https://www.capitolhillseattle.com/feed/", Comments: "Seattle, Capitol Hill", Language: "en", Status: "active", Tier: 2},
+	{ID: "a467cb7317f8c2ac", URL: "https://www.capitolhillseattle.com/tag/protests/feed/", Comments: "Capitol Hill, Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "bad961986bf06fa6", URL: "https://lostcoastoutpost.com/feed/", Comments: "Humboldt County, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7a78d6a938f8b9d0", URL: "https://www.sbsun.com/location/california/los-angeles-county/inland-empire/san-bernardino/feed/", Comments: "San Bernardino", Language: "en", Status: "active", Tier: 2},
+	{ID: "ceaa7f46df03e4a6", URL: "https://www.sbsun.com/location/california/los-angeles-county/feed/", Comments: "San Bernardino, Los Angeles County", Language: "en", Status: "active", Tier: 2},
+	{ID: "81a323bdc6fe512c", URL: "https://www.sbsun.com/location/california/san-bernardino-county/feed/", Comments: "San Bernardino, San Bernardino County", Language: "en", Status: "active", Tier: 2},
+	{ID: "a2e3b1b20266e16a", URL: "https://www.sbsun.com/location/california/san-bernardino-county/high-desert/feed/", Comments: "San Bernardino", Language: "en", Status: "active", Tier: 2},
+	{ID: "633c5863cf6d58de", URL: "https://www.dailybulletin.com/location/california/san-bernardino-county/chino-valley/feed/", Comments: "Chino Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "51f615c9dd351410", URL: "https://www.dailybulletin.com/location/california/los-angeles-county/inland-empire/pomona/feed/", Comments: "Pomona", Language: "en", Status: "active", Tier: 2},
+	{ID: "e6423a2caa736d9d", URL: "https://www.dailybulletin.com/location/california/los-angeles-county/san-gabriel-valley/la-verne/feed/", Comments: "La Verne", Language: "en", Status: "active", Tier: 2},
+	{ID: "a1ff698f48ecf77e", URL: "https://www.dailybulletin.com/location/california/san-bernardino-county/foothill-cities/claremont/feed/", Comments: "Claremont", Language: "en", Status: "active", Tier: 2},
+	{ID: "94ce59e20fc61d36", URL: "https://www.dailybulletin.com/location/california/san-bernardino-county/foothill-cities/upland/feed/", Comments: "Upland", Language: "en", Status: "active", Tier: 2},
+	{ID: "d72763456fc8d540", URL: "https://www.dailybulletin.com/location/california/san-bernardino-county/foothill-cities/montclair/feed/", Comments: "Montclair", Language: "en", Status: "active", Tier: 2},
+	{ID: "94d31aa778b62dac", URL: "https://app.lincoln.ne.gov/aspx/city/main/rss.aspx?ID=6", Comments: "City of Lincoln, NE", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7adeadd3645b7301", URL: "https://www.tapinto.net/towns/franklin-township/articles.atom", Comments: "Franklin Township, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f01a1dc23dfd9af6", URL: "https://hudsoncountyview.com/l/crime/feed", Comments: "Hudson County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "358394cc2da5cb77", URL: "https://hudsoncountyview.com/l/news/feed", Comments: "Hudson County", Language: "en", Status: "active", Tier: 2},
+	{ID: "80a2eb242e661e3e", URL: "http://feeds.parisnews.net/rss/fab36d240e1883d4", Comments: "Paris (TX)", Language: "en", Status: "active", Tier: 2},
+	{ID: "9032ebedb5e5078b", URL: "https://www.everythinglubbock.com/news/local-news/feed/", Comments: "Lubbock", Language: "en", Status: "active", Tier: 2},
+	{ID: "727a5e5f467faeb2", URL: "https://www.everythinglubbock.com/news/state-regional/feed", Comments: "Lubbock", Language: "en", Status: "active", Tier: 2},
+	{ID: "6d3d63e6bb033680", URL: "https://www.everythinglubbock.com/news/feed/", Comments: "Lubbock", Language: "en", Status: "active", Tier: 2},
+	{ID: "362c5b3de35452f4", URL: "https://www.trentonian.com/news/crime-public-safety/feed/", Comments: "Trenton", Language: "en", Status: "active", Tier: 2},
+	{ID: "33454c1cfd290e72", URL: "https://www.wmar2news.com/news/local-news.rss", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "b1076bb4df55c8b3", URL: "https://wtop.com/local/maryland/prince-georges-county/feed/", Comments: "Prince George's County, MD", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "488449940953c21d", URL: "https://wtop.com/local/maryland/baltimore/feed", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "3419b1f67c47b438", URL: "https://www.wspa.com/news/local-news/feed", Comments: "Spartanburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "b43313bd206a5ed1", URL: "https://ccpdblotter.com/feed/", Comments: "Corpus Christi", Language: "en", Status: "active", Tier: 2},
+	{ID: "2f20ca13366d21a6", URL: "https://fox5sandiego.com/news/california-news/feed", Comments: "San Diego, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "551b0d4974f5c077", URL: "https://www.sbsheriff.org/blog/feed/", Comments: "Santa Barbara County", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac07a5932998f776", URL: "https://www.greeleytribune.com/news/crime-public-safety/feed/", Comments: "Greeley", Language: "en", Status: "active", Tier: 2},
+	{ID: "fe55f88cec633e43", URL: "https://www.greeleytribune.com/local-news/feed/", Comments: "Greeley", Language: "en", Status: "active", Tier: 2},
+	{ID: "dde4a4eee6157a53", URL: "https://www.galvnews.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Galveston County, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5a6828eb3f525e4d", URL: "https://wgno.com/news/local/feed/", Comments: "New Orleans", Language: "en", Status: "active", Tier: 2},
+	{ID: "7f16441587ab9207", URL: "https://patersontimes.com/category/police-fire/feed/", Comments: "Paterson", Language: "en", Status: "active", Tier: 2},
+	{ID: "4745180cca81bb3a", URL: "https://www.theatlantavoice.com/category/top-stories/feed/", Comments: "Atlanta", Language: "en", Status: "active", Tier: 2},
+	{ID: "4932d3443cd152fe", URL: "https://www.theatlantavoice.com/category/news/feed/", Comments: "Atlanta", Language: "en", Status: "active", Tier: 2},
+	{ID: "a4abdd319cbdd2d0", URL: "https://www.koin.com/local/washington-county/feed/", Comments: "Washington County, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "42ce538c57fc09f6", URL: "https://www.koin.com/news/wildfires/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c31a0040bca56ee3", URL: "https://www.koin.com/news/washington/feed/", Comments: "Washington state", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "603401d8c0e801f2", URL: "https://www.koin.com/news/oregon/feed/", Comments: "Oregon", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4aa235b3a73a3352", URL: "https://www.abc15.com/news/region-west-valley.rss", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2acc4335f45ffc8b", URL: "https://www.abc15.com/news/region-northeast-valley.rss", Comments: "Arizona, Northeast Valley", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ee4aa25728895da2", URL: "https://www.abc15.com/news/region-central-southern-az.rss", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bd42ef5ee7c049df", URL: "https://www.abc15.com/news/region-northern-az.rss", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "11e79967b6e6a1e9", URL: "https://www.rosevilletoday.com/news/roseville/feed/", Comments: "Roseville", Language: "en", Status: "active", Tier: 2},
+	{ID: "75d35afad2698533", URL: "https://www.rosevilletoday.com/news/sacramento/feed/", Comments: "Roseville, Sacramento", Language: "en", Status: "active", Tier: 2},
+	{ID: "320651d8b57517ef", URL: "https://www.rosevilletoday.com/news/rocklin/feed/", Comments: "Roseville, Rocklin", Language: "en", Status: "active", Tier: 2},
+	{ID: "453c973fb5da009e", URL: "https://www.rosevilletoday.com/news/auburn/feed/", Comments: "Roseville, Auburn", Language: "en", Status: "active", Tier: 2},
+	{ID: "0fafafd9c34be6fd", URL: "https://www.timesheraldonline.com/location/california/solano-county/benicia/feed/", Comments: "Benicia", Language: "en", Status: "active", Tier: 2},
+	{ID: "629a1af182b77a5a", URL: "https://www.timesheraldonline.com/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc550c95004b4fa4", URL: "https://www.timesheraldonline.com/location/california/napa-county/american-canyon/feed/", Comments: "Canyon", Language: "en", Status: "active", Tier: 2},
+	{ID: "33131e22d9b8a8cf", URL: "https://www.timesheraldonline.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "480dc3aa57c3eb5c", URL: "https://www.timesheraldonline.com/news/california-news/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bd965208e5dabc57", URL: "https://www.sanjoseinside.com/category/news/feed", Comments: "San Jose", Language: "en", Status: "active", Tier: 2},
+	{ID: "99e71b9fe0c4a7a0", URL: "https://www.mercurynews.com/location/california/bay-area/south-bay/santa-clara-county/san-jose/feed/", Comments: "San Jose", Language: "en", Status: "active", Tier: 2},
+	{ID: "f0f05e957f21d7c5", URL: "https://www.mercurynews.com/location/california/bay-area/south-bay/santa-clara-county/feed/", Comments: "Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "da3ea719fff3429d", URL: "https://www.mercurynews.com/location/california/bay-area/east-bay/alameda-county/feed", Comments: "Alameda County, Mercury News", Language: "en", Status: "active", Tier: 2},
+	{ID: "3905912b91620d39", URL: "https://www.mercurynews.com/news/california-news/feed", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3047e967f7518c28", URL: "https://www.mercurynews.com/location/california/bay-area/peninsula/san-mateo-county/feed/", Comments: "San Mateo County, Mercury News", Language: "en", Status: "active", Tier: 2},
+	{ID: "c14e5cf832e884ed", URL: "https://www.mercurynews.com/location/california/bay-area/south-bay/santa-cruz-county/feed/", Comments: "Santa Cruz County, Mercury News", Language: "en", Status: "active", Tier: 2},
+	{ID: "51639edc02fd7a7b", URL: "https://kion546.com/category/news/monterey-county/feed/", Comments: "Monterey County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1f96b1ce05adc31b", URL: "https://www.eastbaytimes.com/location/castro-valley/feed/", Comments: "Castro Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "f514bbcceb8a2036", URL: "https://www.eastbaytimes.com/location/california/bay-area/feed/", Comments: "East Bay, Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "d3d7d5d573e6f126", URL: "https://www.mercurynews.com/location/california/bay-area/east-bay/alameda-county/castro-valley/feed/", Comments: "Castro Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "36f53e63138d196c", URL: "https://www.eastbaytimes.com/location/concord/feed/", Comments: "Concord", Language: "en", Status: "active", Tier: 2},
+	{ID: "c0e5b0a7ddb3153f", URL: "http://www.nrtoday.com/search/?f=rss&t=article&c=news/police_logs&l=50&s=start_time&sd=desc", Comments: "Northwest Arkansas", Language: "en", Status: "active", Tier: 2},
+	{ID: "8cf6b539016e7751", URL: "https://www.nrtoday.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "263d3f7860da4a9a", URL: "https://www.nrtoday.com/search/?f=rss&t=article&c=news/state&l=50&s=start_time&sd=desc", Comments: "Northwest Arkansas", Language: "en", Status: "active", Tier: 2},
+	{ID: "51b0d8f4fd85b223", URL: "https://www.nwitimes.com/search/?f=rss&t=article&c=news/local/lake&l=50&s=start_time&sd=desc", Comments: "above", Language: "en", Status: "active", Tier: 2},
+	{ID: "6e2f4432b0d2d59f", URL: "https://www.nwitimes.com/search/?f=rss&t=article&c=news/local/porter&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f0dd829884911933", URL: "https://www.nwitimes.com/search/?f=rss&t=article&c=news/local/laporte&l=50&s=start_time&sd=desc", Comments: "above", Language: "en", Status: "active", Tier: 2},
+	{ID: "8b4ae9151e1bc83f", URL: "https://www.wccbcharlotte.com/feed/", Comments: "Charlotte, North Carolina, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "59c5121fa7a569ec", URL: "https://www.eastbaytimes.com/location/california/bay-area/east-bay/contra-costa-county/feed/", Comments: "Contra Costa County, California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8d4eb4b0a6ea59a8", URL: "https://www.eastbaytimes.com/location/california/bay-area/east-bay/alameda-county/feed/", Comments: "Alameda County, CA, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cb2c3636e904b000", URL: "https://www.eastbaytimes.com/location/california/bay-area/east-bay/contra-costa-county/east-contra-costa/feed/", Comments: "East Contra Costa County, East Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "7a6721c46055c35a", URL: "https://dentonrc.com/search/?c=news/local&f=rss&l=50&s=start_time&sd=desc&t=article", Comments: "Denton", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b08fdcf8eed5ec1", URL: "https://cbs4indy.com/news/indycrime/feed/", Comments: "Indianapolis", Language: "en", Status: "active", Tier: 2},
+	{ID: "0d8519e3991f8494", URL: "https://cbs4indy.com/news/feed/", Comments: "Indy", Language: "en", Status: "active", Tier: 2},
+	{ID: "3cdf56cad89466fd", URL: "https://www.wane.com/news/crime/feed", Comments: "Fort Wayne", Language: "en", Status: "active", Tier: 2},
+	{ID: "097b54872b52bf36", URL: "https://www.nbcwashington.com/news/local/northern-virginia/feed", Comments: "Washington, Northern Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3c64a79235250269", URL: "https://www.nbcwashington.com/news/local/prince-georges-county/feed", Comments: "Washington, DC, Prince George's County, MD", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3b9475f116c1d847", URL: "https://www.myjoyonline.com/feed/", Comments: "Ghana, African", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "b779578787d4613a", URL: "https://politicosl.com/taxonomy/term/24/feed", Comments: "Sierra Leone, African", Language: "en", Status: "active", Country: "SL", Tier: 2},
+	{ID: "327552147c6d9252", URL: "https://www.wavy.com/news/virginia/feed/", Comments: "Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3e9de4366aef6ac3", URL: "https://www.turnto23.com/news/local-news.rss", Comments: "Bakersfield, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9bcadf1cc54a12a4", URL: "https://www.fox44news.com/news/local-news/feed/", Comments: "Macon", Language: "en", Status: "active", Tier: 2},
+	{ID: "f26c3ac9ec8c1db2", URL: "https://www.fox44news.com/news/state-news/feed/", Comments: "Macon, state", Language: "en", Status: "active", Tier: 2},
+	{ID: "d3b8bb109582c35e", URL: "https://www.ocregister.com/location/california/orange-county/brea/feed/", Comments: "Brea, Orange County", Language: "en", Status: "active", Tier: 2},
+	{ID: "2f577e6a1c0f6d43", URL: "https://www.660citynews.com/category/local/feed/", Comments: "Calgary", Language: "en", Status: "active", Tier: 2},
+	{ID: "e62e7ed6f18a6048", URL: "https://anewscafe.com/category/news/feed/", Comments: "Redding, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6348ea581184dfbb", URL: "https://alcornnewsms.com/category/alcorn-county-news/feed/", Comments: "Alcorn County, MS", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6d2c7fbad036a5b6", URL: "https://desotocountynews.com/feed/", Comments: "DeSoto County, MS", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "58f865cc9b506407", URL: "https://desotocountynews.com/category/desoto-county-news/feed/", Comments: "DeSoto County, MS", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ffb99fbd147e1d5b", URL: "https://msnewsgroup.com/category/breakingmississippinews/feed/", Comments: "Mississippi", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ec15975b65cad412", URL: "https://www.newsamericasnow.com/category/latin-america-news-today/feed", Comments: "Latin America", Language: "en", Status: "active", Tier: 2},
+	{ID: "d1013305c04259f5", URL: "https://www.newsamericasnow.com/category/caribbean-news-now/feed", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "c0d8142a2fc5ecb1", URL: "https://bahamaspress.com/category/news/feed/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "c1ae7afec9c463e0", URL: "https://www.mychamplainvalley.com/news/local-news/feed", Comments: "Champlain Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "ebc8e8d93b4f2b18", URL: "https://vtdigger.org/feed/", Comments: "Vermont, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2355f0b00a0218f0", URL: "https://www.news10.com/news/vt-news/feed/", Comments: "Vermont", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "24745263df12bf53", URL: "https://www.news10.com/news/local-news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "c6074945b695da25", URL: "https://www.gwinnettcounty.com/content/rss/policepressreleases.xml", Comments: "Gwinnett County, GA", Language: "en", Status: "active", Country: "GA", Tier: 2},
+	{ID: "5e2753bf4cd8e073", URL: "https://thesoufancenter.org/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0dc78fb48422ac95", URL: "https://theguadalajarapost.com/category/jalisco-news/feed", Comments: "Jalisco, Mexico", Language: "en", Status: "active", Country: "MX", Tier: 2},
+	{ID: "e4d4ff643b74fa1a", URL: "https://themazatlanpost.com/category/sinaloa-news/feed/", Comments: "Mazatlan, Sinaloa, Mexico", Language: "en", Status: "active", Country: "MX", Tier: 2},
+	{ID: "62548f7f35691e97", URL: "https://veracruzdailypost.com/category/veracruz-news/feed/", Comments: "Veracruz, Mexico", Language: "en", Status: "active", Country: "MX", Tier: 2},
+	{ID: "c6b4810afd8790aa", URL: "https://www.bangkokpost.com/rss/data/thailand.xml", Comments: "Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "7f865213fcbaa975", URL: "https://bangordailynews.com/category/news/aroostook/feed/", Comments: "Bangor, Aroostook County, ME", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "38503fe66ba29347", URL: "https://bangordailynews.com/category/news/bangor/feed/", Comments: "Bangor, ME", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d1f142e0199ab0b4", URL: "https://bangordailynews.com/category/news/midcoast/feed", Comments: "Bangor, Maine, Midcoast Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f756b4d09386fd28", URL: "https://bangordailynews.com/category/news/piscataquis/feed/", Comments: "Bangor, Piscataquis County, ME", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9c319b21057b37aa", URL: "https://bangordailynews.com/category/news/hancock/feed/", Comments: "Bangor, ME, Hancock County", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8cbf8d5f390984e9", URL: "https://bangordailynews.com/category/news/down-east/feed", Comments: "Bangor, Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0a2428aa2c0f2063", URL: "https://bangordailynews.com/category/news/portland/feed", Comments: "Bangor, ME, Portland", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "04fc873f1fb184e2", URL: "https://bangordailynews.com/category/news/york/feed", Comments: "Bangor, ME, York County, York, ME, NY, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "70a1defea2183649", URL: "https://bangordailynews.com/category/news/central-maine/feed/", Comments: "Bangor, Maine, Central Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4e987edf167c340f", URL: "https://barbadostoday.bb/category/regional/feed/", Comments: "Barbados, Caribbean", Language: "en", Status: "active", Country: "BB", Tier: 2},
+	{ID: "495a39d5a12b83b2", URL: "https://barbadostoday.bb/category/world/feed/", Comments: "Barbados, global", Language: "en", Status: "active", Country: "BB", Tier: 2},
+	{ID: "6b2b660acc6a66c6", URL: "https://www.boston.com/tag/crime/feed/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "f25b4c304ed09f51", URL: "https://www.dailycamera.com/location/colorado/broomfield-county/broomfield/feed/", Comments: "Broomfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "4fdb1448ad0d0ef3", URL: "https://www.dailycamera.com/location/colorado/boulder-county/lafayette/feed", Comments: "Lafayette", Language: "en", Status: "active", Tier: 2},
+	{ID: "01c08ea949dfc29b", URL: "https://www.dailycamera.com/location/colorado/front-range/boulder-county/boulder/feed/", Comments: "Boulder", Language: "en", Status: "active", Tier: 2},
+	{ID: "a245319dc8b5f692", URL: "https://www.dailycamera.com/location/colorado/boulder-county/louisville/feed/", Comments: "Louisville", Language: "en", Status: "active", Tier: 2},
+	{ID: "df6657a5adde809f", URL: "https://www.dailycamera.com/news/crime-public-safety/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "ebd225cf93cece77", URL: "https://www.dailycamera.com/location/colorado/boulder-county/longmont/feed/", Comments: "Longmont", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0f06af106628d5f", URL: "https://www.dailycamera.com/location/colorado/boulder-county/superior/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec4930b7dd4e62d5", URL: "https://www.dailycamera.com/location/colorado/front-range/weld-county/erie-colorado/feed/", Comments: "Erie, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5093b24cfbe50091", URL: "https://www.brproud.com/news/local-news/feed/", Comments: "Baton Rouge", Language: "en", Status: "active", Tier: 2},
+	{ID: "ebed21eb69793300", URL: "https://calgaryherald.com/category/news/local-news/feed.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "59722c609468e862", URL: "https://calgaryherald.com/category/news/national/feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "15ac7eb763d123b4", URL: "https://calgaryherald.com/category/news/crime/feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b4082abf78339021", URL: "https://calgarysun.com/category/news/local-news/feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f020d6e3e1e4f5c0", URL: "https://calgarysun.com/category/news/provincial/feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac7bd663ceae8c10", URL: "https://calgarysun.com/category/news/crime/feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b8ab20ac1ae9a424", URL: "https://calgarysun.com/category/news/national/feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e694222968316830", URL: "https://www.cameroononline.org/category/general-news/feed/", Comments: "Cameroon", Language: "en", Status: "active", Country: "CM", Tier: 2},
+	{ID: "4dd5582e686c96ea", URL: "https://www.cbs17.com/news/local-news/feed/", Comments: "Raleigh, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "87dcc955275eec4f", URL: "https://pittsburgh.cbslocal.com/category/news/local/feed/", Comments: "Pittsburgh", Language: "en", Status: "active", Tier: 2},
+	{ID: "0596632fc922f5d0", URL: "https://cbs4indy.com/news/central-indiana-news/feed/", Comments: "Central Indiana, United States", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "de65b656063473fc", URL: "https://www.cbs42.com/news/local/feed", Comments: "Birmingham, AL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c6f8e79cb18d86be", URL: "https://www.cbs42.com/alabama-news/feed", Comments: "Alabama", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0b200252f08e909f", URL: "https://www.cbs42.com/news/crime/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "da48730ee27527fb", URL: "https://feeds.feedblitz.com/cbs8/local", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "b6b2581ee2e16cb6", URL: "https://feeds.feedblitz.com/cbs8/crime", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c3d4a404f26fa12b", URL: "https://newyork.cbslocal.com/category/news/ct-news/feed/", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "52443eff1d3574d7", URL: "https://newyork.cbslocal.com/category/news/ny-news/feed/", Comments: "New York State", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ff30d3b97b45108e", URL: "https://newyork.cbslocal.com/category/news/nj-news/feed/", Comments: "New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "12f3a5f2f2254b45", URL: "https://www.shorenewsnetwork.com/category/local-news/new-jersey/jersey-shore-news/feed", Comments: "Jersey Shore, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1e72949e73814700", URL: "https://www.shorenewsnetwork.com/category/local-news/new-jersey/ocean-county-news/feed", Comments: "Ocean County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c72eb04add5fadcb", URL: "https://www.shorenewsnetwork.com/category/local-news/new-jersey/atlantic-county-news/feed", Comments: "Atlantic County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "18f16309fda5279e", URL: "https://fcpdnews.wordpress.com/author/fcpdpio/feed/", Comments: "Fairfax County", Language: "en", Status: "active", Tier: 2},
+	{ID: "07bc6a1d56e9d9f1", URL: "https://www.valleycentral.com/news/local-news/feed/", Comments: "Rio Grande Valley, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2cb8ea3316467a1b", URL: "https://riograndeguardian.com/feed/", Comments: "Rio Grande", Language: "en", Status: "active", Tier: 2},
+	{ID: "24cd27f8e2975623", URL: "https://www.tapinto.net/towns/morristown/articles.atom", Comments: "Morristown", Language: "en", Status: "active", Tier: 2},
+	{ID: "4580163ea9237c36", URL: "https://morristowngreen.com/category/police/feed/", Comments: "Morristown Green", Language: "en", Status: "active", Tier: 2},
+	{ID: "baa0c16d60952802", URL: "https://morristowngreen.com/category/news/feed/", Comments: "Morristown Green", Language: "en", Status: "active", Tier: 2},
+	{ID: "af4d9cb6be8b497c", URL: "https://www.chroniclelive.co.uk/news/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ea593fcbb4ab1e2d", URL: "https://www.chroniclelive.co.uk/all-about/sunderland/?service=rss", Comments: "Sunderland, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e24a8a3aad0adf8f", URL: "https://www.chroniclelive.co.uk/all-about/county-durham/?service=rss", Comments: "County Durham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ebdf667c2607f88b", URL: "https://www.chroniclelive.co.uk/news/north-east-news/?service=rss", Comments: "North East UK", Language: "en", Status: "active", Tier: 2},
+	{ID: "070e9e10c569811f", URL: "https://www.chroniclelive.co.uk/all-about/northumberland/?service=rss", Comments: "Northumberland, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "27b4cb9d6e689785", URL: "https://www.chroniclelive.co.uk/all-about/newcastle-upon-tyne/?service=rss", Comments: "Newcastle upon Tyne, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e706ab45ac82a9dc", URL: "https://www.chroniclelive.co.uk/all-about/gateshead/?service=rss", Comments: "Gateshead, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "eecc99cf7c04e6ac", URL: "http://www.worcesterma.gov/police/press-releases?func=viewRss", Comments: "Worcester", Language: "en", Status: "active", Tier: 2},
+	{ID: "1f957cd3c326843b", URL: "https://californianewswire.com/CA/san_diego/feed/", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "48454357bfc1341a", URL: "https://californianewswire.com/CA/los_angeles/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "8107d667d78d21e5", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/capitola/feed/", Comments: "Capitola, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "30e3eb19c6e28a55", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/aptos/feed/", Comments: "Aptos, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5a45aeadf963a5cf", URL: "https://www.santacruzsentinel.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "070279d463e217e7", URL: "https://www.seattletimes.com/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "90e9411f58f7f5cd", URL: "https://www.seattletimes.com/weather/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5158a946484cad26", URL: "https://www.seattletimes.com/northwest/feed/", Comments: "Northwest", Language: "en", Status: "active", Tier: 2},
+	{ID: "7f3b08d5f800c0d9", URL: "https://www.santacruzsentinel.com/news/feed/", Comments: "Santa Cruz, California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c8b16309cc40fa5f", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/watsonville/feed/", Comments: "Watsonville, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e6e7f41f1509fd97", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/soquel/feed/", Comments: "Soquel, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8884fe894fa69c90", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/live-oak/feed/", Comments: "Live Oak, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e82357ea0f2e040f", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/north-coast/feed/", Comments: "North Coast, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "43d6e88ddf6bb12c", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/san-lorenzo-valley/feed/", Comments: "San Lorenzo Valley, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "56422042b5a56431", URL: "https://www.santacruzsentinel.com/location/california/santa-cruz-county/scotts-valley/feed/", Comments: "Scotts Valley, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9080d10eaf829873", URL: "https://santamariatimes.com/search/?f=rss&t=article&c=news/local/crime-and-courts&l=50&s=start_time&sd=desc", Comments: "Santa Maria", Language: "en", Status: "active", Tier: 2},
+	{ID: "06f288013c3f8e79", URL: "https://santamariatimes.com/search/?f=rss&t=article&c=news/local/san-luis-obispo&l=50&s=start_time&sd=desc", Comments: "San Luis Obispo", Language: "en", Status: "active", Tier: 2},
+	{ID: "0376fa099001a69c", URL: "https://www.montereyherald.com/local-news/feed/", Comments: "Monterey", Language: "en", Status: "active", Tier: 2},
+	{ID: "22de8c248c3d95a4", URL: "https://www.montereyherald.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "09431c9128c971f4", URL: "https://fox2now.com/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "77adb44de034df68", URL: "https://fox2now.com/news/missouri/feed/", Comments: "Missouri", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7127f1c16b6b27cb", URL: "https://fox2now.com/news/illinois/feed/", Comments: "Illinois", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3c63015fef9c8e9e", URL: "https://www.riverbender.com/syndication/newsRSS.cfm", Comments: "Riverbender", Language: "en", Status: "active", Tier: 2},
+	{ID: "354208fff69806ce", URL: "https://www.scmp.com/rss/322514/feed", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "5248ed8630ab219c", URL: "https://www.scmp.com/rss/318216/feed", Comments: "SCMP, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "72eeb2361cc9aa45", URL: "https://japantoday.com/category/national/feed", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "2a6db7692c773619", URL: "https://www.tokyoreporter.com/category/crime/feed/", Comments: "Tokyo", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b49d2f2fb732b34", URL: "https://www.tokyoreporter.com/category/japan/chiba/feed", Comments: "Chiba, Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "81351ac5dc4b39b3", URL: "https://www.wishtv.com/tag/crawfordsville-indiana/feed", Comments: "Crawfordsville, IN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "978f8dd363204975", URL: "https://www.thesentinel.com/search/?f=rss&t=article&c=communities/montgomery/news/national&l=50&s=start_time&sd=desc", Comments: "The Sentinel", Language: "en", Status: "active", Tier: 2},
+	{ID: "261b1589173e97a2", URL: "https://www.wrtv.com/news/u-s-world.rss", Comments: "US, World", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d2bcafca45acc8db", URL: "https://www.wrtv.com/news/local-news.rss", Comments: "Indianapolis", Language: "en", Status: "active", Tier: 2},
+	{ID: "b178a5d28eac9a8b", URL: "https://www.latimes.com/california/orange-county/rss2.0.xml", Comments: "Orange County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a986753ac35c4e2c", URL: "https://www.latimes.com/california/rss2.0.xml#nt=0000016c-0bf3-d57d-afed-2fff84fd0000-1col-7030col1", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "13a9bea3dfa7178a", URL: "https://losangeles.cbslocal.com/category/news/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "11938b22216af482", URL: "https://www.dailynews.com/local-news/feed/", Comments: "Daily News", Language: "en", Status: "active", Tier: 2},
+	{ID: "539b03429c1e3cf5", URL: "https://www.dailynews.com/location/california/los-angeles-county/san-fernando-valley/feed/", Comments: "San Fernando Valley, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7d257313d1d4eb0d", URL: "https://www.dailynews.com/location/california/los-angeles-county/los-angeles/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "46c6c9e9b4e722ae", URL: "https://www.wfla.com/news/local-news/feed", Comments: "Tampa Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "37110caa49dca042", URL: "https://www.wfla.com/news/national/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1feaf0e2952f5245", URL: "https://www.wkrg.com/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5729878b4cc4cfae", URL: "https://www.wkrg.com/baldwin-county/feed/", Comments: "Baldwin County, AL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "610c88e447ce5e23", URL: "https://www.wkrg.com/state-regional/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d3ee62327056f6db", URL: "https://www.wkrg.com/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d769f45d6bf1c812", URL: "https://wsvn.com/news/local/miami-dade/feed/", Comments: "Miami-Dade County, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c292e633d04a2696", URL: "https://www.wlns.com/jackson/feed/", Comments: "Jackson, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d8d4466fc8a699f2", URL: "https://www.wlns.com/news/local-news/feed/", Comments: "WLNS 6 News", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b602a9198a12383", URL: "https://www.wlns.com/lansing/feed/", Comments: "Lansing, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ca52fe6bc3e34cee", URL: "https://www.wjbf.com/news/georgia-news/feed/", Comments: "Georgia", Language: "en", Status: "active", Country: "GE", Tier: 2},
+	{ID: "dc1320acf24f7750", URL: "https://www.nwitimes.com/search/?app=editorial&q=&t=article&l=10&d=&d1=&d2=&s=start_time&sd=desc&c=news/national*&f=rss", Comments: "Northwest", Language: "en", Status: "active", Tier: 2},
+	{ID: "9889c9ac1fe83b95", URL: "https://feeds.texastribune.org/feeds/main/", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f89a55e51f06f8e9", URL: "https://fremonttribune.com/search/?f=rss&t=article&c=news/local&l=25&s=start_time&sd=desc", Comments: "Fremont", Language: "en", Status: "active", Tier: 2},
+	{ID: "ecd23b41740a4563", URL: "https://fremonttribune.com/search/?f=rss&t=article&c=news/state-and-regional&l=25&s=start_time&sd=desc", Comments: "Fremont", Language: "en", Status: "active", Tier: 2},
+	{ID: "910003b3a2123397", URL: "https://lacrossetribune.com/search/?f=rss&t=article&c=news/state-and-regional/wi&l=50&s=start_time&sd=desc", Comments: "Wisconsin", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1183e5d17028025e", URL: "https://wacotrib.com/search/?f=rss&t=article&c=news/state-and-regional&l=50&s=start_time&sd=desc", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bf8e7d868f94c8e8", URL: "https://www.winchestersun.com/category/news/feed/", Comments: "Winchester", Language: "en", Status: "active", Tier: 2},
+	{ID: "5ba7150dc86ac403", URL: "http://www.ozarkcountytimes.com/rss.xml", Comments: "Ozark County", Language: "en", Status: "active", Tier: 2},
+	{ID: "d0f8dd69d78143a4", URL: "http://www.ozarkcountytimes.com/taxonomy/term/148/all/feed", Comments: "Ozark County", Language: "en", Status: "active", Tier: 2},
+	{ID: "c7c3cf934fd90df5", URL: "https://dailyvoice.com/new-york/somers/rss/", Comments: "Somers, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2601d042f41b38d7", URL: "https://www.readingeagle.com/news/feed/", Comments: "Reading, Pennsylvania, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7f17d8eb30ddc86b", URL: "https://berksweekly.com/section/news/feed/", Comments: "Berks", Language: "en", Status: "active", Tier: 2},
+	{ID: "66612abed7fec51d", URL: "https://localnews8.com/category/news/crime-tracker/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5205a39d49f11c64", URL: "https://localnews8.com/category/news/idaho/feed", Comments: "Idaho", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b09e0a42a21c9fab", URL: "https://www.wpsdlocal6.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "WPS Local 6", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc51e4dbd1a0cd22", URL: "https://www.wpsdlocal6.com/search/?f=rss&t=article&c=news/kentucky-news&l=50&s=start_time&sd=desc", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "11344868e06d68dd", URL: "https://www.wpsdlocal6.com/search/?f=rss&t=article&c=news/illinois-news&l=50&s=start_time&sd=desc", Comments: "Illinois", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b58840ed79300089", URL: "https://www.ny1.com/services/contentfeed.nyc%7call-boroughs%7cnews.landing.rss", Comments: "NY", Language: "en", Status: "active", Tier: 2},
+	{ID: "cc57335c3c754bc7", URL: "https://pix11.com/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "534dc53dc8128a6e", URL: "https://pix11.com/news/local-news/brooklyn/feed/", Comments: "Brooklyn", Language: "en", Status: "active", Tier: 2},
+	{ID: "db16548ff9ecef01", URL: "https://pix11.com/news/local-news/bronx/feed/", Comments: "Bronx", Language: "en", Status: "active", Tier: 2},
+	{ID: "ef1bf9dfe7966448", URL: "https://pix11.com/news/local-news/long-island/feed/", Comments: "Long Island", Language: "en", Status: "active", Tier: 2},
+	{ID: "00150bd3ce7c149f", URL: "https://pix11.com/news/local-news/manhattan/feed/", Comments: "Manhattan", Language: "en", Status: "active", Tier: 2},
+	{ID: "a2359da20712be71", URL: "https://pix11.com/news/local-news/new-jersey/feed/", Comments: "New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ee69913fc53a33c1", URL: "https://pix11.com/news/local-news/queens/feed/", Comments: "Queens", Language: "en", Status: "active", Tier: 2},
+	{ID: "24a6371c9ed4631a", URL: "https://pix11.com/news/local-news/northern-suburbs/feed/", Comments: "Northern Suburbs", Language: "en", Status: "active", Tier: 2},
+	{ID: "831c428e32b403f9", URL: "https://pix11.com/news/local-news/staten-island/feed/", Comments: "Staten Island", Language: "en", Status: "active", Tier: 2},
+	{ID: "333ab04bab41e194", URL: "https://pix11.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "094f4e3add8e8b75", URL: "https://colliervilleh-i.com/category/news/feed/", Comments: "Collierville", Language: "en", Status: "active", Tier: 2},
+	{ID: "c1af0160e23fe09d", URL: "https://www.pghcitypaper.com/pittsburgh/Rss.xml?oid=1332445", Comments: "Pittsburgh", Language: "en", Status: "active", Tier: 2},
+	{ID: "dd2fa8cdf534b885", URL: "https://www.phillyvoice.com/feed/channel/philadelphia-news/", Comments: "Philly", Language: "en", Status: "active", Tier: 2},
+	{ID: "fedbb5013d8abaf3", URL: "https://www.binghamtonhomepage.com/news/local-news/feed/", Comments: "Binghamton, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "deafe0f926be301c", URL: "https://www.binghamtonhomepage.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9d1f88385377fe42", URL: "https://www.twincities.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "477045ab2d1c528b", URL: "https://www.twincities.com/news/national/feed/", Comments: "Twin Cities", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ee31b8a6cd81ac0", URL: "https://www.twincities.com/tag/ramsey-county/feed/", Comments: "Ramsey County, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4513dcc388213dc6", URL: "https://www.twincities.com/tag/st-paul/feed/", Comments: "St. Paul, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9922654f8343436f", URL: "https://www.twincities.com/tag/wisconsin/feed/", Comments: "Wisconsin", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b11b66ac3ad84b3c", URL: "https://www.twincities.com/tag/minnesota/feed/", Comments: "Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1a2df8c848b3692d", URL: "https://www.twincities.com/tag/dakota-county/feed/", Comments: "Dakota County, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fbb11e02b18e9b30", URL: "https://www.twincities.com/tag/washington-county/feed/", Comments: "Washington County, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "62df2ccd0884f5d9", URL: "https://www.kxan.com/news/texas/feed/", Comments: "Texas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "49979fa83b9e8c22", URL: "https://www.kxan.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3aa74370ce2a5700", URL: "https://www.nwahomepage.com/news/local-news/feed/", Comments: "Northwest Arkansas", Language: "en", Status: "active", Tier: 2},
+	{ID: "9f4603f18ab82452", URL: "https://www.nwahomepage.com/news/national/feed/", Comments: "NWA", Language: "en", Status: "active", Tier: 2},
+	{ID: "95e1d06be0a17667", URL: "https://readthereporter.com/category/news/feed", Comments: "Read The Reporter", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d189f2b0c97c4d6", URL: "https://cw33.com/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7b765487521c2cc3", URL: "https://www.chron.com/rss/feed/News-270.php", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "63788c0656da4d73", URL: "https://midjersey.news/category/monmouth-county/feed/", Comments: "Monmouth County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b47ec0283beeaf01", URL: "https://midjersey.news/category/mercer-county/feed", Comments: "Mercer County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "89d46a78dd5cf99e", URL: "https://www.montclairlocal.news/category/news/feed/", Comments: "Montclair, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a3099b827bcc7567", URL: "https://www.montclairlocal.news/feed/", Comments: "Montclair, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "eea2eec07120e599", URL: "https://www.trentonian.com/news/national-news/feed/", Comments: "Trenton", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1b2d559437e7d20", URL: "https://www.trentonian.com/south-jersey-local-news/feed/", Comments: "South Jersey", Language: "en", Status: "active", Tier: 2},
+	{ID: "173ca78874190523", URL: "https://www.trentonian.com/news/feed", Comments: "Trenton", Language: "en", Status: "active", Tier: 2},
+	{ID: "28498436b2e5e983", URL: "https://tomsriver.org/feed/", Comments: "Toms River", Language: "en", Status: "active", Tier: 2},
+	{ID: "d5b17be74eb9f2d2", URL: "https://unionnewsdaily.com/feed", Comments: "Union", Language: "en", Status: "active", Tier: 2},
+	{ID: "48e5ddb587eb378e", URL: "https://wrnjradio.com/category/wrnj-news/news-dept/feed", Comments: "WRNJ Radio", Language: "en", Status: "active", Tier: 2},
+	{ID: "14e4cfaf4abea81e", URL: "https://www.wearecentralpa.com/news/local-news/feed/", Comments: "WeAreCentralPA", Language: "en", Status: "active", Tier: 2},
+	{ID: "915a5a54c52d701f", URL: "https://www.wearecentralpa.com/news/regional-news/feed/", Comments: "WeAreCentralPA", Language: "en", Status: "active", Tier: 2},
+	{ID: "786ec42455fc4d92", URL: "https://www.wearecentralpa.com/news/national-news/feed", Comments: "WeAreCentralPA", Language: "en", Status: "active", Tier: 2},
+	{ID: "3416e2e33795f547", URL: "https://www.wearecentralpa.com/feed/", Comments: "WeAreCentralPA", Language: "en", Status: "active", Tier: 2},
+	{ID: "5cc045adc8e9ca6a", URL: "https://www.localsyr.com/news/ny-capitol-news/feed/", Comments: "NY", Language: "en", Status: "active", Tier: 2},
+	{ID: "88aa961b19175293", URL: "https://www.localsyr.com/news/national/feed/", Comments: "LocalSYR", Language: "en", Status: "active", Tier: 2},
+	{ID: "fb3d0319782d2cdb", URL: "https://www.marinij.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b379a222ea834c8", URL: "https://www.lowellsun.com/news/police/feed/", Comments: "Lowell", Language: "en", Status: "active", Tier: 2},
+	{ID: "d918a2cd805e49ad", URL: "https://auburnpub.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Auburn", Language: "en", Status: "active", Tier: 2},
+	{ID: "c62b316d1862f78c", URL: "https://auburnpub.com/search/?f=rss&t=article&c=news/state-and-regional&l=50&s=start_time&sd=desc", Comments: "Auburn", Language: "en", Status: "active", Tier: 2},
+	{ID: "6341bf53f6bed0a9", URL: "https://www.cnyhomepage.com/news/local-news/feed/", Comments: "CNY", Language: "en", Status: "active", Tier: 2},
+	{ID: "3d6acd1098b09379", URL: "https://www.cnyhomepage.com/news/national-news/feed/", Comments: "CNY", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c71a42058bef9bd", URL: "https://www.cnyhomepage.com/news/feed/", Comments: "CNY", Language: "en", Status: "active", Tier: 2},
+	{ID: "1a73b970165ff4c0", URL: "https://buffalonews.com/search/?f=rss&t=article&c=news/state-and-regional&l=50&s=start_time&sd=desc", Comments: "Buffalo", Language: "en", Status: "active", Tier: 2},
+	{ID: "b0d535024baa8490", URL: "https://www.wivb.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a11c36a3bf2c7d81", URL: "https://dailyvoice.com/new-jersey/sussex/rss/", Comments: "Sussex County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c7bef873bd1e85d6", URL: "https://kfor.com/news/local/feed/", Comments: "KFOR", Language: "en", Status: "active", Tier: 2},
+	{ID: "a6e582903f7a3c7b", URL: "https://www.pahomepage.com/news/local-news/feed/", Comments: "PAHomepage", Language: "en", Status: "active", Tier: 2},
+	{ID: "d135f4de3765d5b9", URL: "https://www.pahomepage.com/news/national/feed/", Comments: "PAHomepage", Language: "en", Status: "active", Tier: 2},
+	{ID: "444f73035a0ae603", URL: "https://www.pahomepage.com/news/crime-courts/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "184fd382cc40d938", URL: "https://dailyvoice.com/new-jersey/paramus/rss/", Comments: "Paramus, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "46273d41bf8f06a5", URL: "https://dailyvoice.com/new-york/lewisboro/rss/", Comments: "Lewisboro, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "55bfe5465586ff6f", URL: "https://framinghamsource.com/index.php/category/ashland-source/feed", Comments: "Ashland, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "294ddc41afed5246", URL: "https://framinghamsource.com/index.php/category/natick-source/feed", Comments: "Natick, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c5e01c4106880186", URL: "https://framinghamsource.com/index.php/category/marlborough-source/feed", Comments: "Marlborough, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8a058be0ece92432", URL: "https://jocoreport.com/category/crime/feed/", Comments: "Joco", Language: "en", Status: "active", Tier: 2},
+	{ID: "bf7d34e92ee5269b", URL: "https://www.morningjournal.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e9fb308ec5866147", URL: "https://hudsonvalleyone.com/category/news/crime/feed/", Comments: "Hudson Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "a2959c487e351d2f", URL: "https://www.lex18.com/news/crime.rss", Comments: "LEX18", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c7dd8d29902e246", URL: "https://veronews.com/feed/", Comments: "Vero", Language: "en", Status: "active", Tier: 2},
+	{ID: "561ab01c5ec77c45", URL: "https://dailyvoice.com/new-york/eastdutchess/rss/", Comments: "East Dutchess, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "80a17a5ba616e944", URL: "https://www.postandcourier.com/search/?f=rss&t=article&c=berkeley-independent/news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "c55efaaeac06cb78", URL: "https://www.wbtw.com/crime/pee-dee-crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "86d557cd0e4edfd1", URL: "https://www.wspa.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "16ed63bdea4ee004", URL: "https://www.news-herald.com/news/crime-public-safety/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "46a2c6b70494d748", URL: "https://www.chicoer.com/news/crime-public-safety/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "684c5ccf7e747c6c", URL: "https://www.wavy.com/news/local-news/portsmouth/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "d5dcd60f72e2e54b", URL: "https://kyma.com/category/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2be3d63592fe6d0c", URL: "https://www.wcpo.com/news/local-news/kenton-county.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "4590701ba7146eb2", URL: "https://www.wcpo.com/news/local-news/boone-county.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ff95f608e9d8f2e8", URL: "https://www.tcipolice.tc/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "11fa2f093b29ad64", URL: "https://www.fbcnews.com.fj/section/news/crime/feed/", Comments: "Fiji", Language: "en", Status: "active", Country: "FJ", Tier: 2},
+	{ID: "f32f91b13e758fe7", URL: "https://www.edinburghnews.scotsman.com/news/crime/rss", Comments: "Edinburgh", Language: "en", Status: "active", Tier: 2},
+	{ID: "25ae17d9b422b278", URL: "https://www.glasgowtimes.co.uk/news/glasgow-crime/rss/", Comments: "Glasgow", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b6652f2c00edc9c", URL: "https://wellington.scoop.co.nz/?feed=rss2&cat=180", Comments: "Wellington", Language: "en", Status: "active", Tier: 2},
+	{ID: "6c91d838808d5da7", URL: "https://dailyvoice.com/new-york/putnam/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "093310ba99dcea22", URL: "https://vifreepress.com/category/irregularities/feed/", Comments: "Virgin Islands", Language: "en", Status: "active", Tier: 2},
+	{ID: "24ebbfc28aeff61f", URL: "https://www.hampshirechronicle.co.uk/news/crime/rss/", Comments: "Hampshire", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ab6cb60e41f09d6", URL: "https://www.thecoastlandtimes.com/category/news/crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "244ca33998fabdc0", URL: "https://rceno.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "d93de2ded83f0517", URL: "https://www.salisburypost.com/category/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d7ad232651e75b84", URL: "https://sandhillssentinel.com/category/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce42ab92957313b5", URL: "http://therecord-online.com/site/archives/category/local-news/police-news/feed", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "0e26d61358fe5d42", URL: "https://www.marinij.com/tag/novato-police-department/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c4c046a4be92d4cf", URL: "https://lptv.org/category/news/crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "440d205aa04ee0c5", URL: "https://www.timesnews.net/search/?f=rss&t=article&c=news/crime&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "19c1a5058e2e33a4", URL: "https://www.columbian.com/news/courts-crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "accc23273b804b1c", URL: "https://dailyvoice.com/new-york/mamaroneck/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "2f25788dfef88a75", URL: "https://www.marinij.com/tag/san-rafael-police-department/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8b21c36c36999845", URL: "https://www.columbiagorgenews.com/search/?f=rss&t=article&c=whitesalmonenterprise/news/police-fire&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea15534fb6826b61", URL: "https://whav.net/category/localnews/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5008e2c405fc46cd", URL: "https://www.ttownmedia.com/search/?f=rss&t=article&c=tracy_press/news/law_and_order&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9fce4ddde8b45acd", URL: "https://morganhilltimes.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c2631e62f6a126c5", URL: "https://www.unionleader.com/search/?f=rss&t=article&c=news/crime&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7d25f235d265d376", URL: "https://www.wwlp.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "91a519a84bd579e0", URL: "https://www.kitsapdailynews.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "2df59558b8545373", URL: "https://www.kitsapdailynews.com/news/feed", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "352558b9dee57834", URL: "https://www.news5cleveland.com/news/local-news/oh-portage.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "832743bd7180b567", URL: "https://www.myarklamiss.com/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bb6acea7e09e1571", URL: "https://globegazette.com/search/?f=rss&t=article&c=news/local/crime-and-courts&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bcd826629325be05", URL: "https://www.keysnews.com/search/?f=rss&t=article&c=news/crime&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ed810a01eb69077", URL: "https://www.dominionpost.com/category/latest-news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7e29294ac3f11c0d", URL: "https://www.wtrf.com/news/crimes-and-courts/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "626f99b7083b2b12", URL: "https://www.oxfordeagle.com/category/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fddbdeaea19aa9ce", URL: "https://dailyvoice.com/connecticut/stamford/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "23019bf2ccfdb7e9", URL: "https://www.wtnh.com/news/crime-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "103237b0d1807387", URL: "https://dailyvoice.com/connecticut/norwalk/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "999b71dc33ea1cfd", URL: "https://www.thenewportbuzz.com/tag/police-and-fire/feed", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea4ebdb84affed2a", URL: "https://www.wpri.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9caac17ece420cc0", URL: "https://www.lkldnow.com/category/crime-justice/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "429b20dff3b932f7", URL: "https://www.bossiernow.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "893e93675b8595f3", URL: "https://www.ktbs.com/search/?f=rss&t=article&c=news/crime&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b00708bf8cefc941", URL: "https://www.reporterherald.com/news/crime-public-safety/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "86ff720c116e7f86", URL: "https://www.eptrail.com/news/crime-public-safety/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "cff69c4770d52aa7", URL: "https://www.valleycentral.com/news/crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "81bda9f3ea5a20aa", URL: "https://txktoday.com/category/crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "a14ea1de40a9b8a9", URL: "https://www.thereporter.com/news/crime-public-safety/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "85b9d037b4b9865c", URL: "https://pvtimes.com/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9ab925568b045625", URL: "https://www.timescall.com/news/crime-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cbec2bf1de0c9f0f", URL: "https://www.broomfieldenterprise.com/news/crime-public-safety/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "df26dfe89fd0d600", URL: "https://ladailypost.com/tag/police/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "10989449227f63a9", URL: "https://dailyvoice.com/new-york/whiteplains/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c8c449c6d8141c0", URL: "https://www.suffolknewsherald.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8df7ea4c3320cc82", URL: "https://www.saratogian.com/news/crime-public-safety/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c4d4c5bee5cd6db", URL: "https://dailyvoice.com/pennsylvania/northampton/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8f4e608b0fc33b12", URL: "https://www.mypanhandle.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "95bd93533ace8fe6", URL: "https://www.kenoshanews.com/search/?f=rss&t=article&c=news/local/crime-and-courts&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "70920e701623a484", URL: "https://www.wkbn.com/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "bf4dce2040884266", URL: "https://www.wjbf.com/news/columbia-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "710b86cf57453842", URL: "https://ktvz.com/category/news/crime-courts/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "18666b069d875082", URL: "https://ktla.com/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "82aa6fc7a0d86d3b", URL: "https://lebtown.com/category/crime-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7a4d07d525a759d", URL: "https://www.pressandjournal.co.uk/category/news/crime-courts/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "6513c9c8dd2c42ee", URL: "https://www.bristolpost.co.uk/news/bristol-news/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "92b04b995ff83b18", URL: "https://metro.co.uk/tag/crime-news/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "32d7679dd7551cee", URL: "https://www.devonlive.com/news/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e1b18303c71971ee", URL: "https://www.theguardian.com/uk-news/heathrow-airport/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "05462e4674f3a89f", URL: "https://www.ivpressonline.com/search/?f=rss&t=article&c=news/local/crime&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f704adfab7a83e47", URL: "https://www.nwahomepage.com/northwest-arkansas-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "5fd2036ee3ec1eac", URL: "https://www.timesofisrael.com/feed/", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "2e46458a89fc00af", URL: "https://dailyvoice.com/new-york/ramapo/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "90af7ce0cb98ba12", URL: "https://dailyvoice.com/new-york/northrockland/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "55df197636e32b3f", URL: "https://www.theyeshivaworld.com/news/category/monsey-news/feed", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "6e9d958daf6030e1", URL: "https://www.wtnh.com/news/connecticut/tolland/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "668508f4bbf51102", URL: "https://www.wcia.com/crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8be744d169127301", URL: "https://www.wtvq.com/tag/police/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "5904c31ffebb73d1", URL: "https://www.news10.com/news/rensselaer-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "fb2e5da8a6aa08d8", URL: "https://fox8.com/tag/trumbull-county-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa5110cb2430e360", URL: "https://www.centralillinoisproud.com/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "5f97f64e743d03bd", URL: "https://maurycountysource.com/category/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f269f0bda4e68c7a", URL: "https://wokingham.today/category/news/crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ad624acaa6cb9a0e", URL: "https://www.gmtoday.com/search/?f=rss&t=article&c=daily_news/news&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "21ca8a0d7da9bce9", URL: "https://bloomingtonian.com/category/local-news/crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "618c888d3c73612f", URL: "https://www.theadvocate.com/search/?f=rss&t=article&c=baton_rouge/news/communities/ascension&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3e9a4f541c79c4bb", URL: "https://www.wavy.com/news/local-news/york-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "75d3e5ba93fdeed5", URL: "https://newsregister.com/index.php?rss=Article", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "52663af7b254dcaf", URL: "https://dailyiowan.com/category/news/johnson-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "a257386a74777ffd", URL: "https://www.eastbaytimes.com/location/california/bay-area/east-bay/alameda-county/hayward/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ed1f6626e68aa23a", URL: "https://www.sgvtribune.com/location/california/los-angeles-county/san-gabriel-valley/city-of-industry/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "4f9eec64f21a604f", URL: "http://www.loscerritosnews.net/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "5aeb5e1568dba7ca", URL: "https://sanbenito.com/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "42744996ac5f081d", URL: "https://signalscv.com/category/news/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "50153d16fd0be7e9", URL: "https://www.mytwintiers.com/crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "f88c5a3fea493401", URL: "https://jcitytimes.com/tag/jersey-city-crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "87018b99516cdfa9", URL: "https://www.co.wright.mn.us/RSSFeed.aspx?ModID=1&CID=Sheriff-News-Releases-11", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4cfbfb061cf5117b", URL: "https://www.dothanpd.org/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "bbb545f6c0a84468", URL: "https://www.wcpo.com/news/local-news/campbell-county.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "31ac5481efe15723", URL: "https://www.denverpost.com/tag/aurora-police-department/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac161032bd6db3e7", URL: "https://www.wboy.com/news/monongalia/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "5e70df86a95d7624", URL: "https://www.leavenworthtimes.com/search/crime/feed/rss2/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "a250044f66e73893", URL: "https://www.villages-news.com/category/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "979f33e28b076533", URL: "https://sandhillssentinel.com/category/accidentsfire/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "38395488c086683a", URL: "https://www.hokenews.com/index.php/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "4171d72d87568ff2", URL: "https://capcity.news/category/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e005a2d82496fa9c", URL: "https://smnewsnet.com/archives/category/county/calvert/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "1ffa6b1cd73562ee", URL: "https://dailynewshungary.com/tag/crime/feed/", Comments: "Hungary, global", Language: "en", Status: "active", Country: "HU", Tier: 2},
+	{ID: "988cd8d5a3dff711", URL: "https://www.themountaineer.com/search/?c=news&f=rss&l=50&s=start_time&sd=desc&t=article", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "912c44311fe5d6d2", URL: "https://newsghana.com.gh/ghana-news/crime/feed/", Comments: "Ghana, global", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "f66071b78df5066c", URL: "https://www.stabroeknews.com/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "4473364b2640d5e8", URL: "https://smokeybarn.com/category/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3af4730170790c67", URL: "https://www.telegraphherald.com/search/?f=rss&t=article&c=news/tri-state/police&l=50&s=start_time&sd=desc", Comments: "CSO", Language: "en", Status: "active", Tier: 2},
+	{ID: "c1a8a25966e7124c", URL: "https://www.theguardian.com/world/congo/rss", Comments: "Congo", Language: "en", Status: "active", Country: "CG", Tier: 2},
+	{ID: "b68a6f6f3391639b", URL: "https://www.theguardian.com/world/paris/rss", Comments: "Paris, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1f517bd3176aea1b", URL: "https://e.vnexpress.net/rss/news.rss", Comments: "Vietnam, Asia", Language: "en", Status: "active", Country: "VN", Tier: 2},
+	{ID: "f7988902e339a8e8", URL: "https://guardian.ng/tag/tanzania/feed/", Comments: "Tanzania", Language: "en", Status: "active", Country: "TZ", Tier: 2},
+	{ID: "084a87158460c5b1", URL: "https://torontosun.com/category/news/crime/feed.xml", Comments: "Toronto", Language: "en", Status: "active", Tier: 2},
+	{ID: "e55aba06f08e8926", URL: "https://addisstandard.com/feed/", Comments: "Ethiopia", Language: "en", Status: "active", Country: "ET", Tier: 2},
+	{ID: "2b4085a57abfbd86", URL: "https://mexicodailypost.com/category/nuevo-leon-news/feed/", Comments: "Nuevo Leon", Language: "en", Status: "active", Tier: 2},
+	{ID: "973d80d43aa922b8", URL: "https://www.theguardian.com/world/kenya/rss", Comments: "Kenya, global", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "be7b2c8c02ec0522", URL: "https://pajhwok.com/category/security-crime/feed/", Comments: "Afghan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "82c01b394848389a", URL: "https://www.independent.co.uk/topic/rome/rss", Comments: "Rome", Language: "en", Status: "active", Tier: 2},
+	{ID: "6dcd57d6b469ed96", URL: "https://guardian.ng/tag/kano/feed/", Comments: "Kano", Language: "en", Status: "active", Tier: 2},
+	{ID: "3fef9f2e5d514f58", URL: "https://ekurhuleninews.co.za/feed/", Comments: "Ekurhuleni, South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "a57ebb20b0594091", URL: "https://guardian.ng/tag/ibadan/feed/", Comments: "Ibadan", Language: "en", Status: "active", Tier: 2},
+	{ID: "0cbcd3ecc541b9aa", URL: "https://guardian.ng/tag/abuja/feed/", Comments: "Abuja", Language: "en", Status: "active", Tier: 2},
+	{ID: "bf9fdf7fc42a2fd3", URL: "https://guardian.ng/tag/port-harcourt/feed/", Comments: "Port Harcourt", Language: "en", Status: "active", Tier: 2},
+	{ID: "f8d77dff13326a8e", URL: "https://www.dailynk.com/english/feed/", Comments: "North Korea", Language: "en", Status: "active", Country: "KP", Tier: 2},
+	{ID: "e6cba5c5a3da9f5d", URL: "https://www.wantedinmilan.com/news?format=rss", Comments: "Milan", Language: "en", Status: "active", Tier: 2},
+	{ID: "c45c6fb083fad635", URL: "https://www.lpso.net/crime-arrests/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b06e501a179e3e6", URL: "https://www.theguardian.com/world/yemen/rss", Comments: "Yemen, global", Language: "en", Status: "active", Country: "YE", Tier: 2},
+	{ID: "5ce0a332d2aa933b", URL: "https://www.theguardian.com/world/portugal/rss", Comments: "Portugal, global", Language: "en", Status: "active", Country: "PT", Tier: 2},
+	{ID: "f802a8831e30c1c4", URL: "https://www.echo.net.au/category/local-news/feed/", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "c6cf746c706b0e31", URL: "https://www.lusakatimes.com/feed/", Comments: "Zambia", Language: "en", Status: "active", Country: "ZM", Tier: 2},
+	{ID: "b1d737875c0c487b", URL: "https://www.puertoricoreport.com/feed/", Comments: "Puerto Rico", Language: "en", Status: "active", Country: "PR", Tier: 2},
+	{ID: "12d39ad054ef79be", URL: "https://guardian.ng/tag/tunisia/feed/", Comments: "Tunisia", Language: "en", Status: "active", Country: "TN", Tier: 2},
+	{ID: "f66f713ab1c0da72", URL: "https://www.jessaminejournal.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "23cc8c852bd883f4", URL: "https://arynews.tv/tag/peshawar/feed/", Comments: "Peshawar, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "835b892a641ab09c", URL: "https://astanatimes.com/feed/", Comments: "Kazakhstan, Central Asia", Language: "en", Status: "active", Country: "KZ", Tier: 2},
+	{ID: "c56c6a66f15c32f4", URL: "https://830times.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "30bb978a24ae907e", URL: "https://www.theguardian.com/world/mozambique/rss", Comments: "Mozambique, global", Language: "en", Status: "active", Country: "MZ", Tier: 2},
+	{ID: "e66e9de9e7f1d0c2", URL: "https://www.budapesttimes.hu/feed/", Comments: "Budapest", Language: "en", Status: "active", Tier: 2},
+	{ID: "a393ae272a1488a4", URL: "https://www.glasgowlive.co.uk/news/glasgow-news/?service=rss", Comments: "Glasgow", Language: "en", Status: "active", Tier: 2},
+	{ID: "cf0088585cb5aec2", URL: "https://guardian.ng/tag/kaduna-state/feed/", Comments: "Kaduna State", Language: "en", Status: "active", Tier: 2},
+	{ID: "1ff1f29792533ce8", URL: "https://www.theguardian.com/world/syria/rss", Comments: "Syria", Language: "en", Status: "active", Country: "SY", Tier: 2},
+	{ID: "d20e47698d6995ae", URL: "https://astanatimes.com/category/nation/feed/", Comments: "Kazakhstan", Language: "en", Status: "active", Country: "KZ", Tier: 2},
+	{ID: "fee64b797713516c", URL: "https://www.myclallamcounty.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "888f23ee72f950dd", URL: "https://www.kbzk.com/news/crime-courts.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "29fa0600fece7d83", URL: "https://themercury.com/search/?f=rss&t=article&c=news/crime&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "eb75df08da0c5f19", URL: "https://www.news5cleveland.com/news/local-news/oh-wayne.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "94b211d8cc05ec14", URL: "https://www.wearecentralpa.com/crime/feed/", Comments: "CSO", Language: "en", Status: "active", Tier: 2},
+	{ID: "81aca8e11b3f04df", URL: "https://www.mauicounty.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "549df102e28e3eba", URL: "https://www.fox46.com/news/u-s/north-carolina/caldwell-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "c564a04de2539e04", URL: "https://walworthcountycommunitynews.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "92f6fc45b553cb2a", URL: "https://www.mytwintiers.com/steuben-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "0adffecd421abbde", URL: "https://www.fingerlakes1.com/category/steuben-county/bath/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "cba82b391b3e0bbb", URL: "https://www.wboy.com/news/marion/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "7ddd419b67cba325", URL: "https://coastalcourier.com/news/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "eeb89a2cbc233088", URL: "https://flatheadbeacon.com/feed/", Comments: "Flathead Beacon, Montana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f7242fee7c6d0631", URL: "https://www.nbc4i.com/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e4bd35b7e8cea882", URL: "https://www.denver7.com/news/front-range/littleton.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "1a21b7f5eb660a7b", URL: "https://www.stokesentinel.co.uk/news/stoke-on-trent-news/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8e2c797e31961594", URL: "https://www.theguardian.com/australia-news/melbourne/rss", Comments: "Melbourne", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c576c9000057572", URL: "https://www.luxtimes.lu/rss/en/luxembourg", Comments: "Luxembourg", Language: "en", Status: "active", Country: "LU", Tier: 2},
+	{ID: "4c46587492c659b0", URL: "https://www.ksat.com/arc/outboundfeeds/rss/category/weather/?outputType=xml&size=10", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "616cc19728760a16", URL: "https://rss.jpost.com/rss/rssfeedsarabisraeliconflict.aspx", Comments: "Jerusalem, Arab-Israeli Conflict", Language: "en", Status: "active", Tier: 2},
+	{ID: "79cb08c0324973cc", URL: "https://www.orangeleader.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "387977a116a2e837", URL: "https://wsnwradio.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f36c9222fd4b6793", URL: "https://www.wavy.com/news/local-news/isle-of-wight/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "47ec71abfcc6580a", URL: "https://www.blogpreston.co.uk/category/crime/feed/", Comments: "Preston", Language: "en", Status: "active", Tier: 2},
+	{ID: "e4156434330c3ae6", URL: "https://www.kpcw.org/summit-county.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "1483da65afca8072", URL: "https://www.marysvillejt.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8af4c6c2bcb9374f", URL: "https://www.wdef.com/category/bradley-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e2e6f4188f818d08", URL: "https://knco.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "69b264fdba339989", URL: "http://www.fernleyreporter.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b55a25e406023e9", URL: "https://coosavalleynews.com/category/bartownews/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "57996d8683aa8fbb", URL: "https://www.wnct.com/local-news/new-bern/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "f0a027a58aaa423d", URL: "https://fcfreepresspa.com/category/news/feed/", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e412de02c417a86d", URL: "https://www.news5cleveland.com/news/local-news/oh-geauga.rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b0494e964a95772d", URL: "https://www.sgvtribune.com/location/california/los-angeles-county/san-gabriel-valley/monrovia/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "d27f45bad73be6db", URL: "https://www.pasadenastarnews.com/location/california/los-angeles-county/san-gabriel-valley/duarte/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8cd47cc208adec98", URL: "https://www.ocregister.com/location/california/orange-county/huntington-beach/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9602f394b3fcb03c", URL: "https://www.sgvtribune.com/location/california/los-angeles-county/san-gabriel-valley/covina/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "6089f76e26fdb4a8", URL: "https://www.sgvtribune.com/location/california/los-angeles-county/san-gabriel-valley/glendora/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "1ea89f097b92c703", URL: "https://muddyrivernews.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8e23a89f1ff30951", URL: "https://www.wric.com/news/local-news/the-tri-cities/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c0ee51f1ed8bd98", URL: "https://www.benewsjournal.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "6507ff158a65a095", URL: "https://www.whidbeynewstimes.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "133e28c7e5209ef8", URL: "https://www.avpress.com/search/?f=rss&t=article&c=news/local_news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "88c6f797c3a5b48b", URL: "https://www.news10.com/news/warren-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac75fbc8328e10c4", URL: "http://bartlesvilleradio.com/news_rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "860b7015533a623b", URL: "https://www.easternnewmexiconews.com/rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "74e9315ff4462fe2", URL: "https://islandfreepress.org/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "06d57d35c1ef37d5", URL: "https://www.wrbl.com/news/local-news/troup-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "3fcc2b85e0f403ca", URL: "https://www.wrganews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4bd6188211dbf52f", URL: "https://www.sunjournal.com/news/mainecrime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bab5fadc5b97d5d4", URL: "https://www.sunjournal.com/news/lewiston-auburn/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "f595e2f244bdfa28", URL: "https://www.qcnews.com/crime-and-public-safety/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e4fcabee3d02618b", URL: "https://www.state-journal.com/search/?f=rss&t=article&c=crime/frankfort&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4189b76e716ec90b", URL: "https://www.state-journal.com/search/?f=rss&t=article&c=crime/franklin_county&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "41698283bfc11dfa", URL: "https://kobi5.com/tag/coos-county/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8d2dc65ef1abd22c", URL: "https://kobi5.com/category/news/crime-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9ea30fd0d0deec2d", URL: "https://abc17news.com/category/news/camden/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e1ceef5ddc7c8ab8", URL: "http://greensboro.com/search/?f=rss&t=article&c=community/rockingham_now&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b2ced9ba2033cf89", URL: "https://gilaherald.com/category/category-local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b29244e20f85fc1a", URL: "https://weisradio.com/tag/etowah-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f473039097642016", URL: "https://kaynewscow.com/stories/police/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6fa1f0977e2781b9", URL: "https://kaynewscow.com/stories/headlines/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9cdfd43998015de7", URL: "https://www.thesnaponline.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "56e7bdebb14f2304", URL: "https://bluebonnetnews.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "322a280b7308c8c9", URL: "https://norfolkdailynews.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9794107d5a6311cc", URL: "https://www.wcmessenger.com/category/breaking-news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "867ea2313d0bf965", URL: "https://www.wcmessenger.com/tag/crime/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ebd83fea3fcbac0", URL: "https://antiochherald.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e1b3c246053b43ac", URL: "https://bloomingtonian.com/category/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "dff4ca170f7ec4b7", URL: "https://capcity.news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "cb1838f963d4876f", URL: "https://egreenvilleextra.com/category/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "6333c4b1fc439c25", URL: "https://english.onlinekhabar.com/feed", Comments: "Nepal", Language: "en", Status: "active", Country: "NP", Tier: 2},
+	{ID: "47e3ccb31cb50465", URL: "https://ewnews.com/feed", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "c3871b1299648eab", URL: "https://herald-zeitung.com/search/?f=rss&t=article&c=news/local_news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "3bbbdbc68e5556ce", URL: "https://howzitkohala.com/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5f5ff7c69a8e9362", URL: "https://hudsonvalleyone.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "7190f96400f272ef", URL: "https://inmenlo.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ca9d9e167100da0", URL: "https://ithacavoice.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "db8c2732b4f17320", URL: "https://jcitytimes.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "2bacb1cca75bde80", URL: "https://jocoreport.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "805ca28d167fe72e", URL: "https://kobi5.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "0db58ce8d91ff182", URL: "https://lebtown.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "11ae774d671e21e6", URL: "https://morganhilltimes.com/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "a4719d1384041abb", URL: "https://mydakotan.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b8f2f5c6fd6fc0f7", URL: "https://newsghana.com.gh/ghana-news/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "91756205db9bcd91", URL: "https://palisadesnews.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "d7b33e48f4544483", URL: "https://smokeybarn.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "0a173053170cebb8", URL: "https://texarkanafyi.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "57b41ca994ccd206", URL: "https://thepattayanews.com/category/localnews/feed/", Comments: "Pattaya, Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "16a349c6e6c69f1b", URL: "https://tulsaworld.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9bd949b91b3a2578", URL: "https://txktoday.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b8713225c18df455", URL: "https://vifreepress.com/feed/", Comments: "Virgin Islands", Language: "en", Status: "active", Tier: 2},
+	{ID: "a853b691328ee29e", URL: "https://wokingham.today/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1d7ffbd1e233f838", URL: "https://www.chiangraitimes.com/category/chiangrai-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "86032257f3fc29b9", URL: "https://www.chicoer.com/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c74386cf5acfddd", URL: "https://www.classiccitynews.com/blog-feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "78c14e5f00c0392e", URL: "https://www.dominionpost.com/feed/", Comments: "West Virginia, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b770dc9f542f01bc", URL: "https://www.edinburghnews.scotsman.com/news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "de07467b21b78946", URL: "https://www.glasgowtimes.co.uk/news/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f93a05ee7b71c469", URL: "https://www.hampshirechronicle.co.uk/news/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1996af9eacba469", URL: "https://www.heraldguide.com/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9aae8efb8081d767", URL: "https://www.journalpatriot.com/search/?c=news&f=rss&l=50&s=start_time&sd=desc&t=article", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c8011984f040714a", URL: "https://www.lowellsun.com/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "b63bea7cbf187d32", URL: "https://www.myarklamiss.com/news/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "273213c1c3637fda", URL: "https://www.news-herald.com/local-news/feed/", Comments: "Ohio, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "29123906496d88c1", URL: "https://www.oxfordeagle.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fd596d54e391368f", URL: "https://www.postandcourier.com/search/?f=rss&t=article&c=aikenstandard/news/local&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "fdb11af525fc4416", URL: "https://www.pressandjournal.co.uk/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "15ff42c9fcd30a79", URL: "https://www.qcnews.com/news/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ed20c3ceff99734e", URL: "https://www.salisburypost.com/category/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "4e73fe120fd9dac7", URL: "https://www.state-journal.com/search/?f=rss&t=article&c=news/local_news&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "feb65c6829319aec", URL: "https://www.thereporter.com/news/feed/", Comments: "California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9f5f5c9b4ce22078", URL: "https://www.villages-news.com/category/news/feed/", Comments: "The Villages, Florida, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cf16fb2a93a01075", URL: "https://www.wfmz.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b00e52ca1ce8dea7", URL: "https://www.wwlp.com/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "abde24532d21334e", URL: "http://www.dailysentinel.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4ae6c436bcb733c1", URL: "http://www.ktbs.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d3460dcc9c318a50", URL: "https://www.ksn.com/feed/", Comments: "Wichita, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c0e8082979fc22a6", URL: "https://thelakewoodscoop.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d53c3e08a588efc", URL: "https://www.wkrn.com/news/local-news/nashville/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fbe88a0ece02944e", URL: "https://www.dailytribune.com/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "064b84cb42ed1d6e", URL: "https://www.austindailyherald.com/category/news/courts-and-crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e55a09742d177d91", URL: "https://www.katc.com/news/iberia-parish.rss", Comments: "Iberia Parish, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9576e09469a23e12", URL: "https://www.wvnstv.com/news/west-virginia-news/raleigh-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f9eb01663a12196", URL: "https://elmoreautauganews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ccf8ef1c0defc5b1", URL: "https://www.nrtoday.com/search/?f=rss&t=article&c=news/crime&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "30ee85457c4a5817", URL: "https://www.wtrf.com/jefferson-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d491b9f28dfe7b14", URL: "https://mendovoice.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2d018081d851e117", URL: "https://www.wgil.com/category/news/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec6566c037e9a76c", URL: "https://sapulpaherald.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "202d9256d6004baa", URL: "https://www.klfy.com/local/st-landry-parish/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "40fafa3c8bf76727", URL: "https://www.stlandrynow.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "39c39a38fdbd7a56", URL: "https://pickensprogress.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "40054e3a25c15c05", URL: "https://www.newsandpress.net/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "abae0c63782be00d", URL: "https://www.dawsonnews.com/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "70642e916c7d9a65", URL: "https://maurycountysource.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9e1d247aa42bb5b", URL: "https://milpitasbeat.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "45ec21c24af024a8", URL: "https://napavalleyregister.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d0114d3745ce0329", URL: "https://nonpareilonline.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "253cd1beedbe34a4", URL: "https://oanow.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a27ce0a216873d1c", URL: "https://whav.net/category/localnews/haverhill/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "d00588538cb058be", URL: "https://www.gjsentinel.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "57ad9496b8b6c45d", URL: "https://www.lpso.net/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f310726e692dba9a", URL: "https://www.republictimes.net/feed/", Comments: "Republic", Language: "en", Status: "active", Tier: 2},
+	{ID: "5cf5b90fc34cd17c", URL: "https://www.wcia.com/news/vermilion-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7b8145874c8f1d63", URL: "https://www.canoncitydailyrecord.com/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cdc98e9f10814ee8", URL: "https://www.mystateline.com/news/freeport/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4dd8c63737f260ac", URL: "https://abc17news.com/category/news/sedalia/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "45a5871ab9b5655f", URL: "https://royalexaminer.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "960e9898ef077db5", URL: "https://royalexaminer.com/category/crime-court/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d9ed220f6ba25d3", URL: "https://www.centralmaine.com/news/localstate/feed/", Comments: "Central Maine, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fb6a0d20fdfdc6fd", URL: "https://www.wptv.com/news/treasure-coast/region-okeechobee-county.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e9fd371653f75274", URL: "https://www.inkfreenews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "270b7e016a2bc18f", URL: "https://www.statesboroherald.com/rss/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ccc4e1792d912db6", URL: "https://www.news10.com/news/montgomery-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3be0f73816a8fee5", URL: "https://gazette.com/search/?f=rss&t=article&c=pikespeakcourier&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "828e0e4aba7ce936", URL: "https://www.redbluffdailynews.com/news/crime-public-safety/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "9536c4c131153a38", URL: "https://theparisnews.com/search/?f=rss&t=article&c=news/communities/lamar_county&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "11ec960ecd3f94ec", URL: "https://covingtonleader.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cd3da8f356c9d30f", URL: "https://www.effinghamherald.net/local/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "58bfe8ae8e292485", URL: "https://www.albertleatribune.com/category/news/courts-and-crime/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "f0e9b73271c6dba1", URL: "https://www.wbtw.com/news/state-regional-news/scotland-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "aeaa6c0fa172cc96", URL: "https://www.wate.com/news/loudon-county-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b85df5f8b86c72ef", URL: "https://www.wtrf.com/ohio-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9d665968f1fef2b2", URL: "https://www.mytwintiers.com/tioga-county-ny/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c392f9d289f128e", URL: "https://www.wtrf.com/hancock-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1180f8b5e83ee657", URL: "https://www.klfy.com/local/st-martin-parish/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f240668c47b868c5", URL: "https://www.southernstandard.com/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "969753604b4f746f", URL: "https://aberdeeninsider.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a34b7b40ff01613e", URL: "https://chicago.suntimes.com/rss/index.xml", Comments: "Chicago, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "185b525a84463cc4", URL: "https://fox2now.com/feed", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "c6c719c9972587a1", URL: "https://heartlandnewsfeed.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bb20ff982df9860b", URL: "https://kdvr.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3122e1b74e252de", URL: "https://ktla.com/feed", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "797682b097829b25", URL: "https://metro.co.uk/feed", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "dfc000453f18e252", URL: "https://nypost.com/feed", Comments: "NY", Language: "en", Status: "active", Tier: 2},
+	{ID: "f137699d824f1d4e", URL: "https://whdh.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f7ed3be208f76410", URL: "https://wtop.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7deaef788597efde", URL: "https://www.belfastlive.co.uk/?service=rss", Comments: "Belfast, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b0620327b58417f6", URL: "https://www.birminghammail.co.uk/?service=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "dfe14f298a6642b1", URL: "https://www.bostonherald.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8326b1a122548edc", URL: "https://www.channel4.com/news/feed", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ec646db75ab8e63d", URL: "https://www.dailyecho.co.uk/news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "de07467b21b78946", URL: "https://www.glasgowtimes.co.uk/news/rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "81ece93da38c8f0e", URL: "https://www.grimsbytelegraph.co.uk/news/?service=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "337229fe3aa5240a", URL: "https://www.heraldscotland.com/news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e40bc4a90801b80f", URL: "https://www.independent.ie/rss/section/ada62966-6b00-4ead-a0ba-2c179a0730b0", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "9c6e4866fb4cc3cd", URL: "https://www.independent.ie/rss/section/d0f67320-5c3b-451a-aacc-4d7111f4f6fb", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2f49405c0fb46a61", URL: "https://www.irishtimes.com/arc/outboundfeeds/feed-irish-news/?from=0&size=20", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "99e682601a65cf89", URL: "https://www.kron4.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fb8c829fdc5f1810", URL: "https://www.kxan.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bf5c07a33afb80dc", URL: "https://www.manchestereveningnews.co.uk/?service=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5adb17b2b16a1c87", URL: "https://www.nytimes.com/svc/collections/v1/publish/http://www.nytimes.com/topic/destination/china/rss.xml", Comments: "China", Language: "en", Status: "active", Country: "CN", Tier: 2},
+	{ID: "059ce87819a106d6", URL: "https://www.nytimes.com/services/xml/rss/nyt/HomePage.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e7fb9bf28d82443", URL: "https://www.perthnow.com.au/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1dabc74877c4b74", URL: "https://www.phillyvoice.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5949fd9e9ce6db92", URL: "https://www.pressenterprise.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8030e0be8e4ccc3a", URL: "https://www.scotsman.com/news/rss", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ab40aec462b9c1ad", URL: "https://www.telegraph.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c122d660c6329dcd", URL: "https://www.theargus.co.uk/news/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7e1bf6fb9004d967", URL: "https://www.theboltonnews.co.uk/news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6cf37b307c1df99", URL: "https://www.theguardian.com/au/rss", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "482f028f7cbde246", URL: "https://www.thenorthernecho.co.uk/news/rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "3e66faf1aa834539", URL: "https://www.walesonline.co.uk/?service=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "79085145be7d7cd9", URL: "https://www.wivb.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2010b7a81901af75", URL: "https://uk.news.yahoo.com/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c7b98cd00899b03a", URL: "https://www.yahoo.com/news/rss/america", Comments: "America", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c4641f1ecbd2dfa3", URL: "https://www.yahoo.com/news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "81e461dcef2ad13e", URL: "https://www.yahoo.com/news/rss/us", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "320d6893f603d744", URL: "https://www.yorkpress.co.uk/news/rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7717ffe487d89ad", URL: "http://timesofsandiego.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4bf41975c38b5786", URL: "http://www.cambridge-news.co.uk/rss.xml", Comments: "Cambridge, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1619881dc386cdb4", URL: "http://www.dailymail.co.uk/home/index.rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "31558a7bfe1df6f4", URL: "http://www.metrotimes.com/detroit/Rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "05232bd3de78bafa", URL: "http://www.sbs.com.au/news/rss/Section/Top+Stories", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "4d5323ebb051e6ec", URL: "http://www.washingtontimes.com/rss/headlines/news", Language: "en", Status: "active", Tier: 2},
+	{ID: "ece9e81af9ec4d7f", URL: "https://www.wowktv.com/news/west-virginia/putnam-county-wv/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "7d63bea5a11a9bc8", URL: "https://www.superiortelegram.com/news/local.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "49b5c370a9f7c96c", URL: "https://www.wvnstv.com/news/west-virginia-news/mercer-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3e9fcd24da7bacf0", URL: "https://www.wowktv.com/news/ohio/scioto-county-oh/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6a4f44145fff53e8", URL: "https://www.woodtv.com/news/van-buren-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d7c6e2d80d5ce057", URL: "https://www.kpcw.org/wasatch-county.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c992cf38768aa48f", URL: "http://feeds.feedburner.com/FcnpLatestNews", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "28aaadee4fa1e0b6", URL: "https://atascaderonews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c4c10b36a2c794a0", URL: "https://sandhillssentinel.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "75ce2832b7927f22", URL: "https://www.deltaplexnews.com/category/local-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7efa0151f4c024a", URL: "https://www.goulburnpost.com.au/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c2cfd13d31fdbbf2", URL: "https://www.irontontribune.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "67948152c3691b15", URL: "https://www.gordongazettega.com/blog-feed.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c1ab044d7f6e05b6", URL: "https://wtop.com/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3124f90fe2fc3cb6", URL: "https://morganton.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5806f81612fba3cf", URL: "https://www.newberryobserver.com/category/news/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "89b895fd8040071f", URL: "https://www.wbbjtv.com/category/gibson-county/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0c08c75d0067b861", URL: "https://www.sciotocountydailynews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c40cd8b93c3eaf51", URL: "https://www.leaderpub.com/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7485fadcbda04280", URL: "https://ctmirror.org/feed/", Comments: "Connecticut, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f393c2824e0b993a", URL: "https://patch.com/feeds/aol/connecticut/hartford", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "46128b6a1cf80784", URL: "https://nmfireinfo.com/feed/", Comments: "New Mexico", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9bdd3d1f5863632e", URL: "https://thenwfireblog.com/feed/", Comments: "Northwest US", Language: "en", Status: "active", Tier: 2},
+	{ID: "e8793435fff675fd", URL: "https://www.deutschland.de/en/feed-news/rss.xml", Comments: "Germany", Language: "en", Status: "active", Country: "DE", Tier: 2},
+	{ID: "2701d522af36104a", URL: "https://finlandtoday.fi/feed/", Comments: "Finland", Language: "en", Status: "active", Country: "FI", Tier: 2},
+	{ID: "1a0d9e92d9cebdbb", URL: "https://www.yourcentralvalley.com/news/crime/feed/", Comments: "Central Valley, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ee93446811229094", URL: "http://feeds.indiasnews.net/rss/701ee96610c884a6", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "6539ff720c60e5c5", URL: "https://allafrica.com/tools/headlines/rdf/kenya/headlines.rdf", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "1d71961951c9d2a4", URL: "https://euromaidanpress.com/feed/", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "4c0d9acbb41b1035", URL: "https://famagusta-gazette.com/feed/", Comments: "Famagusta, Cyprus", Language: "en", Status: "active", Country: "CY", Tier: 2},
+	{ID: "5a5b84b1aee8646a", URL: "https://fatunetwork.net/feed/", Comments: "Gambia", Language: "en", Status: "active", Country: "GM", Tier: 2},
+	{ID: "14f7f2c5b22198f3", URL: "https://feeds.feedblitz.com/wcnc/home", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f73832959c6fe2eb", URL: "https://jknewsline.com/feed/", Comments: "Jammu & Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "09ed9d4a7438f9cb", URL: "https://lgcnews.com/feed/", Comments: "Liberia", Language: "en", Status: "active", Country: "LR", Tier: 2},
+	{ID: "8ee1a283692f974b", URL: "https://malawi24.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "f5fde4fc1374fdbd", URL: "https://nairobiwire.com/feed", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "74b8a06797d00b3b", URL: "https://news.postimees.ee/rss", Comments: "Estonia", Language: "en", Status: "active", Country: "EE", Tier: 2},
+	{ID: "af28f4700c6f7b9c", URL: "https://odishabarta.com/feed/", Comments: "Odisha, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "75a0431ec3f1ab5f", URL: "https://portcitydaily.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "77ecd2cf40fa8631", URL: "https://pragativadi.com/feed/", Comments: "Odisha, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "29a74bc3d8c0aae7", URL: "https://prod-qt-images.s3.amazonaws.com/production/thenewsminute/feed.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "219274d2b99a33d5", URL: "https://sandiegocountynews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b0f7a95f50c10c7e", URL: "https://sloveniatimes.com/feed/", Comments: "Slovenia", Language: "en", Status: "active", Country: "SI", Tier: 2},
+	{ID: "5d6c3e81356be935", URL: "https://thelivenagpur.com/feed/", Comments: "Nagpur, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "a63d8ce2211a56bc", URL: "https://thepoint.gm/posts/rss/xml", Comments: "Gambia", Language: "en", Status: "active", Country: "GM", Tier: 2},
+	{ID: "dd9605079ee3246a", URL: "https://timesofindia.indiatimes.com/rssfeedstopstories.cms?x=1", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "8182a779a56dd2ad", URL: "https://www.africanews.com/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "adeecdea3cbb3e16", URL: "https://www.chulavistatoday.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3bfc604d97394392", URL: "https://www.dailyexcelsior.com/feed/", Comments: "Jammu & Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "e7b794099476d900", URL: "https://www.faceofmalawi.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "45931327f52aaa67", URL: "https://www.independent.co.uk/topic/ukraine/rss", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "78a9097fa5b587b8", URL: "https://www.india.com/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "e7eb982110cbb78b", URL: "https://www.indiatoday.in/rss/1206578", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "43f0cf2c9faf49e8", URL: "https://www.jpost.com/Rss/RssFeedsFrontPage.aspx", Comments: "Israel, Jerusalem", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "0e825cdc3e5fb14e", URL: "https://www.kron4.com/news/app-feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c5ade9865a94cb40", URL: "https://www.kxan.com/news/local/san-marcos/feed/", Comments: "San Marcos, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fb8c829fdc5f1810", URL: "https://www.kxan.com/feed/", Comments: "Austin, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0cd944596e35ec0a", URL: "https://www.latimes.com/local/rss2.0.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "088968045a737ac0", URL: "https://www.malawivoice.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "4e3fd27568e7fcd2", URL: "https://www.navhindtimes.in/feed/", Comments: "Goa, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "188886698301101c", URL: "https://www.news10.com/feed/", Comments: "Sacramento, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cad4a7b1458c231c", URL: "https://www.nytimes.com/svc/collections/v1/publish/https://www.nytimes.com/topic/destination/iraq/rss.xml", Comments: "Iraq", Language: "en", Status: "active", Country: "IQ", Tier: 2},
+	{ID: "738453cbcf4f29b9", URL: "https://www.nytimes.com/svc/collections/v1/publish/https://www.nytimes.com/topic/destination/yemen/rss.xml", Comments: "Yemen", Language: "en", Status: "active", Country: "YE", Tier: 2},
+	{ID: "2230f5ec56a8a6f9", URL: "https://www.oneindia.com/rss/news-india-fb.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "e6fe727f3daa7d1f", URL: "https://www.pioneeredge.in/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5464dc370b75cd54", URL: "https://www.telanganatribune.com/feed/", Comments: "Telangana, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "012572619f527267", URL: "https://www.theguardian.com/world/poland/rss", Comments: "Poland", Language: "en", Status: "active", Country: "PL", Tier: 2},
+	{ID: "fd420b419176308e", URL: "https://www.theguardian.com/us-news/kentucky/rss", Comments: "US, Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7f9fc96aba290af4", URL: "https://www.theguardian.com/world/ecuador/rss", Comments: "Ecuador", Language: "en", Status: "active", Country: "EC", Tier: 2},
+	{ID: "c27a1a11519cb1f1", URL: "https://www.theguardian.com/us-news/oregon/rss", Comments: "Oregon, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e91bc0eabf0630f1", URL: "https://www.theguardian.com/us-news/illinois/rss", Comments: "US, Illinois", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0e11e010363528ee", URL: "https://www.thehindu.com/news/national/?service=rss", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "3817c14dde165b67", URL: "https://www.wkrn.com/tag/clarksville/feed/", Comments: "Clarksville", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f2f92be426c9682", URL: "https://www.maravipost.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "5e932f52e2b1d1e9", URL: "https://malawifreedomnetwork.com/feed/", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "25ad877f31dc4dfd", URL: "https://jamaicainquirer.com/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "64ac13c109d6e750", URL: "https://spectrumlocalnews.com/services/contentfeed.wi%7Cmilwaukee%7Cnews.landing.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8231dda57e9c0d6d", URL: "https://triblive.com/category/pennsylvania/feed/", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "556d6099a19c7707", URL: "https://www.ktsm.com/local/las-cruces-news/feed/", Comments: "Las Cruces", Language: "en", Status: "active", Tier: 2},
+	{ID: "fbe5c237c39d11e2", URL: "https://www.ktsm.com/feed/", Comments: "El Paso", Language: "en", Status: "active", Tier: 2},
+	{ID: "b4f221880b7d2795", URL: "https://www.fijitimes.com/feed/", Comments: "Fiji", Language: "en", Status: "active", Country: "FJ", Tier: 2},
+	{ID: "f7ac430dc0a217c8", URL: "https://informante.web.na/?feed=rss2", Comments: "Namibia", Language: "en", Status: "active", Country: "NA", Tier: 2},
+	{ID: "7fb3130e1df1006d", URL: "https://fox8.com/tag/canton-news/feed/", Comments: "Canton", Language: "en", Status: "active", Tier: 2},
+	{ID: "3a1dc6c2ad7c165f", URL: "https://fox8.com/tag/columbus-news/feed/", Comments: "Columbus, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fb8820b03166c209", URL: "https://www.inquirer.net/fullfeed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "e057c241796c7ca3", URL: "https://www.panaynews.net/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "beae12a0889da722", URL: "https://tribune.net.ph/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "52d46b1e33c89457", URL: "https://www.subicbaynews.com/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "400f9c3cdc44482c", URL: "https://www.mindanews.com/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "9176173afade0a71", URL: "https://www.tokyoreporter.com/feed/", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "e54fe90c6024c1b2", URL: "https://www.cbsnews.com/latest/rss/main", Language: "en", Status: "active", Tier: 2},
+	{ID: "7ed5239efb74c10a", URL: "https://fox8.com/tag/cleveland-news/feed/", Comments: "Cleveland", Language: "en", Status: "active", Tier: 2},
+	{ID: "fffaf3345090addc", URL: "https://spectrumlocalnews.com/services/contentfeed.oh%7Ccincinnati%7Cnews.landing.rss", Comments: "Cincinnati", Language: "en", Status: "active", Tier: 2},
+	{ID: "6176ea13db644dd7", URL: "https://sanantonioreport.org/feed/", Comments: "San Antonio", Language: "en", Status: "active", Tier: 2},
+	{ID: "428955ed7ab5da8f", URL: "https://dailyvoice.com/new-jersey/atlantic/rss/", Comments: "Atlantic County, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7b39c4f305bb7863", URL: "https://www.sundaystandard.info/feed/", Comments: "Botswana", Language: "en", Status: "active", Country: "BW", Tier: 2},
+	{ID: "c63d1b39701487b9", URL: "https://guardiansun.co.bw/rssFeed/48", Comments: "Botswana", Language: "en", Status: "active", Country: "BW", Tier: 2},
+	{ID: "82073d8f9f2b2033", URL: "https://www.davisnewspaper.net/feed/", Comments: "Davis, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "da50fa31319a1c22", URL: "https://www.okgazette.com/oklahoma/Rss.xml", Comments: "Oklahoma City", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa9f6e4d3035a2da", URL: "https://sfstandard.com/feed/", Comments: "San Francisco", Language: "en", Status: "active", Tier: 2},
+	{ID: "02a570a9a4d1a9d3", URL: "https://www.thesfnews.com/feed", Comments: "San Francisco", Language: "en", Status: "active", Tier: 2},
+	{ID: "2ab3dea72b5f21e3", URL: "https://missionlocal.org/feed/", Comments: "San Francisco, Mission District", Language: "en", Status: "active", Tier: 2},
+	{ID: "6bd02e42baed095a", URL: "https://www.theguardian.com/us-news/san-francisco/rss", Comments: "San Francisco", Language: "en", Status: "active", Tier: 2},
+	{ID: "e339582f14663a71", URL: "https://ocgnews.com/feed/", Comments: "Orange County, GA", Language: "en", Status: "active", Country: "GA", Tier: 2},
+	{ID: "26d69d0ebfe8a7ed", URL: "https://midjersey.news/category/mercer-county/trenton/feed/", Comments: "Trenton", Language: "en", Status: "active", Tier: 2},
+	{ID: "e8a26851a871e787", URL: "https://trinidadtribune.com/feed/", Comments: "Trinidad", Language: "en", Status: "active", Country: "TT", Tier: 2},
+	{ID: "9bb4e754adb991de", URL: "https://dailyvoice.com/maryland/baltimore/rss/", Comments: "Baltimore County, MD", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "488449940953c21d", URL: "https://wtop.com/local/maryland/baltimore/feed/", Comments: "Baltimore", Language: "en", Status: "active", Tier: 2},
+	{ID: "2fbf46d15836230d", URL: "https://www.presstelegram.com/feed/", Comments: "Long Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "ca055da8b63dc9c2", URL: "https://ktla.com/tag/long-beach/feed/", Comments: "Long Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "d886f96f56a75e12", URL: "https://www.dailynews.com/location/california/los-angeles-county/long-beach/feed/", Comments: "Long Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "d8942660ec886dc5", URL: "https://fox40.com/feed/", Comments: "Sacramento", Language: "en", Status: "active", Tier: 2},
+	{ID: "480e926b0b1b13cb", URL: "https://jaxtoday.org/feed/", Comments: "Jacksonville, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "70f86b1670c8ffde", URL: "https://jacksonvillefreepress.com/feed/", Comments: "Jacksonville, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9f89991609d1a14a", URL: "https://jaxtrib.org/feed/", Comments: "Jacksonville, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5f080d321f0fef86", URL: "https://whdh.com/news/local/worcester/feed/", Comments: "Worcester", Language: "en", Status: "active", Tier: 2},
+	{ID: "628a66dc6c27b3c4", URL: "https://www.cbs17.com/news/local-news/durham-county-news/feed/", Comments: "Durham County", Language: "en", Status: "active", Tier: 2},
+	{ID: "45758fcbf09e51eb", URL: "https://feeds.feedburner.com/TheCardinal", Comments: "Arlington Heights, IL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "232002b4d019bf6b", URL: "https://www.journal-topics.com/category/news/arlington-heights-news/feed/", Comments: "Arlington Heights", Language: "en", Status: "active", Tier: 2},
+	{ID: "378ef5fd490300df", URL: "https://www.mainlinemedianews.com/feed/", Comments: "Main Line, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2e9ee827872389cf", URL: "https://www.abc27.com/feed/", Comments: "Harrisburg, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f38f2a5c12f70ea2", URL: "https://www.pahomepage.com/feed/", Comments: "Northeastern PA", Language: "en", Status: "active", Tier: 2},
+	{ID: "7b3fec827c00f289", URL: "https://www.lehighvalleylive.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "Lehigh Valley, PA/NJ, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "435d8ee40b4852ab", URL: "https://www.cityofeastlansing.com/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "East Lansing", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b7484362fad6e84", URL: "https://deerfield-news.com/feed/", Comments: "Deerfield, IL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3efd53c819b26f28", URL: "https://www.newarab.com/rss", Comments: "Middle East, Arab World", Language: "en", Status: "active", Tier: 2},
+	{ID: "6053560bee8dd623", URL: "https://orangecountytribune.com/feed/", Comments: "Orange County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d5f9fee8ef3e6e74", URL: "https://orangecountytribune.com/category/westminster/feed/", Comments: "Westminster, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "34cd5fe933079233", URL: "https://www.cbs17.com/news/north-carolina-news/feed/", Comments: "North Carolina", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "513066a068996a65", URL: "https://www.cbs17.com/news/local-news/orange-county-news/feed/", Comments: "Orange County, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "04d8095fe5c2fc42", URL: "https://newsantaana.com/feed/", Comments: "Santa Ana", Language: "en", Status: "active", Tier: 2},
+	{ID: "13987f3eff93dba1", URL: "https://www.myzimbabwe.co.zw/feed", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "ecd082e89d7fc8dd", URL: "https://zimmorningpost.com/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "1ee9f0592c0215df", URL: "https://feeds.feedburner.com/EastBostonTimes", Comments: "East Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "9e14466c94e8d246", URL: "https://www.kgun9.com/index.rss", Comments: "Tucson", Language: "en", Status: "active", Tier: 2},
+	{ID: "72cf520eb2c040d8", URL: "https://spectrumlocalnews.com/services/contentfeed.ky%7Clouisville%7Cnews.landing.rss", Comments: "Louisville", Language: "en", Status: "active", Tier: 2},
+	{ID: "b30e202e008d159f", URL: "https://spectrumlocalnews.com/services/contentfeed.nys%7Ccapital-region%7Cnews.landing.rss", Comments: "Capital Region, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a408a9e43cfb13fc", URL: "https://www.eastbaytimes.com/feed/", Comments: "East Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6aa66fea36e8d55", URL: "https://www.eastbaytimes.com/location/california/bay-area/east-bay/contra-costa-county/east-contra-costa/antioch/feed/", Comments: "Antioch, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3f34341204bee59d", URL: "https://fox8.com/tag/akron-news/feed/", Comments: "Akron", Language: "en", Status: "active", Tier: 2},
+	{ID: "5f971100ca19e6fb", URL: "https://www.news5cleveland.com/news/local-news/akron-canton-news.rss", Comments: "Akron, Canton, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "42ea22189f61f798", URL: "https://ktla.com/tag/pomona/feed/", Comments: "Pomona", Language: "en", Status: "active", Tier: 2},
+	{ID: "f12f2f94c029c1ac", URL: "https://www.pressenterprise.com/location/california/san-bernardino-county/redlands/feed/", Comments: "Redlands", Language: "en", Status: "active", Tier: 2},
+	{ID: "d073c7ce64988664", URL: "https://patch.com/feeds/aol/illinois/bolingbrook", Comments: "Bolingbrook", Language: "en", Status: "active", Tier: 2},
+	{ID: "386cd586db7ce5b3", URL: "https://openvallejo.org/feed/", Comments: "Vallejo", Language: "en", Status: "active", Tier: 2},
+	{ID: "b302424a1f8f92fb", URL: "https://www.vallejosun.com/rss/", Comments: "Vallejo", Language: "en", Status: "active", Tier: 2},
+	{ID: "2572e8fadf6c756b", URL: "https://www.startribune.com/rss/?sf=1&s=", Comments: "Minneapolis, St. Paul", Language: "en", Status: "active", Tier: 2},
+	{ID: "9336cd4083345187", URL: "https://patch.com/feeds/aol/illinois/skokie", Comments: "Skokie", Language: "en", Status: "active", Tier: 2},
+	{ID: "e5bc6e0c8e9b1180", URL: "https://www.marinij.com/location/california/marin-county/san-rafael/feed/", Comments: "San Rafael", Language: "en", Status: "active", Tier: 2},
+	{ID: "b01814573ffc3535", URL: "https://www.cityofsanrafael.org/feed", Comments: "San Rafael", Language: "en", Status: "active", Tier: 2},
+	{ID: "4865bf79b41449e8", URL: "https://patch.com/feeds/aol/california/sanrafael", Comments: "San Rafael", Language: "en", Status: "active", Tier: 2},
+	{ID: "2fa8985ef11381cc", URL: "https://marinlocalnews.com/category/local-news/san-rafael/feed/", Comments: "San Rafael", Language: "en", Status: "active", Tier: 2},
+	{ID: "a41618ca2f529cb7", URL: "https://evanstonnow.com/feed/", Comments: "Evanston", Language: "en", Status: "active", Tier: 2},
+	{ID: "2bd0db543b049820", URL: "https://fox40.com/news/local-news/modesto/feed/", Comments: "Modesto", Language: "en", Status: "active", Tier: 2},
+	{ID: "446f080e0c8ca5f8", URL: "https://www.mercurynews.com/location/california/bay-area/peninsula/san-mateo-county/daly-city/feed/", Comments: "Daly City", Language: "en", Status: "active", Tier: 2},
+	{ID: "5631aaf39911a8d7", URL: "https://patch.com/feeds/aol/massachusetts/somerville", Comments: "Somerville", Language: "en", Status: "active", Tier: 2},
+	{ID: "2d6542661a8ee1be", URL: "https://www.fox21news.com/feed/", Comments: "Colorado Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "0d871dec50990503", URL: "https://www.koaa.com/index.rss", Comments: "Colorado Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "b37b64566b69f05a", URL: "https://www.wcpo.com/news/state/state-kentucky.rss", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "342168dd3b63fea6", URL: "https://www.wdtn.com/feed/", Comments: "Dayton, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "153c0a24ee0d4713", URL: "https://fox8.com/feed/", Comments: "Cleveland", Language: "en", Status: "active", Tier: 2},
+	{ID: "11eb285de5bcd6e2", URL: "https://www.nbc4i.com/feed/", Comments: "Columbus, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "db351eb83430ff97", URL: "https://www.morningjournal.com/feed/", Comments: "Lorain County, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "00028741d8244dda", URL: "https://www.wwlp.com/feed/", Comments: "Springfield, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "67ee7c332bae462c", URL: "https://www.abc6.com/category/massachusetts/feed/", Comments: "Massachusetts", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3d0009b34e11c6e8", URL: "https://www.masslive.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "Massachusetts, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8f74ad3648a994ca", URL: "https://thecaribbeannewsnow.com/feed/", Comments: "Caribbean", Language: "en", Status: "active", Tier: 2},
+	{ID: "a1b351f176f435ba", URL: "https://westorlandonews.com/feed/", Comments: "West Orlando", Language: "en", Status: "active", Tier: 2},
+	{ID: "337a22ef6c11686a", URL: "http://feeds.theorlandonews.net/rss/88746c23afcdd0e0", Comments: "Orlando", Language: "en", Status: "active", Tier: 2},
+	{ID: "a539c23578a72d07", URL: "https://www.orlando-news.com/feed/", Comments: "Orlando", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9882a3fe525d754", URL: "https://www.jordantimes.com/rss.xml", Comments: "Jordan", Language: "en", Status: "active", Country: "JO", Tier: 2},
+	{ID: "dd4b37830e5db22b", URL: "https://denverite.com/feed/", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "5287affc7f7cb6d7", URL: "https://www.wric.com/feed/", Comments: "Richmond, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "654b23010864b559", URL: "https://elkgrovelagunanews.com/feed/", Comments: "Elk Grove, CA, Laguna, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f9d4f11d69c086e8", URL: "https://talkradio960.com/feed/", Comments: "Oklahoma City", Language: "en", Status: "active", Tier: 2},
+	{ID: "54a5b0dae518641a", URL: "https://kpel965.com/feed/", Comments: "Lafayette, LA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a90587bedafe1952", URL: "https://www.ksn.com/news/local/feed/", Comments: "Wichita", Language: "en", Status: "active", Tier: 2},
+	{ID: "64a5800d0e80f405", URL: "https://www.wptv.com/news/region-c-palm-beach-county/lake-worth.rss", Comments: "Lake Worth", Language: "en", Status: "active", Tier: 2},
+	{ID: "19eb73704f0f9225", URL: "https://www.wptv.com/news/region-c-palm-beach-county/wellington.rss", Comments: "Wellington, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b898bf0319351a55", URL: "https://www.wptv.com/news/region-s-palm-beach-county/boca-raton.rss", Comments: "Boca Raton, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2096dd36292c8696", URL: "https://bocapost.com/feed/", Comments: "Boca Raton", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b19f2101d5b0136", URL: "https://www.fox4now.com/index.rss", Comments: "Fort Myers, Naples", Language: "en", Status: "active", Tier: 2},
+	{ID: "fecb96a1dca77ed4", URL: "https://www.fox17online.com/news/local-news/lakeshore/muskegon.rss", Comments: "Muskegon, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "14ce6d34a2e86346", URL: "https://www.montereypark.ca.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Monterey Park, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "017d69917f7f186e", URL: "https://710keel.com/feed/", Comments: "Shreveport", Language: "en", Status: "active", Tier: 2},
+	{ID: "8d680d3f4bc60a02", URL: "https://www.wbtw.com/feed/", Comments: "Florence, Myrtle Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "8adb7f726bc3c260", URL: "https://www.wfxb.com/feed/", Comments: "Myrtle Beach, Florence", Language: "en", Status: "active", Tier: 2},
+	{ID: "7bef300eff88d61f", URL: "https://www.everythinglubbock.com/feed/", Comments: "Lubbock", Language: "en", Status: "active", Tier: 2},
+	{ID: "75b90f6199f61c4b", URL: "https://www.abc15.com/news/region-southeast-valley/chandler.rss", Comments: "Chandler, AZ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bdf5b69ba78d8e0d", URL: "https://www.wavy.com/news/local-news/virginia-beach/feed/", Comments: "Virginia Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "3b2afdde7c42f878", URL: "https://theflintcouriernews.com/feed/", Comments: "Flint", Language: "en", Status: "active", Tier: 2},
+	{ID: "36e0abf0696533ee", URL: "https://dailyvoice.com/new-york/newrochelle/rss/", Comments: "New Rochelle, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c843bef7c7fd84d9", URL: "https://killeenpdnews.com/feed/", Comments: "Killeen", Language: "en", Status: "active", Tier: 2},
+	{ID: "cbd248f1a049c8c6", URL: "https://dailyvoice.com/new-york/yonkers/rss/", Comments: "Yonkers, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6227d260522baa9d", URL: "https://www.14850.com/feed/", Comments: "Ithaca, Tompkins County", Language: "en", Status: "active", Tier: 2},
+	{ID: "170bd10a2553b7d1", URL: "https://www.bigcountryhomepage.com/feed/", Comments: "Abilene", Language: "en", Status: "active", Tier: 2},
+	{ID: "95abcfabf4c36d77", URL: "https://abilenetx.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Abilene", Language: "en", Status: "active", Tier: 2},
+	{ID: "dd918b5c175ad3d0", URL: "https://www.ocalapost.com/feed/", Comments: "Ocala", Language: "en", Status: "active", Tier: 2},
+	{ID: "9d0f805d616d90de", URL: "https://www.tristatehomepage.com/feed/", Comments: "WV, KY, OH", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bbac491f68061663", URL: "https://www.hackread.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c617bf93fff78650", URL: "https://gbhackers.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d5de1af8d5e717d3", URL: "https://www.wfla.com/news/florida/feed/", Comments: "Florida", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "134dcd06c5dfa0e0", URL: "https://www.browardpalmbeach.com/browardpalmbeach/Rss.xml", Comments: "Broward, Palm Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "43f791bfbc5bae75", URL: "https://www.thestar.com/search/?f=rss&t=article&bl=2827101&l=20", Comments: "Toronto", Language: "en", Status: "active", Tier: 2},
+	{ID: "43e903fdf42e5380", URL: "https://toronto.citynews.ca/feed/", Comments: "Toronto", Language: "en", Status: "active", Tier: 2},
+	{ID: "e532fbd6668891ff", URL: "https://windsorstar.com/feed", Comments: "Windsor", Language: "en", Status: "active", Tier: 2},
+	{ID: "fe5492869aa7dfdc", URL: "https://thestarphoenix.com/feed", Comments: "Saskatoon", Language: "en", Status: "active", Tier: 2},
+	{ID: "60a8e833507d05d1", URL: "http://www.sunnysouthnews.com/feed/", Comments: "South Georgia", Language: "en", Status: "active", Tier: 2},
+	{ID: "d835849e4cf05154", URL: "https://www.wowktv.com/news/kentucky/feed/", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8c32ab8c9c72bf6b", URL: "https://www.manisteenews.com/news/feed/Latest-News-Feed-2564.php", Comments: "Manistee, MI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "54cba25b55a64581", URL: "https://www.mlive.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "Michigan", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "44bda6c6e6c61cbd", URL: "https://www.cameroonconcordnews.com/feed/", Comments: "Cameroon", Language: "en", Status: "active", Country: "CM", Tier: 2},
+	{ID: "666f02fa10f6197a", URL: "http://feeds.sudannews.net/rss/c1ab2109a5bf37ec", Comments: "Sudan", Language: "en", Status: "active", Country: "SD", Tier: 2},
+	{ID: "f8efa1f9e75556c3", URL: "https://www.mercurynews.com/location/california/bay-area/east-bay/alameda-county/oakland/feed/", Comments: "Oakland", Language: "en", Status: "active", Tier: 2},
+	{ID: "b17559f1c2d04441", URL: "https://oaklandside.org/feed/", Comments: "Oakland", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b75456dd993df7b", URL: "https://hoodline.com/news/oakland/rss/", Comments: "Oakland", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c53d67139e12101", URL: "https://ukenreport.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "91ed5cf9936b44ba", URL: "https://patch.com/feeds/aol/california/moorpark", Comments: "Moorpark", Language: "en", Status: "active", Tier: 2},
+	{ID: "95d4bd3f123a5998", URL: "https://patch.com/feeds/aol/california/lakeelsinore-wildomar", Comments: "Lake Elsinor/Wildomar", Language: "en", Status: "active", Tier: 2},
+	{ID: "5d7d81640e908c60", URL: "https://txktoday.com/category/news/feed/", Comments: "Texarkana", Language: "en", Status: "active", Tier: 2},
+	{ID: "c3f078f2d41728b1", URL: "https://www.ktalnews.com/news/texarkana-news/feed/", Comments: "Texarkana", Language: "en", Status: "active", Tier: 2},
+	{ID: "489b0c886548aa91", URL: "https://www.abc15.com/news/region-west-valley/peoria.rss", Comments: "Peoria, AZ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "28642400febe96c5", URL: "https://www.marinij.com/location/california/marin-county/novato/feed/", Comments: "Novato", Language: "en", Status: "active", Tier: 2},
+	{ID: "b78c9bcc872eb5bc", URL: "https://patch.com/feeds/aol/california/petaluma", Comments: "Petaluma", Language: "en", Status: "active", Tier: 2},
+	{ID: "10808f5c586e48da", URL: "https://www.wishtv.com/tag/muncie-indiana/feed/", Comments: "Muncie, IN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b795c4145a389acd", URL: "https://baldwinparknewsonline.com/feed/", Comments: "Baldwin Park", Language: "en", Status: "active", Tier: 2},
+	{ID: "03e0b0fcf47ddff3", URL: "https://www.baytowncops.com/?feed=rss2", Comments: "Baytown", Language: "en", Status: "active", Tier: 2},
+	{ID: "cbfd56591884b57a", URL: "https://www.inmaricopa.com/feed/", Comments: "Maricopa, AZ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9f6d35e2d459d989", URL: "https://patch.com/feeds/aol/california/burlingame-hillsborough", Comments: "Burlingame, Hillsborough", Language: "en", Status: "active", Tier: 2},
+	{ID: "1762a520bf4658f0", URL: "https://www.kxlh.com/news.rss", Comments: "Montana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d1e4a8962c6dee27", URL: "https://northeastoregonnow.com/feed/", Comments: "Northeast Oregon", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ab76c0b8c92efc2", URL: "https://lincolncityhomepage.com/feed/", Comments: "Lincoln City, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ec13f8aec3e22876", URL: "https://suntimesnews.com/feed/", Comments: "IL", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "37dc74979b29bd70", URL: "https://houstonherald.com/feed/", Comments: "Houston County, GA", Language: "en", Status: "active", Country: "GA", Tier: 2},
+	{ID: "5f9f1b9f3124801d", URL: "https://wgno.com/feed/", Comments: "New Orleans", Language: "en", Status: "active", Tier: 2},
+	{ID: "702951df60d84cab", URL: "https://africanreporter.co.za/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "23ae8b8e22f71542", URL: "https://africatodaynewsnewyork.com/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "b06e9f5e5c7046ae", URL: "https://drgnews.com/feed/", Comments: "South Dakota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c94ca8ea6b1317aa", URL: "https://notesfrompoland.com/feed/", Comments: "Poland", Language: "en", Status: "active", Country: "PL", Tier: 2},
+	{ID: "673dfd6148bfe929", URL: "https://feeds.feedburner.com/radioiowanews", Comments: "Iowa", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6535ed6b88b983d1", URL: "https://northiowatoday.com/feed/", Comments: "North Iowa", Language: "en", Status: "active", Tier: 2},
+	{ID: "15124f927e4961d5", URL: "https://www.denverpost.com/location/colorado/front-range/el-paso-county/colorado-springs/feed/", Comments: "Colorado Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "74c1766505b6aa0c", URL: "https://www.denverpost.com/location/colorado/front-range/jefferson-county/lakewood/feed/", Comments: "Lakewood, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "688b19674076e66d", URL: "https://www.denverpost.com/location/colorado/front-range/aurora/feed/", Comments: "Aurora, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2a514520bb278c65", URL: "https://www.spokanecounty.org/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Spokane County", Language: "en", Status: "active", Tier: 2},
+	{ID: "be0b3c61a4ee330f", URL: "https://patch.com/feeds/aol/california/newportbeach", Comments: "Newport Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "b981a1f7aee2e7b1", URL: "https://smmirror.com/category/news/feed/", Comments: "Santa Monica", Language: "en", Status: "active", Tier: 2},
+	{ID: "12dbec3de090681b", URL: "https://smdp.com/category/news/feed/", Comments: "Santa Monica", Language: "en", Status: "active", Tier: 2},
+	{ID: "ed0352ccddef79e6", URL: "https://ktla.com/tag/santa-monica/feed/", Comments: "Santa Monica", Language: "en", Status: "active", Tier: 2},
+	{ID: "8688ec4b213f587e", URL: "http://feeds.batonrougenews.net/rss/3a72c8badb1b144e", Comments: "Baton Rouge", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ace767b6901978a", URL: "https://rockfordscanner.com/feed/", Comments: "Rockford", Language: "en", Status: "active", Tier: 2},
+	{ID: "49330cc5cdefeb2f", URL: "https://www.kjrh.com/news.rss", Comments: "Tulsa, OK, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9b0694953a512d85", URL: "https://brentwoodnewsla.com/feed/", Comments: "Brentwood, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2ab8000820776b32", URL: "https://journalstar.com/search/?f=rss&t=article&c=news*&l=25&s=start_time&sd=desc", Comments: "Lincoln, NE", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6d43e8376a077679", URL: "https://www.klkntv.com/feed/", Comments: "Little Rock", Language: "en", Status: "active", Tier: 2},
+	{ID: "cb49e65781ede654", URL: "https://kstp.com/tag/brooklyn-park/feed/", Comments: "Brooklyn Park", Language: "en", Status: "active", Tier: 2},
+	{ID: "bf346eb81fca6571", URL: "https://www.yumasun.com/search/?f=rss&t=article&l=50&s=start_time&sd=desc", Comments: "Yuma", Language: "en", Status: "active", Tier: 2},
+	{ID: "f617e87d318308b8", URL: "https://www.vvng.com/category/barstow/feed/", Comments: "Barstow", Language: "en", Status: "active", Tier: 2},
+	{ID: "af01acdf97d25423", URL: "https://www.vvng.com/category/hesperia_news/feed/", Comments: "Hesperia", Language: "en", Status: "active", Tier: 2},
+	{ID: "9faee67507603a04", URL: "https://padailypost.com/category/mountain-view/feed/", Comments: "Mountain View", Language: "en", Status: "active", Tier: 2},
+	{ID: "e7ad0a5b41a5967f", URL: "https://wchsnetwork.com/feed/", Comments: "Charleston, Huntington", Language: "en", Status: "active", Tier: 2},
+	{ID: "067161039081d619", URL: "https://www.ripplesnigeria.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "0d8a00b9702bcc5a", URL: "https://dailynigerian.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "2e72b7c13778754f", URL: "https://thewillnews.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "37af20e67c1c4950", URL: "https://pointblanknews.com/pbn/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "247c2a053f8458ba", URL: "https://patch.com/feeds/aol/michigan/dearborn", Comments: "Dearborn", Language: "en", Status: "active", Tier: 2},
+	{ID: "28f7449aa3d307f1", URL: "https://www.pressandguide.com/feed/", Comments: "Dearborn, Dearborn Heights", Language: "en", Status: "active", Tier: 2},
+	{ID: "31ea0368b3c0763a", URL: "https://www.fox41yakima.com/feed/", Comments: "Yakima", Language: "en", Status: "active", Tier: 2},
+	{ID: "79f5b2b7e24ed0c0", URL: "https://patch.com/feeds/aol/massachusetts/brockton", Comments: "Brockton", Language: "en", Status: "active", Tier: 2},
+	{ID: "317892ffad5a6f84", URL: "https://www.cnyhomepage.com/feed/", Comments: "Central NY", Language: "en", Status: "active", Tier: 2},
+	{ID: "c5bfafa55b9adea5", URL: "https://patch.com/feeds/aol/michigan/stclairshores", Comments: "St. Clair Shores", Language: "en", Status: "active", Tier: 2},
+	{ID: "ee60acccea241565", URL: "https://fox8.com/tag/lorain-news/feed/", Comments: "Lorain", Language: "en", Status: "active", Tier: 2},
+	{ID: "93cb4cd17906f394", URL: "https://newstalkkgvo.com/feed/", Comments: "Missoula", Language: "en", Status: "active", Tier: 2},
+	{ID: "fa7c25d876ab9d0e", URL: "https://ktla.com/tag/lancaster/feed/", Comments: "Lancaster", Language: "en", Status: "active", Tier: 2},
+	{ID: "0738658407b90525", URL: "https://dailyvoice.com/pennsylvania/lebanon/rss/", Comments: "Lebanon County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5d655708d6cb5990", URL: "https://www.wfmz.com/search/?f=rss&t=article&c=news/area/berks&l=50&s=start_time&sd=desc", Comments: "Berks County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a9654f85b7517cc5", URL: "https://berksweekly.com/feed/", Comments: "Berks County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "772b873722ea5ffd", URL: "https://ktla.com/tag/glendale/feed/", Comments: "Glendale", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d1307a8414fa3d9", URL: "https://patch.com/feeds/aol/new-york/huntington", Comments: "Huntington, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "01d19dcbe36311c3", URL: "https://www.abc15.com/news/region-west-valley/glendale.rss", Comments: "Glendale, AZ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dc7694578b4d25f4", URL: "https://whnt.com/news/decatur/feed/", Comments: "Decatur, AL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1f1bbb12da85da2a", URL: "https://myeverettnews.com/feed/", Comments: "Everett, WA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bcf3475db46a4159", URL: "https://www.news10.com/news/schenectady-county/feed/", Comments: "Schenectady County", Language: "en", Status: "active", Tier: 2},
+	{ID: "fbf91d8bb2d6b425", URL: "https://www.clovisroundup.com/feed/", Comments: "Clovis, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e8f0ecc8e90bf127", URL: "https://www.jns.org/feed/", Comments: "Israel, Middle East", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "924f9715538d2d3a", URL: "https://www.rte.ie/feeds/rss/?index=/news/", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "a845a2ca38e8957c", URL: "https://www.limerickleader.ie/rss.jsp?sezione=1", Comments: "Limerick, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "b911200a8b520efd", URL: "https://www.kilkennypeople.ie/rss.jsp?sezione=92", Comments: "Kilkenny, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "aad05aeb4da46a27", URL: "https://gephardtdaily.com/feed/", Comments: "Utah", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6b4701c10e7ae41c", URL: "https://www.benewsjournal.com/feed/", Comments: "Bensalem, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5ab0e662094c18c0", URL: "https://www.abc4.com/feed/", Comments: "Salt Lake City", Language: "en", Status: "active", Tier: 2},
+	{ID: "f107c1cd359dcdd6", URL: "https://www.ariananews.af/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "780d0696a7cc03cf", URL: "https://www.hometownstation.com/feed", Comments: "Santa Clarita", Language: "en", Status: "active", Tier: 2},
+	{ID: "a0c988d2e7801e8d", URL: "https://patch.com/feeds/aol/new-jersey/jersey-city", Comments: "Jersey City", Language: "en", Status: "active", Tier: 2},
+	{ID: "10f6ee2b3146fe2b", URL: "https://www.parisstaronline.com/feed", Comments: "Paris, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7cee6ed438c8e3e0", URL: "https://www.lex18.com/news.rss", Comments: "Kentucky", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d1cbb05155c28a8e", URL: "https://www.wfla.com/news/sarasota-county/feed/", Comments: "Sarasota County", Language: "en", Status: "active", Tier: 2},
+	{ID: "7580c54f1c609691", URL: "https://www.abcactionnews.com/news/region-sarasota-manatee.rss", Comments: "Sarasota, Manatee County", Language: "en", Status: "active", Tier: 2},
+	{ID: "0edecff5627048ff", URL: "https://www.wwaytv3.com/news-tags/wilmington/feed/", Comments: "Wilmington, NC", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "007b5a288fd28b65", URL: "https://wehotimes.com/category/news/feed/", Comments: "West Hollywood", Language: "en", Status: "active", Tier: 2},
+	{ID: "3d460cf06dbf52c4", URL: "https://medfordalert.com/feed/", Comments: "Medford, OR", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "047559b1723c48d4", URL: "https://ktla.com/tag/inglewood/feed/", Comments: "Inglewood", Language: "en", Status: "active", Tier: 2},
+	{ID: "33552755e98c6956", URL: "https://www.norfolk.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Norfolk", Language: "en", Status: "active", Tier: 2},
+	{ID: "f4831be6cf4c43dc", URL: "https://www.vvng.com/feed/", Comments: "Victor Valley", Language: "en", Status: "active", Tier: 2},
+	{ID: "852ce6f499927fb4", URL: "https://kgab.com/category/local-news/feed", Comments: "Cheyenne", Language: "en", Status: "active", Tier: 2},
+	{ID: "c8e80d3f3f11b48f", URL: "https://www.murfreesborotn.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Murfreesboro", Language: "en", Status: "active", Tier: 2},
+	{ID: "ca1d2b42ca755f1d", URL: "https://wina.com/news/feed", Comments: "Charlottesville", Language: "en", Status: "active", Tier: 2},
+	{ID: "ead548320ca5e179", URL: "https://www.wral.com/news/rss/48", Comments: "Raleigh, Durham", Language: "en", Status: "active", Tier: 2},
+	{ID: "989fbd8fb26fdd42", URL: "https://patch.com/feeds/aol/illinois/aurora", Comments: "Aurora, IL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e252335b1a7f85c8", URL: "https://abc17news.com/category/news/columbia/feed/", Comments: "Columbia, MO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5a911af9e6c2d4cd", URL: "https://wjon.com/category/local-news/feed", Comments: "St. Cloud", Language: "en", Status: "active", Tier: 2},
+	{ID: "68991448cdb5d8db", URL: "https://patch.com/feeds/aol/california/dublin", Comments: "Dublin, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c992f57c594874e4", URL: "https://patch.com/feeds/aol/california/lagunaniguel-danapoint", Comments: "Laguna Niguel, Dana Point", Language: "en", Status: "active", Tier: 2},
+	{ID: "9d0d9b5cc3f5dc77", URL: "https://www.stltoday.com/search/?f=rss&t=article&c=news/local/stcharles&l=50&s=start_time&sd=desc", Comments: "St. Charles County, MO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "90ac9a1fbcf7aef5", URL: "https://fox2now.com/tag/st-charles/feed/", Comments: "St. Charles", Language: "en", Status: "active", Tier: 2},
+	{ID: "09e93d7222d41687", URL: "https://www.turlockjournal.com/news/rss/", Comments: "Turlock", Language: "en", Status: "active", Tier: 2},
+	{ID: "50702cb314a562ff", URL: "https://www.bismarcknd.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Bismarck", Language: "en", Status: "active", Tier: 2},
+	{ID: "42e42111bceb92af", URL: "https://patch.com/feeds/aol/illinois/desplaines", Comments: "Des Plaines", Language: "en", Status: "active", Tier: 2},
+	{ID: "f4775582b4fa755b", URL: "https://ghstandard.com/feed/", Comments: "Greater Hartford, CT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d9f4ce031b04aec6", URL: "https://www.nhregister.com/default/feed/News-RSS-1670.php", Comments: "New Haven", Language: "en", Status: "active", Tier: 2},
+	{ID: "e28baa275da3fdc5", URL: "https://www.stamfordadvocate.com/rss/feed/News-1443.php", Comments: "Stamford", Language: "en", Status: "active", Tier: 2},
+	{ID: "a41bc4b634f04ba2", URL: "https://www.wmdt.com/category/maryland/feed/", Comments: "Maryland", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f333eba3dae63e57", URL: "https://www.kark.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4b6669da93c86384", URL: "https://www.fox16.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce8b5f2147950f58", URL: "https://neareport.com/feed/", Comments: "region", Language: "en", Status: "active", Tier: 2},
+	{ID: "5ae3d6691461f4d8", URL: "https://cbs4indy.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "deff8ea91dd244bb", URL: "https://www.redlakenationnews.com/rss", Comments: "area", Language: "en", Status: "active", Tier: 2},
+	{ID: "d29620534eb18dc1", URL: "https://www.irrawaddy.com/feed", Comments: "Myanmar", Language: "en", Status: "active", Country: "MM", Tier: 2},
+	{ID: "249f76fd2027d62e", URL: "https://www.theguardian.com/world/myanmar/rss", Comments: "Myanmar", Language: "en", Status: "active", Country: "MM", Tier: 2},
+	{ID: "1e648f3960b3f0ab", URL: "https://www.theguardian.com/world/brazil/rss", Comments: "Brazil", Language: "en", Status: "active", Country: "BR", Tier: 2},
+	{ID: "b08f65829164ab09", URL: "https://www.boston.com/tag/vermont/feed/", Comments: "Boston, Vermont", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "267c6052e1debe26", URL: "https://www.ffxnow.com/feed/", Comments: "Fairfax County, Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "10ff165e5a10e775", URL: "https://fcpdnews.wordpress.com/feed/", Comments: "Fairfax County", Language: "en", Status: "active", Tier: 2},
+	{ID: "5d022a9ec4f62e81", URL: "https://www.dcnewsnow.com/news/local-news/virginia/fairfax-county/feed/", Comments: "Fairfax County, Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "93e1d0765622acd6", URL: "https://dailyvoice.com/virginia/fairfax/rss/", Comments: "Fairfax County, Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "36b2e8f18250283d", URL: "https://annandaletoday.com/feed/", Comments: "Annandale, Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c7a3d22aff1f84c0", URL: "https://taarifa.rw/feed/", Comments: "Rwanda", Language: "en", Status: "active", Country: "RW", Tier: 2},
+	{ID: "9866756ecac49205", URL: "https://www.wearegreenbay.com/news/local-news/feed/", Comments: "Green Bay", Language: "en", Status: "active", Tier: 2},
+	{ID: "90931b52cc55a6bb", URL: "https://krdo.com/category/news/pueblo/feed/", Comments: "Pueblo", Language: "en", Status: "active", Tier: 2},
+	{ID: "45a31e1ac9552a4e", URL: "https://www.denverpost.com/location/pueblo/feed/", Comments: "Pueblo", Language: "en", Status: "active", Tier: 2},
+	{ID: "69513129042625fb", URL: "https://www.wishtv.com/tag/kokomo-indiana/feed/", Comments: "Kokomo, Indiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b5953aee56ec2020", URL: "https://kpq.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e805a7a2177bd1a4", URL: "https://patch.com/feeds/aol/california/escondido", Comments: "Escondido", Language: "en", Status: "active", Tier: 2},
+	{ID: "bcb49113c9ec3b0a", URL: "https://www.kxlh.com/news/great-falls-news.rss", Comments: "Great Falls", Language: "en", Status: "active", Tier: 2},
+	{ID: "c6cd29ca29121b73", URL: "https://wilsoncountysource.com/category/lebanon/feed/", Comments: "Lebanon", Language: "en", Status: "active", Country: "LB", Tier: 2},
+	{ID: "1b2a223687efd638", URL: "https://www.cambridgeday.com/category/news/feed/", Comments: "Cambridge", Language: "en", Status: "active", Tier: 2},
+	{ID: "c0700578942bab82", URL: "https://www.springtx.com/news/rss.php", Comments: "Spring, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b5a8574e084f739c", URL: "https://patch.com/feeds/aol/new-jersey/oceancity", Comments: "Ocean City", Language: "en", Status: "active", Tier: 2},
+	{ID: "e1b2513f2e96554d", URL: "https://www.tapinto.net/towns/paterson/articles.atom", Comments: "Paterson", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c3f81132908874e", URL: "https://www.dailybreeze.com/location/california/los-angeles-county/south-bay/carson/feed/", Comments: "Carson", Language: "en", Status: "active", Tier: 2},
+	{ID: "660eac3eecc6f459", URL: "https://www.denverpost.com/location/colorado/front-range/larimer-county/fort-collins/feed/", Comments: "Denver, Fort Collins", Language: "en", Status: "active", Tier: 2},
+	{ID: "8e3cc3379e19b188", URL: "https://dailyvoice.com/new-york/mountvernon/rss/", Comments: "Mount Vernon", Language: "en", Status: "active", Tier: 2},
+	{ID: "5916d90878d48645", URL: "https://patch.com/feeds/aol/new-york/mountvernonny", Comments: "Mount Vernon", Language: "en", Status: "active", Tier: 2},
+	{ID: "5ca9a34b1c43fde5", URL: "https://patch.com/feeds/aol/virginia/fredericksburg", Comments: "Fredericksburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "2716e31e636a1e89", URL: "https://dailyvoice.com/new-york/peekskill/rss/", Comments: "Peekskill", Language: "en", Status: "active", Tier: 2},
+	{ID: "29a76f25dd8c24a6", URL: "https://www.abc27.com/local-news/york/feed/", Comments: "York", Language: "en", Status: "active", Tier: 2},
+	{ID: "85baa23a99c698d6", URL: "https://www.ourquadcities.com/feed/", Comments: "Quad Cities", Language: "en", Status: "active", Tier: 2},
+	{ID: "4ab5e2a30c86eca6", URL: "https://patch.com/feeds/aol/georgia/lawrenceville-ga", Comments: "Lawrenceville", Language: "en", Status: "active", Tier: 2},
+	{ID: "4e1a18593426ec14", URL: "https://dailyvoice.com/new-york/portchester/rss/", Comments: "Port Chester", Language: "en", Status: "active", Tier: 2},
+	{ID: "efe085dee9d0166a", URL: "https://patch.com/feeds/aol/georgia/marietta", Comments: "Marietta", Language: "en", Status: "active", Tier: 2},
+	{ID: "758d792cf3508d69", URL: "https://www.wavy.com/news/local-news/williamsburg/feed/", Comments: "Williamsburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "6f59e89b45935783", URL: "https://www.cityofkyle.com/news/rss", Comments: "Kyle", Language: "en", Status: "active", Tier: 2},
+	{ID: "94186be0d38d88d2", URL: "https://www.tapinto.net/towns/new-brunswick/articles.atom", Comments: "New Brunswick", Language: "en", Status: "active", Tier: 2},
+	{ID: "0167903c79178e7b", URL: "https://patch.com/feeds/aol/california/ranchosantamargarita", Comments: "Rancho Santa Margarita", Language: "en", Status: "active", Tier: 2},
+	{ID: "82c8fe714085d3a2", URL: "https://patch.com/feeds/aol/new-york/eastmeadow", Comments: "East Meadow", Language: "en", Status: "active", Tier: 2},
+	{ID: "c9edb1dcad902ebd", URL: "https://www.wavy.com/news/local-news/suffolk/feed/", Comments: "Suffolk", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5b0703d979f0072", URL: "https://patch.com/feeds/aol/california/lakeforest-ca", Comments: "Lake Forest", Language: "en", Status: "active", Tier: 2},
+	{ID: "dad76989aff06649", URL: "https://www.wkrn.com/news/tennessee-news/feed/", Comments: "Tennessee", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4149e8644a2688d5", URL: "https://fox8.com/tag/tennessee-news/feed/", Comments: "Tennessee", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6317c189ea405722", URL: "https://www.clarksvilleonline.com/feed/", Comments: "Clarksville", Language: "en", Status: "active", Tier: 2},
+	{ID: "bcce87b00449cc2c", URL: "https://www.chattanoogan.com/Breaking-News/feed", Comments: "Chattanooga", Language: "en", Status: "active", Tier: 2},
+	{ID: "af720d16caf5bf11", URL: "https://www.wdef.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "e295d35df1767086", URL: "https://www.eastridgenewsonline.com/feed/", Comments: "East Ridge", Language: "en", Status: "active", Tier: 2},
+	{ID: "3258a1a60d0c34e3", URL: "https://www.wjhl.com/feed/", Comments: "Tri-Cities, TN/VA, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9599d3906a542d4a", URL: "https://azdailysun.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Arizona", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "723a8a7d2e442ebd", URL: "https://www.latimes.com/news/rss2.0.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2942f8eebab5a0ad", URL: "https://www.sandiegouniontribune.com/news/rss2.0.xml", Comments: "region", Language: "en", Status: "active", Tier: 2},
+	{ID: "bfdbd88493542306", URL: "https://www.berkeleyscanner.com/rss/", Comments: "Berkeley", Language: "en", Status: "active", Tier: 2},
+	{ID: "155b60227ec3af82", URL: "https://pasoroblespress.com/feed/", Comments: "Paso Robles", Language: "en", Status: "active", Tier: 2},
+	{ID: "e5edd9d8fada87f0", URL: "https://www.herald.ng/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "39499bb03baf0527", URL: "https://hallmarknews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c6e53b9cad473a6c", URL: "https://vicksburgnews.com/feed/", Comments: "Vicksburg", Language: "en", Status: "active", Tier: 2},
+	{ID: "da60a6bf1558867a", URL: "https://www.wcbi.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "505338aed2089882", URL: "https://news.stv.tv/feed", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "bff6cb4ccf42771e", URL: "https://www.shetlandtimes.co.uk/feed", Comments: "Shetland, Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2d9c4905a7c94377", URL: "https://www.the-gazette.co.uk/news/rss/", Comments: "Colchester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "6186b213fedcef74", URL: "https://www.sundaypost.com/feed/", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "472f9a74429420ce", URL: "https://www.homernews.com/feed/", Comments: "Homer, Alaska", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "01d1c03e25c52620", URL: "https://www.khon2.com/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "869e2ac0c40e02cc", URL: "https://kauainownews.com/feed/", Comments: "Kauai, Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7ee439b14b776081", URL: "https://www.thegardenisland.com/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d43f7f6506ab1553", URL: "https://www.sowetanlive.co.za/rss/?publication=sowetan-live", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "f78867d10a5212d7", URL: "https://www.dcnewsnow.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c75879401c57bc7", URL: "https://www.malaymail.com/feed/rss/malaysia", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "447625d9a5df9902", URL: "https://www.theborneopost.com/feed/", Comments: "Borneo", Language: "en", Status: "active", Tier: 2},
+	{ID: "193e71bc44527e38", URL: "https://thecanarynews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f01e23e9c83e03ec", URL: "https://www.ocregister.com/location/california/orange-county/garden-grove/feed/", Comments: "Garden Grove", Language: "en", Status: "active", Tier: 2},
+	{ID: "3445f6f426662e7b", URL: "https://orangecountytribune.com/category/garden-grove/feed/", Comments: "Garden Grove", Language: "en", Status: "active", Tier: 2},
+	{ID: "8c324932b5035d3e", URL: "https://londonnewsonline.co.uk/feed/", Comments: "London, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "aef512f6c435b04d", URL: "https://lfpress.com/feed", Comments: "London, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "44ac859007b72a58", URL: "https://www.yourlocalguardian.co.uk/news/rss/", Comments: "London, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "08574afe8714f5c8", URL: "https://www.thisislocallondon.co.uk/news/rss/", Comments: "London, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0280771b6ac340ec", URL: "https://www.anaheim.net/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Anaheim", Language: "en", Status: "active", Tier: 2},
+	{ID: "d8f557832dbdd02b", URL: "https://www.pasadenastarnews.com/location/california/los-angeles-county/san-gabriel-valley/altadena/feed/", Comments: "Pasadena, Altadena", Language: "en", Status: "active", Tier: 2},
+	{ID: "d65de00e36a8d59b", URL: "https://patch.com/feeds/aol/california/manhattanbeach", Comments: "Manhattan Beach", Language: "en", Status: "active", Tier: 2},
+	{ID: "48eba7aa8fbb0c26", URL: "https://www.wavy.com/news/local-news/newport-news/feed/", Comments: "Newport News", Language: "en", Status: "active", Tier: 2},
+	{ID: "c18bb2d1c0c9435f", URL: "https://www.highpointnc.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "High Point", Language: "en", Status: "active", Tier: 2},
+	{ID: "239661538f4728ab", URL: "https://dailyvoice.com/new-jersey/hackensack/rss/", Comments: "Hackensack", Language: "en", Status: "active", Tier: 2},
+	{ID: "3a3148bc90b6d217", URL: "https://www.fox44news.com/tag/copperas-cove/feed/", Comments: "Copperas Cove", Language: "en", Status: "active", Tier: 2},
+	{ID: "b92e989d4fa36315", URL: "https://www.kxlh.com/news/helena-news.rss", Comments: "Helena", Language: "en", Status: "active", Tier: 2},
+	{ID: "a3d72f0c58459682", URL: "https://fox8.com/tag/mansfield-news/feed/", Comments: "Mansfield", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1f281e8823cbcbe", URL: "https://wajr.com/category/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "0460aff998d5fbed", URL: "https://patch.com/feeds/aol/massachusetts/framingham", Comments: "Framingham", Language: "en", Status: "active", Tier: 2},
+	{ID: "58ecf55c5eaeacad", URL: "https://www.eastbaytimes.com/location/california/bay-area/east-bay/contra-costa-county/east-contra-costa/oakley/feed/", Comments: "Oakley", Language: "en", Status: "active", Tier: 2},
+	{ID: "cdef783b1e671ebc", URL: "https://patch.com/feeds/aol/california/losgatos", Comments: "Los Gatos", Language: "en", Status: "active", Tier: 2},
+	{ID: "32e0ca9eeed74564", URL: "https://www.ocala-news.com/tag/belleview-fl/feed/", Comments: "Belleview", Language: "en", Status: "active", Tier: 2},
+	{ID: "32be29b6746257be", URL: "https://patch.com/feeds/aol/new-york/levittown-ny", Comments: "Levittown", Language: "en", Status: "active", Tier: 2},
+	{ID: "6fc8620574b6ac68", URL: "https://patch.com/feeds/aol/connecticut/milford", Comments: "Milford", Language: "en", Status: "active", Tier: 2},
+	{ID: "acee3d99e4c40926", URL: "https://www.wvnstv.com/news/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "0f2725da9195a491", URL: "https://www.kvrr.com/tag/west-fargo/feed/", Comments: "West Fargo", Language: "en", Status: "active", Tier: 2},
+	{ID: "d62b2f27d25e88f5", URL: "https://patch.com/feeds/aol/new-york/mineola", Comments: "Mineola", Language: "en", Status: "active", Tier: 2},
+	{ID: "6a59595a3fb575bc", URL: "https://patch.com/feeds/aol/connecticut/meriden", Comments: "Meriden", Language: "en", Status: "active", Tier: 2},
+	{ID: "0acc62ca7bb5b4b7", URL: "https://sciotocountydailynews.com/tag/portsmouth/feed/", Comments: "Portsmouth", Language: "en", Status: "active", Tier: 2},
+	{ID: "c14de9c056e800c6", URL: "https://www.eastbaytimes.com/location/california/bay-area/east-bay/contra-costa-county/west-contra-costa/san-pablo/feed/", Comments: "San Pablo", Language: "en", Status: "active", Tier: 2},
+	{ID: "ddfb5741a97c35dd", URL: "https://columbustelegram.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Columbus", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6163ec1898382b3", URL: "https://fox8.com/tag/east-cleveland-news/feed/", Comments: "East Cleveland", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e61c842c6c53f8f", URL: "https://www.tapinto.net/towns/bayonne/articles.atom", Comments: "Bayonne", Language: "en", Status: "active", Tier: 2},
+	{ID: "37e89907fec2a9ba", URL: "https://www.wric.com/app-feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec08d7284b00a317", URL: "https://wtop.com/local/virginia/feed/", Comments: "Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dac89449b7f60181", URL: "https://www.wfxrtv.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f463f1fa2d1e71a1", URL: "https://www.wiproud.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc7f933503a9b806", URL: "https://www.wowktv.com/news/west-virginia/feed/", Comments: "West Virginia", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cf5eb86dab7cf563", URL: "https://www.wtrf.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "65edab23b5c21ad1", URL: "https://www.wvnstv.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c2196e5794ef383d", URL: "https://www.idahofallsidaho.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Idaho Falls", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1fb92719489a3a7", URL: "https://idahocapitalsun.com/feed/", Comments: "Idaho", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3eb184929f1c1227", URL: "https://www.onlanka.com/feed", Comments: "Sri Lanka", Language: "en", Status: "active", Country: "LK", Tier: 2},
+	{ID: "013331af17383740", URL: "https://www.columbian.com/feed/", Comments: "Vancouver, Washington", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c032783c12a79ec1", URL: "https://www.theguardian.com/us-news/washington-state/rss", Comments: "Washington State", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b6c8441f6f452a01", URL: "https://whatcom-news.com/feed/", Comments: "Whatcom County", Language: "en", Status: "active", Tier: 2},
+	{ID: "65033b55f9e989ab", URL: "https://washingtonstatenews.net/feed/", Comments: "Washington", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3137977e1c975eb9", URL: "https://www.bangordailynews.com/feed/", Comments: "Maine", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dd80aa9216fadf02", URL: "https://fox4kc.com/news/kansas-news/feed/", Comments: "Kansas", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bd7ce45032ebfd9d", URL: "https://baytownsun.com/search/?q=&t=article&l=25&d=&d1=&d2=&s=start_time&sd=desc&c%5B%5D=news*&f=rss", Comments: "Baytown", Language: "en", Status: "active", Tier: 2},
+	{ID: "14baddf19f9cb2ad", URL: "https://www.nytimes.com/svc/collections/v1/publish/https://www.nytimes.com/section/world/rss.xml", Comments: "New York, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d1c3e97fdef94289", URL: "https://europeantimes.news/feed/", Comments: "European", Language: "en", Status: "active", Tier: 2},
+	{ID: "59e538f35aaae37f", URL: "https://nypost.com/tag/brooklyn/feed/", Comments: "Brooklyn, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "402ba99f50b2ed9d", URL: "https://ktla.com/tag/pasadena/feed/", Comments: "Pasadena", Language: "en", Status: "active", Tier: 2},
+	{ID: "689cbb07a5e94849", URL: "https://www.coloradoboulevard.net/category/pasadena-news/feed/", Comments: "Pasadena", Language: "en", Status: "active", Tier: 2},
+	{ID: "e85552ed05fd092a", URL: "https://www.sweetwaternow.com/feed/", Comments: "Sweetwater County", Language: "en", Status: "active", Tier: 2},
+	{ID: "2f55553b75246d07", URL: "https://k2radio.com/feed/", Comments: "Wyoming", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "201dddff124c3b12", URL: "https://montreal.citynews.ca/feed/", Comments: "Montreal, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "99289c485379232a", URL: "https://patch.com/feeds/aol/connecticut/norwich", Comments: "Norwich", Language: "en", Status: "active", Tier: 2},
+	{ID: "da518a1f9e6095c7", URL: "https://www.vvng.com/category/adelanto_news/feed/", Comments: "Adelanto", Language: "en", Status: "active", Tier: 2},
+	{ID: "981bf2f2d7f57f81", URL: "https://patch.com/feeds/aol/california/sanramon", Comments: "San Ramon", Language: "en", Status: "active", Tier: 2},
+	{ID: "63e49bf98344efe0", URL: "https://patch.com/feeds/aol/new-jersey/easthanover-florhampark", Comments: "East Hanover, Florham Park", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c6ffd7b86fee5fd", URL: "https://wbontv.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "333a580ef12113bd", URL: "https://www.villagenews.com/rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "85563117e82e3a04", URL: "https://www.reviewjournal.com/local/north-las-vegas/feed/", Comments: "North Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "3c9777a2176faced", URL: "https://kqennewsradio.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "5b4ab87599003f2e", URL: "https://whky.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f30be2ed70c4a3d0", URL: "https://www.gallupsun.com/index.php?option=com_content&view=section&layout=blog&id=16&Itemid=560&format=feed&type=rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "f1a589045ca4d426", URL: "https://www.krqe.com/tag/gallup/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "48f2d6aa1e19d02e", URL: "https://wnynewsnow.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "794f483390084a69", URL: "https://dailyvoice.com/new-jersey/englewood/rss/", Comments: "Englewood", Language: "en", Status: "active", Tier: 2},
+	{ID: "a314f22d9dfe527d", URL: "https://patch.com/feeds/aol/florida/aventura", Comments: "Aventura", Language: "en", Status: "active", Tier: 2},
+	{ID: "fff371cef87f6805", URL: "https://patch.com/feeds/aol/florida/landolakes", Comments: "Land O' Lakes", Language: "en", Status: "active", Tier: 2},
+	{ID: "b5db5075d36881a2", URL: "https://dailyvoice.com/new-jersey/fortlee/rss/", Comments: "Fort Lee", Language: "en", Status: "active", Tier: 2},
+	{ID: "904ec7a66b705f59", URL: "https://patch.com/feeds/aol/new-jersey/fortlee", Comments: "Fort Lee", Language: "en", Status: "active", Tier: 2},
+	{ID: "539a5918f3344e62", URL: "https://patch.com/feeds/aol/connecticut/naugatuck", Comments: "Naugatuck", Language: "en", Status: "active", Tier: 2},
+	{ID: "a37bd25a6a608656", URL: "https://patch.com/feeds/aol/new-york/portwashington", Comments: "Port Washington", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac4d3bf1fd7b4342", URL: "https://patch.com/feeds/aol/california/sananselmofairfax", Comments: "San Anselmo, Fairfax", Language: "en", Status: "active", Tier: 2},
+	{ID: "b47b652d399952ab", URL: "https://patch.com/feeds/aol/pennsylvania/phoenixville", Comments: "Phoenixville", Language: "en", Status: "active", Tier: 2},
+	{ID: "7ad6241ceb3b5718", URL: "https://patch.com/feeds/aol/connecticut/shelton", Comments: "Shelton", Language: "en", Status: "active", Tier: 2},
+	{ID: "799795b66cef3647", URL: "https://patch.com/feeds/aol/georgia/canton-ga", Comments: "Canton", Language: "en", Status: "active", Tier: 2},
+	{ID: "be1c89fa1b0aa732", URL: "https://patch.com/feeds/aol/new-york/patchogue", Comments: "Patch - Patchogue", Language: "en", Status: "active", Tier: 2},
+	{ID: "635ec987660f5ecd", URL: "https://patch.com/feeds/aol/florida/bradenton", Comments: "Bradenton", Language: "en", Status: "active", Tier: 2},
+	{ID: "cc7f861bc5c357bc", URL: "https://www.koin.com/search/albany/feed/rss2", Comments: "Albany", Language: "en", Status: "active", Tier: 2},
+	{ID: "196f54a9b34b8a45", URL: "https://www.wishtv.com/tag/jeffersonville-indiana/feed/", Comments: "Jeffersonville", Language: "en", Status: "active", Tier: 2},
+	{ID: "f64d97f3daa5c40b", URL: "https://www.nwahomepage.com/tag/rogers/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "35f705d0a7cfb18a", URL: "https://levittownnow.com/feed/", Comments: "US, Levittown", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e9c8f46db932a2d9", URL: "https://theapopkachief.com/feed/", Comments: "Apopka", Language: "en", Status: "active", Tier: 2},
+	{ID: "dbaae33c05acca7e", URL: "https://www.krqe.com/tag/hobbs/feed/", Comments: "Hobbs", Language: "en", Status: "active", Tier: 2},
+	{ID: "d91d0ba51920fe00", URL: "https://www.koze.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "7dab546ae4afb8d4", URL: "https://wbhfradio.org/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea96ea4f632c2d48", URL: "https://patch.com/feeds/aol/georgia/cartersville", Comments: "Cartersville", Language: "en", Status: "active", Tier: 2},
+	{ID: "653f11538221c34a", URL: "https://bartlett-express.com/feed/", Comments: "Bartlett", Language: "en", Status: "active", Tier: 2},
+	{ID: "e6a6ed73e67d906f", URL: "https://patch.com/feeds/aol/illinois/glenellyn", Comments: "Glen Ellyn", Language: "en", Status: "active", Tier: 2},
+	{ID: "864bea07ef410540", URL: "https://patch.com/feeds/aol/virginia/ashburn", Comments: "Ashburn", Language: "en", Status: "active", Tier: 2},
+	{ID: "f9ee0996abe6b8b1", URL: "https://triblive.com/category/monroeville/feed/", Comments: "Monroeville", Language: "en", Status: "active", Tier: 2},
+	{ID: "3f63dd86c5674daf", URL: "https://kobi5.com/tag/ashland/feed/", Comments: "Ashland", Language: "en", Status: "active", Tier: 2},
+	{ID: "c73005d0cd3a0f61", URL: "https://patch.com/feeds/aol/illinois/homewood-flossmoor", Comments: "Homewood-Flossmoor", Language: "en", Status: "active", Tier: 2},
+	{ID: "93fca5f3094c62e0", URL: "https://www.theobserver.com/category/news/kearny/feed/", Comments: "Kearny", Language: "en", Status: "active", Tier: 2},
+	{ID: "8f9b1a060a2eb87f", URL: "https://lynnwoodtimes.com/category/news/feed/", Comments: "Lynnwood", Language: "en", Status: "active", Tier: 2},
+	{ID: "ae1ddafbc4ce7e4e", URL: "https://www.issaquahwa.gov/RSSFeed.aspx?ModID=1&CID=All-newsflash.xml", Comments: "Issaquah", Language: "en", Status: "active", Tier: 2},
+	{ID: "f8a1ece9b17b7245", URL: "https://www.wtnh.com/news/connecticut/new-london/feed/", Comments: "New London", Language: "en", Status: "active", Tier: 2},
+	{ID: "1fa17664d6c16b98", URL: "https://calexicochronicle.com/feed/", Comments: "Calexico", Language: "en", Status: "active", Tier: 2},
+	{ID: "afd130269fc79973", URL: "https://www.jurist.org/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "345305b028f6e7d5", URL: "https://airlive.net/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea4022ed14ece59c", URL: "https://aviationsourcenews.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ef274f9cf3892987", URL: "https://www.newsnationnow.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "08b0f10731b66f93", URL: "https://data.gmanetwork.com/gno/rss/news/nation/feed.xml", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "5518788e79e9c155", URL: "https://data.gmanetwork.com/gno/rss/news/metro/feed.xml", Comments: "Philippines, Metro Manila", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "81d93018bd1d2a7c", URL: "https://today.rtl.lu/rss/luxembourg", Comments: "Luxembourg", Language: "en", Status: "active", Country: "LU", Tier: 2},
+	{ID: "669f5e9db6f7dcd1", URL: "https://arunachaltimes.in/index.php/feed/", Comments: "Arunachal Pradesh, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "41c8874befa69218", URL: "https://www.thehindu.com/news/cities/feeder/default.rss", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "4b8a7e0fccdbd64a", URL: "https://newstodaynet.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "16f60b11ecab5b03", URL: "https://prod-qt-images.s3.amazonaws.com/production/sentinelassam-english/feed.xml", Comments: "Assam, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7adb7cbdc974af5d", URL: "https://theshillongtimes.com/feed/", Comments: "Shillong, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "c155ccab305f5102", URL: "https://tehelka.com/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ac3b2038e7500f39", URL: "https://interaksyon.philstar.com/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "2847915fcc3567e5", URL: "https://davaotoday.com/feed/", Comments: "Davao, Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "9efb8311b17d6632", URL: "https://www.rappler.com/nation/metro-manila/feed/", Comments: "Metro Manila, Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "e74fe4dee52852c5", URL: "https://balita.com/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "2e95a09e8e0f0558", URL: "https://www.google.com/alerts/feeds/02412288231469291361/11605679334736161637", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c27a5267a77fbe79", URL: "https://www.google.com/alerts/feeds/02412288231469291361/501329372115700331", Language: "en", Status: "active", Tier: 2},
+	{ID: "28196651ea8fecd2", URL: "https://www.google.com/alerts/feeds/02412288231469291361/14416974173416161152", Language: "en", Status: "active", Tier: 2},
+	{ID: "cc53335e461bbad5", URL: "https://www.google.com/alerts/feeds/02412288231469291361/1211632576687846581", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5fb3b17c5285509e", URL: "https://prod-qt-images.s3.amazonaws.com/production/deccanherald/feed.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "aad6a06a32cd18c7", URL: "https://www.thehansindia.com/feed", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "ab83bae1282db3e5", URL: "https://www.timesnownews.com/feeds/gns-en-latest.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "d921bf3bc5b8ee5d", URL: "https://www.news18.com/commonfeeds/v1/eng/rss/india.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "0e65c42f5017c026", URL: "https://www.justicenews.co.in/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "63292f66ce2c1def", URL: "https://www.prokerala.com/news/kerala/rss.xml", Comments: "Kerala, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7558179c59752bdd", URL: "https://www.wcpo.com/news/local-news/butler-county.rss", Comments: "Butler County", Language: "en", Status: "active", Tier: 2},
+	{ID: "e716c640048851a2", URL: "https://www.wcpo.com/news/local-news/butler-county/west-chester.rss", Comments: "West Chester", Language: "en", Status: "active", Tier: 2},
+	{ID: "a7d22013e9a3c275", URL: "https://www.firefighterclosecalls.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5613990c5f247140", URL: "https://kentland33.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "6a12d216bf1d76ce", URL: "https://www.firerescue1.com/recently-published.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7646064b128b559e", URL: "https://www.firefighternation.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "878e61c5356d81cf", URL: "https://www.flightaware.com/squawks/rss/general/24_hours/new", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5bc696a709cc431", URL: "https://www.deccanchronicle.com/feed", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "965a5ebc6532bdcb", URL: "https://www.fortmcmurraytoday.com/category/news/feed.xml", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "1f2bb9db49688416", URL: "https://leaderpost.com/category/news/feed.xml", Comments: "Regina, Saskatchewan, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "d5a645b1f86d322c", URL: "https://www.dailyheraldtribune.com/category/news/feed.xml", Comments: "Grande Prairie, Alberta, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "570315dc77a34dfc", URL: "https://mcac.maryland.gov/feed/", Comments: "Maryland", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7503f4047e1dcc59", URL: "https://ournews.bs/feed/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "d3c31444340c6ec8", URL: "https://www.aviation24.be/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "69d55f93865e59f8", URL: "https://simpleflying.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b43052db34632266", URL: "https://airspace-africa.com/feed/", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "6b0cd2945d05f44c", URL: "https://www.avweb.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e0e02369a512f0a4", URL: "https://ukaviation.news/category/news/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7e1c308ddffa890c", URL: "https://travelradar.aero/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "878d874f85327a8e", URL: "https://aviationghana.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "c34680d53c5a42ff", URL: "https://prod-qt-images.s3.amazonaws.com/production/heraldgoa-main/feed.xml", Comments: "Goa, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "6e7245aa93e00dbd", URL: "https://nagalandpost.com/index.php/feed/", Comments: "Nagaland, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "8909f1d9793d4493", URL: "https://www.adaderana.lk/rss.php", Comments: "Sri Lanka", Language: "en", Status: "active", Country: "LK", Tier: 2},
+	{ID: "3a60fd907999ee2d", URL: "https://en.somoynews.tv/rss.xml", Comments: "Bangladesh", Language: "en", Status: "active", Country: "BD", Tier: 2},
+	{ID: "b3d6e499e2e8de6f", URL: "https://www.news18.com/rss/news.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "4439af5e07507c7d", URL: "https://www.dailytimes.lk/feed/", Comments: "Sri Lanka", Language: "en", Status: "active", Country: "LK", Tier: 2},
+	{ID: "16bc91a2ae488349", URL: "https://www.northeasttoday.in/feed/", Comments: "North East India", Language: "en", Status: "active", Tier: 2},
+	{ID: "340b0cb10c02eb53", URL: "https://assamtribune.com/feed", Comments: "Assam, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "cf61801e943f1b32", URL: "https://arunachal24.in/feed/", Comments: "Arunachal Pradesh, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "4ee444d6322975df", URL: "https://kalingatv.com/feed/", Comments: "Odisha, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "a7459c195515c0c9", URL: "https://montanafreepress.org/feed/", Comments: "Montana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dfc000453f18e252", URL: "https://nypost.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "acf4870833ea3089", URL: "https://thenevadaindependent.com/feed", Comments: "Nevada", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ce363c77e627bfdb", URL: "https://www.gpb.org/rss.xml", Comments: "Georgia", Language: "en", Status: "active", Country: "GE", Tier: 2},
+	{ID: "0d17e289b45de5cd", URL: "https://www.arlnow.com/feed", Comments: "Arlington, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "93e089472e1fb6f1", URL: "https://whnt.com/feed/", Comments: "Huntsville, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "89e599184d71045a", URL: "https://www.sltrib.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "region", Language: "en", Status: "active", Tier: 2},
+	{ID: "22ba375e398d5c2f", URL: "https://www.tmj4.com/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0ad1e50a1a5b3453", URL: "https://www.mprnews.org/feed/homepage", Comments: "Minnesota", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bec0fd2551f917bc", URL: "https://en.irna.ir/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ad91fe41195916b7", URL: "https://www.afro.who.int/rss.xml", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "47e3ccb31cb50465", URL: "https://ewnews.com/feed/", Comments: "Bahamas", Language: "en", Status: "active", Country: "BS", Tier: 2},
+	{ID: "8c756065538150ca", URL: "https://amandala.com.bz/news/feed/", Comments: "Belize", Language: "en", Status: "active", Country: "BZ", Tier: 2},
+	{ID: "cf539930cc174805", URL: "https://nationnews.com/feed/", Comments: "Barbados", Language: "en", Status: "active", Country: "BB", Tier: 2},
+	{ID: "e6a58d3774119255", URL: "https://www.ecdc.europa.eu/en/taxonomy/term/1505/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5efe082cda0ed3d9", URL: "https://antiguanewsroom.com/feed/", Comments: "Antigua", Language: "en", Status: "active", Tier: 2},
+	{ID: "2cf12012c44b15f2", URL: "https://bvinews.com/feed/", Comments: "British Virgin Islands", Language: "en", Status: "active", Country: "VG", Tier: 2},
+	{ID: "5512e3d297c930c5", URL: "https://www.bvibeacon.com/feed/", Comments: "British Virgin Islands", Language: "en", Status: "active", Country: "VG", Tier: 2},
+	{ID: "f7aa9e4537a9c613", URL: "https://dominicagazette.com/feed/", Comments: "Dominica", Language: "en", Status: "active", Country: "DM", Tier: 2},
+	{ID: "d5986226e43140b4", URL: "https://tt.loopnews.com/rss.xml", Comments: "Trinidad and Tobago", Language: "en", Status: "active", Country: "TT", Tier: 2},
+	{ID: "ee7879606930ddc9", URL: "https://www.searchlight.vc/feed/", Comments: "St. Vincent and Grenadines", Language: "en", Status: "active", Country: "VC", Tier: 2},
+	{ID: "bf7226d5ddd4746b", URL: "https://nowgrenada.com/feed/", Comments: "Grenada", Language: "en", Status: "active", Country: "GD", Tier: 2},
+	{ID: "d3958f2087de4de9", URL: "https://www.dailymail.co.uk/news/tasmania/index.rss", Comments: "Tasmania, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "ab8301563bcef48a", URL: "https://www.chicoer.com/feed/", Comments: "Chico, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fe8f0b59679ed957", URL: "https://buckrail.com/category/town/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "61bb4d7bf861f7df", URL: "https://www.dailymail.co.uk/news/alaska/index.rss", Comments: "Alaska, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "54a8c6f77e8f7751", URL: "https://www.nbcphiladelphia.com/tag/montgomery-county/feed/", Comments: "Montgomery County, Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5d0fd3b0d52a3608", URL: "https://blackpressusa.com/feed/", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "d7e156f79e67da2d", URL: "https://palawan-news.com/feed/", Comments: "Palawan, Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "5d335a9ccecdd7ff", URL: "https://newsroom.gy/feed/rss/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "316a144f7907033d", URL: "https://qcitymetro.com/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "01be9d008fc51564", URL: "https://www.dailymail.co.uk/news/north_korea/index.rss", Comments: "North Korea", Language: "en", Status: "active", Country: "KP", Tier: 2},
+	{ID: "e1761b7a15a3566f", URL: "http://www.corkbeo.ie/rss.xml", Comments: "Cork, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "de16843c63703ad8", URL: "https://www.cbsnews.com/latest/rss/us", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "097b54872b52bf36", URL: "https://www.nbcwashington.com/news/local/northern-virginia/feed/", Comments: "Northern Virginia, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a3ee5b0b080b4560", URL: "https://www.omanobserver.om/rssFeed/13/19", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "bda11a78e98c3795", URL: "https://www.whittierdailynews.com/location/california/los-angeles-county/feed/", Comments: "Whittier, LA County, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "520a35d090ff6ce3", URL: "http://www.brooklyneagle.com/feed", Comments: "Brooklyn, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b664c84d22a204aa", URL: "http://www.ibtimes.co.uk/rss/feed", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e1c7db9de26752fe", URL: "https://globalnews.ca/peterborough/feed/", Comments: "Peterborough, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "ab044b13ee68a009", URL: "https://www.atlanticcouncil.org/region/israel/feed/", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "b5090606f7ac7943", URL: "https://naturenews.africa/category/news/feed/", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "ccbc4e11f2b14231", URL: "http://www.examiner.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "23f88c8b38f74133", URL: "https://www.shorenewsnetwork.com/category/local-news-nj/new-jersey/ocean-county-news/feed/", Comments: "Ocean County, New Jersey, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2e5b610b0c1969a0", URL: "https://www.radioexe.co.uk/news-and-features/local-news/feed.xml", Comments: "Exeter, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1860db0c1cde1468", URL: "https://qfm96.com/news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "4175dde52e8b3524", URL: "https://www.eastbaytimes.com/news/national-news/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3cb15b5ee67609ac", URL: "https://www.theatlantic.com/feed/best-of/", Language: "en", Status: "active", Tier: 2},
+	{ID: "1f6d9141a91f13d6", URL: "https://www.atlanticcouncil.org/region/united-states-canada/feed/", Comments: "US, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "27430f14c784274f", URL: "https://ctmirror.org/news/environment/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "dfc520aa55405bf2", URL: "https://www.wtkr.com/news/military.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "3a5da89555e7d6bb", URL: "https://www.beaufortcountynow.com/rss.php?id=24", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "48c29f00bb0e7205", URL: "https://www.tvcnews.tv/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "6f6ded19c3595a76", URL: "https://euroweeklynews.com/news/spain/feed/", Comments: "Spain", Language: "en", Status: "active", Country: "ES", Tier: 2},
+	{ID: "9f14a54f393e6fa2", URL: "https://www.eptrail.com/local-news/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "df05adb7fe7f0e00", URL: "http://www.examiner.co.uk/news/?service=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7cc25ea7c5e6d96a", URL: "https://www.insightcrime.org/feed/", Comments: "Latin America", Language: "en", Status: "active", Tier: 2},
+	{ID: "b30e3f0e93761b6b", URL: "https://www.asdnews.com/rss-feed/?channelId=7", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c830839fdfd7751", URL: "https://feeds.feedburner.com/indybay/media", Language: "en", Status: "active", Tier: 2},
+	{ID: "b102e106d3f87e86", URL: "https://thediplomat.com/topics/environment/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "87e3a676f32c474b", URL: "https://www.omanobserver.om/rssFeed/13/20", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "c431a92fd54a96ab", URL: "http://news.met.police.uk/rss/source/60923/news", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b2e879d173557583", URL: "https://www.citizen.co.za/news/south-africa/crime/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "7166648f47986326", URL: "https://www.asdnews.com/rss-feed/?channelId=47", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e91a057c8e4b47a4", URL: "https://www.nbcphiladelphia.com/tag/philadelphia/feed/", Comments: "Philadelphia", Language: "en", Status: "active", Tier: 2},
+	{ID: "c08a6d59425c8cfc", URL: "http://www.essexlive.news/rss.xml", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "8baf708a48f38894", URL: "https://www.dailymail.co.uk/news/mississippi/index.rss", Comments: "Mississippi", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7b984cdc40c4fc18", URL: "https://euroweeklynews.com/news/uk-news/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "821bdbb789901689", URL: "https://www.nbcmiami.com/tag/south-florida/feed/", Comments: "South Florida", Language: "en", Status: "active", Tier: 2},
+	{ID: "e510254d55de6228", URL: "https://www.asdnews.com/rss-feed/?channelId=56", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1c79099de5c879d3", URL: "http://www.jamaica-gleaner.com/feed/rss.xml", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "da77d8e824684ec1", URL: "https://www.davisvanguard.org/category/breaking-news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "51c3e7062010cde7", URL: "https://www.dailymail.co.uk/news/brussels/index.rss", Comments: "Brussels", Language: "en", Status: "active", Tier: 2},
+	{ID: "fdf8b106eb9cb283", URL: "https://www.nbcphiladelphia.com/tag/pennsylvania/feed/", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f3c1c7830408f14c", URL: "http://www.belfasttelegraph.co.uk/news/northern-ireland/rss", Comments: "Northern Ireland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "41a0aca6b38f0966", URL: "http://www.theage.com.au/rss/national.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "e57f19b43381b968", URL: "https://www.warrington-worldwide.co.uk/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7bce26af0f5ca6ce", URL: "https://www.belfastlive.co.uk/news/belfast-news/rss.xml", Comments: "Belfast", Language: "en", Status: "active", Tier: 2},
+	{ID: "856f322c1b2af8a3", URL: "http://newsrangers.com/feed/atom/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ddad26d05935d199", URL: "https://japantoday.com/category/national/feed/atom", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "8b45e74e966dd082", URL: "https://www.chrisd.ca/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "746dad5a952f1dc3", URL: "http://feeds.foxnews.com/foxnews/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c4fa6441827f89cb", URL: "http://www.dvidshub.net/rss/publication", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "82ec38b9db177e0d", URL: "https://news.mit.edu/rss/topic/environment", Language: "en", Status: "active", Tier: 2},
+	{ID: "40684c942b6e2b10", URL: "https://www.nbc30.com/news/local/feed/", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d18b09312623de33", URL: "https://afloat.ie/marine-environment/coastal-notes?format=feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c266e3cbe6ca7964", URL: "https://afloat.ie/marine-environment?format=feed&type=atom", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3611aa688f637642", URL: "https://wgy.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7a6e7e6b9fafb71d", URL: "https://www.policeprofessional.com/region/england/feed/", Comments: "England", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ee7eb64061386a2e", URL: "https://www.kpcw.org/npr-news.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "76dd628f7a63f28e", URL: "https://ktvz.com/category/news/oregon-northwest/feed/", Comments: "Oregon, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "acf5ea885e1f108c", URL: "https://www.boston.com/rss/", Comments: "Boston, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a414990608ccf520", URL: "https://www.thecourier.co.uk/category/news/dundee/feed/", Comments: "Dundee, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "5606e4844c3211ba", URL: "https://www.khaama.com/tag/kabul/feed/", Comments: "Kabul", Language: "en", Status: "active", Tier: 2},
+	{ID: "b8c375bce2c4e81d", URL: "http://feeds.feedburner.com/Bernewscom", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "30abc007e316c594", URL: "https://afloat.ie/marine-environment/coastal-notes?format=feed&type=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6c32d41ad7299692", URL: "https://theblaze.com/feeds/feed.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "40f28ecf238de316", URL: "https://www.rawstory.com/feeds/raw-investigates.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "85d57a8568caad50", URL: "https://kashmirobserver.net/news/feed/", Comments: "Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "51b103f06ef7e36f", URL: "https://www.kztv10.com/news/national-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0f53d31b36a716ad", URL: "https://www.dl-online.com/index.rss", Comments: "Germany", Language: "en", Status: "active", Country: "DE", Tier: 2},
+	{ID: "b020b5a9a0d62a1c", URL: "https://www.thewhig.com/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "d7880d8650f406d1", URL: "https://wfin.com/feed/", Comments: "Findlay, Ohio", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "503cdb273cf18ff8", URL: "https://www.nhpr.org/tags/latest-from-npr.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa4be2101ac91c2e", URL: "https://www.wbfo.org/tags/npr-top-stories.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e71f9c99aca5d98e", URL: "https://cap-sources.s3.amazonaws.com/tz-tma-en/rss.xml", Comments: "Tanzania", Language: "en", Status: "active", Country: "TZ", Tier: 2},
+	{ID: "232de53139be700b", URL: "https://www.dailymail.co.uk/news/north-dakota/index.rss", Comments: "North Dakota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "33bddd80f4ff4455", URL: "https://globalnews.ca/kitchener/feed/", Comments: "Kitchener, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "41bc7a7e33984dde", URL: "http://www.brisbanetimes.com.au/rss/feed.xml", Comments: "Brisbane", Language: "en", Status: "active", Tier: 2},
+	{ID: "52f161b82646d62c", URL: "https://www.katc.com/news.rss", Comments: "Louisiana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ccc7128132f917cf", URL: "https://www.derbyshiretimes.co.uk/rss", Comments: "Derbyshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ce68632d8ccdf60c", URL: "https://www.asdnews.com/rss-feed/?channelId=44", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6d9ef169b67efbfb", URL: "https://igberetvnews.com/feed", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "70f5db752903ce82", URL: "http://www.phillyvoice.com/feed/section/news/", Comments: "Philadelphia, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "684999a5b1d37470", URL: "https://nationwideradiojm.com/category/latest-news/feed/", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "dd1697e878d746d6", URL: "https://www.dailymail.co.uk/news/oklahoma/index.rss", Comments: "Oklahoma, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f1b7ace35113b8ca", URL: "https://www.loscerritosnews.net/category/around-town/feed/", Comments: "Los Cerritos, California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "295f09d3eec37114", URL: "http://www.thedesertreview.com/search/?f=rss&t=article&c=news/world&l=50&s=start_time&sd=desc", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "00907fe9a6ec5c60", URL: "https://filipinotimes.net/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d60f564af2161ffb", URL: "https://www.wtxl.com/news/florida-news.rss", Comments: "Florida, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "767d0aac21922e85", URL: "http://alerts.weather.gov/cap/tx.php?x=0", Comments: "Texas, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b278c0a04265098d", URL: "https://ksnmedia.com/feed/", Comments: "Kansas, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8fbc7bee96353b1e", URL: "https://euroweeklynews.com/news/world-news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2168c452490b4457", URL: "https://www.nbcboston.com/tag/forecast/feed/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "40824180d71bf21e", URL: "https://www.dailymail.co.uk/news/nato/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "67bce5763b2bb509", URL: "https://www.thesundaily.my/rss/local", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "52f51a6ca4bc129d", URL: "http://www.wcpo.com/news/local-news.rss", Comments: "Cincinnati, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9a5ab4301e84702f", URL: "https://afloat.ie/marine-environment?format=feed&type=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c54bcb45530f057", URL: "https://www.bolnews.com/feed", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "254fb2e36b67e992", URL: "https://www.thedenverchannel.com/news.rss", Comments: "Denver, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "548d8bca237e8603", URL: "https://www.news.admin.ch/NSBSubscriber/feeds/rss?lang=en&org-nr=501&topic=0&keyword=&offer-nr=&catalogueElement=&kind=M", Comments: "Switzerland", Language: "en", Status: "active", Country: "CH", Tier: 2},
+	{ID: "f92296c302206748", URL: "https://asiapacificreport.nz/category/syndicate/feed/", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "5dbacedcb0fbab7d", URL: "https://www.wctrib.com/news.rss", Comments: "Willmar, Minnesota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "25650f71f2b11d26", URL: "https://www.atlanticcouncil.org/region/turkey/feed/", Comments: "Turkey", Language: "en", Status: "active", Country: "TR", Tier: 2},
+	{ID: "3dcacce98df240b4", URL: "https://www.wnbc.com/news/recall-alert/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "1077457dd3183e0f", URL: "https://www.atlanticcouncil.org/issue/resilience-society/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "aceeb2800dc05acd", URL: "https://economictimes.indiatimes.com/news/defence/rssfeeds/46687796.cms", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "48fb1c196efeb5dc", URL: "https://en.mynewsne.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8cdc54fb8ebad1cf", URL: "https://www.theatlantic.com/feed/all/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bafebd23a3f2b09c", URL: "https://centralchronicle.com/feed", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "797682b097829b25", URL: "http://metro.co.uk/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2e1e2aa0756f11a1", URL: "http://www.hulldailymail.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "70d3179817d089d0", URL: "https://newsroom.gy/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "93955e9861714d45", URL: "https://www.asdnews.com/rss-feed/?channelId=6", Language: "en", Status: "active", Tier: 2},
+	{ID: "6307b5d67f037e85", URL: "https://www.wnbc.com/news/national-international/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "17b0ea515fce4b62", URL: "https://asiapacificreport.nz/category/global/feed/", Comments: "Asia Pacific region, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7d3ac0247404fe5e", URL: "https://www.nbcconnecticut.com/investigations/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "4174643e0ad7bc8c", URL: "https://www.ukrinform.net/rss/block-video", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "3bda2fa4ccc9a33e", URL: "https://www.nbc4.com/news/local/changing-climate/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "04347bb72717bbf8", URL: "https://www.nbcwashington.com/tag/alexandria/feed/", Comments: "Alexandria, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c0781b1d77da7e74", URL: "https://www.asdnews.com/rss-feed/?channelId=57", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d29b46874d603b8", URL: "http://www.nottinghampost.com/rss.xml", Comments: "Nottingham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c192c64231d1a6b8", URL: "https://www.nbclosangeles.com/news/local/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "adb9e41e0c7a17e4", URL: "https://www.dailymail.co.uk/news/united-nations/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ecba806b72b1723c", URL: "https://www.fox4now.com/news/local-news.rss", Comments: "Fort Myers, FL", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "798df8f3060d5417", URL: "http://www.plymouthherald.co.uk/rss.xml", Comments: "UK, Plymouth", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2adf17da4cdee58c", URL: "https://www.ethiopiaobserver.com/feed/", Comments: "Ethiopia", Language: "en", Status: "active", Country: "ET", Tier: 2},
+	{ID: "1106684aa8474821", URL: "https://www.nbcchicago.com/tag/chicago-weather/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "161eddd77b7c53bb", URL: "https://www.kpax.com/news/montana-news.rss", Comments: "Montana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "79e64d90fa7ffcf8", URL: "https://www.bolnews.com/international/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "455f738126e38382", URL: "https://www.eastidahonews.com/news/feed/", Comments: "East Idaho, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "20b51f3ea252d000", URL: "https://www.alternet.org/feeds/human-rights.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "63485759a7c5d49c", URL: "https://www.sxm-talks.com/category/local-news/feed/", Comments: "Sint Maarten", Language: "en", Status: "active", Country: "SX", Tier: 2},
+	{ID: "388d3dbfb8fa6f65", URL: "https://kenyagist.com/category/news/feed", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "7e3b8c6e46051232", URL: "http://www.polsatnews.pl/rss/swiat.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "aa56da969d8c3194", URL: "https://www.nbcwashington.com/tag/northern-virginia/feed/", Comments: "Northern Virginia, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "42586d85b9696ec7", URL: "https://www.surinenglish.com/rss/2.0/?section=surinenglish/malaga/estepona-manilva", Comments: "Estepona-Manilva, Spain", Language: "en", Status: "active", Country: "ES", Tier: 2},
+	{ID: "f97a09db905ac26d", URL: "https://www.dailymail.co.uk/news/rhode-island/index.rss", Comments: "Rhode Island, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cc1199b6f3a79e44", URL: "https://www.christianheadlines.com/topics/israel/rss/", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "df5d131cc3941d85", URL: "https://anash.org/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "996250d5554b2ce0", URL: "https://theglittersonline.com.ng/category/news-cat/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "812e254fa40bb335", URL: "https://www.nbcconnecticut.com/tag/new-haven/feed/", Comments: "New Haven, Connecticut, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6d9ef169b67efbfb", URL: "http://www.igberetvnews.com/feed", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "8f8be410f3501726", URL: "https://www.iraqhurr.org/api/", Comments: "Iraq", Language: "en", Status: "active", Country: "IQ", Tier: 2},
+	{ID: "3b016333a953070c", URL: "https://www.irishexaminer.com/feed/35-top_news.xml", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "0f3cd24a5d7f67e0", URL: "https://www.thetruthaboutguns.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "93a921538ea07a83", URL: "https://feeds.npr.org/1004/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2b8684401bf979b2", URL: "https://www.dailymail.co.uk/news/nigeria/index.rss", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "0bcc0a5430b6c4ae", URL: "https://www.bucksherald.co.uk/rss", Comments: "Bucks, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "320882b786e81be1", URL: "https://www.weku.org/npr-news.rss", Comments: "WEEKU", Language: "en", Status: "active", Tier: 2},
+	{ID: "4c35db1a2c3156cb", URL: "https://www.businesslive.co.za/rss/?publication=bd&section=national", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "1a1f873f3b2cf980", URL: "https://www.newtimes.co.rw/rssFeed/14", Comments: "Rwanda", Language: "en", Status: "active", Country: "RW", Tier: 2},
+	{ID: "a464f3bcba5a5f63", URL: "https://globalnews.ca/national/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "0880f82bcad993a8", URL: "https://www.birminghammail.co.uk/news/rss.xml", Comments: "Birmingham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e716a3fd2359cad4", URL: "https://economictimes.indiatimes.com/news/india/rssfeeds/81582957.cms", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "967cc0e3998bb040", URL: "https://www.jta.org/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b038f0f49ddf8999", URL: "https://943wsc.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "df05adb7fe7f0e00", URL: "https://www.examiner.co.uk/news/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d7f9e2ae164f4a34", URL: "https://theshiftnews.com/category/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c612ccd0b74e370", URL: "https://bringmethenews.com/.rss/full/wisconsin-news", Comments: "Wisconsin, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "df6da87e86423fc8", URL: "https://www.khaama.com/category/afghanistan/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "3e0532f2aff206a5", URL: "https://globalnews.ca/london/feed/", Comments: "London, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "2ed34ed1366459ec", URL: "https://www.citywatchla.com/index.php/375-voices?format=feed&type=atom", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "7dbe3a33bd5164f0", URL: "https://www.fox17online.com/news/morning-news.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "14f552b6d94029ad", URL: "https://vancouversun.com/category/news/local-news/feed.xml", Comments: "Vancouver, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "3eb67e0edb25a868", URL: "https://www.nbcbayarea.com/investigations/feed/", Comments: "Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "656bea171caa20d7", URL: "https://www.wadenapj.com/index.rss", Comments: "Wadena, Iowa, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0fe95bbb5a1f68bc", URL: "https://alerts.weather.gov/cap/ar.php?x=0", Comments: "Arkansas, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f30e588a2f9d470e", URL: "https://www.euronews.com/rss?format=mrss&level=theme&name=news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7fbc27efdd329275", URL: "https://www.wnky.com/category/news/feed/", Comments: "Kentucky, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "557c81a28194c962", URL: "https://telanganatoday.com/world/feed", Comments: "Telangana, India, global", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "9cb35ca5b53356e0", URL: "https://www.dailymail.co.uk/news/egypt/index.rss", Comments: "Egypt", Language: "en", Status: "active", Country: "EG", Tier: 2},
+	{ID: "669df10500669ced", URL: "https://www.the-journal.com/feeds/local-news", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "e9e3d411685a3e74", URL: "https://www.fenews.co.uk/category/social-impact/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "98b611e252d63bf4", URL: "https://www.sundayworld.com/rss/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "ce612cdf70e23999", URL: "https://www.fox47news.com/neighborhoods/downtown-old-town-reo-town.rss", Comments: "Lansing, MI, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0254cef9cf2797fd", URL: "https://talkradio1059.iheart.com/topic/national-news.rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "11f24a951c94efd5", URL: "https://www.cbsnews.com/latest/rss/evening-news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "eaa1727dd8462a1a", URL: "https://www.lex18.com/news/state-of-the-commonwealth.rss", Comments: "Kentucky, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0c4bd4e26fa29b1c", URL: "https://newsbulletin247.com/world/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c665bcab17a5a95", URL: "http://www.berkshireeagle.com/search/?f=rss&t=article&c=weather&l=50&s=start_time&sd=desc", Comments: "Berkshire", Language: "en", Status: "active", Tier: 2},
+	{ID: "3469f916e4ea44eb", URL: "https://cde.news/category/news/asia/feed/", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "085bd8b971fab0b7", URL: "http://coloradosun.com/feed", Comments: "Colorado, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "11cc9fc92f95ff21", URL: "https://www.malaysiakini.com/rss/en/news.rss", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "8f4b3371275dc4ca", URL: "https://www.africa-press.net/zambia/feed", Comments: "Africa, Zambia", Language: "en", Status: "active", Country: "ZM", Tier: 2},
+	{ID: "caed4a555c333086", URL: "https://www.ukiahdailyjournal.com/feed/", Comments: "Ukiah, California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "00a2a70fbc8a9bf0", URL: "https://tolland.dailyvoice.com/rss/", Comments: "Tolland, Connecticut, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "50bb09885b7e6961", URL: "https://www.khaama.com/tag/taliban/feed/", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "25756ac1bdf07c30", URL: "https://www.dailymail.co.uk/news/texas/index.rss", Comments: "Texas, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e43cd63c0bf73a4e", URL: "https://www.thehindu.com/news/cities/bangalore/feeder/default.rss", Comments: "Bangalore, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "80a998c3dbcaeb10", URL: "https://yorktown.dailyvoice.com/rss/", Comments: "Yorktown, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d319ce337e9f4c95", URL: "https://www.radiofree.org/tag/aid/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ca2b175def771843", URL: "http://news.ontario.ca/newsroom/en/rss/allnews.rss", Comments: "Ontario", Language: "en", Status: "active", Tier: 2},
+	{ID: "3d97da26a6ffb65c", URL: "https://globalnews.ca/durham/feed/", Comments: "Durham, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "e4c666b0f86daff4", URL: "https://www.nigerianeye.com/feeds/posts/default", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "f5371073548df63e", URL: "https://www.kpcw.org/npr-top-stories.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b30881f21961d570", URL: "http://setopati.net/feed", Comments: "Nepal", Language: "en", Status: "active", Country: "NP", Tier: 2},
+	{ID: "acf40d97627eef69", URL: "https://english.alaraby.co.uk/rss.xml?current=/search", Language: "en", Status: "active", Tier: 2},
+	{ID: "8167950a77395fd4", URL: "https://www.birminghammail.co.uk/rss.xml", Comments: "UK, Birmingham", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "88d3f16936165a4c", URL: "https://www.softpower.ug/feed/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "6f185337f6c5ce36", URL: "https://www.shorenewsnetwork.com/category/local-news-nj/new-jersey/feed/", Comments: "New Jersey, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f222ba6767166ded", URL: "https://trinidad.us/aggregator/rss", Comments: "Trinidad, Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "648b497d58639c5e", URL: "https://www.africaninsider.com/feed/", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "dfdd9b991650663e", URL: "https://www.commondreams.org/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "93682245093644a4", URL: "https://www.wshu.org/npr-news.rss", Comments: "WHSH", Language: "en", Status: "active", Tier: 2},
+	{ID: "6cb37c31887f7bfe", URL: "https://www.gazettelive.co.uk/news/teesside-news/rss.xml", Comments: "Teesside, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "64c2e59dfac8505e", URL: "https://news.google.com/rss/topics/CAAqJggKIiBDQkFTRWdvSUwyMHZNRGx1YlY4U0FtVnVHZ0pWVXlnQVAB?hl=en-US&gl=US&ceid=US:en", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "36e8b0850dfb2ea2", URL: "https://news.stv.tv/section/world/feed", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "5bcf11c0747a903d", URL: "https://www.helpnetsecurity.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4d922bab0f1c3158", URL: "https://www.coventrytelegraph.net/news/rss.xml", Comments: "UK, Coventry", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "babedb763f39adc1", URL: "https://deccanchronicle.com/rss_feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "64c4009c003fa436", URL: "https://www.wbnowqct.com/feed/", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ecd46e65d52fccfc", URL: "https://asianewsnetwork.net/category/jammu-kashmir/feed/", Comments: "Jammu & Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "4f3c82d5daf364db", URL: "https://brookfield.dailyvoice.com/rss/", Comments: "Brookfield, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cee680955e04f38d", URL: "http://www.kerr-fatou.com/feed/", Comments: "Gambia", Language: "en", Status: "active", Country: "GM", Tier: 2},
+	{ID: "e18ba41b455e44cd", URL: "https://www.freemalaysiatoday.com/category/category/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "001d61e8fd1c24fd", URL: "https://kgmi.com/news/feed/", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "99fe6863a8027538", URL: "http://www.sherwoodparknews.com/rss", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "3a0c8c6dde9a3ab5", URL: "http://www.dailypost.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e57f19b43381b968", URL: "http://www.warrington-worldwide.co.uk/feed/", Comments: "Warrington, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0ec31336ef743ca9", URL: "https://www.nbcchicago.com/tag/california/feed/", Comments: "Chicago, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e64fd6a4868d2a66", URL: "https://axanewz.com/e-news/?feed=rss2", Comments: "Azerbaijan", Language: "en", Status: "active", Country: "AZ", Tier: 2},
+	{ID: "5000d8e2fdfbda08", URL: "https://www.bangkokpost.com/rss/data/world.xml", Comments: "Bangkok, world", Language: "en", Status: "active", Tier: 2},
+	{ID: "64e330afb87c855d", URL: "https://rss.iol.io/iol/saturday-star", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "a34b7b40ff01613e", URL: "http://chicago.suntimes.com/rss/index.xml", Comments: "USA, Chicago", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9510d8579faf750f", URL: "https://www.boston.com/feed/", Comments: "Boston, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "acc3589985a0377d", URL: "http://feeds.thecambodianews.net/rss/c7e1014a94f7e43b", Comments: "Cambodia", Language: "en", Status: "active", Country: "KH", Tier: 2},
+	{ID: "615adb6776e52ff5", URL: "https://www.nbcbayarea.com/?rss=y", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fb364f3ecb35059b", URL: "https://www.environmental-expert.com/rss/news-8/waste-recycling", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "65114ce1be9a34c3", URL: "https://globalnews.ca/ottawa/feed/", Comments: "Ottawa", Language: "en", Status: "active", Tier: 2},
+	{ID: "330b9cd052f25e64", URL: "https://www.marinij.com/local-news/feed/", Comments: "Marin County, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "43b84e1aefd13e89", URL: "https://www.dailyfreeman.com/feed/", Comments: "Kingston, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "837a8c95d1258a09", URL: "https://www.thehindu.com/news/cities/Visakhapatnam/feeder/default.rss", Comments: "Visakhapatnam, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "e6c4b44d09786540", URL: "https://jamaica-gleaner.com/feed/news.xml", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "c159cd3bb1fd8ad1", URL: "https://freerepublic.com/tag/*/feed.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "fdde4b5029b66a09", URL: "https://www.leeds-live.co.uk/news/leeds-news/rss.xml", Comments: "Leeds, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "e3181f1618d19bc5", URL: "https://www.shorenewsnetwork.com/category/local-news-nj/new-jersey/jersey-shore-news/feed/", Comments: "Jersey Shore, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "02d6e246c1b54fe8", URL: "http://yellowscene.com/feed/", Comments: "Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f15c8d92a0b1f13d", URL: "https://www.scottishdailyexpress.co.uk/news/scottish-news/rss.xml", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9d87e8e62adc704a", URL: "http://tempo.com.ph/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "c5259857aff11906", URL: "https://www.pressenza.com/-/press-releases/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1e36966579c203e0", URL: "https://feeds.feedburner.com/americanthinker_blog", Language: "en", Status: "active", Tier: 2},
+	{ID: "57f0946b37a62226", URL: "https://www.katc.com/news/st-landry-parish.rss", Comments: "St. Landry Parish, Louisiana", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "dd28280faf7e3de0", URL: "https://rss.24-7pressrelease.com/rss/government_foreign_policy.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "11c530a3c38dcb38", URL: "https://www.postbulletin.com/news.rss", Comments: "Rochester, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "51ed3e948aaa387e", URL: "https://nationalpost.com/feed/", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "3b8f084e17f6df64", URL: "https://www.dailymail.co.uk/news/wales/index.rss", Comments: "Wales", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0cc511f316fc3655", URL: "https://westbridgfordwire.com/news/west-bridgford/feed/", Comments: "West Bridgford, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "991f3afc791d2ace", URL: "https://www.asdnews.com/rss-feed/?channelId=45", Language: "en", Status: "active", Tier: 2},
+	{ID: "7b2f0f811eac59ef", URL: "https://www.stokesentinel.co.uk/news/stoke-on-trent-news/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "40630445cec8fa41", URL: "https://www.nugget.ca/feed", Comments: "North Bay, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "43988b55ff87347a", URL: "https://feedpress.me/InsideAppalachia", Comments: "Appalachia, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7caebbdfd1dac1bc", URL: "https://www.southpunjabnews.com/feeds/posts/default", Comments: "South Punjab, Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "ea2cf4702f3443b8", URL: "https://asiapacificreport.nz/feed/", Comments: "Asia Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "2cb8790d24303aab", URL: "https://theappeal.org/tag/wrongful-conviction/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "70236a8113e5ae0a", URL: "https://thediplomat.com/category/flashpoints/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "798f4fc2abd7bc17", URL: "https://www.shieldsgazette.com/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "89055937f643b7a6", URL: "https://www.newsanyway.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "cfb811e8afe42b73", URL: "http://feeds.hawaiitelegraph.com/rss/6b17ec7a35065289", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c5359daae9c0e5cc", URL: "https://www.independent.ie/rss/", Comments: "Ireland, Europe", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "289925622527f2e2", URL: "https://middlesex.dailyvoice.com/rss/", Comments: "Middlesex, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a347c0a2eefe28b6", URL: "https://www.perhamfocus.com/index.rss", Comments: "Perham, MN", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fb4538642ef74df0", URL: "https://en.neonetwork.pk/rss/pakistan", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "e532fbd6668891ff", URL: "https://windsorstar.com/feed/", Comments: "Windsor, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "dc212136c927ef6c", URL: "https://english.alaraby.co.uk/rss.xml?current=/tag/45245/Palestinian_resistance", Comments: "Palestine", Language: "en", Status: "active", Country: "PS", Tier: 2},
+	{ID: "be3bdaab90aebbd0", URL: "https://www.nknews.org/feed/", Comments: "NK", Language: "en", Status: "active", Tier: 2},
+	{ID: "5a4b32d944c6e34b", URL: "https://thenewamerican.com/video/deep-state/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "73594ca1764042b5", URL: "https://www.dailymail.co.uk/news/jeffrey_epstein/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "832cca1ee99050cd", URL: "https://news.smh.com.au/rss/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ceb49107af33617c", URL: "https://www.nbc5.com/news/local/chicago-politics/feed/", Comments: "Chicago, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9f096ea00ea745ba", URL: "https://egyptianstreets.com/feed/atom/", Comments: "Egypt, Middle East", Language: "en", Status: "active", Country: "EG", Tier: 2},
+	{ID: "914e9b98d555215f", URL: "https://jerseyeveningpost.com/category/morenews/uknews/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1a0cfb53e25c735c", URL: "https://www.nbcmiami.com/tag/caught-on-camera/feed/", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "498975abff0a90b5", URL: "https://www.nepm.org/national-world-news.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4504b257813464e9", URL: "https://www.newsfetchers.com/feeds/posts/default?alt=rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a824d3dc9628104f", URL: "https://www.nwpb.org/feed/", Comments: "Washington State", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "986401686c40cb48", URL: "https://www.atlanticcouncil.org/issue/defense-industry/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "363c90d9250aacb3", URL: "https://www.perthnow.com.au/news/feed", Comments: "Perth, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "a49c2ef1442ec7fa", URL: "https://greenwich.dailyvoice.com/rss/", Comments: "Greenwich, CT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "87c147c984e2c42f", URL: "https://www.navsea.navy.mil/DesktopModules/ArticleCS/RSS.ashx?ContentType=1&Site=705&isdashboardselected=0&max=20&Year=2015&Month=0", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1e7798c221f7187e", URL: "https://yubanet.com/category/regional/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "febaf3f0adaf2e7c", URL: "https://www.radiofree.org/tag/justice/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "83e43c5eb77e914e", URL: "http://feeds.russiaherald.com/rss/723971d98160d438", Comments: "Russia", Language: "en", Status: "active", Country: "RU", Tier: 2},
+	{ID: "eaace43ecb841f27", URL: "https://www.atlanticcouncil.org/region/china/feed/", Comments: "China", Language: "en", Status: "active", Country: "CN", Tier: 2},
+	{ID: "34e650ff868c5913", URL: "https://www.dailymail.co.uk/news/fbi/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6c6a920e6a1c61c1", URL: "https://www.dailymail.co.uk/news/south_africa/index.rss", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "4e0c5409d71dda35", URL: "https://theappeal.org/topics/punishment/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0afda3708d1c698a", URL: "https://www.arabnews.jp/en/feed/", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "cd11347b96ae1066", URL: "https://perilofafrica.com/category/africa/feed", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "af55c2360c478663", URL: "https://news.google.com/rss/search?q=%22landslide%22+OR+%22snowslide%22+OR+%22avalanche%22&hl=en-US&gl=US&ceid=US:en", Language: "en", Status: "active", Tier: 2},
+	{ID: "2bb9880fed6ff6ad", URL: "https://www.dailymail.co.uk/news/kim_jong_un/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7a98b2e4af311f79", URL: "https://www.dailymail.co.uk/news/taiwan/index.rss", Comments: "Taiwan", Language: "en", Status: "active", Country: "TW", Tier: 2},
+	{ID: "a261f3569e005533", URL: "https://www.kxxv.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bdac27d74348d3a8", URL: "https://krdo.com/category/news/state-regional-news/feed/", Comments: "US, Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a45981ca975dc0fe", URL: "http://dailynewsegypt.com/feed/", Comments: "Egypt, global", Language: "en", Status: "active", Country: "EG", Tier: 2},
+	{ID: "6a1dcac41be4a2ab", URL: "http://cphpost.dk/?feed=rss2", Comments: "Copenhagen, Denmark, Europe", Language: "en", Status: "active", Country: "DK", Tier: 2},
+	{ID: "2e1b21a188af1043", URL: "https://independent.ng/category/featured/feed/", Comments: "Nigeria, Africa", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "6fa9f64ccf48fae0", URL: "https://adnamerica.com/en/rss.xml", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "ec65cfdbfcff61b5", URL: "https://www.manchestereveningnews.co.uk/news/?service=rss", Comments: "UK, Manchester", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4088bad934b0dcb9", URL: "https://theprovince.com/feed", Comments: "Vancouver", Language: "en", Status: "active", Tier: 2},
+	{ID: "abbd8606960d112c", URL: "http://feeds2.feedburner.com/frontpagenewsatcalcoastnews?format=xml", Comments: "California, Central Coast", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2c3fd773cc108d8e", URL: "https://www.mylondon.news/news/south-london-news/rss.xml", Comments: "UK, South London", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ab92f1691eae8e3f", URL: "https://www.rawstory.com/feeds/front-page-featured-1.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1d7ec035c5a793b5", URL: "https://www.vicksburgpost.com/category/news/feed/", Comments: "Vicksburg, Mississippi, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "40916648f80b4f43", URL: "https://www.timesherald.com/feed/", Comments: "US, Norristown, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c21e1511ff8a2e24", URL: "https://english.alaraby.co.uk/rss.xml?current=/tag/52029/Sahel.", Comments: "Sahel", Language: "en", Status: "active", Tier: 2},
+	{ID: "5fa1077d67918466", URL: "https://bearingarms.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cd238c8f15200771", URL: "https://www.delcotimes.com/news/crime-public-safety/feed/", Comments: "US, Delaware County, PA, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "53f70eec79ffa381", URL: "http://auburnpub.com/search/?f=rss&t=article&c=news/national&l=50&s=start_time&sd=desc", Comments: "US, Auburn, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "281fa6b5aeaddcbe", URL: "https://newsafricanow.com/category/south-africa/feed/", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "b82cc596e382e004", URL: "https://bernews.com/category/bermuda-news/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "cb0f2de0cf90ac75", URL: "https://www.silive.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "US, Staten Island, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "0cab28287cc539db", URL: "https://www.ft.com/world/us?format=rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "414181a29f3f7261", URL: "https://pajhwok.com/category/accidents-disasters/feed/", Comments: "Afghan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "afd9d90f50b6c5f4", URL: "https://www.themoscowtimes.com/rss/news", Comments: "Russia, region", Language: "en", Status: "active", Country: "RU", Tier: 2},
+	{ID: "666d381202dad251", URL: "http://gazette.com/search/?f=rss&t=article&c=news/local&l=50&s=start_time&sd=desc", Comments: "Colorado Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c156c670f99ea24", URL: "https://midutahradio.com/feed/", Comments: "US, Utah", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "970df6d910559bc7", URL: "https://www.khaama.com/category/world/asia/feed/", Comments: "Afghan, Asia", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "db09d95924b69b5d", URL: "https://phys.org/rss-feed/breaking/", Language: "en", Status: "active", Tier: 2},
+	{ID: "b2eb2bc38367ea24", URL: "https://tennews.in/News/india-news/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "6c37c5e33f629639", URL: "https://www.dailymail.co.uk/news/france/index.rss", Comments: "France", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "59664a5ccb07b566", URL: "https://gnnhd.tv/rss/Pakistan", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "1a64d896fcb431ca", URL: "https://www.india.com/maharashtra/feed/", Comments: "Maharashtra, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "6b2a681f049ee799", URL: "https://www.somalidispatch.com/feed/", Comments: "Somalia, East Africa", Language: "en", Status: "active", Country: "SO", Tier: 2},
+	{ID: "a126ec3970b44006", URL: "https://www.recorder.com/SpecialPages/RSS?feed=News", Comments: "US, Greenfield, MA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2cea3d4f9618d618", URL: "https://www.pottsmerc.com/local-news/feed/", Comments: "Pottstown, PA, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6892a6478ad6abb7", URL: "https://www.firerescue1.com/firefighting-rss-feeds/news.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "5a570e3e0f121bc7", URL: "https://www.wptv.com/news/region-c-palm-beach-county.rss", Comments: "Palm Beach County, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e69aa1f6f8679cef", URL: "https://www.oregonlive.com/arc/outboundfeeds/rss/?outputType=xml", Comments: "Oregon, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c8a2a6c36f7df8a8", URL: "https://fairlawn.dailyvoice.com/rss/", Comments: "US, NJ, Fair Lawn", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "69f57e192bd6ded4", URL: "https://www.q102.ie/news/q102-news/feed.xml", Comments: "Dublin", Language: "en", Status: "active", Tier: 2},
+	{ID: "448264a6c1a5e95e", URL: "https://www.narcity.com/feeds/toronto.rss", Comments: "Toronto", Language: "en", Status: "active", Tier: 2},
+	{ID: "90aecf8b89716b63", URL: "https://www.devonlive.com/news/rss.xml", Comments: "UK, Devon", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "fb9cc6a8a93f4063", URL: "https://www.nytimes.com/svc/collections/v1/publish/https://www.nytimes.com/section/world/africa/rss.xml", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "4e2407d55a10e609", URL: "http://www.reformer.com/search/?f=rss&t=article&c=community-news&l=50&s=start_time&sd=desc", Comments: "US, Southern Vermont", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "36cba4a1e7bf1da0", URL: "https://feeds.bignewsnetwork.com/category/ffe56b8f30c50146", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e91bb5c5c0960485", URL: "https://www.dailymail.co.uk/news/crime/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5563a7ed46bd8740", URL: "https://www.ktnv.com/news.rss", Comments: "Las Vegas, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "caaada1221cf558f", URL: "https://www.eptrail.com/feed/", Comments: "US, Estes Park, CO", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "20a442431da3bfe4", URL: "https://www.coventrytelegraph.net/news/coventry-news/rss.xml", Comments: "UK, Coventry", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8637611ed9c67294", URL: "https://www.pattayamail.com/thailandnews/feed", Comments: "Thailand", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "536ecdc50fcb5d5a", URL: "https://www.latestnigeriannews.com/feed/thesun/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "66d0094938f989e1", URL: "https://worldcrunch.com/feeds/feed.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "06f0558c310609f0", URL: "https://www.africa-press.net/eswatini/feed", Comments: "Eswatini, Africa", Language: "en", Status: "active", Country: "SZ", Tier: 2},
+	{ID: "532e6c2e61a96014", URL: "https://www.news5cleveland.com/news/local-news/cleveland-metro.rss", Comments: "Cleveland, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "92460a663c53d231", URL: "https://www.latestnigeriannews.com/feed/vanguard/rss.xml", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "bcc10c810b3bcc3f", URL: "https://philnews.ph/category/news/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "9e697a0efb1eee87", URL: "https://feeds.skynews.com/feeds/rss/world.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3733f35d75895ba7", URL: "https://www.news4jax.com/arc/outboundfeeds/rss/category/news/national/?outputType=xml&size=10", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8f1913106c1120b9", URL: "https://www.standard.co.uk//rss", Comments: "UK, Europe", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "b5efba3896792054", URL: "https://www.gdacs.org/xml/rss_eq_5.5_3m.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a459c8ae8abee8c6", URL: "https://www.theage.com.au/rss/environment.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "0597890e0858b750", URL: "https://perilofafrica.com/category/africa/drc/feed", Comments: "Democratic Republic of Congo, Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "63f9e35979b20bf5", URL: "https://en.neonetwork.pk/rss/important-news", Comments: "Pakistan, Asia", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "f229c29b79b7d0a1", URL: "https://www.directrelief.org/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b575711e533d3929", URL: "https://filipinotimes.net/feed/", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "61ade0d4a87e0a2b", URL: "https://www.omanobserver.om/rssFeed/1", Comments: "Oman, Middle East", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "975c25e5090eef3e", URL: "https://www.nbcwashington.com/news/national-international/feed/", Comments: "US, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ffd2f34e10a0b3c7", URL: "https://forefrontng.com/feed/", Comments: "Nigeria, Africa", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "d9f44d7b09ee049d", URL: "https://www.newzimbabwe.com/category/news/zimbabwe/feed/", Comments: "Zimbabwe, Africa", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "6af53d69de77ac4b", URL: "http://www.ibtimes.com.au/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "84ac0dfbdd396369", URL: "https://time.news/category/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f24d7a8700a32a13", URL: "https://www.inewsguyana.com/category/local-news/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "52a14d31be84e85e", URL: "https://news.google.com/rss/search?q=%22looted%22+OR+%22vandalism%22+OR+%22vandalisms%22+OR+%22spray%22+OR+%22vandalized%22&hl=en-US&gl=US&ceid=US:en", Language: "en", Status: "active", Tier: 2},
+	{ID: "fa7be5505b6e4240", URL: "https://news.google.com/rss/search?q=%22sandstorm%22+OR+%22wind+gust%22+OR+%22blizzard%22+OR+%22make+landfall%22+OR+%22severe+thunderstorms%22+OR+%22heavy+snowfall%22+OR+%22typhoon%22+OR+%22hurricane%22+OR+%22snowstorm%22+OR+%22sevethunderstorm%22+OR+%22tropical+cyclone%22+OR+%22storm%22+OR+%22tornado%22+OR+%22tropical+storm%22&hl=en-US&gl=US&ceid=US:en", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "4f26facaed35c263", URL: "https://www.dailymail.co.uk/news/florida/index.rss", Comments: "Florida, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "811d14e768f18723", URL: "https://www.wxyz.com/sitemap.rss", Comments: "Detroit, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "24f96ef3e470e51b", URL: "https://jamaica-gleaner.com/latest/feed", Comments: "Jamaica, Caribbean, Latin America", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "50ec96b279aa6c22", URL: "https://bernews.com/category/accidents-and-fires/feed/", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "c258e59d16bdcf02", URL: "https://cliffsidepark.dailyvoice.com/rss/", Comments: "Cliffside Park, NJ", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5756eb4f70829aa6", URL: "https://www.asdnews.com/rss-feed/?channelId=46", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a845a2ca38e8957c", URL: "http://www.limerickleader.ie/rss.jsp?sezione=1", Comments: "Limerick", Language: "en", Status: "active", Tier: 2},
+	{ID: "ff2109a768da9e5a", URL: "https://www.dailymail.co.uk/news/the-home-office/index.rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "6d782c8fc90ffea9", URL: "http://www.southernhighlandnews.com.au/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "a042657fca4aa5d9", URL: "https://www.pressandjournal.co.uk/category/news/environment/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e633fc9e3f99c483", URL: "https://www.gbcghanaonline.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "8ba606fb9eee4620", URL: "http://www.thehindu.com/news/?service=rss", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "218272624944cceb", URL: "https://wercfm.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "20719fed2012a3be", URL: "https://nysun.com/rss/national.xml", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8f96ac8102b48cbe", URL: "https://www.icrc.org/en/rss/region/asia-pacific", Comments: "Asia-Pacific", Language: "en", Status: "active", Tier: 2},
+	{ID: "3ed5e5245fd343f8", URL: "https://www.shropshirelive.com/feed/", Comments: "Shropshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "a4347275ff6b786e", URL: "https://www.rawstory.com/feeds/enviro-science.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "00f99b86c8cd5724", URL: "http://alerts.weather.gov/cap/tn.php?x=0", Comments: "Tennessee", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "39bcc90c7caefe8f", URL: "https://afloat.ie/safety?format=feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "43502f8de4c11d72", URL: "https://seattlemedium.com/feed/", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "63f2f6b92e74dad6", URL: "https://5pillarsuk.com/category/world/feed/", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "835aff90906b1792", URL: "https://www.nbcchicago.com/tag/chicago-police/feed/", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "b9c5c143817bf0b2", URL: "https://www.dailymail.co.uk/news/the-pentagon/index.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "61c417b121f1b812", URL: "https://bernews.com/category/environment/feed", Comments: "Bermuda", Language: "en", Status: "active", Country: "BM", Tier: 2},
+	{ID: "e633fc9e3f99c483", URL: "https://www.gbcghanaonline.com/feed", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "8c1a678a9105045c", URL: "https://www.radiofree.org/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bcb67266b5514a35", URL: "https://www.spyuganda.com/category/news/feed/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "d3facd97c9b46347", URL: "https://www.kivitv.com/sitemap.rss", Comments: "Idaho", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5e468665754ff079", URL: "http://www.theobserver.ca/rss", Comments: "Canadian", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "849e01ee886a0019", URL: "https://www.fox17online.com/news/national-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "66dd7a87feca81c3", URL: "https://www.abc15.com/news.rss", Comments: "Phoenix, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e679bb4dce13556e", URL: "https://9jaflaver.com/category/9ja-news/feed/", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "f5fde4fc1374fdbd", URL: "http://nairobiwire.com/feed", Comments: "Kenya", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "7e4f9aee2d063a0f", URL: "https://www.cbsnews.com/dfw/latest/rss/main", Comments: "Dallas, Fort Worth, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6ffc1d4fdd601614", URL: "https://www.mercopress.com/rss/", Comments: "South Atlantic", Language: "en", Status: "active", Tier: 2},
+	{ID: "c937c194e3ff95f2", URL: "https://www.10news.com/news/local-news.rss", Comments: "San Diego, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7bd01f262a0d53b2", URL: "http://timesofindia.indiatimes.com/rssfeeds/-2128819658.cms", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7d58d3643876ad90", URL: "https://feeds.bignewsnetwork.com/category/d7006824400aaac1", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "05dc9ac38be8c009", URL: "https://www.jdsupra.com/topics/data-breach_rss/", Language: "en", Status: "active", Tier: 2},
+	{ID: "6c1d9b47f2461c3b", URL: "https://kgab.com/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "2dea738dadbebb50", URL: "https://islamabadpost.com.pk/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "59dadc8e1b3a691b", URL: "https://www.eastidahonews.com/news/local/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "53c6a92b6c7fe409", URL: "https://jerseyeveningpost.com/category/morenews/feed/", Comments: "Jersey", Language: "en", Status: "active", Country: "JE", Tier: 2},
+	{ID: "9d0d0fae69a1418d", URL: "https://www.standard.co.uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "94cad646f0465fe5", URL: "https://www.africa-press.net/liberia/feed", Comments: "Liberia", Language: "en", Status: "active", Country: "LR", Tier: 2},
+	{ID: "eb7ca5eccf71daf0", URL: "http://www.westsideseattle.com/rss.xml", Comments: "Seattle", Language: "en", Status: "active", Tier: 2},
+	{ID: "c14afb5654e6729e", URL: "https://abc17news.com/category/news/national-world/feed/", Comments: "national, world", Language: "en", Status: "active", Tier: 2},
+	{ID: "840ec7ef49e2d794", URL: "https://www.thehindu.com/news/cities/Mangalore/feeder/default.rss", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "38ea29b975e95606", URL: "https://www.rawstory.com/feeds/feed.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "c4575fca31930822", URL: "https://www.africanexaminer.com/category/news-across-nigeria/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "404f9eeca88749f0", URL: "https://www.therakyatpost.com/category/news/malaysia/feed/", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "ddb38e325ba68f08", URL: "https://frontpage.lk/feed/", Comments: "Sri Lanka", Language: "en", Status: "active", Country: "LK", Tier: 2},
+	{ID: "deb020d8bdbc5e5e", URL: "https://camden.dailyvoice.com/rss/", Comments: "US - Camden", Language: "en", Status: "active", Tier: 2},
+	{ID: "82135e14603f4027", URL: "https://www.thehindu.com/feeder/default.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e9b6f56b2b296a61", URL: "https://en.neonetwork.pk/rss/world", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "46fcdc88da3007ec", URL: "https://www.africanexaminer.com/feed/", Comments: "African", Language: "en", Status: "active", Tier: 2},
+	{ID: "d3cfa9c129991086", URL: "https://www.rawstory.com/feeds/smartnews.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "f6f353c60d53b237", URL: "https://infotel.ca/rssfeed/vernon", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "f60952be05a5438b", URL: "https://www.theguardian.com/world/ukraine/rss", Comments: "Ukraine", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "609ffe2683a42ac7", URL: "https://arlington.dailyvoice.com/rss/", Comments: "Arlington", Language: "en", Status: "active", Tier: 2},
+	{ID: "84f06c70b2392809", URL: "https://www.voa.gov/api/zqbomekvi_", Language: "en", Status: "active", Tier: 2},
+	{ID: "6d9ef169b67efbfb", URL: "https://www.igberetvnews.com/feed", Comments: "Igberetv, Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "db3af8f068e00a52", URL: "https://www.dailymail.co.uk/news/ohio/index.rss", Comments: "Ohio", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ea3dea5dfee2167a", URL: "https://www.monitor.co.ug/rss.xml", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "7b94889db7d63b6d", URL: "https://www.thehindu.com/news/national/feeder/default.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e6b81199125eca66", URL: "https://www.dailymail.co.uk/news/worldnews/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8332f86efe2b9659", URL: "https://artsakh.news/en/rss/", Comments: "Artsakh (Nagorno-Karabakh)", Language: "en", Status: "active", Tier: 2},
+	{ID: "43869f1c13aa3279", URL: "https://www.latestnigeriannews.com/feed/theherald/rss.xml", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "e8259584564a5e3e", URL: "https://www.startribune.com/rss/?sf=1&s=/", Comments: "Minneapolis", Language: "en", Status: "active", Tier: 2},
+	{ID: "f533f1448960b221", URL: "https://www.dailypost.co.uk/?service=rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2220fd78ffbdf9bd", URL: "https://www.africanexaminer.com/category/top-stories/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "33c10296843f7e74", URL: "https://trumbull.dailyvoice.com/rss/", Comments: "US, Trumbull", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "83680bf9d3e2f3b1", URL: "https://www.theguardian.com/world/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "105a02c22bd18641", URL: "https://tribune.com.pk/feed/world", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "dce6ac6dc1006c1b", URL: "https://www.dailystar.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "59355d9daa4c3921", URL: "https://www.walesonline.co.uk/rss.xml", Comments: "Wales", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0ce5e81575d7b156", URL: "https://newsroomodisha.com/feed/", Comments: "India, Odisha", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7d232a1efcce00f1", URL: "https://www.irishexaminer.com/feed/566-ireland_rss_summary.xml", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "7a7b542953e16cad", URL: "https://www.spokesman.com/feeds/stories/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6486e7fc6e602b4f", URL: "https://www.readingeagle.com/local-news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "b8c0655dfb64e462", URL: "https://www.independent.co.uk/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "dd8462ed95ddf379", URL: "https://www.latestnigeriannews.com/feed/dailyindependent/rss.xml", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "a20ae7985fbf9722", URL: "https://www.nzherald.co.nz/arc/outboundfeeds/rss/section/news/?outputType=xml&_website=rotorua-daily-post", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "a8b0a2cf5c3e150b", URL: "https://www.nottinghampost.com/news/rss.xml", Comments: "Nottingham", Language: "en", Status: "active", Tier: 2},
+	{ID: "9581773465252623", URL: "https://www.grimsbytelegraph.co.uk/news/grimsby-news/rss.xml", Comments: "Grimsby", Language: "en", Status: "active", Tier: 2},
+	{ID: "193be0806d710060", URL: "https://hubnetwork.in/category/news/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "0f53c95367af03a4", URL: "https://theglobepost.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fd71550b6a6e4cb1", URL: "https://www.fox47news.com/news/local-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fc09061a9b81ed3d", URL: "https://edmontonjournal.com/feed/", Comments: "Edmonton", Language: "en", Status: "active", Tier: 2},
+	{ID: "8f7ed791a5f31914", URL: "https://www.omanobserver.om/rssFeed/4/54", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "f0cb1fca1f1a9073", URL: "https://www.kunc.org/npr-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d5e7e6b4bbc4ea1d", URL: "https://canoe.com/feed", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "77f4885542982c07", URL: "https://1190talkradio.iheart.com/topic/national-news.rss/", Language: "en", Status: "active", Tier: 2},
+	{ID: "979fd74ab0915425", URL: "https://yubanet.com/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "069f0983e4d9af42", URL: "https://cocorioko.net/feed/", Comments: "Sierra Leone", Language: "en", Status: "active", Country: "SL", Tier: 2},
+	{ID: "4fbd82e118c14969", URL: "http://www.gdacs.org/xml/gdacs_cap.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1085db3ba20c4444", URL: "https://qns.com/feed/", Comments: "Queens, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "abe413dd38286be5", URL: "https://en.baaghitv.com/category/pakistan/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "888128597ea5cb7f", URL: "https://www.mynewsgh.com/feed/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "3895c0cd76149cb8", URL: "https://www.nbcmiami.com/tag/miami-dade-county/feed/", Comments: "Miami-Dade County", Language: "en", Status: "active", Tier: 2},
+	{ID: "d3c31444340c6ec8", URL: "http://www.aviation24.be/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "126770fada7d3415", URL: "http://news.met.police.uk/rss/source/60923/video", Comments: "London", Language: "en", Status: "active", Tier: 2},
+	{ID: "b563a344870caa45", URL: "https://www.abcactionnews.com/news/national.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3dcacce98df240b4", URL: "https://wnbc.com/news/recall-alert/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "bb348cd20a286fea", URL: "http://www.thisissouthdevon.co.uk/rss.xml", Comments: "South Devon, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "29fc1f08eacbef49", URL: "https://www.nbc5.com/consumer/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1090153767424227", URL: "https://www.nbcboston.com/investigations/consumer/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "89660ebdd9f6f8d3", URL: "https://www.atlanticcouncil.org/issue/crisis-management/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5d5dcdb3aaf8a8d", URL: "https://www.indiatvnews.com/rssnews/topstory-india.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "55d6998dc8130643", URL: "https://rss.nytimes.com/services/xml/rss/nyt/World.xml", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e68df9144ba17794", URL: "http://www.westerngazette.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "aaa263ebee6e886d", URL: "https://www.nst.com.my/feed", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "1ab3d4309b8c191b", URL: "https://www.wvpe.org/npr-news.rss", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "70d4b2f846383445", URL: "https://www.derryjournal.com/rss", Comments: "Derry, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0c15b78c30e7d182", URL: "https://www.peterboroughtoday.co.uk/rss", Comments: "Peterborough, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "da0b5bcbaa4cb3b2", URL: "https://japannews.yomiuri.co.jp/feed", Comments: "Japan", Language: "en", Status: "active", Country: "JP", Tier: 2},
+	{ID: "f360a2a969baabc6", URL: "https://www.wnd.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "41ed5466f54b3b7c", URL: "https://businessday.ng/category/news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "4900f6c595221014", URL: "https://www.al-monitor.com/rss", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "cbff8ed41513b00f", URL: "https://america.cgtn.com/category/world-today/feed", Language: "en", Status: "active", Tier: 2},
+	{ID: "0e661e83db1cc4fb", URL: "https://www.winnipegsun.com/rss", Comments: "Winnipeg, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "0c5aee496bec179e", URL: "https://www.insideedition.com/feed/taxonomy/term/news", Language: "en", Status: "active", Tier: 2},
+	{ID: "c1fa462e3772de76", URL: "https://whoradio.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fe3a6b4d47379bc5", URL: "https://cedarnews.net/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac929b9aa307e443", URL: "https://www.newindianexpress.com/States/Odisha/rssfeed/?id=179&getXmlFeed=true", Comments: "Odisha, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "9b3abd93601d9fdd", URL: "https://www.19fortyfive.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9b1a1c1f80e937ea", URL: "https://economictimes.indiatimes.com/news/latest-news/rssfeeds/20989204.cms", Language: "en", Status: "active", Tier: 2},
+	{ID: "5429c0eb0eeaa3fa", URL: "https://www.warwickshireworld.com/rss", Comments: "Warwickshire, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "52207c53cd6c994b", URL: "https://torontosun.com/rss", Comments: "Toronto, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "5f92abcad9312878", URL: "https://www.nationalworld.com/rss", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "fd5b053770434141", URL: "https://chester.dailyvoice.com/rss/", Comments: "Chester, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2c971c726f343387", URL: "https://nenow.in/category/north-east-news/assam/feed", Comments: "Assam, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "5f4d85d9b5f028d0", URL: "https://theloadstar.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0a897eeb78c0ede6", URL: "https://www.voanews.com/api/zqbomekvi_", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9854858208df8a78", URL: "https://www.dailynews.com/news/feed/", Comments: "Los Angeles", Language: "en", Status: "active", Tier: 2},
+	{ID: "fb5a50e8dff0713d", URL: "https://www.3newsnow.com/news/local-news.rss", Comments: "Omaha, NE, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "636e6e62ea7a810f", URL: "https://www.dailymail.co.uk/news/arizona/index.rss", Comments: "Arizona, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a57ddc105ef56c36", URL: "https://www.chroniclelive.co.uk/news/rss.xml", Comments: "Newcastle, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2a80419fdc3bdca8", URL: "https://woay.com/news/local/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1049b1746211c4aa", URL: "https://news.stv.tv/section/scotland/feed", Comments: "Scotland, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "5004e80860bf415a", URL: "https://www.hawaiitribune-herald.com/tag/local/feed/", Comments: "Hawaii, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b2483bd0b8903760", URL: "https://www.naturalnews.com/rss.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c19db0d4624b0de", URL: "https://krdo.com/category/news/national-world/feed/", Comments: "Colorado Springs, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "96336a8a78a5ca91", URL: "https://www.cde.news/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2726a015b5bf0081", URL: "https://alamanahdaily.wordpress.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d1cbab961c3c38d6", URL: "https://losalamosreporter.com/category/public-safety/feed/", Comments: "Los Alamos", Language: "en", Status: "active", Tier: 2},
+	{ID: "4901be7c1dfa56bc", URL: "https://www.thejournal.ie/category/international/feed/", Comments: "Ireland, global", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "aad259fd070f26e1", URL: "http://www.france24.com/en/timeline/rss", Comments: "France", Language: "en", Status: "active", Country: "FR", Tier: 2},
+	{ID: "312eade27be577d7", URL: "https://feeds.feedburner.com/gcaptain", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1230952c49df56ef", URL: "https://www.pinejournal.com/index.rss", Comments: "Minnesota, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1c367851fb924a21", URL: "https://www.rnz.co.nz/rss/national.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "99f1e0b0dfceb295", URL: "https://www.capetownetc.com/feed/", Comments: "Cape Town, South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "3b8bea62528ab5d1", URL: "http://www.sfgate.com/bayarea/feed/Bay-Area-News-429.php", Comments: "San Francisco Bay Area", Language: "en", Status: "active", Tier: 2},
+	{ID: "7c521146da064060", URL: "https://www.morningjournal.com/local-news/feed/", Comments: "Ohio", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "aa2a1cec286b2fcb", URL: "https://www.delcotimes.com/news/feed/", Comments: "Delaware County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a5f04150e31c9fd3", URL: "https://americanmilitarynews.com/feed/", Comments: "US, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f9cfbf92356565da", URL: "https://peekskill.dailyvoice.com/rss/", Comments: "Peekskill, NY", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c00850289ffe5781", URL: "https://www.dailylocal.com/local-news/feed/", Comments: "Chester County, PA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "7b765487521c2cc3", URL: "http://www.chron.com/rss/feed/News-270.php", Comments: "Houston", Language: "en", Status: "active", Tier: 2},
+	{ID: "dc38543047f4b452", URL: "https://www.kxxv.com/news/local-news.rss", Comments: "Waco, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b9df14f968b43b20", URL: "https://www.dailydemocrat.com/feed/", Comments: "Woodland, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "934920ecc280c14b", URL: "https://www.santacruzsentinel.com/feed/", Comments: "Santa Cruz, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "83a0b4f19a00696e", URL: "https://www.voa.gov/api/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9b70923a567dce1b", URL: "https://www.dailybulletin.com/news/feed/", Comments: "San Bernardino County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b31f8c8c9fa3efe9", URL: "https://www.socialnews.xyz/category/accident/feed/", Comments: "Indian", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "eb1c595b95720ce5", URL: "https://www.hrw.org/rss/news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "a98bdaf3c2c7fd7e", URL: "https://antiguabreakingnews.com/feed/", Comments: "Antigua", Language: "en", Status: "active", Tier: 2},
+	{ID: "51635fca59e1dec8", URL: "https://www.marinij.com/feed/", Comments: "Marin County, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c11dc36dc9ffeb0f", URL: "https://thebalochistanpost.net/feed/", Comments: "Balochistan", Language: "en", Status: "active", Tier: 2},
+	{ID: "a137ea906f5da883", URL: "https://ridgefield.dailyvoice.com/rss/", Comments: "Ridgefield, CT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "939340beff238263", URL: "https://vinnews.com/category/breaking-news/us/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1794f263214e519d", URL: "https://accesswdun.com/rss/10/rss.xml", Comments: "Gainesville, NE Georgia, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fce3dc7c303f3939", URL: "https://www.dailylocal.com/news/feed/", Comments: "Chester County, PA, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b4cecb143848d04b", URL: "https://panatimes.com/rss", Comments: "Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "d8ad2289e96a14d6", URL: "https://afloat.ie/safety/lifeboats?format=feed&type=rss", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "e771702e82ab1074", URL: "https://americanmilitarynews.com/category/branches/feed/", Comments: "American", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ad4555466400e429", URL: "https://www.edinburghlive.co.uk/rss.xml", Comments: "Edinburgh, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "db4be3ae55aa9082", URL: "https://intelligencebriefs.com/feed/atom/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "656ed6fdcc17520c", URL: "https://frontpage.lk/feed/rss/", Comments: "Sri Lanka", Language: "en", Status: "active", Country: "LK", Tier: 2},
+	{ID: "cce01e2a8ff2668c", URL: "https://www.socialnews.xyz/category/general/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c5e7ba1d08a733f9", URL: "https://www.reporterherald.com/news/feed/", Comments: "Loveland, Colorado, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6635d4bbc694ee37", URL: "https://mynewsla.com/feed/", Comments: "Los Angeles, California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cfeb8e2504e41316", URL: "https://www.lakemchenryscanner.com/category/news/feed/", Comments: "Lake County, Illinois, McHenry County, Illinois", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f351d98ab7994b9b", URL: "https://afloat.ie/port-news?format=feed&type=atom", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "8c1ad78e598346d8", URL: "https://whdh.com/news/feed/", Comments: "Boston, Massachusetts, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6e623d7629d0ffd0", URL: "https://www.eastmojo.com/national-news/feed/", Comments: "India, EastMojo (India)", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "2708b8772e970ea0", URL: "https://www.staradvertiser.com/category/hawaii-news/feed/", Comments: "Hawaii, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "39d29988b330dbf5", URL: "https://www.derbytelegraph.co.uk/rss.xml", Comments: "Derby, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7aad5d206495a630", URL: "https://www.shorenewsnetwork.com/category/local-news/maryland-news/feed/", Comments: "Maryland, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8ff5b25c72a410d2", URL: "https://vinnews.com/category/breaking-news/israel/feed/", Comments: "Israel", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "9d25d44782ef4f69", URL: "https://ccxmedia.org/featured_news/news-stories/feed/", Comments: "Minnesota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5483295468da7e09", URL: "https://www.newschannel5.com/news/national.rss", Comments: "Nashville, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "cf1c4775d4e6d28f", URL: "https://www.bostonherald.com/news/national-news/feed/", Comments: "Boston", Language: "en", Status: "active", Tier: 2},
+	{ID: "336364b8c9c2101f", URL: "https://www.latestnigeriannews.com/feed/Tori/rss.xml", Comments: "Nigerian", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "cf16fb2a93a01075", URL: "http://www.wfmz.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "Allentown, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ff0a3c2f3a252c51", URL: "https://www.eptrail.com/news/feed/", Comments: "Estes Park, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2982a545a1c73891", URL: "https://fox13now.com/sitemap.rss", Comments: "Salt Lake City, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2208e63f1e9d8fcd", URL: "https://www.jamestownsun.com/index.rss", Comments: "Jamestown, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f77807b573d303ca", URL: "https://www.calgarysun.com/rss", Comments: "Calgary, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "e95441ee9a9a53d7", URL: "https://www.ksby.com/sitemap.rss", Comments: "San Luis Obispo, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e1fdee93f4169b41", URL: "https://www.wtxl.com/sitemap.rss", Comments: "Tallahassee, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5d738c53b3bf37b8", URL: "https://telanganatoday.com/andhra-pradesh/feed", Comments: "Andhra Pradesh, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "0ddde20fe084fccd", URL: "https://www.theobserver.ca/feed", Comments: "Sarnia, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "531531c4d920904b", URL: "https://www.omanobserver.om/rssFeed/55/42", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "c91febd4be26bb6c", URL: "https://wibx950.com/feed/", Comments: "Utica, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ac99cd1b111008c7", URL: "https://newsroomodisha.com/category/odisha/feed/", Comments: "Odisha", Language: "en", Status: "active", Tier: 2},
+	{ID: "634e1e4fd326f017", URL: "https://www.theonefeather.com/feed/", Comments: "Cherokee", Language: "en", Status: "active", Tier: 2},
+	{ID: "e779a55605aa10b0", URL: "https://www.eastmojo.com/news/feed/", Comments: "EastMojo (India), India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "fae1fa89603f91cc", URL: "https://mingooland.com/category/general/feed/", Comments: "Mingooland", Language: "en", Status: "active", Tier: 2},
+	{ID: "c66c98b66555bfb7", URL: "https://www.brisbanetimes.com.au/rss/environment.xml", Comments: "Brisbane", Language: "en", Status: "active", Tier: 2},
+	{ID: "61ee53357044982f", URL: "https://www.africa-press.net/sierra-leone/feed", Comments: "Sierra Leone, Africa", Language: "en", Status: "active", Country: "SL", Tier: 2},
+	{ID: "3601793026e16b32", URL: "https://www.omanobserver.om/rssFeed/3/31", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "02ff3b96bc59c77b", URL: "https://z94.com/feed/", Comments: "Wausau, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "38a7613d0c77a103", URL: "https://www.mylondon.news/news/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1fc7cd22dc0127ee", URL: "http://www.glasgowlive.co.uk/rss.xml", Comments: "Glasgow, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ce8ee457351df763", URL: "https://www.independent.ie/news/rss/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "c10971165f57dc58", URL: "https://home.nzcity.co.nz/rss/news.aspx?catid=1032", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "ab8e752aeaba548c", URL: "https://vancouversun.com/feed/", Comments: "Vancouver, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "ef6c648c2dea9c19", URL: "https://www.dailymail.co.uk/news/new_york/index.rss", Comments: "New York, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "22ac94c5de953543", URL: "http://timesofindia.indiatimes.com/rssfeeds/2950623.cms", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "bd9fe125829b2aae", URL: "https://english.alaraby.co.uk/rss.xml?current=/tag/19045/elWaq", Comments: "AlAraby English", Language: "en", Status: "active", Tier: 2},
+	{ID: "9e4d68c1d8a3de73", URL: "https://www.kentlive.news/news/kent-news/rss.xml", Comments: "Kent, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "71052f6179f262aa", URL: "https://newsroomodisha.com/category/india/feed/", Comments: "India, Odisha", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "8dd34074bba8bc68", URL: "https://wsyr.iheart.com/topic/national-news.rss/", Comments: "Syracuse, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e6bb75bcc5d0ad2b", URL: "https://www.dailyfreeman.com/news/feed/", Comments: "Kingston, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b78a2c0bf77bb7fa", URL: "https://www.timeslive.co.za/rss/?section=news", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "c17b005c5a8fa7de", URL: "https://www.mumbailive.com/rss/en/latest.xml", Comments: "Mumbai, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "87de6de5e5147bfd", URL: "https://www.953mnc.com/feed/", Comments: "Elkhart, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ad6923023e131947", URL: "https://www.omanobserver.om/rssFeed/3/32", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "ca4a02bd016acdd3", URL: "https://www.birminghammail.co.uk/news/midlands-news/rss.xml", Comments: "Birmingham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ac54e228f0dd13c3", URL: "https://1190kex.iheart.com/topic/national-news.rss/", Comments: "Portland, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "28a472940965d817", URL: "https://www.news5cleveland.com/news/local-news/oh-cuyahoga.rss", Comments: "Cuyahoga County, Ohio, Cleveland", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e9315879dfa4cbf6", URL: "https://www.srnnews.com/feed/?post_type=post", Comments: "SRN News", Language: "en", Status: "active", Tier: 2},
+	{ID: "00e5c172a7655c77", URL: "http://smh.com.au/rss/feed.xml", Comments: "Sydney, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "6a68623480092673", URL: "https://www.kztv10.com/sitemap.rss", Comments: "Corpus Christi, USA, KZTV 10", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "bee9da796cc8efce", URL: "https://www.persecution.org/feed/", Comments: "Persecution.org", Language: "en", Status: "active", Tier: 2},
+	{ID: "c6219fbf1cff5ed1", URL: "https://www.macombdaily.com/news/feed/", Comments: "USA, Macomb Daily", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "79aa1b947013d038", URL: "https://wyckoff.dailyvoice.com/rss/", Comments: "Wyckoff, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e9dfb954d76696e2", URL: "https://kyma.com/category/news/immigration/feed/", Comments: "KYMA", Language: "en", Status: "active", Tier: 2},
+	{ID: "d5a25bfefdf999fe", URL: "https://www.wnyc.org/atomfeeds/all/", Comments: "New York City, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d931b383e16e50b8", URL: "https://timesofindia.indiatimes.com/rssfeeds/3831863.cms", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "bd5c95f94b58b406", URL: "https://www.fox9.com/latest.xml", Comments: "Minneapolis, St. Paul, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "275d6a3f48aee624", URL: "https://www.castanetkamloops.net/rss/topheadlines.xml", Comments: "Castanet Kamloops, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "5e34cc13cf9dce1e", URL: "https://www.freerepublic.com/tag/extended-news/feed.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "085bd8b971fab0b7", URL: "https://coloradosun.com/feed/", Comments: "Colorado, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8e499420059c913a", URL: "https://feeds.nbcnews.com/nbcnews/public/world", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "99e682601a65cf89", URL: "https://www.kron4.com/feed/", Comments: "San Francisco Bay Area, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ae2387687119ebe0", URL: "https://www.nbcdfw.com/tag/arlington/feed/", Comments: "Arlington", Language: "en", Status: "active", Tier: 2},
+	{ID: "5e1aae0bc4b793d1", URL: "https://www.3newsnow.com/news.rss", Comments: "Omaha, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "427a43c9cd253aaa", URL: "https://thepublicsradio.org/feed/", Comments: "Rhode Island, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2f134b829bfc0284", URL: "https://www.abc6.com/feed/", Comments: "Providence, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fae664183611432f", URL: "https://www.valleycentral.com/feed/", Comments: "Rio Grande Valley, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "09bb9f1c28f32db7", URL: "https://www.newschannel5.com/index.rss", Comments: "Nashville, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3efbe4fbad7ecec3", URL: "https://feeds.skynews.com/feeds/rss/home.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "10ae37ce4fa70870", URL: "https://rss.iol.io/iol/sundayindependent", Comments: "South Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "fd5e83864815a8b8", URL: "https://feeds.nbcnews.com/nbcnews/public/news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d2663fd5e3b575cd", URL: "https://timesofindia.indiatimes.com/rssfeeds/7503091.cms", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "c122d660c6329dcd", URL: "http://www.theargus.co.uk/news/rss/", Comments: "Brighton, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1a34543aa2886777", URL: "https://5pillarsuk.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "236e461cf483a4db", URL: "https://tribuneonlineng.com/category/latest-news/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "c8444a2bd5985c6a", URL: "https://americanmilitarynews.com/category/news/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "7b3edadfaf20ebc1", URL: "https://blackpressusa.com/category/video/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2db3a6fdb517c0a2", URL: "http://thesmokinggun.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bd0497081a43e6b6", URL: "https://www.daventryexpress.co.uk/rss", Comments: "Daventry, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "4e8a051247885349", URL: "https://www.orissapost.com/feed/", Comments: "Odisha, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "da52ad2b541fcbac", URL: "https://www.expressiveinfo.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3905912b91620d39", URL: "https://www.mercurynews.com/news/california-news/feed/", Comments: "California, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "93c6bbd4b999f277", URL: "https://www.aa.com.tr/en/rss/default?cat=live", Comments: "Turkey", Language: "en", Status: "active", Country: "TR", Tier: 2},
+	{ID: "84888b55f4a490cb", URL: "https://www.krtv.com/sitemap.rss", Comments: "Montana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e8afef3d2f8291dc", URL: "https://www.kshb.com/sitemap.rss", Comments: "Kansas City, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5208bd6bfecc85f0", URL: "https://www.dailymail.co.uk/news/iowa/index.rss", Comments: "Iowa, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "8a0d2979cf99aaf4", URL: "https://www.glasgowlive.co.uk/?service=rss", Comments: "Glasgow, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "3e5e3def1294fe7e", URL: "https://www.dailymail.co.uk/news/las-vegas/index.rss", Comments: "Las Vegas, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e94c6f745067eaf3", URL: "https://timesofoman.com/feed/world", Comments: "World", Language: "en", Status: "active", Tier: 2},
+	{ID: "f5720b1ea3eda21e", URL: "https://metro.co.uk/news/us/feed/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6fc2772b3e553fb5", URL: "https://www.burnleyexpress.net/news/rss", Comments: "Burnley, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ccbc4e11f2b14231", URL: "https://www.examiner.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ab440d651c2eefe9", URL: "https://www.wral.com/news/rss/11/", Comments: "Raleigh, NC, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b9023828ff25c3a9", URL: "https://www.news-herald.com/tag/lake-county/feed/", Comments: "Lake County, Ohio, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2e1e2aa0756f11a1", URL: "https://www.hulldailymail.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "26509310bc7b3bfc", URL: "https://www.newindianexpress.com/Cities/Chennai/rssfeed/?id=181&getXmlFeed=true", Comments: "Chennai, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "7fa7151921ec968e", URL: "https://www.gdacs.org/xml/rss_24h.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "63d6b15e4d11a770", URL: "https://www.westhawaiitoday.com/tag/editors-picks/feed/", Comments: "West Hawaii, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b1cad78c8195238c", URL: "https://cde.news/category/news/americas/feed/", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "bd51c3661d62d4ea", URL: "https://www.fox17online.com/sitemap.rss", Comments: "Grand Rapids, MI, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f54f5b0cf275a107", URL: "https://www.villages-news.com/feed/", Comments: "The Villages, Florida, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c32114b17cbab70a", URL: "https://www.hawaiitribune-herald.com/tag/editors-picks/feed/", Comments: "Hawaii, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e2912a8d88eb9025", URL: "https://eurasiantimes.com/category/middle-east/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "0cae91fc27cb5481", URL: "https://www.searchlight.vc/category/front-page/feed/", Comments: "St. Vincent", Language: "en", Status: "active", Tier: 2},
+	{ID: "f735f4afa4519438", URL: "https://www.pottsmerc.com/news/feed/", Comments: "Pottsmerc, Pennsylvania, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1f8a36e267f833a7", URL: "https://www.wcpo.com/sitemap.rss", Comments: "Cincinnati, OH, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "01681b321c097272", URL: "https://www.bostonherald.com/local-news/feed/", Comments: "Boston, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "866b501994873eeb", URL: "https://www.granthamjournal.co.uk/_api/rss/grantham_journal_news_feed.xml", Comments: "Grantham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d94efed3ca67ed07", URL: "https://www.crikey.com.au/feed/", Comments: "Australia, global", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "51614af95308c8b9", URL: "https://www.dailymail.co.uk/news/new-south-wales/index.rss", Comments: "New South Wales, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "fea2644ed8ff8ab9", URL: "https://www.x1071.com/category/local-news/feed/", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c628ad0b8ba1adad", URL: "https://www.environmental-expert.com/rss/news-8/air-climate", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "de797d6f590fb7a6", URL: "https://www.staradvertiser.com/tag/video/feed/", Comments: "Hawaii, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "38454f8440f7eccb", URL: "http://www.pajhwok.com/rss.xml", Comments: "Afghanistan", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "19918adb53b0aa6a", URL: "https://www.blackpoolgazette.co.uk/topic/blackpool/rss", Comments: "Blackpool, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9667048b87a25a73", URL: "https://news.stv.tv/section/west-central/feed", Comments: "West Central Scotland, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "eb57f8c2857d099e", URL: "https://www.omanobserver.om/rssFeed/4", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "76a4ba21fe91a7c1", URL: "https://www.wbiw.com/feed/", Comments: "Bedford, Indiana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b47ec0283beeaf01", URL: "https://midjersey.news/category/mercer-county/feed/", Comments: "Mercer County, New Jersey, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "053b9056b0fe6673", URL: "https://news.google.ca/rss?hl=en-CA&gl=CA&ceid=CA%3Aen&oc=11", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "23620fb4dfc5f10b", URL: "http://buffalonews.com/search/?f=rss&t=article&c=&l=50&s=start_time&sd=desc", Comments: "Buffalo, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4e3acfd7b172b1ea", URL: "https://www.arise.tv/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "d55e215f521f8ceb", URL: "https://www.eastcoastdaily.in/category/news/world/feed", Comments: "India, World", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "bbf7f191e985b823", URL: "https://kogo.iheart.com/topic/national-news.rss/", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "4275579b4ac81ee7", URL: "https://www.mylondon.news/rss.xml", Comments: "London, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "61071f474a70d2d6", URL: "https://news.google.com/news/rss/?gl=US&ned=us&hl=en", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "067449f0ff3cf0df", URL: "https://www.africa-press.net/south-africa/feed", Comments: "South Africa, Africa", Language: "en", Status: "active", Country: "ZA", Tier: 2},
+	{ID: "7cdc33ea7a68e010", URL: "https://vosizneias.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "93d5430beebe21d3", URL: "https://www.dglobe.com/index.rss", Comments: "Detroit Lakes, Minnesota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1ee195c77a36b5bd", URL: "https://express.co.uk/posts/rss/78/world", Comments: "UK, global", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "0ee80c5fc173ceb3", URL: "https://cresskill.dailyvoice.com/rss/", Comments: "New Jersey, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5821cdff96ba78e0", URL: "https://www.chronline.com/browse.rss", Comments: "Lewis County, Washington, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "5e2b1931dc5be5a0", URL: "https://www.nbnnews.com.au/category/northern-rivers-news/feed/", Comments: "Northern Rivers, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "559bb2cc440d6060", URL: "https://www.thejournal.ie/topic/assault/feed/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "7527a7085f292f4f", URL: "http://www.nst.com.my/latest.xml", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "53ad54d756cb6b86", URL: "https://www.reviewjournal.com/local/local-las-vegas/feed/", Comments: "Las Vegas, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "81ba66e230ed3f60", URL: "https://www.the-journal.com/local-news", Comments: "Martinsburg, WV, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a8b7856dc7881280", URL: "https://www.gistreel.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "d03ea676e9d1fdd6", URL: "https://www.workers.org/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "89ad608dbb6eb02a", URL: "https://www.wrkf.org/npr-news.rss", Comments: "Baton Rouge, Louisiana, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e03a4fb647e5fc3e", URL: "https://www.troyrecord.com/feed/", Comments: "New York, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "807f3c15fda5fff9", URL: "https://www.irishcentral.com/feeds/section-articles.atom?section=news", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "daa650556e59b668", URL: "https://voanews.com/api/zjboveytit", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "514fb51c07bbdf35", URL: "https://www.mumbailive.com/rss/en/civic.xml", Comments: "Mumbai, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "0d962eb6e366c115", URL: "https://www.dglobe.com/news.rss", Comments: "Detroit Lakes, Minnesota, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "9a7b9d721a6a28b3", URL: "https://www.thestranger.com/seattle/Rss.xml?section=23360772", Comments: "Seattle, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f71ec76c88f0d177", URL: "https://foroyaa.net/feed/", Comments: "Gambia", Language: "en", Status: "active", Country: "GM", Tier: 2},
+	{ID: "683bc9bdb919d286", URL: "https://www.bhaskarlive.in/category/international/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1d71961951c9d2a4", URL: "http://euromaidanpress.com/feed/", Comments: "Ukraine, Eastern Europe", Language: "en", Status: "active", Country: "UA", Tier: 2},
+	{ID: "d8ea50bcbe5d1d29", URL: "https://californiaglobe.com/feed/", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "21b025af822e9ba5", URL: "https://www.kshb.com/news/national.rss", Comments: "US, Kansas City", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "d43ae1e2b2651a2b", URL: "https://www.breakingisraelnews.com/feed/", Comments: "Israel, Middle East", Language: "en", Status: "active", Country: "IL", Tier: 2},
+	{ID: "986556574ce9dfa7", URL: "https://ktsmradio.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1552295eca36e79b", URL: "https://www.economist.com/asia/rss.xml", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "0fc11363bb64508f", URL: "https://feeds.feedburner.com/ndtvnews-world-news", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2a84cd6e3cc20f33", URL: "https://www.africa-press.net/kenya/feed", Comments: "Kenya, East Africa", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "9b1efe55f11348ef", URL: "https://www.thecourier.co.uk/category/news/courts/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "d1bbf1cff76d2688", URL: "https://www.ktvh.com/news.rss", Comments: "Helena, MT", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "315c0bdbac069883", URL: "https://www.8newsnow.com/feed/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "f4e7300f196d7b0a", URL: "https://guyanachronicle.com/category/news/feed/", Comments: "Guyana, South America", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "0d242620ab6c1457", URL: "https://jknewstoday.com/feed/", Comments: "Jammu & Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "20869c6e9c745613", URL: "https://forcechange.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5e55592ef90acab1", URL: "https://spacecoastdaily.com/feed/", Comments: "Space Coast, Florida, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "97806d1dd9f84bb8", URL: "https://newsafricanow.com/category/kenya/feed/", Comments: "Kenya, East Africa", Language: "en", Status: "active", Country: "KE", Tier: 2},
+	{ID: "eda9e0d9c14827aa", URL: "https://stluciatimes.com/category/headlines/feed/", Comments: "St. Lucia, Caribbean", Language: "en", Status: "active", Country: "LC", Tier: 2},
+	{ID: "b664c84d22a204aa", URL: "https://www.ibtimes.co.uk/rss/feed", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "8e0c18cf4506af28", URL: "https://feeds.bignewsnetwork.com/category/da4df54c389ee3f7", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "386fb9318f4aadbf", URL: "https://cde.news/category/news/eur/feed/", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "d23b92cde0095f7e", URL: "https://feeds.feedburner.com/ieireland", Comments: "Ireland, Europe", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "f42535fb21127a86", URL: "https://www.indiatvnews.com/rssnews/topstory-world.xml", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "10edaacb9d1a5404", URL: "https://odishabytes.com/category/india/feed/", Comments: "India, Odisha", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "bc6466832f2b07cc", URL: "https://odishabytes.com/feed/", Comments: "India, Odisha", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "af60fcf577772a92", URL: "http://montgomerycountypolicereporter.com/feed/", Comments: "Montgomery County, Texas, US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f3b8a08cbe7f9738", URL: "https://www.omanobserver.om/rssFeed/3/33", Comments: "Oman, Middle East", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "d583b8d302b3e140", URL: "https://kvia.com/category/news/border/feed/", Comments: "El Paso, US-Mexico border", Language: "en", Status: "active", Tier: 2},
+	{ID: "9df8e8f1e5d80f10", URL: "https://www.readingeagle.com/patriot-item/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "29aa31cc3cee78f7", URL: "https://www.citywatchla.com/index.php/375-voices?format=feed&type=rss", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "daa6278ad97d16e8", URL: "https://www.policeprofessional.com/region/uk/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bec1efaeb6877f8d", URL: "https://www.nbcnewyork.com/news/local/feed/", Comments: "New York", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1f7fa16e7a018355", URL: "https://www.radiofree.org/category/sources/radio-free-asia/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "9f5ef87caa111883", URL: "https://www.sott.net/xml_engine/signs_rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "05cbe0eef2ddfa6c", URL: "https://thefrontierpost.com/category/pakistan/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "999e8374814c57e0", URL: "https://www.atlanticcouncil.org/region/the-gulf/feed/", Comments: "Gulf region", Language: "en", Status: "active", Tier: 2},
+	{ID: "2f8ab1238d6c0728", URL: "https://www.nbcchicago.com/news/local/chicago-politics/feed/", Comments: "Chicago", Language: "en", Status: "active", Tier: 2},
+	{ID: "514c2743ee93ca3d", URL: "https://ghananewsonline.com.gh/feed/rss/", Comments: "Ghana", Language: "en", Status: "active", Country: "GH", Tier: 2},
+	{ID: "340c3fa5b0470cb1", URL: "http://infotel.ca/rssfeed/penticton", Comments: "Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "f51bb485cdb4b58a", URL: "http://www.getwestlondon.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "af8825a1fd564cae", URL: "http://feeds.iranherald.com/rss/1b76a2b4cf7810bd", Comments: "Iran", Language: "en", Status: "active", Country: "IR", Tier: 2},
+	{ID: "963f6592ec92a9e1", URL: "https://www.thetrumpet.com/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "dd0af46241ceb5c2", URL: "https://theconversation.com/global/articles.atom", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0ec736bafbf3bf45", URL: "http://www.southend.gov.uk/rss/news", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "7c3d5a8149b09a9b", URL: "https://maritime-executive.com/articles.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "88d3f16936165a4c", URL: "https://softpower.ug/feed/", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "297a7c0d91d04983", URL: "https://www.nbcsandiego.com/news/national-international/feed/", Comments: "San Diego, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ac5597297a172b2b", URL: "http://www.leeds-live.co.uk/rss.xml", Comments: "Leeds", Language: "en", Status: "active", Tier: 2},
+	{ID: "d4b03e1accdd25a1", URL: "https://www.nbcphiladelphia.com/news/national-international/feed/", Comments: "Philadelphia, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc94e36afdddd92e", URL: "http://www.irinnews.org/rss/all.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "372496fb8c39fc7f", URL: "https://www.thecitizen.co.tz/service/rss/tanzania/2486616/feed.rss", Comments: "Tanzania", Language: "en", Status: "active", Country: "TZ", Tier: 2},
+	{ID: "94d9a2e857114b13", URL: "https://www.nbcmiami.com/news/national-international/feed/", Comments: "Miami, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "86adcb8e30ed6e8b", URL: "http://www.irishmirror.ie/rss.xml", Comments: "Irish", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "f19ca8e7b457955b", URL: "https://akelicious.net/category/news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fd3d5c6be9eec4f9", URL: "https://www.bhaskarlive.in/category/accident/feed/", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "cc1335513fc479a5", URL: "https://www.dailymail.co.uk/news/vermont/index.rss", Comments: "Vermont, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "f97c786eba6346af", URL: "https://www.icrc.org/en/rss/region/europe-central-asia", Comments: "Europe and Central Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "ea857296e498ee63", URL: "https://www.nbc30.com/investigations/feed/", Comments: "Connecticut", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "c9dda07bc97d75aa", URL: "https://www.leicestermercury.co.uk/news/uk-world-news/rss.xml", Comments: "UK, world", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c5bea5b4bfa54717", URL: "http://www.thenorthlines.com/feed/", Comments: "The North", Language: "en", Status: "active", Tier: 2},
+	{ID: "19bee497b1e6a290", URL: "https://www.dailymail.co.uk/news/turkey/index.rss", Comments: "Turkey", Language: "en", Status: "active", Country: "TR", Tier: 2},
+	{ID: "5cc456464d9ae4f1", URL: "https://pakistannewsupdates.com/feed/", Comments: "Pakistan", Language: "en", Status: "active", Country: "PK", Tier: 2},
+	{ID: "1484c139889e080a", URL: "http://www.benningtonbanner.com/search/?f=rss&t=article&c=news&l=50&s=start_time&sd=desc", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "47a695195cc9da3f", URL: "https://www.a1plus.am/en/rss", Comments: "Armenia", Language: "en", Status: "active", Country: "AM", Tier: 2},
+	{ID: "a3c7a8f8ea9dd94c", URL: "http://abc11.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c5359daae9c0e5cc", URL: "http://www.independent.ie/rss/", Comments: "Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "9109bfc6e95799c6", URL: "https://www.theguardian.com/world/europe-news/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "9c71e043caf40204", URL: "https://www.ktnv.com/index.rss", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "1652c9eccb64dc38", URL: "https://peoplesdailyng.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "b875ad45a858f057", URL: "https://www.deccanchronicle.com/rss_feed/?subsectionId=11", Comments: "India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "8b59fb5960a2e4bf", URL: "https://blackpressusa.com/category/national-news/feed/", Comments: "USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "26842a834f31bba2", URL: "https://woay.com/news/weather/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "80ab9251f6f61f66", URL: "https://www.beaufortcountynow.com/rss.php", Comments: "Beaufort County, SC, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b5f59eb8e62334d9", URL: "https://www.atlanticcouncil.org/issue/conflict/feed/", Language: "en", Status: "active", Tier: 2},
+	{ID: "831899f1171d981c", URL: "https://www.atlanticcouncil.org/region/americas/feed/", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "29b20f4254ba9cc5", URL: "https://www.fox17online.com/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "5a6b445dba6b78ba", URL: "http://www.coventrytelegraph.net/rss.xml", Comments: "Coventry, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "3b816e2e17c58906", URL: "http://feeds.denverpost.com/dp-news-local", Comments: "Denver", Language: "en", Status: "active", Tier: 2},
+	{ID: "02d6e246c1b54fe8", URL: "https://yellowscene.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "c245acc55b5acfd4", URL: "https://www.dailymail.co.uk/news/iran/index.rss", Comments: "Iran", Language: "en", Status: "active", Country: "IR", Tier: 2},
+	{ID: "bd6e30230a4940cd", URL: "https://www.dailymail.co.uk/news/south-korea/index.rss", Comments: "South Korea", Language: "en", Status: "active", Country: "KR", Tier: 2},
+	{ID: "7d64e9b1833b4773", URL: "http://feeds.feedburner.com/KashmirImagesNewspaper", Comments: "Kashmir", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "eb245b76b3a14d99", URL: "https://www.khaama.com/tag/war/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e13a19fdeb7c67f4", URL: "https://www.ok.co.uk/?service=rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "1db7ccf0e3c58bbf", URL: "https://www.staradvertiser.com/category/hawaii-news/volcanic-ash/feed/", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "edac59b4daf74e04", URL: "https://www.shorenewsnetwork.com/category/local-news-nj/new-jersey/monmouth-countynews/feed/", Comments: "Monmouth County, NJ, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ee05d639e042e31f", URL: "https://www.fox47news.com/index.rss", Language: "en", Status: "active", Tier: 2},
+	{ID: "00b661fc84d2ffc8", URL: "https://www.nbc4.com/news/local/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ae788409d815cb9d", URL: "https://www.dailymail.co.uk/news/idaho/index.rss", Comments: "Idaho, USA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "25bd269b09276e2a", URL: "https://euroweeklynews.com/news/feed/", Comments: "Europe", Language: "en", Status: "active", Tier: 2},
+	{ID: "16b023cbea043654", URL: "http://www.getsurrey.co.uk/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "46d609aa0ea90531", URL: "https://www.dailylocal.com/news/pennsylvania-news/feed/", Comments: "Pennsylvania", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "e11004c331155472", URL: "https://www.reviewjournal.com/local/local-las-vegas/downtown/feed/", Comments: "Las Vegas", Language: "en", Status: "active", Tier: 2},
+	{ID: "6473b5a604253c59", URL: "https://www.atlanticcouncil.org/category/blogs/menasource/feed/", Comments: "Middle East, North Africa", Language: "en", Status: "active", Tier: 2},
+	{ID: "505e5aef8fdd2efb", URL: "https://home.nzcity.co.nz/rss/news.aspx?catid=975&fm=newsmain%2cnarts", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "05954620eee59702", URL: "https://www.dailymail.co.uk/news/perth/index.rss", Comments: "Perth, Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "8c1446f9dfcae7eb", URL: "https://www.khaama.com/category/world/africa/feed/", Comments: "Afghanistan, Africa", Language: "en", Status: "active", Country: "AF", Tier: 2},
+	{ID: "9c6a1918f30a8b3c", URL: "https://www.kivitv.com/index.rss", Comments: "Idaho", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fa150c36214d3e30", URL: "https://www.atlanticcouncil.org/region/middle-east/feed/", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "e5145f6da781b37f", URL: "https://rss.24-7pressrelease.com/rss/government_national.xml", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b06b4ef58324851f", URL: "https://www.omanobserver.om/rssFeed/55/41", Comments: "Oman", Language: "en", Status: "active", Country: "OM", Tier: 2},
+	{ID: "22db1a94bca7d0e3", URL: "https://www.nbcmiami.com/news/local/feed/", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "cf1839323254224f", URL: "https://www.thecanary.co/topics/protest/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e45043428b5ff5b5", URL: "https://www.turnto23.com/index.rss", Comments: "California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "82ba4474c849c7f9", URL: "https://www.icrc.org/en/rss/region/americas", Comments: "Americas", Language: "en", Status: "active", Tier: 2},
+	{ID: "d055840c46de5777", URL: "https://www.nbcdfw.com/tag/dallas/feed/", Comments: "Dallas", Language: "en", Status: "active", Tier: 2},
+	{ID: "e67ae21464060a67", URL: "https://www.theguardian.com/international/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "6486a451026c677b", URL: "https://www.manchestereveningnews.co.uk/rss.xml", Comments: "Manchester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "60ad8d0eace45d9f", URL: "https://avenuemail.in/category/jamshedpur/feed/", Comments: "Jamshedpur, India", Language: "en", Status: "active", Country: "IN", Tier: 2},
+	{ID: "9168b29ea8f19ffd", URL: "https://www.nbcsandiego.com/tag/california/feed/", Comments: "San Diego, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fea476883f2cfa1f", URL: "http://www.wnyc.org/feeds/all/", Comments: "New York, global", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "16adf2a3dfe99756", URL: "http://www.chesterchronicle.co.uk/rss.xml", Comments: "Chester, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "809035856916f707", URL: "https://www.atlanticcouncil.org/region/iran/feed/", Comments: "Iran", Language: "en", Status: "active", Country: "IR", Tier: 2},
+	{ID: "8167950a77395fd4", URL: "http://www.birminghammail.co.uk/rss.xml", Comments: "Birmingham, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "ed67e7c3bc6a631b", URL: "http://feeds.foxnews.com/foxnews/national", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "671a12e23cadc4e4", URL: "https://www.rcmp-grc.gc.ca/en/n/pe", Comments: "Prince Edward Island", Language: "en", Status: "active", Tier: 2},
+	{ID: "c3dcf44c86a7532c", URL: "https://localnews8.com/category/videos/feed/", Comments: "local", Language: "en", Status: "active", Tier: 2},
+	{ID: "27d1779c07791d05", URL: "https://townlift.com/category/weather/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8cdc54fb8ebad1cf", URL: "http://www.theatlantic.com/feed/all/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "fc17b4bac45803c7", URL: "https://www.dailymail.co.uk/news/beijing/index.rss", Comments: "Beijing, China", Language: "en", Status: "active", Country: "CN", Tier: 2},
+	{ID: "0282a73e9731109e", URL: "http://www.devonlive.com/rss.xml", Comments: "Devon, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9dea966c92f54cf4", URL: "https://www.news-herald.com/location/ohio/lake-county/feed/", Comments: "Lake County, Ohio", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b5ce719834978fef", URL: "https://www.wnyc.org/feeds/tags/national_news", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "ba270500fe9d2b1a", URL: "https://www.icrc.org/en/rss/region/middle-east", Comments: "Middle East", Language: "en", Status: "active", Tier: 2},
+	{ID: "2578f75a504b9119", URL: "https://www.dailymail.co.uk/news/palestine/index.rss", Comments: "Palestine", Language: "en", Status: "active", Country: "PS", Tier: 2},
+	{ID: "809a59f50432e996", URL: "https://www.nbcchicago.com/news/national-international/feed/", Comments: "Chicago, global", Language: "en", Status: "active", Tier: 2},
+	{ID: "ce2e0b01c6089a75", URL: "https://www.rnz.co.nz/rss/ldr.xml", Comments: "New Zealand", Language: "en", Status: "active", Country: "NZ", Tier: 2},
+	{ID: "c8dbb479b4b8c396", URL: "https://www.dailymail.co.uk/news/saudi_arabia/index.rss", Comments: "Saudi Arabia", Language: "en", Status: "active", Country: "SA", Tier: 2},
+	{ID: "65f2a74bda9fa043", URL: "https://www.newschannel5.com/news/local-news.rss", Comments: "Nashville", Language: "en", Status: "active", Tier: 2},
+	{ID: "8ef22f1799faa222", URL: "https://www.independent.co.ug/feed", Comments: "Uganda", Language: "en", Status: "active", Country: "UG", Tier: 2},
+	{ID: "8c4b762be22065f6", URL: "https://eurasiantimes.com/category/eurasian-region/feed/", Comments: "Eurasian Region", Language: "en", Status: "active", Tier: 2},
+	{ID: "5639919a50080950", URL: "https://www.wtkr.com/sitemap.rss", Comments: "Norfolk, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2e1933141a43472c", URL: "http://www.jpost.com/Rss/RssFeedsHeadlines.aspx", Comments: "Jerusalem", Language: "en", Status: "active", Tier: 2},
+	{ID: "811017adf0f504b5", URL: "https://www.stabroeknews.com/news/guyana/feed/", Comments: "Guyana", Language: "en", Status: "active", Country: "GY", Tier: 2},
+	{ID: "1b86ff0dc6ace94c", URL: "https://wrno.iheart.com/topic/national-news.rss/", Comments: "US", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "a88358cd1ba4734b", URL: "https://www.rcmp-grc.gc.ca/en/n/nb", Comments: "New Brunswick, Canada", Language: "en", Status: "active", Country: "CA", Tier: 2},
+	{ID: "e5e2d4a09b25f479", URL: "https://www.sgvtribune.com/feed/", Comments: "San Gabriel Valley, CA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "68fb61a9ed5bd4d8", URL: "http://feeds.feedburner.com/pngfacts/PcXZ", Comments: "Papua New Guinea", Language: "en", Status: "active", Country: "PG", Tier: 2},
+	{ID: "940382139cec82af", URL: "https://koaa.com/sitemap.rss", Comments: "Colorado Springs", Language: "en", Status: "active", Tier: 2},
+	{ID: "437156399cee72de", URL: "https://www.thecitizen.co.tz/service/rss/tanzania/2486558/feed.rss", Comments: "Tanzania", Language: "en", Status: "active", Country: "TZ", Tier: 2},
+	{ID: "2d126c765ce8d5b5", URL: "https://www.transcontinentaltimes.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "8d9ba7e9947d74ef", URL: "https://www.wtvr.com/sitemap.rss", Comments: "Richmond, VA", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "989ae5d1d5c92124", URL: "https://www.dailymail.co.uk/news/hawaii/index.rss", Comments: "Hawaii", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "1ba5039bdc38fe0a", URL: "https://www.kristv.com/news/national-news.rss", Comments: "Corpus Christi, TX", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "27d67b857ab29b59", URL: "https://en.mynewsne.com/category/state/feed/", Comments: "Myanmar", Language: "en", Status: "active", Country: "MM", Tier: 2},
+	{ID: "974fceb3e717b4b5", URL: "https://www.scotsman.com/rss", Comments: "Scotland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "6f2f92be426c9682", URL: "https://www.maravipost.com/feed", Comments: "Malawi", Language: "en", Status: "active", Country: "MW", Tier: 2},
+	{ID: "01fc3dcf9e0ab750", URL: "https://www.nbc26.com/news/local-news.rss", Comments: "Green Bay, WI", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "3ffe37feca3c4115", URL: "https://www.10news.com/news/national.rss", Comments: "San Diego", Language: "en", Status: "active", Tier: 2},
+	{ID: "5de66bf4f1e2e4dd", URL: "https://diggers.news/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "0fa5a2b022567554", URL: "https://edgedavao.net/feed/", Comments: "Davao, Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "34fcbe5beaadaf0b", URL: "https://www.independent.ie/world-news/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "37e2ed9788f1111a", URL: "https://opslens.com/category/recent/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "b2f6e37c711a8951", URL: "https://www.westhawaiitoday.com/tag/local/feed/", Comments: "West Hawaii", Language: "en", Status: "active", Tier: 2},
+	{ID: "09bcd9e5de3dbf27", URL: "https://theguildng.com/feed/rss/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "42be8b7a15f58acd", URL: "https://thefactnews.in/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "3d2a3ad3e1a92341", URL: "https://wsvn.com/news/feed/", Comments: "Miami", Language: "en", Status: "active", Tier: 2},
+	{ID: "fb78cabbf9495227", URL: "http://ntv.ca/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fa29617cc10c8261", URL: "https://www.theguardian.com/uk-news/rss", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "2353acabe3de1465", URL: "https://www.dailymail.co.uk/news/northern-ireland/index.rss", Comments: "Northern Ireland", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "083d9ba29066be92", URL: "http://timesofindia.indiatimes.com/rssfeeds/4118235.cms", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "fc61fbc9b2a347f7", URL: "https://moxie.foxnews.com/feedburner/latest.xml", Language: "en", Status: "active", Tier: 2},
+	{ID: "9ba74a15b459bbc5", URL: "https://www.gulte.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "85d6fe3549618a04", URL: "https://www.glasgowlive.co.uk/news/glasgow-news/rss.xml", Comments: "Glasgow", Language: "en", Status: "active", Tier: 2},
+	{ID: "38ea29b975e95606", URL: "https://rawstory.com/feeds/feed.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "64647a72914cae65", URL: "https://www.independent.co.uk/asia/rss", Comments: "Asia", Language: "en", Status: "active", Tier: 2},
+	{ID: "48802da41f289eaa", URL: "https://www.liverpoolecho.co.uk/news/liverpool-news/rss.xml", Comments: "Liverpool", Language: "en", Status: "active", Tier: 2},
+	{ID: "ee886cf52f6cc055", URL: "https://www.independent.co.uk/news/world/rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "bd93a250f8ef4ed1", URL: "https://metro.co.uk/news/world/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "006f3d87b2b69208", URL: "https://keyt.com/category/news/ventura-county/feed/", Comments: "Ventura County, California", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "2fc0348b528eeb87", URL: "https://southpassaic.dailyvoice.com/rss/", Comments: "South Passaic, New Jersey", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "6740e80efc72e1d1", URL: "https://www.denverpost.com/news/colorado/feed/", Comments: "Colorado", Language: "en", Status: "active", Country: "US", Tier: 2},
+	{ID: "b7ced56f80dabd2d", URL: "https://www.donegaldaily.com/feed/", Comments: "Donegal, Ireland", Language: "en", Status: "active", Country: "IE", Tier: 2},
+	{ID: "602f2c1a7139f627", URL: "http://www.thisisbristol.com/rss.xml", Comments: "Bristol, UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "9111b765e8214254", URL: "https://www.dailymail.co.uk/news/breaking_news/index.rss", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "2c4d6571d837984f", URL: "https://acleddata.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "083e2a9d901826dd", URL: "https://watchers.news/feed/", Comments: "Earth, space", Language: "en", Status: "active", Tier: 2},
+	{ID: "7402edfac2c3d822", URL: "https://www.cisecurity.org/feed/advisories", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "f289b85915064dc6", URL: "https://www.malaymail.com/feed/rss", Comments: "Malaysia", Language: "en", Status: "active", Country: "MY", Tier: 2},
+	{ID: "fb8820b03166c209", URL: "https://www.inquirer.net/fullfeed", Comments: "Philippine", Language: "en", Status: "active", Tier: 2},
+	{ID: "318679ad2118e2ce", URL: "https://www.allafrica.com/tools/headlines/rdf/latest/headlines.rdf", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "822a5577505058b4", URL: "https://punchng.com/feed/", Comments: "Nigeria", Language: "en", Status: "active", Country: "NG", Tier: 2},
+	{ID: "cf25d1706195bde2", URL: "https://www.chronicle.co.zw/feed/", Comments: "Zimbabwe", Language: "en", Status: "active", Country: "ZW", Tier: 2},
+	{ID: "1619881dc386cdb4", URL: "https://www.dailymail.co.uk/home/index.rss", Comments: "Home", Language: "en", Status: "active", Tier: 2},
+	{ID: "c9f11ae1b20f3e47", URL: "https://www.express.co.uk/posts/rss/1/UK", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "1c79099de5c879d3", URL: "https://jamaica-gleaner.com/feed/rss.xml", Comments: "Jamaica", Language: "en", Status: "active", Country: "JM", Tier: 2},
+	{ID: "1f6773dd526e2cbc", URL: "https://www.abc.net.au/news/feed/1948/rss.xml", Comments: "Australia", Language: "en", Status: "active", Country: "AU", Tier: 2},
+	{ID: "2c78a59106c83cc6", URL: "https://www.philstar.com/rss/nation", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "b1ae2d36a2b50327", URL: "https://www.jpost.com/rss/rssfeedsfrontpage.aspx", Comments: "Jerusalem", Language: "en", Status: "active", Tier: 2},
+	{ID: "c538aac6b0c68006", URL: "https://www.securitymagazine.com/rss/topic/2236", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "e3e13632a94eb058", URL: "https://www.ifsecglobal.com/feed/", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "1b38dbfbe2172d22", URL: "https://www.counterterrorism.police.uk/feed/", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+	{ID: "c168810c32004262", URL: "https://www.khaosodenglish.com/feed", Comments: "Thailand, Southeast Asia", Language: "en", Status: "active", Country: "TH", Tier: 2},
+	{ID: "cb5c65d420cc198f", URL: "https://www.thedefensepost.com/feed", Comments: "global", Language: "en", Status: "active", Tier: 2},
+	{ID: "be3bdaab90aebbd0", URL: "https://www.nknews.org/feed", Comments: "North Korea", Language: "en", Status: "active", Country: "KP", Tier: 2},
+	{ID: "6fe4d0df3a0dfe39", URL: "https://rappler.com/feed", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "78c2667f1734c336", URL: "https://www.philstar.com/rss/headlines", Comments: "Philippines", Language: "en", Status: "active", Country: "PH", Tier: 2},
+	{ID: "c520e107b94ab799", URL: "https://www.mirror.co.uk/news/world-news/rss.xml", Comments: "UK", Language: "en", Status: "active", Country: "GB", Tier: 2},
+}
@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"mime"
+	"slices"
+	"strings"
+)
+
+// DefaultAccept is the Accept header sent with feed requests: the feed
+// formats first, then XML, then anything, as some servers answer requests
+// that don't ask for a feed with an HTML landing page.
+const DefaultAccept = "application/rss+xml, application/atom+xml, application/feed+json, application/xml;q=0.9, */*;q=0.8"
+
+// mediaType returns the media type of a Content-Type or Accept entry,
+// lowercased, with text/xml taken as its alias application/xml, and its
+// parameters, or "" if it doesn't parse.
+func mediaType(s string) (string, map[string]string) {
+	t, params, err := mime.ParseMediaType(s)
+	if err != nil {
+		return "", nil
+	}
+	if t == "text/xml" {
+		t = "application/xml"
+	}
+	return t, params
+}
+
+// acceptedTypes returns the media types accept asks for by name, leaving
+// out wildcards and those it refuses with q=0.
+func acceptedTypes(accept string) []string {
+	var types []string
+	for _, entry := range strings.Split(accept, ",") {
+		t, params := mediaType(strings.TrimSpace(entry))
+		if t == "" || strings.Contains(t, "*") || strings.TrimSpace(params["q"]) == "0" {
+			continue
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+// checkContentType warns on result when a feed was served with a
+// Content-Type other than the types the Accept header asked for.
+func (v *Validator) checkContentType(result *Result, contentType string) {
+	if len(v.acceptTypes) == 0 || contentType == "" {
+		return
+	}
+	if t, _ := mediaType(contentType); !slices.Contains(v.acceptTypes, t) {
+		result.Warn(WarningContentType, "Served as %s rather than a feed type asked for", contentType)
+	}
+}
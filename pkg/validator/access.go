@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	ext "github.com/mmcdole/gofeed/extensions"
+)
+
+// Access restriction values, as used by the dataset's paywall column.
+const (
+	AccessOpen         = ""
+	AccessPaywall      = "paywall"
+	AccessRegistration = "registration"
+)
+
+var (
+	paywallPattern      = regexp.MustCompile(`(?i)subscribe (now )?to (read|continue|unlock)|subscribers?[- ]only|for subscribers|premium (content|article|story)|paywall|become a (subscriber|member) to`)
+	registrationPattern = regexp.MustCompile(`(?i)(sign|log) ?in to (read|continue|view)|register (now )?to (read|continue|view)|registration required|create a free account`)
+)
+
+// accessCategories are item categories, lowercased, that publishers tag
+// restricted articles with.
+var accessCategories = map[string]string{
+	"premium":               AccessPaywall,
+	"paid":                  AccessPaywall,
+	"paywall":               AccessPaywall,
+	"subscriber":            AccessPaywall,
+	"subscribers":           AccessPaywall,
+	"subscriber-only":       AccessPaywall,
+	"subscribers-only":      AccessPaywall,
+	"registration":          AccessRegistration,
+	"registration-required": AccessRegistration,
+}
+
+// detectAccessRestriction looks for paywall or registration markers in what
+// a feed says about itself, not in the text of its items, which is news and
+// can be about paywalls: the channel's title and description, and the
+// markup of its items, their categories and access elements such as
+// dcterms:accessRights or isAccessibleForFree. A feed counts as restricted
+// when its channel says so, or when at least half of its items are marked.
+// 401 and 402 responses are told apart by fetch.
+func detectAccessRestriction(feed *gofeed.Feed) string {
+	chrome := feed.Title + " " + feed.Description
+	switch {
+	case paywallPattern.MatchString(chrome):
+		return AccessPaywall
+	case registrationPattern.MatchString(chrome):
+		return AccessRegistration
+	}
+	if len(feed.Items) == 0 {
+		return AccessOpen
+	}
+
+	var paywalled, registration int
+	for _, item := range feed.Items {
+		switch itemAccess(item) {
+		case AccessPaywall:
+			paywalled++
+		case AccessRegistration:
+			registration++
+		}
+	}
+
+	switch half := (len(feed.Items) + 1) / 2; {
+	case paywalled >= half:
+		return AccessPaywall
+	case paywalled+registration >= half:
+		return AccessRegistration
+	}
+	return AccessOpen
+}
+
+// itemAccess returns the restriction an item's markup declares.
+func itemAccess(item *gofeed.Item) string {
+	access := AccessOpen
+	for _, category := range item.Categories {
+		if a := accessCategories[strings.ToLower(strings.TrimSpace(category))]; a != AccessOpen {
+			access = a
+			if a == AccessPaywall {
+				return a
+			}
+		}
+	}
+	for _, elements := range item.Extensions {
+		if a := accessElement(elements); a != AccessOpen {
+			access = a
+			if a == AccessPaywall {
+				return a
+			}
+		}
+	}
+	return access
+}
+
+// accessElement returns the restriction declared by an item's extension
+// elements of one namespace.
+func accessElement(elements map[string][]ext.Extension) string {
+	for _, e := range elements["isAccessibleForFree"] {
+		if strings.EqualFold(strings.TrimSpace(e.Value), "false") {
+			return AccessPaywall
+		}
+	}
+	for _, name := range []string{"accessRights", "access"} {
+		for _, e := range elements[name] {
+			value := strings.ToLower(e.Value)
+			switch {
+			case strings.Contains(value, "subscri"), strings.Contains(value, "paywall"), strings.Contains(value, "premium"), strings.Contains(value, "paid"):
+				return AccessPaywall
+			case strings.Contains(value, "regist"):
+				return AccessRegistration
+			}
+		}
+	}
+	return AccessOpen
+}
@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// adaptiveErrorRate is the share of fetches timing out or answered with a
+// server error (5xx or 429) above which adaptive concurrency backs off.
+const adaptiveErrorRate = 0.2
+
+// adaptiveMinWindow is the fewest fetches the error rate is judged on.
+const adaptiveMinWindow = 10
+
+// ConcurrencyChange is an adjustment made by adaptive concurrency; see
+// WithAdaptiveConcurrency.
+type ConcurrencyChange struct {
+	At time.Time `json:"at"`
+	// From and To are the limits on fetches in flight before and after.
+	From int `json:"from"`
+	To   int `json:"to"`
+	// ErrorRate is the share of the fetches since the previous adjustment
+	// that timed out or got a server error.
+	ErrorRate float64 `json:"error_rate"`
+}
+
+// aimd limits the fetches in flight, additive increase, multiplicative
+// decrease: after each window of fetches, as many as the limit, it halves
+// the limit if too many of them failed in ways that point at overload, and
+// raises it by a tenth of the ceiling otherwise.
+type aimd struct {
+	min, max int
+	clock    Clock
+	logf     func(format string, args ...any)
+
+	mu       sync.Mutex
+	limit    int
+	inFlight int
+	fetches  int
+	failures int
+	changes  []ConcurrencyChange
+	// wake is closed, and replaced, when a slot may have freed up.
+	wake chan struct{}
+}
+
+// acquire waits for a slot within the limit, or until ctx is done. A nil
+// aimd doesn't wait.
+func (a *aimd) acquire(ctx context.Context) error {
+	if a == nil {
+		return nil
+	}
+	for {
+		a.mu.Lock()
+		if a.inFlight < a.limit {
+			a.inFlight++
+			a.mu.Unlock()
+			return nil
+		}
+		wake := a.wake
+		a.mu.Unlock()
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// release frees a slot taken by acquire, counting the fetch as overloaded
+// or not, and adjusts the limit once a window's worth have completed.
+func (a *aimd) release(overloaded bool) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.inFlight--
+	a.fetches++
+	if overloaded {
+		a.failures++
+	}
+	if a.fetches >= max(a.limit, adaptiveMinWindow) {
+		rate := float64(a.failures) / float64(a.fetches)
+		limit := min(a.limit+max(a.max/10, 1), a.max)
+		if rate > adaptiveErrorRate {
+			limit = max(a.limit/2, a.min)
+		}
+		if limit != a.limit {
+			a.changes = append(a.changes, ConcurrencyChange{At: a.clock.Now(), From: a.limit, To: limit, ErrorRate: rate})
+			a.logf("Concurrency %d → %d: %d of the last %d fetches timed out or got a server error", a.limit, limit, a.failures, a.fetches)
+			a.limit = limit
+		}
+		a.fetches, a.failures = 0, 0
+	}
+	close(a.wake)
+	a.wake = make(chan struct{})
+}
+
+// overloaded reports whether r failed in a way that suggests the network or
+// servers are overwhelmed: a timeout, or a server error or 429 after any
+// retries.
+func overloaded(r Result) bool {
+	e := r.Err
+	if e == nil {
+		return false
+	}
+	return e.Code == CodeTimeout || e.Code == CodeHTTPStatus && (e.HTTPStatus >= 500 || e.HTTPStatus == 429)
+}
+
+// ConcurrencyChanges returns the adjustments adaptive concurrency has made,
+// oldest first; none without WithAdaptiveConcurrency.
+func (v *Validator) ConcurrencyChanges() []ConcurrencyChange {
+	if v.adaptive == nil {
+		return nil
+	}
+	v.adaptive.mu.Lock()
+	defer v.adaptive.mu.Unlock()
+	return append([]ConcurrencyChange(nil), v.adaptive.changes...)
+}
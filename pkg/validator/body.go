@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/atom"
+	"github.com/mmcdole/gofeed/rss"
+)
+
+// headSize is how much of the start of a body is kept after parsing, for
+// the ttl element and captures.
+const headSize = 64 << 10
+
+var errBodyTooLarge = errors.New("body too large")
+
+// readBody reads a response body whole, for the feeds whose body is needed
+// before parsing: partial fetches, which are closed after their last item,
+// and feeds that may be unchanged. It fails with errBodyTooLarge as soon as
+// more than limit bytes arrive (no limit if 0), without reading on.
+func readBody(r io.Reader, limit int64) ([]byte, error) {
+	if limit > 0 {
+		r = io.LimitReader(r, limit+1)
+	}
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	if limit > 0 && int64(buf.Len()) > limit {
+		return nil, errBodyTooLarge
+	}
+	return buf.Bytes(), nil
+}
+
+// bodyReader reads a response body as it is parsed. It fails once more than
+// limit bytes are read (no limit if 0), hashes what it reads, and keeps the
+// first bytes, and all of them when keepAll is set, for what needs the raw
+// body afterwards.
+type bodyReader struct {
+	r     io.Reader
+	limit int64
+	n     int64
+	head  []byte
+	all   *bytes.Buffer
+	hash  hash.Hash
+	// eof is set once the whole body is read, tooLarge once it exceeded
+	// limit, and err holds the first other error reading it.
+	eof      bool
+	tooLarge bool
+	err      error
+}
+
+func newBodyReader(r io.Reader, limit int64, headLimit int, keepAll bool) *bodyReader {
+	b := &bodyReader{r: r, limit: limit, head: make([]byte, 0, headLimit), hash: sha256.New()}
+	if keepAll {
+		b.all = new(bytes.Buffer)
+	}
+	return b
+}
+
+func (b *bodyReader) Read(p []byte) (int, error) {
+	if b.limit > 0 && int64(len(p)) > b.limit-b.n+1 {
+		p = p[:b.limit-b.n+1]
+	}
+	n, err := b.r.Read(p)
+	b.n += int64(n)
+	if b.limit > 0 && b.n > b.limit {
+		n -= int(b.n - b.limit)
+		b.n, b.tooLarge, err = b.limit, true, errBodyTooLarge
+	}
+	if room := cap(b.head) - len(b.head); room > 0 {
+		b.head = append(b.head, p[:min(n, room)]...)
+	}
+	if b.all != nil {
+		b.all.Write(p[:n])
+	}
+	b.hash.Write(p[:n])
+	switch {
+	case err == io.EOF:
+		b.eof = true
+	case err != nil && err != errBodyTooLarge && b.err == nil:
+		b.err = err
+	}
+	return n, err
+}
+
+// truncated reports whether head is only the start of the body.
+func (b *bodyReader) truncated() bool {
+	return !b.eof || b.n > int64(len(b.head))
+}
+
+// sum returns the hex SHA-256 of the body read so far.
+func (b *bodyReader) sum() string {
+	return hex.EncodeToString(b.hash.Sum(nil))
+}
+
+// parseFeed parses an RSS or Atom feed as it is read from r, rather than
+// reading it whole first as gofeed.Parser does. Only the start is buffered,
+// to tell the format; JSON feeds, which gofeed must see whole to tell apart
+// from other JSON, are read whole.
+func parseFeed(r io.Reader, parser *gofeed.Parser) (*gofeed.Feed, error) {
+	br := bufio.NewReaderSize(r, headSize)
+	start, _ := br.Peek(headSize)
+	switch gofeed.DetectFeedType(bytes.NewReader(start)) {
+	case gofeed.FeedTypeRSS:
+		feed, err := (&rss.Parser{}).Parse(br)
+		if err != nil {
+			return nil, err
+		}
+		return (&gofeed.DefaultRSSTranslator{}).Translate(feed)
+	case gofeed.FeedTypeAtom:
+		feed, err := (&atom.Parser{}).Parse(br)
+		if err != nil {
+			return nil, err
+		}
+		return (&gofeed.DefaultAtomTranslator{}).Translate(feed)
+	}
+	return parser.Parse(br)
+}
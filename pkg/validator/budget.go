@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// domainBudget keeps the time spent fetching the feeds of each domain in a
+// run, for WithDomainBudget.
+type domainBudget struct {
+	limit time.Duration
+	mu    sync.Mutex
+	spent map[string]time.Duration
+}
+
+// newDomainBudget returns a run's budget of limit per domain, or nil for no
+// limit.
+func newDomainBudget(limit time.Duration) *domainBudget {
+	if limit <= 0 {
+		return nil
+	}
+	return &domainBudget{limit: limit, spent: make(map[string]time.Duration)}
+}
+
+// exhausted reports whether domain has used up its budget. A nil budget
+// never runs out.
+func (b *domainBudget) exhausted(domain string) bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.spent[domain] >= b.limit
+}
+
+// charge adds d to the time spent on domain, reporting whether that used up
+// its budget.
+func (b *domainBudget) charge(domain string, d time.Duration) bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	before := b.spent[domain]
+	b.spent[domain] = before + d
+	return before < b.limit && b.spent[domain] >= b.limit
+}
+
+// OverBudgetDomains returns the domains that used up their WithDomainBudget
+// in the latest run of ValidateAll or ValidateStream to start, sorted.
+func (v *Validator) OverBudgetDomains() []string {
+	b := v.lastBudget.Load()
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var domains []string
+	for domain, spent := range b.spent {
+		if spent >= b.limit {
+			domains = append(domains, domain)
+		}
+	}
+	sort.Strings(domains)
+	return domains
+}
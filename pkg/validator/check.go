@@ -0,0 +1,422 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fetch is the network-bound stage: it requests the feed, retrying as
+// needed, and reads the body of a successful response. Feeds that fail, and
+// those only probed, end here.
+func (v *Validator) fetch(j *feedJob) {
+	url, ctx := j.url, j.ctx
+
+	req, reqErr := http.NewRequestWithContext(ctx, "GET", RequestURL(url), nil)
+	if reqErr != nil {
+		j.end(failed(url, StatusInvalid, NewError(CodeInvalidURL, reqErr, "Invalid URL: "+reqErr.Error())))
+		return
+	}
+	header, err := ParseHeaders(j.feed.Headers)
+	if err != nil {
+		j.end(failed(url, StatusInvalid, NewError(CodeHeaders, err, "Invalid headers column: "+err.Error())))
+		return
+	}
+	if v.dnsWarmup {
+		if err := j.run.dns.unresolvable(req.URL.Hostname()); err != nil {
+			j.end(failed(url, StatusInvalid, NewError(CodeUnresolvable, err, "Host not found: "+err.Error())))
+			return
+		}
+	}
+
+	domain := registrableDomain(url)
+	if j.run.budget.exhausted(domain) {
+		j.end(failed(url, StatusTransient, NewError(CodeOverBudget, nil, fmt.Sprintf("Skipped: %s used up its %s budget for this run", domain, j.run.budget.limit))))
+		return
+	}
+
+	// The feed waits its turn for its host before its timeout starts.
+	if err := v.limiter.wait(ctx, req.URL.Host); err != nil {
+		cause := context.Cause(ctx)
+		j.end(failed(url, StatusTransient, NewError(CodeCanceled, cause, "Validation canceled: "+cause.Error())))
+		return
+	}
+	// So does it for a slot within the adaptive concurrency limit.
+	if err := v.adaptive.acquire(ctx); err != nil {
+		cause := context.Cause(ctx)
+		j.end(failed(url, StatusTransient, NewError(CodeCanceled, cause, "Validation canceled: "+cause.Error())))
+		return
+	}
+	defer func() { v.adaptive.release(j.done && overloaded(j.result)) }()
+	// The time the fetch takes from here, retries included, counts
+	// towards its domain's budget.
+	defer func(start time.Time) {
+		if j.run.budget.charge(domain, v.clock.Now().Sub(start)) {
+			v.logf("%s used up its %s budget; skipping its remaining feeds", domain, j.run.budget.limit)
+		}
+	}(v.clock.Now())
+	parent := ctx
+	ctx, j.cancel = context.WithTimeout(ctx, v.timeout)
+	j.ctx = ctx
+
+	req.Header.Set("User-Agent", v.userAgent)
+	if v.accept != "" {
+		req.Header.Set("Accept", v.accept)
+	}
+	if lang := v.acceptLanguage(j.feed); lang != "" {
+		req.Header.Set("Accept-Language", lang)
+	}
+	// The feed's own headers, such as a specific Accept, take precedence.
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	ranged := v.partial.ranged(j.feed)
+	if ranged {
+		v.partial.setRange(req)
+	}
+	if err := v.beforeFetch(ctx, j.feed, req); err != nil {
+		j.end(failed(url, StatusTransient, NewError(CodeHook, err, err.Error())))
+		return
+	}
+
+	var resp *http.Response
+
+	attempts := v.retries + 1
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			if err = v.limiter.wait(ctx, req.URL.Host); err != nil {
+				break
+			}
+		}
+		attemptCtx, attemptSpan := tracer.Start(ctx, "fetch", trace.WithAttributes(attribute.Int("http.request.resend_count", attempt-1)))
+		var timings *httpTimings
+		if v.debugHTTP {
+			timings = newHTTPTimings()
+			attemptCtx = timings.trace(attemptCtx)
+		}
+		resp, err = v.do(req.WithContext(traceHTTP(attemptCtx)))
+		if timings != nil {
+			v.logf("HTTP %s %s (attempt %d/%d): %s", req.Method, url, attempt, attempts, timings.summary(resp, err))
+		}
+		if err == nil {
+			attemptSpan.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		}
+		endSpan(attemptSpan, err)
+		if err == nil {
+			if hookErr := v.afterFetch(ctx, j.feed, resp); hookErr != nil {
+				resp.Body.Close()
+				j.end(failed(url, StatusInvalid, NewError(CodeHook, hookErr, hookErr.Error())))
+				return
+			}
+		}
+
+		if err != nil {
+			// Check specifically for context canceled errors
+			if strings.Contains(err.Error(), "context canceled") || strings.Contains(err.Error(), "context deadline exceeded") {
+				v.logf("Timeout on attempt %d/%d for %s: %v", attempt, attempts, url, err)
+			} else {
+				v.logf("Error on attempt %d/%d for %s: %v", attempt, attempts, url, err)
+			}
+
+			if attempt == attempts {
+				break
+			}
+
+			if !v.clock.Sleep(ctx, v.retryDelay(url, attempt)) {
+				err = ctx.Err()
+				break
+			}
+			continue
+		}
+
+		if resp.StatusCode != 200 && !(ranged && resp.StatusCode == http.StatusPartialContent) {
+			errMsg := fmt.Sprintf("HTTP status %d", resp.StatusCode)
+
+			// Don't retry client errors (4xx) except 429 (too many requests)
+			if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != 429 {
+				result := failed(url, StatusInvalid, httpStatusError(resp.StatusCode, errMsg))
+				switch resp.StatusCode {
+				case 401:
+					result.Access, result.AccessObserved = AccessRegistration, true
+				case 402:
+					result.Access, result.AccessObserved = AccessPaywall, true
+				}
+				if v.capturer != nil {
+					body, truncated := readLimited(resp.Body, v.capturer.Limit())
+					v.capture(&result, resp, body, truncated)
+				}
+				resp.Body.Close()
+				j.end(result)
+				return
+			}
+			resp.Body.Close()
+
+			v.logf("Retry %d/%d for %s: %v", attempt, attempts, url, errMsg)
+
+			if attempt == attempts {
+				break
+			}
+
+			if !v.clock.Sleep(ctx, v.retryDelay(url, attempt)) {
+				err = ctx.Err()
+				break
+			}
+			continue
+		}
+
+		// If we got here, we have a successful response
+		break
+	}
+
+	if err != nil {
+		// The caller giving up is told apart from the feed being slow.
+		if parent.Err() != nil {
+			cause := context.Cause(parent)
+			j.end(failed(url, StatusTransient, NewError(CodeCanceled, cause, "Validation canceled: "+cause.Error())))
+			return
+		}
+		if page := consentWall(req.URL, nil, err); page != "" {
+			j.end(failed(url, StatusInvalid, consentWallError(page)))
+			return
+		}
+		// Check specifically for timeout errors
+		if strings.Contains(err.Error(), "context canceled") || strings.Contains(err.Error(), "context deadline exceeded") {
+			j.end(failed(url, StatusTransient, NewError(CodeTimeout, err, fmt.Sprintf("Request timed out after %d seconds", int(v.timeout/time.Second)))))
+			return
+		}
+		j.end(failed(url, StatusTransient, NewError(CodeFetch, err, err.Error())))
+		return
+	}
+
+	if resp == nil || resp.StatusCode != 200 && !(ranged && resp.StatusCode == http.StatusPartialContent) {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		j.end(failed(url, StatusTransient, httpStatusError(statusCode, fmt.Sprintf("Failed after %d attempts, last status: %d", attempts, statusCode))))
+		return
+	}
+
+	// The response is handed to the parse stage open, to be parsed as it
+	// streams, unless its body is needed whole first.
+	handedOff := false
+	defer func() {
+		if !handedOff {
+			resp.Body.Close()
+		}
+	}()
+
+	// A redirect to what looks like a consent page is only one if the
+	// page isn't a feed: probes, which don't read the body, go by whether
+	// it is HTML, and the rest by whether it parses.
+	j.consentPage = consentWall(req.URL, resp, nil)
+	if j.depth == DepthProbe {
+		if typ, _ := mediaType(resp.Header.Get("Content-Type")); j.consentPage != "" && typ == "text/html" {
+			j.end(failed(url, StatusInvalid, consentWallError(j.consentPage)))
+			return
+		}
+		j.end(Result{URL: url, Status: StatusValid, ProbeOnly: true})
+		return
+	}
+	if resp.StatusCode != http.StatusPartialContent && v.previous == nil {
+		j.resp, handedOff = resp, true
+		return
+	}
+
+	_, readSpan := tracer.Start(ctx, "read body")
+	body, err := readBody(resp.Body, v.maxBodySize)
+	if err == nil && resp.StatusCode == http.StatusPartialContent {
+		// Servers that ignore the Range send the whole feed with a 200.
+		if closed, ok := closePartialFeed(body); ok {
+			body, j.partial, j.fullSize = closed, true, fullSize(resp)
+		} else {
+			v.logf("No complete item in the first %d bytes of %s; fetching it whole", len(body), url)
+			if resp, err = v.fetchWhole(req.WithContext(ctx)); err == nil {
+				defer resp.Body.Close()
+				body, err = readBody(resp.Body, v.maxBodySize)
+			}
+		}
+	}
+	readSpan.SetAttributes(attribute.Int("http.response.body.size", len(body)))
+	endSpan(readSpan, err)
+	switch {
+	case errors.Is(err, errBodyTooLarge):
+		j.end(failed(url, StatusInvalid, NewError(CodeTooLarge, nil, fmt.Sprintf("Feed is larger than %d bytes", v.maxBodySize))))
+	case err != nil:
+		j.end(failed(url, StatusTransient, NewError(CodeReadBody, err, "Error reading response: "+err.Error())))
+	default:
+		sum := sha256.Sum256(body)
+		j.resp, j.body, j.bodyHash = resp, body, hex.EncodeToString(sum[:])
+		v.unchanged(j)
+	}
+}
+
+// unchanged ends j with its previous result when its body hasn't changed;
+// see WithPrevious.
+func (v *Validator) unchanged(j *feedJob) {
+	if v.previous == nil {
+		return
+	}
+	prev, ok := v.previous(j.feed)
+	if !ok || prev.Status != StatusValid || prev.BodyHash != j.bodyHash {
+		return
+	}
+	result := prev
+	result.URL, result.Unchanged, result.LatencyMS = j.url, true, 0
+	result.Warnings = slices.Clone(prev.Warnings)
+	if len(result.Warnings) == 0 && result.ItemCount > 0 && v.stale(result.LastUpdate) {
+		result.Warn(WarningStale, "Feed hasn't been updated in over 6 months")
+	}
+	j.end(result)
+}
+
+// stale reports whether a feed last updated at t has gone too long without
+// an update.
+func (v *Validator) stale(t time.Time) bool {
+	return t.Before(v.clock.Now().AddDate(0, -6, 0))
+}
+
+// parse is the CPU-bound stage: it parses the body as it streams from the
+// response fetch handed over, or the body fetch read whole. Feeds that
+// don't parse end here.
+func (v *Validator) parse(j *feedJob) {
+	_, span := tracer.Start(j.ctx, "parse")
+	var src io.Reader = bytes.NewReader(j.body)
+	if j.body == nil {
+		src = j.resp.Body
+		defer j.resp.Body.Close()
+	}
+	// Only the start of the body, or all of it when archiving, is kept.
+	headLimit := headSize
+	if v.capturer != nil {
+		headLimit = max(headLimit, v.capturer.Limit())
+	}
+	body := newBodyReader(src, v.maxBodySize, headLimit, v.archive != nil)
+	parser := gofeed.NewParser()
+	parser.UserAgent = v.userAgent
+	parsed, err := parseFeed(body, parser)
+	if err == nil {
+		// The parser may stop before the end of the body, which is read
+		// on for its hash and size.
+		if _, copyErr := io.Copy(io.Discard, body); copyErr != nil && !body.tooLarge {
+			err = copyErr
+		}
+	}
+	span.SetAttributes(attribute.Int64("http.response.body.size", body.n))
+	endSpan(span, err)
+
+	if body.tooLarge {
+		j.end(failed(j.url, StatusInvalid, NewError(CodeTooLarge, nil, fmt.Sprintf("Feed is larger than %d bytes", v.maxBodySize))))
+		return
+	}
+	if body.err != nil {
+		j.end(failed(j.url, StatusTransient, NewError(CodeReadBody, body.err, "Error reading response: "+body.err.Error())))
+		return
+	}
+	if err == nil {
+		j.parsed, j.head, j.bodySize = parsed, body.head, body.n
+		if j.body == nil {
+			j.bodyHash = body.sum()
+		}
+		if body.all != nil {
+			j.body = body.all.Bytes()
+		}
+		return
+	}
+
+	result := failed(j.url, StatusInvalid, NewError(CodeParse, err, err.Error()))
+	// Check if it might be a different format than expected
+	if j.consentPage != "" {
+		result.Fail(StatusInvalid, consentWallError(j.consentPage))
+	} else if errors.Is(err, gofeed.ErrFeedTypeNotDetected) {
+		result.Fail(StatusInvalid, NewError(CodeNotAFeed, err, err.Error()))
+	} else if strings.Contains(err.Error(), "EOF") || strings.Contains(err.Error(), "no XML") {
+		result.Fail(StatusInvalid, NewError(CodeNotAFeed, err, "Not a valid feed format"))
+	}
+	v.capture(&result, j.resp, body.head, body.truncated())
+	j.end(result)
+}
+
+// analyze is the last stage: it derives the result of a feed that parsed,
+// checking its items, and their links for tiers that sample them, and runs
+// the AfterParse hooks.
+func (v *Validator) analyze(j *feedJob) {
+	url, parsed := j.url, j.parsed
+	result := Result{
+		URL:       url,
+		ItemCount: len(parsed.Items),
+		Status:    StatusValid,
+		BodyHash:  j.bodyHash,
+		Partial:   j.partial,
+		BodySize:  j.bodySize,
+	}
+	if j.partial {
+		result.BodySize = j.fullSize
+	}
+
+	if v.archive != nil {
+		var err error
+		if result.Snapshot, err = v.archive.Put(j.body); err != nil {
+			v.logf("Error archiving %s: %v", url, err)
+		}
+	}
+
+	result.TTLMinutes = int(ttlHint(parsed, j.head[:min(len(j.head), headSize)]) / time.Minute)
+	result.PublishIntervalMinutes = int(publishInterval(parsed) / time.Minute)
+	result.License, result.NoRedistribution = licenseHint(url, parsed.Copyright)
+	result.Access, result.AccessObserved = detectAccessRestriction(parsed), true
+
+	// Check update time if available
+	if parsed.UpdatedParsed != nil {
+		result.LastUpdate = *parsed.UpdatedParsed
+	} else if len(parsed.Items) > 0 && parsed.Items[0].PublishedParsed != nil {
+		result.LastUpdate = *parsed.Items[0].PublishedParsed
+	}
+
+	// Add warnings for potential issues but don't mark as invalid
+	if len(parsed.Items) == 0 {
+		result.Warn(WarningNoItems, "No feed items")
+	} else if v.stale(result.LastUpdate) {
+		result.Warn(WarningStale, "Feed hasn't been updated in over 6 months")
+	}
+
+	if j.depth == DepthSample && len(result.Warnings) == 0 {
+		// Links cut off by cancellation aren't counted as unreachable.
+		if checked, unreachable := v.sampleItemLinks(j.ctx, parsed.Items); unreachable > checked/2 && j.ctx.Err() == nil {
+			result.Warn(WarningUnreachableLinks, "%d of %d sampled item links unreachable", unreachable, checked)
+		}
+	}
+	if v.homepageCheck {
+		v.checkHomepage(j, &result, parsed.Link)
+	}
+	v.checkContentType(&result, j.resp.Header.Get("Content-Type"))
+
+	v.afterParse(j.ctx, j.feed, parsed, &result)
+	j.end(result)
+}
+
+// retryDelay is how long to wait after the given failed attempt to fetch
+// url: a second, doubling with each attempt, give or take half, so feeds
+// failing together don't retry in lockstep. The jitter follows from the
+// validator's seed, the URL and the attempt alone; see WithSeed.
+func (v *Validator) retryDelay(url string, attempt int) time.Duration {
+	backoff := time.Second << (attempt - 1)
+	h := fnv.New64a()
+	h.Write([]byte(url))
+	rng := rand.New(rand.NewPCG(v.seed, h.Sum64()+uint64(attempt)))
+	return backoff/2 + time.Duration(rng.Int64N(int64(backoff)))
+}
@@ -0,0 +1,35 @@
+package validator
+
+import (
+	"context"
+	"time"
+)
+
+// Clock tells the time and waits for validation: retry backoff, host rate
+// limits, staleness and latency go by it. A fake Clock lets tests step
+// through retries without real time passing. Timeouts are contexts' and
+// keep to real time.
+type Clock interface {
+	Now() time.Time
+	// Sleep waits for d, or until ctx is done, and reports whether it
+	// waited the full d.
+	Sleep(ctx context.Context, d time.Duration) bool
+}
+
+// realClock is the system clock.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+)
+
+// checkInput is what a command check reads on stdin.
+type checkInput struct {
+	ID       string       `json:"id"`
+	URL      string       `json:"url"`
+	Language string       `json:"language,omitempty"`
+	Tier     int          `json:"tier"`
+	Result   Result       `json:"result"`
+	Feed     *gofeed.Feed `json:"feed"`
+}
+
+// checkOutput is what a command check writes on stdout.
+type checkOutput struct {
+	Findings []struct {
+		// Severity is "warning", the default, or "error".
+		Severity string `json:"severity"`
+		Code     string `json:"code"`
+		Message  string `json:"message"`
+	} `json:"findings"`
+}
+
+// CommandCheck returns a Hook running an external program as a check of
+// feeds that parsed, so checks of one's own can be written in any language.
+// The program is given the feed's ID, URL, language and tier, its result so
+// far and the parsed feed as a JSON object on stdin, and answers with its
+// findings on stdout:
+//
+//	{"findings": [{"severity": "warning", "code": "no_logo", "message": "Feed has no image"}]}
+//
+// Warnings are added to the result with their code; an error finding marks
+// the feed invalid with CodeCheck. A program that fails, or answers with
+// anything else, adds a WarningCheckFailed warning rather than failing the
+// feed.
+func CommandCheck(name string, args ...string) Hook {
+	return Hook{AfterParse: func(ctx context.Context, feed Feed, parsed *gofeed.Feed, result *Result) {
+		input, err := json.Marshal(checkInput{
+			ID:       feed.ID,
+			URL:      result.URL,
+			Language: feed.Language,
+			Tier:     feed.Tier,
+			Result:   *result,
+			Feed:     parsed,
+		})
+		if err != nil {
+			result.Warn(WarningCheckFailed, "Check %s: %v", name, err)
+			return
+		}
+		cmd := exec.CommandContext(ctx, name, args...)
+		cmd.Stdin = bytes.NewReader(input)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				err = fmt.Errorf("%w: %s", err, msg)
+			}
+			result.Warn(WarningCheckFailed, "Check %s: %v", name, err)
+			return
+		}
+		var output checkOutput
+		if err := json.Unmarshal(out, &output); err != nil {
+			result.Warn(WarningCheckFailed, "Check %s: reading its output: %v", name, err)
+			return
+		}
+		for _, f := range output.Findings {
+			message := f.Message
+			if message == "" {
+				message = f.Code
+			}
+			switch f.Severity {
+			case "error":
+				if result.Status == StatusValid {
+					result.Fail(StatusInvalid, NewError(CodeCheck, nil, message))
+				}
+			default:
+				result.Warn(WarningCode(f.Code), "%s", message)
+			}
+		}
+	}}
+}
@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// consentPage reports whether u looks like a cookie-consent page, where
+// many European sites send visitors without a consent cookie: a consent.
+// host, or a path mentioning consent, a cookie wall or the GDPR.
+func consentPage(u *url.URL) bool {
+	if strings.HasPrefix(strings.ToLower(u.Hostname()), "consent.") {
+		return true
+	}
+	path := strings.ToLower(u.Path)
+	for _, marker := range []string{"consent", "cookiewall", "cookie-wall", "gdpr"} {
+		if strings.Contains(path, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// consentWall returns the consent page the request for requested was
+// redirected to, ending on it with resp or, when the site kept redirecting
+// without a cookie to remember consent by, with err, or "" if it wasn't.
+// A requested URL that looks like a consent page itself, such as a feed
+// about the GDPR, isn't taken for one. A response is only a candidate:
+// whether it is the consent page depends on its body; see fetch.
+func consentWall(requested *url.URL, resp *http.Response, err error) string {
+	if consentPage(requested) {
+		return ""
+	}
+	if resp != nil && resp.Request != nil && resp.Request.URL.String() != requested.String() && consentPage(resp.Request.URL) {
+		return resp.Request.URL.String()
+	}
+	var loopErr *consentLoopError
+	if errors.As(err, &loopErr) {
+		return loopErr.page
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && strings.Contains(urlErr.Err.Error(), "redirects") {
+		if u, parseErr := url.Parse(urlErr.URL); parseErr == nil && consentPage(u) {
+			return urlErr.URL
+		}
+	}
+	return ""
+}
+
+// consentLoopError is the error checkRedirect gives up with when the
+// redirects went through a consent page, which sites without a cookie to
+// remember consent by send visitors back and forth through.
+type consentLoopError struct {
+	page string
+}
+
+func (e *consentLoopError) Error() string {
+	return "stopped after 10 redirects through consent page " + e.page
+}
+
+// consentWallError is the error of a feed that ended up on the consent
+// page at the URL page.
+func consentWallError(page string) *Error {
+	return NewError(CodeConsentWall, nil, "Redirected to a consent page: "+page)
+}
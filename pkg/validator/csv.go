@@ -0,0 +1,206 @@
+package validator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// DatasetColumns is the column order assumed for CSV without a header.
+var DatasetColumns = []string{"url", "comments", "language", "status"}
+
+// SkippedRowsError is returned by ReadCSV, along with the feeds it could
+// read, when malformed rows were skipped.
+type SkippedRowsError struct {
+	// Rows holds why each row was skipped, prefixed with its line.
+	Rows []error
+}
+
+func (e *SkippedRowsError) Error() string {
+	return fmt.Sprintf("skipped %d malformed rows: %v", len(e.Rows), errors.Join(e.Rows...))
+}
+
+// RowError is why a malformed row was skipped.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// ReadCSV reads feeds from a dataset in CSV. Columns are located by header
+// name, so files may reorder or add columns; without a header, hasHeader
+// false, DatasetColumns is assumed. A first row holding a URL, with or
+// without a scheme, is taken as data rather than a header, so a plain list
+// of URLs, one per line, reads as a dataset too. URLs are kept as written;
+// see NormalizeURL for how they are fetched. Blank rows and rows starting with '#' are skipped, and rows
+// without an id get one derived from their URL with DeriveID.
+func ReadCSV(r io.Reader, hasHeader bool) ([]Feed, error) {
+	var feeds []Feed
+	var skipped []error
+	for feed, err := range ScanCSV(r, hasHeader) {
+		var rowErr *RowError
+		if errors.As(err, &rowErr) {
+			skipped = append(skipped, err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		feeds = append(feeds, feed)
+	}
+	if len(skipped) > 0 {
+		return feeds, &SkippedRowsError{Rows: skipped}
+	}
+	return feeds, nil
+}
+
+// ScanCSV reads feeds from a dataset in CSV as ReadCSV does, yielding each
+// as it is read, so datasets too large to hold in memory can be validated
+// with ValidateStream. A malformed row yields a *RowError, and the rows
+// after it follow; an error reading the header ends the sequence.
+func ScanCSV(r io.Reader, hasHeader bool) iter.Seq2[Feed, error] {
+	return func(yield func(Feed, error) bool) {
+		scanCSV(r, hasHeader, yield)
+	}
+}
+
+func scanCSV(r io.Reader, hasHeader bool, yield func(Feed, error) bool) {
+	reader := csv.NewReader(r)
+
+	reader.FieldsPerRecord = -1 // Allow varying number of fields
+	reader.LazyQuotes = true    // Handle quotes more flexibly
+	reader.TrimLeadingSpace = true
+
+	// Rows are located by the physical line they start on, which blank
+	// lines and quoted fields spanning lines put ahead of the record count.
+	columns := DatasetColumns
+	var first []string
+	firstLine := 0
+	if hasHeader {
+		header, err := reader.Read()
+		if err != nil {
+			yield(Feed{}, fmt.Errorf("reading header: %w", err))
+			return
+		}
+		if slices.ContainsFunc(header, func(name string) bool { return strings.Contains(NormalizeURL(name), "://") }) {
+			first = header
+			firstLine, _ = reader.FieldPos(0)
+		} else {
+			columns = make([]string, len(header))
+			for i, name := range header {
+				columns[i] = strings.ToLower(strings.TrimSpace(name))
+			}
+		}
+	}
+
+	for {
+		var record []string
+		var line int
+		var err error
+		if first != nil {
+			record, line, first = first, firstLine, nil
+		} else if record, err = reader.Read(); err == nil {
+			line, _ = reader.FieldPos(0)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				line = parseErr.StartLine
+			}
+			if !yield(Feed{}, &RowError{Line: line, Err: err}) {
+				return
+			}
+			continue
+		}
+
+		feed := Feed{Line: line, Tier: DefaultTier}
+		for i, value := range record {
+			if i >= len(columns) {
+				break
+			}
+			switch columns[i] {
+			case "id":
+				feed.ID = strings.TrimSpace(value)
+			case "url":
+				feed.URL = strings.TrimSpace(value)
+			case "comments":
+				feed.Comments = value
+			case "language":
+				feed.Language = value
+			case "status":
+				feed.Status = value
+			case "license":
+				feed.License = value
+			case "paywall":
+				feed.Paywall = value
+			case "tier":
+				feed.Tier = ParseTier(value)
+			case "headers":
+				feed.Headers = value
+			case "added_by":
+				feed.AddedBy = value
+			case "added_date":
+				feed.AddedDate = value
+			case "source":
+				feed.Source = value
+			}
+		}
+
+		if feed.URL == "" || strings.HasPrefix(feed.URL, "#") {
+			continue
+		}
+		if feed.ID == "" {
+			feed.ID = DeriveID(feed.URL)
+		}
+		if !yield(feed, nil) {
+			return
+		}
+	}
+}
+
+// DeriveID returns the ID a feed is assigned when it is first added: a hash
+// of its URL in CanonicalURL's form, so the same feed gets the same ID
+// however its URL is written. Once written to the id column the ID stays
+// with the row, so later URL changes keep it. Rows differing only in
+// scheme or trailing slash are the same feed and get the same ID, which
+// is reported as a duplicate.
+func DeriveID(rawURL string) string {
+	sum := sha256.Sum256([]byte(CanonicalURL(rawURL)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// ValidateCSV reads a dataset in CSV from r, as ReadCSV does with a header,
+// and validates its feeds with a Validator configured by opts, returning
+// their results, in the order they completed, and the run's summary. It
+// reads nothing from the filesystem, for services validating uploaded
+// lists. Malformed rows are skipped and reported in a *SkippedRowsError
+// alongside the results of the others; any other error reading r means
+// nothing was validated.
+func ValidateCSV(ctx context.Context, r io.Reader, opts ...Option) ([]Result, Summary, error) {
+	feeds, err := ReadCSV(r, true)
+	var skipped *SkippedRowsError
+	if err != nil && !errors.As(err, &skipped) {
+		return nil, Summary{}, err
+	}
+	v := NewValidator(opts...)
+	start := v.clock.Now()
+	results := v.ValidateSlice(ctx, feeds)
+	return results, Summarize(results, start, v.clock.Now()), err
+}
@@ -0,0 +1,233 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator/internal/feedtest"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name, csv string
+		want      []Feed
+	}{
+		{
+			name: "header",
+			csv:  "Tier,URL,id\ntier1,https://a.example/rss,a\n,# https://b.example/rss,b\n3,https://c.example/rss,\n",
+			want: []Feed{
+				{ID: "a", URL: "https://a.example/rss", Tier: 1, Line: 2},
+				{ID: DeriveID("https://c.example/rss"), URL: "https://c.example/rss", Tier: 3, Line: 4},
+			},
+		},
+		{
+			name: "headers",
+			csv:  "url,headers\nhttps://a.example/rss,\"Referer: https://a.example/ | Accept: application/xml\"\n",
+			want: []Feed{
+				{ID: DeriveID("https://a.example/rss"), URL: "https://a.example/rss", Tier: DefaultTier, Headers: "Referer: https://a.example/ | Accept: application/xml", Line: 2},
+			},
+		},
+		{
+			name: "plain list",
+			csv:  "https://a.example/rss\n\nhttps://c.example/rss\n",
+			want: []Feed{
+				{ID: DeriveID("https://a.example/rss"), URL: "https://a.example/rss", Tier: DefaultTier, Line: 1},
+				{ID: DeriveID("https://c.example/rss"), URL: "https://c.example/rss", Tier: DefaultTier, Line: 3},
+			},
+		},
+		{
+			name: "multi-line field",
+			csv:  "url,comments\nhttps://a.example/rss,\"World,\nin depth\"\n\nhttps://c.example/rss,\n",
+			want: []Feed{
+				{ID: DeriveID("https://a.example/rss"), URL: "https://a.example/rss", Comments: "World,\nin depth", Tier: DefaultTier, Line: 2},
+				{ID: DeriveID("https://c.example/rss"), URL: "https://c.example/rss", Tier: DefaultTier, Line: 5},
+			},
+		},
+		{
+			name: "scheme-less list",
+			csv:  "a.example/rss\n//c.example/rss\n",
+			want: []Feed{
+				{ID: DeriveID("a.example/rss"), URL: "a.example/rss", Tier: DefaultTier, Line: 1},
+				{ID: DeriveID("//c.example/rss"), URL: "//c.example/rss", Tier: DefaultTier, Line: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV(strings.NewReader(tt.csv), true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d feeds, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("feed %d: got %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeURL(t *testing.T) {
+	tests := map[string]string{
+		" https://a.example/rss ":  "https://a.example/rss",
+		"http://a.example/rss":     "http://a.example/rss",
+		"a.example/rss":            "https://a.example/rss",
+		"a.example:8080/rss?x=1":   "https://a.example:8080/rss?x=1",
+		"//cdn.a.example/rss":      "https://cdn.a.example/rss",
+		"localhost/rss":            "https://localhost/rss",
+		"/rss.xml":                 "/rss.xml",
+		"rss.xml":                  "https://rss.xml",
+		"not a url":                "notaurl",
+		"mailto:editor@a.example":  "mailto:editor@a.example",
+		"feed:https://a.example/x": "feed:https://a.example/x",
+	}
+	for raw, want := range tests {
+		if got := NormalizeURL(raw); got != want {
+			t.Errorf("NormalizeURL(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+func TestDeriveID(t *testing.T) {
+	same := []string{"https://a.example/rss", "http://a.example/rss/", "https://WWW.a.example:443/rss", "a.example/rss#top", " //a.example/rss"}
+	for _, raw := range same[1:] {
+		if DeriveID(raw) != DeriveID(same[0]) {
+			t.Errorf("DeriveID(%q) differs from DeriveID(%q)", raw, same[0])
+		}
+	}
+	for _, raw := range []string{"https://a.example/rss?lang=fr", "https://a.example:8443/rss", "https://b.example/rss", "https://a.example/RSS"} {
+		if DeriveID(raw) == DeriveID(same[0]) {
+			t.Errorf("DeriveID(%q) is the same as DeriveID(%q)", raw, same[0])
+		}
+	}
+}
+
+func TestFixURL(t *testing.T) {
+	tests := map[string]string{
+		"https://a.example/rss":             "",
+		"HTTPS://a.example/rss":             "",
+		"https://httpbin.example/rss":       "",
+		"https://a.example/o'neill.xml":     "",
+		"http:/a.example/rss":               "http://a.example/rss",
+		"http:///a.example/rss":             "http://a.example/rss",
+		"htp://a.example/rss":               "http://a.example/rss",
+		"htttps://a.example/rss":            "https://a.example/rss",
+		"ttp://a.example/rss":               "http://a.example/rss",
+		"https;//a.example/rss":             "https://a.example/rss",
+		"https//a.example/rss":              "https://a.example/rss",
+		"http://https://a.example/rss":      "https://a.example/rss",
+		"http://http//a.example/rss":        "http://a.example/rss",
+		"https://a.example/ rss":            "https://a.example/rss",
+		"not a url":                         "notaurl",
+		"\"https://a.example/rss\"":         "https://a.example/rss",
+		"\u201chttps://a.example/rss\u201d": "https://a.example/rss",
+		"'https://a.example/rss'":           "https://a.example/rss",
+		"<https://a.example/rss>":           "https://a.example/rss",
+	}
+	for raw, want := range tests {
+		got, fixed := FixURL(raw)
+		if want == "" {
+			if fixed || got != raw {
+				t.Errorf("FixURL(%q) = %q, %v, want it unchanged", raw, got, fixed)
+			}
+			continue
+		}
+		if got != want || !fixed {
+			t.Errorf("FixURL(%q) = %q, %v, want %q, true", raw, got, fixed, want)
+		}
+	}
+
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	typo := strings.Replace(srv.URL, "http://", "htp:/", 1) + "/rss.xml"
+	r := NewValidator(WithFetcher(srv.Client())).ValidateFeed(context.Background(), Feed{URL: typo})
+	if r.Status != StatusValid || r.URL != srv.URL+"/rss.xml" || r.CorrectedFrom != typo {
+		t.Errorf("got %s for %s corrected from %q, want valid for the corrected URL", r.Status, r.URL, r.CorrectedFrom)
+	}
+}
+
+func TestRequestURL(t *testing.T) {
+	tests := map[string]string{
+		"https://a.example/rss":                                      "https://a.example/rss",
+		"http://пример.рф/новости?x=1":                               "http://xn--e1afmkfd.xn--p1ai/%D0%BD%D0%BE%D0%B2%D0%BE%D1%81%D1%82%D0%B8?x=1",
+		"ПРИМЕР.РФ:8080/rss":                                         "https://xn--e1afmkfd.xn--p1ai:8080/rss",
+		"https://%D0%BF%D1%80%D0%B8%D0%BC%D0%B5%D1%80.%D1%80%D1%84/": "https://xn--e1afmkfd.xn--p1ai/",
+		"https://xn--e1afmkfd.xn--p1ai/rss":                          "https://xn--e1afmkfd.xn--p1ai/rss",
+	}
+	for raw, want := range tests {
+		if got := RequestURL(raw); got != want {
+			t.Errorf("RequestURL(%q) = %q, want %q", raw, got, want)
+		}
+	}
+
+	// The request goes to the ASCII host; the result keeps the feed's URL.
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	var host string
+	fetcher := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		host = req.URL.Host
+		req = req.Clone(req.Context())
+		req.URL.Host = strings.TrimPrefix(srv.URL, "http://")
+		return srv.Client().Do(req)
+	}))
+	r := NewValidator(fetcher).ValidateFeed(context.Background(), Feed{URL: "http://пример.рф/rss.xml"})
+	if r.Status != StatusValid || r.URL != "http://пример.рф/rss.xml" || host != "xn--e1afmkfd.xn--p1ai" {
+		t.Errorf("got %s for %s requested from %s, want valid for пример.рф requested from xn--e1afmkfd.xn--p1ai", r.Status, r.URL, host)
+	}
+}
+
+func TestValidateCSV(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	dataset := "url,tier\n" +
+		srv.URL + "/rss.xml,tier2\n" +
+		srv.URL + "/page.html,tier2\n" +
+		srv.URL + "/status/404,tier3\n"
+	results, summary, err := ValidateCSV(context.Background(), strings.NewReader(dataset), WithRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 || summary.Total() != 3 || summary.Valid != 1 || summary.Invalid != 2 {
+		t.Errorf("got %d results, %d valid and %d invalid; want 3, 1 and 2", len(results), summary.Valid, summary.Invalid)
+	}
+}
+
+func TestValidateStream(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	dataset := "url\n" + srv.URL + "/rss.xml\n" + srv.URL + "/status/404\n"
+	var feeds []Feed
+	for feed, err := range ScanCSV(strings.NewReader(dataset), true) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		feeds = append(feeds, feed)
+	}
+	var s Summary
+	for r := range NewValidator(WithRetries(0)).ValidateStream(context.Background(), slices.Values(feeds)) {
+		s.Add(r)
+	}
+	if s.Total() != 2 || s.Valid != 1 || s.Invalid != 1 || s.Results != nil {
+		t.Errorf("got %d results, %d valid and %d invalid; want 2, 1 and 1", s.Total(), s.Valid, s.Invalid)
+	}
+
+	// A list without end is validated until the results stop being taken.
+	endless := func(yield func(Feed) bool) {
+		for yield(Feed{URL: srv.URL + "/rss.xml"}) {
+		}
+	}
+	consumed := 0
+	for range NewValidator().ValidateStream(context.Background(), endless) {
+		if consumed++; consumed == 5 {
+			break
+		}
+	}
+}
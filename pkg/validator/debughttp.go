@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"time"
+)
+
+// httpTimings records how a request went, phase by phase, for
+// WithDebugHTTP.
+type httpTimings struct {
+	mu                               sync.Mutex
+	start                            time.Time
+	dnsStart, connectStart, tlsStart time.Time
+	dns, connect, handshake          time.Duration
+	firstByte                        time.Duration
+	addr                             string
+	reused                           bool
+	tlsVersion                       string
+}
+
+func newHTTPTimings() *httpTimings {
+	return &httpTimings{start: time.Now()}
+}
+
+// trace returns a context recording the request's phases into t.
+func (t *httpTimings) trace(ctx context.Context) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.dnsStart = time.Now()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.dns = time.Since(t.dnsStart)
+		},
+		ConnectStart: func(network, addr string) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.connectStart = time.Now()
+		},
+		ConnectDone: func(network, addr string, err error) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if err == nil {
+				t.connect, t.addr = time.Since(t.connectStart), addr
+			}
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.tlsStart = time.Now()
+		},
+		TLSHandshakeDone: func(state tls.ConnectionState, err error) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.handshake, t.tlsVersion = time.Since(t.tlsStart), tls.VersionName(state.Version)
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.reused = info.Reused
+			if t.addr == "" && info.Conn != nil {
+				t.addr = info.Conn.RemoteAddr().String()
+			}
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.firstByte = time.Since(t.start)
+		},
+	})
+}
+
+// summary describes the outcome of the request, resp or err, and its
+// phases, such as "200 OK (HTTP/2.0, application/rss+xml) in 180ms: dns
+// 3ms, connect 10ms to 192.0.2.1:443, tls 20ms (TLS 1.3), first byte 150ms".
+func (t *httpTimings) summary(resp *http.Response, err error) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var b strings.Builder
+	if err != nil {
+		fmt.Fprintf(&b, "error %v", err)
+	} else {
+		fmt.Fprintf(&b, "%s (%s", resp.Status, resp.Proto)
+		if ct := resp.Header.Get("Content-Type"); ct != "" {
+			b.WriteString(", " + ct)
+		}
+		b.WriteString(")")
+	}
+	fmt.Fprintf(&b, " in %s", ms(time.Since(t.start)))
+
+	var phases []string
+	if t.dns > 0 {
+		phases = append(phases, fmt.Sprintf("dns %s", ms(t.dns)))
+	}
+	switch {
+	case t.reused:
+		phases = append(phases, "reused connection to "+t.addr)
+	case t.addr != "":
+		phases = append(phases, fmt.Sprintf("connect %s to %s", ms(t.connect), t.addr))
+	}
+	if t.tlsVersion != "" {
+		phases = append(phases, fmt.Sprintf("tls %s (%s)", ms(t.handshake), t.tlsVersion))
+	}
+	if t.firstByte > 0 {
+		phases = append(phases, fmt.Sprintf("first byte %s", ms(t.firstByte)))
+	}
+	if len(phases) > 0 {
+		b.WriteString(": " + strings.Join(phases, ", "))
+	}
+	return b.String()
+}
+
+// ms rounds d to the millisecond, for display.
+func ms(d time.Duration) time.Duration {
+	return d.Round(time.Millisecond)
+}
@@ -0,0 +1,210 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// dnsCacheMaxAge bounds how long a lookup is reused. Each run has a cache of
+// its own, so it only matters to runs longer than that.
+const dnsCacheMaxAge = 30 * time.Minute
+
+// dnsLookupTimeout bounds a lookup, which outlives the request that started
+// it when others wait for it too.
+const dnsLookupTimeout = 15 * time.Second
+
+// dnsCache resolves each host once for a run, so hosts with many feeds
+// aren't resolved again for every request. Hosts found not to exist are
+// cached too; other failures, such as a resolver timeout, aren't, and the
+// next request tries again. Concurrent requests for the same host share a
+// lookup.
+type dnsCache struct {
+	lookup func(ctx context.Context, host string) ([]string, error)
+	clock  Clock
+
+	mu      sync.Mutex
+	entries map[string]*dnsEntry
+}
+
+// dnsEntry is the outcome of resolving a host, once ready is closed.
+type dnsEntry struct {
+	ready chan struct{}
+	at    time.Time
+	addrs []string
+	err   error
+}
+
+func newDNSCache(lookup func(ctx context.Context, host string) ([]string, error), clock Clock) *dnsCache {
+	return &dnsCache{lookup: lookup, clock: clock, entries: make(map[string]*dnsEntry)}
+}
+
+// dnsCacheKey is the context key of the run's dnsCache.
+type dnsCacheKey struct{}
+
+// withDNSCache returns ctx carrying c, for the dialer to resolve through.
+func withDNSCache(ctx context.Context, c *dnsCache) context.Context {
+	if c == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, dnsCacheKey{}, c)
+}
+
+// notFound reports whether err says the host doesn't exist.
+func notFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// resolve returns the addresses of host, looking it up unless it has been
+// already, or is being. Waiting for the lookup ends early when ctx is done,
+// but the lookup carries on for others waiting.
+func (c *dnsCache) resolve(ctx context.Context, host string) ([]string, error) {
+	c.mu.Lock()
+	e := c.entries[host]
+	if e == nil || c.clock.Now().Sub(e.at) > dnsCacheMaxAge {
+		e = &dnsEntry{ready: make(chan struct{}), at: c.clock.Now()}
+		c.entries[host] = e
+		go c.fill(context.WithoutCancel(ctx), host, e)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-e.ready:
+		return e.addrs, e.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fill looks up host for e, keeping e only if the host resolved or was found
+// not to exist.
+func (c *dnsCache) fill(ctx context.Context, host string, e *dnsEntry) {
+	ctx, cancel := context.WithTimeout(ctx, dnsLookupTimeout)
+	defer cancel()
+	ctx, span := tracer.Start(ctx, "dns lookup", trace.WithAttributes(attribute.String("net.host.name", host)))
+	e.addrs, e.err = c.lookup(ctx, host)
+	if e.err == nil && len(e.addrs) == 0 {
+		e.err = &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	span.SetAttributes(attribute.Int("dns.addresses", len(e.addrs)))
+	endSpan(span, e.err)
+	if e.err != nil && !notFound(e.err) {
+		c.mu.Lock()
+		if c.entries[host] == e {
+			delete(c.entries, host)
+		}
+		c.mu.Unlock()
+	}
+	close(e.ready)
+}
+
+// warm resolves the hosts of feeds, concurrency at a time, and counts the
+// hosts resolved and those found not to exist.
+func (c *dnsCache) warm(ctx context.Context, feeds []Feed, concurrency int) (resolved, missing int) {
+	ctx, span := tracer.Start(ctx, "dns warmup")
+	defer func() {
+		span.SetAttributes(attribute.Int("dns.resolved", resolved), attribute.Int("dns.missing", missing))
+		span.End()
+	}()
+	seen := make(map[string]bool)
+	hosts := make(chan string)
+	go func() {
+		defer close(hosts)
+		for _, feed := range feeds {
+			u, err := url.Parse(RequestURL(feed.URL))
+			if err != nil {
+				continue
+			}
+			host := u.Hostname()
+			if host == "" || seen[host] || net.ParseIP(host) != nil {
+				continue
+			}
+			seen[host] = true
+			select {
+			case hosts <- host:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for range max(concurrency, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for host := range hosts {
+				_, err := c.resolve(ctx, host)
+				mu.Lock()
+				if err == nil {
+					resolved++
+				} else if notFound(err) {
+					missing++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return resolved, missing
+}
+
+// unresolvable returns why host was found not to exist, or nil if it
+// wasn't, or hasn't been looked up yet. A nil cache knows of no such hosts.
+func (c *dnsCache) unresolvable(host string) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	e := c.entries[host]
+	c.mu.Unlock()
+	if e == nil {
+		return nil
+	}
+	select {
+	case <-e.ready:
+		if notFound(e.err) {
+			return e.err
+		}
+	default:
+	}
+	return nil
+}
+
+// cachedDial dials the addresses of the host of addr in turn, resolved
+// through the dnsCache of the request's run, if its context carries one.
+func cachedDial(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		c, _ := ctx.Value(dnsCacheKey{}).(*dnsCache)
+		host, port, err := net.SplitHostPort(addr)
+		if c == nil || err != nil || net.ParseIP(host) != nil {
+			return dialer.DialContext(ctx, network, addr)
+		}
+		ips, err := c.resolve(ctx, host)
+		if err != nil {
+			return nil, &net.OpError{Op: "dial", Net: network, Err: err}
+		}
+		var firstErr error
+		for _, ip := range ips {
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip, port))
+			if err == nil {
+				return conn, nil
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		return nil, firstErr
+	}
+}
@@ -0,0 +1,189 @@
+package validator
+
+import "fmt"
+
+// Status is the outcome of validating a feed.
+type Status string
+
+const (
+	// StatusValid feeds answered and parsed, possibly with warnings.
+	StatusValid Status = "valid"
+	// StatusInvalid feeds are broken: they answered with a client error or
+	// something that isn't a feed.
+	StatusInvalid Status = "invalid"
+	// StatusTransient feeds couldn't be checked this time and may recover:
+	// the request timed out, the network failed or the server erred.
+	StatusTransient Status = "transient"
+)
+
+// ErrorCode identifies why a feed failed validation. Codes are stable and
+// safe to branch on and store.
+type ErrorCode string
+
+const (
+	CodeInvalidURL ErrorCode = "invalid_url"
+	// CodeHTTPStatus is an unsuccessful response status, given in
+	// Error.HTTPStatus.
+	CodeHTTPStatus ErrorCode = "http_status"
+	CodeTimeout    ErrorCode = "timeout"
+	// CodeCanceled means the caller's context ended validation.
+	CodeCanceled ErrorCode = "canceled"
+	// CodeUnresolvable means the feed's host doesn't exist in DNS, as found
+	// by WithDNSWarmup.
+	CodeUnresolvable ErrorCode = "unresolvable"
+	// CodeOverBudget means the feed wasn't fetched, as its domain had used
+	// up its time budget; see WithDomainBudget.
+	CodeOverBudget ErrorCode = "over_budget"
+	// CodeConsentWall means the feed redirected to a cookie-consent page,
+	// as European sites do for visitors without a consent cookie; see
+	// WithCookieJar.
+	CodeConsentWall ErrorCode = "consent_wall"
+	// CodeHeaders means the feed's headers column is malformed; see
+	// ParseHeaders.
+	CodeHeaders ErrorCode = "headers"
+	// CodeFetch is a network error other than a timeout.
+	CodeFetch    ErrorCode = "fetch"
+	CodeReadBody ErrorCode = "read_body"
+	// CodeTooLarge means the body exceeded the maximum size; see
+	// WithMaxBodySize.
+	CodeTooLarge ErrorCode = "too_large"
+	// CodeNotAFeed means the body isn't XML or JSON, such as an HTML page;
+	// CodeParse that it is, but didn't parse as a feed.
+	CodeNotAFeed ErrorCode = "not_a_feed"
+	CodeParse    ErrorCode = "parse"
+	// CodeHook is an error returned by a Hook.
+	CodeHook ErrorCode = "hook"
+	// CodeCheck is an error finding of a CommandCheck.
+	CodeCheck ErrorCode = "check"
+	// CodePolicy is a failure decided by policy applied to results after
+	// validation, such as the CLI's --policy rules.
+	CodePolicy ErrorCode = "policy"
+	// CodeInternal is a panic while checking the feed, in the parser or a
+	// hook: a bug rather than a problem with the feed.
+	CodeInternal ErrorCode = "internal"
+)
+
+// Error is why a feed failed validation. It matches the sentinel of its code
+// with errors.Is, and unwraps to the underlying error, if any, such as
+// context.DeadlineExceeded or a hook's error:
+//
+//	if errors.Is(r.Err, validator.ErrTimeout) { ... }
+//	var e *validator.Error
+//	if errors.As(r.Err, &e) && e.HTTPStatus == 404 { ... }
+//
+// Its JSON form has the code, message and HTTP status; the underlying error
+// isn't kept.
+type Error struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+	// HTTPStatus is the last response status, for CodeHTTPStatus.
+	HTTPStatus int `json:"http_status,omitempty"`
+	err        error
+}
+
+// Sentinels for errors.Is, one per code.
+var (
+	ErrInvalidURL   = &Error{Code: CodeInvalidURL}
+	ErrHTTPStatus   = &Error{Code: CodeHTTPStatus}
+	ErrTimeout      = &Error{Code: CodeTimeout}
+	ErrCanceled     = &Error{Code: CodeCanceled}
+	ErrUnresolvable = &Error{Code: CodeUnresolvable}
+	ErrOverBudget   = &Error{Code: CodeOverBudget}
+	ErrConsentWall  = &Error{Code: CodeConsentWall}
+	ErrHeaders      = &Error{Code: CodeHeaders}
+	ErrFetch        = &Error{Code: CodeFetch}
+	ErrReadBody     = &Error{Code: CodeReadBody}
+	ErrTooLarge     = &Error{Code: CodeTooLarge}
+	ErrNotAFeed     = &Error{Code: CodeNotAFeed}
+	ErrParse        = &Error{Code: CodeParse}
+	ErrHook         = &Error{Code: CodeHook}
+	ErrCheck        = &Error{Code: CodeCheck}
+	ErrPolicy       = &Error{Code: CodePolicy}
+	ErrInternal     = &Error{Code: CodeInternal}
+)
+
+// NewError returns an error of code with message, wrapping err, which may be
+// nil.
+func NewError(code ErrorCode, err error, message string) *Error {
+	return &Error{Code: code, Message: message, err: err}
+}
+
+func httpStatusError(status int, message string) *Error {
+	return &Error{Code: CodeHTTPStatus, Message: message, HTTPStatus: status}
+}
+
+func (e *Error) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	if e.Message == "" {
+		return string(e.Code)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.err
+}
+
+// Is reports whether target is the sentinel of e's code.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && e != nil && t.Message == "" && t.Code == e.Code
+}
+
+// WarningCode identifies a problem with a valid feed. Hooks may add codes of
+// their own.
+type WarningCode string
+
+const (
+	WarningNoItems WarningCode = "no_items"
+	// WarningStale feeds haven't been updated in over six months.
+	WarningStale WarningCode = "stale"
+	// WarningUnreachableLinks feeds have most of their sampled item links
+	// failing to resolve.
+	WarningUnreachableLinks WarningCode = "unreachable_item_links"
+	// WarningCheckFailed means a CommandCheck couldn't run or answered with
+	// something other than its findings.
+	WarningCheckFailed WarningCode = "check_failed"
+	// WarningHomepage feeds link to a homepage that is unreachable or a
+	// parked domain, often a sign of an abandoned site; see
+	// WithHomepageCheck.
+	WarningHomepage WarningCode = "homepage"
+	// WarningContentType feeds were served with a Content-Type other than
+	// the feed types asked for; see WithAccept.
+	WarningContentType WarningCode = "content_type"
+	// WarningPolicy is a warning decided by policy applied after validation.
+	WarningPolicy WarningCode = "policy"
+)
+
+// Warning is a problem found with a feed that doesn't make it invalid.
+type Warning struct {
+	Code    WarningCode `json:"code"`
+	Message string      `json:"message"`
+}
+
+// Fail marks r as failed with err, which also becomes its Message. Hooks
+// can use it to fail feeds that parsed.
+func (r *Result) Fail(status Status, err *Error) {
+	r.Status, r.Err, r.Message = status, err, err.Message
+}
+
+// failed returns a failed result for url.
+func failed(url string, status Status, err *Error) Result {
+	r := Result{URL: url}
+	r.Fail(status, err)
+	return r
+}
+
+// Warn adds a warning to r. The first warning also becomes r's Message.
+func (r *Result) Warn(code WarningCode, format string, args ...any) {
+	w := Warning{Code: code, Message: fmt.Sprintf(format, args...)}
+	r.Warnings = append(r.Warnings, w)
+	if r.Message == "" {
+		r.Message = "Warning: " + w.Message
+	}
+}
@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxHandlerRequest bounds the body of a request to Handler.
+const maxHandlerRequest = 64 << 10
+
+// handlerRequest is the body of a request to Handler.
+type handlerRequest struct {
+	URL  string `json:"url"`
+	Tier int    `json:"tier,omitempty"`
+}
+
+// Handler returns an http.Handler validating one feed per request with a
+// Validator configured by opts, for tools offering to check a feed on
+// demand. It takes a POST of a JSON object with the feed's "url" and
+// optionally its "tier" (DefaultTier if absent), and answers with the Result
+// as JSON, whatever the feed's status. Malformed requests get 400, other
+// methods 405. The request's context bounds the validation, so a client
+// that goes away cancels it.
+//
+// Mount it where it should answer, e.g.
+//
+//	mux.Handle("POST /validate", validator.Handler(validator.WithTimeout(20*time.Second)))
+//
+// It fetches any URL it is given, from wherever it runs; serve it only to
+// trusted clients.
+func Handler(opts ...Option) http.Handler {
+	v := NewValidator(opts...)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req handlerRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxHandlerRequest)).Decode(&req); err != nil {
+			http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		url := strings.TrimSpace(req.URL)
+		if url == "" {
+			http.Error(w, "url is required", http.StatusBadRequest)
+			return
+		}
+		if req.Tier == 0 {
+			req.Tier = DefaultTier
+		}
+		if _, ok := TierPolicies[req.Tier]; !ok {
+			http.Error(w, fmt.Sprintf("unknown tier %d", req.Tier), http.StatusBadRequest)
+			return
+		}
+
+		result := v.ValidateFeed(r.Context(), Feed{ID: DeriveID(url), URL: url, Tier: req.Tier})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	})
+}
@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator/internal/feedtest"
+)
+
+func TestHandler(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	h := Handler(WithRetries(0))
+
+	tests := []struct {
+		name, method, body string
+		wantCode           int
+		wantStatus         Status
+	}{
+		{"valid", "POST", `{"url": "` + srv.URL + `/rss.xml"}`, 200, StatusValid},
+		{"invalid", "POST", `{"url": "` + srv.URL + `/page.html", "tier": 2}`, 200, StatusInvalid},
+		{"probe", "POST", `{"url": "` + srv.URL + `/status/500", "tier": 3}`, 200, StatusTransient},
+		{"no url", "POST", `{"tier": 1}`, 400, ""},
+		{"unknown tier", "POST", `{"url": "` + srv.URL + `/rss.xml", "tier": 9}`, 400, ""},
+		{"not json", "POST", `url=x`, 400, ""},
+		{"get", "GET", ``, 405, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tt.method, "/validate", strings.NewReader(tt.body)))
+			if rec.Code != tt.wantCode {
+				t.Fatalf("got %d %s, want %d", rec.Code, rec.Body, tt.wantCode)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			var r Result
+			if err := json.Unmarshal(rec.Body.Bytes(), &r); err != nil {
+				t.Fatal(err)
+			}
+			if r.Status != tt.wantStatus || r.ID != DeriveID(r.URL) {
+				t.Errorf("got %s result with ID %q, want %s with the URL's ID", r.Status, r.ID, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ParseHeaders parses a feed's headers column: "Name: value" pairs
+// separated by "|", such as "Referer: https://example.com/ | Accept:
+// application/xml". The column is public, so values are sent as written:
+// ${NAME} placeholders are refused rather than filled in, as secrets belong
+// in the CLI's --credentials file, bound to the hosts they are for.
+func ParseHeaders(s string) (http.Header, error) {
+	header := make(http.Header)
+	for _, field := range strings.Split(s, "|") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(field, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("header %q isn't \"Name: value\"", field)
+		}
+		if strings.Contains(value, "${") {
+			return nil, fmt.Errorf("header %s has a placeholder; secrets go in a credentials file, not the dataset", name)
+		}
+		header.Add(name, strings.TrimSpace(value))
+	}
+	return header, nil
+}
@@ -0,0 +1,170 @@
+package validator
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// hedgeWindow is how many of the latest response times the hedging delay is
+// worked out from, and hedgeMinSamples how many it needs first.
+const (
+	hedgeWindow     = 200
+	hedgeMinSamples = 20
+)
+
+// hedger decides when a slow request gets a second, hedged, request; see
+// WithHedging.
+type hedger struct {
+	after time.Duration
+
+	mu    sync.Mutex
+	times []time.Duration
+	next  int
+	sent  int
+	won   int
+}
+
+// delay returns how long to wait for a response before hedging, or 0 not
+// to hedge yet.
+func (h *hedger) delay() time.Duration {
+	if h.after > 0 {
+		return h.after
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.times) < hedgeMinSamples {
+		return 0
+	}
+	sorted := slices.Sorted(slices.Values(h.times))
+	return sorted[(95*len(sorted)+99)/100-1]
+}
+
+// observe records how long a request took to answer.
+func (h *hedger) observe(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.times) < hedgeWindow {
+		h.times = append(h.times, d)
+		return
+	}
+	h.times[h.next] = d
+	h.next = (h.next + 1) % hedgeWindow
+}
+
+// hedgedResponse is the outcome of one of the requests of do.
+type hedgedResponse struct {
+	resp   *http.Response
+	err    error
+	cancel context.CancelFunc
+	hedge  bool
+}
+
+// cancelOnClose cancels a request's context once its body is closed, so a
+// response that won keeps its context while being read.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+// do sends req with the fetcher or, when hedging and no response has come
+// by the hedging delay, sends it again and returns whichever answers first,
+// canceling the other. A request that fails while the other is pending
+// leaves the outcome to it.
+func (v *Validator) do(req *http.Request) (*http.Response, error) {
+	h := v.hedger
+	if h == nil {
+		return v.fetcher.Do(req)
+	}
+	start := v.clock.Now()
+	delay := h.delay()
+	if delay == 0 {
+		resp, err := v.fetcher.Do(req)
+		if err == nil {
+			h.observe(v.clock.Now().Sub(start))
+		}
+		return resp, err
+	}
+
+	responses := make(chan hedgedResponse, 2)
+	send := func(hedge bool) {
+		ctx, cancel := context.WithCancel(req.Context())
+		resp, err := v.fetcher.Do(req.Clone(ctx))
+		responses <- hedgedResponse{resp: resp, err: err, cancel: cancel, hedge: hedge}
+	}
+	go send(false)
+	timer := make(chan struct{})
+	timerCtx, stopTimer := context.WithCancel(req.Context())
+	defer stopTimer()
+	go func() {
+		if v.clock.Sleep(timerCtx, delay) {
+			close(timer)
+		}
+	}()
+
+	pending := 1
+	var last hedgedResponse
+	for pending > 0 {
+		select {
+		case <-timer:
+			timer = nil
+			pending++
+			h.mu.Lock()
+			h.sent++
+			h.mu.Unlock()
+			v.logf("No response from %s after %s; sending a hedged request", req.URL, delay.Round(time.Millisecond))
+			go send(true)
+		case r := <-responses:
+			pending--
+			if r.err != nil {
+				r.cancel()
+				last = r
+				if timer != nil {
+					// The request failed before it was slow enough to
+					// hedge.
+					return nil, r.err
+				}
+				continue
+			}
+			stopTimer()
+			h.observe(v.clock.Now().Sub(start))
+			if r.hedge {
+				h.mu.Lock()
+				h.won++
+				h.mu.Unlock()
+			}
+			if pending > 0 {
+				go func() {
+					loser := <-responses
+					loser.cancel()
+					if loser.resp != nil {
+						loser.resp.Body.Close()
+					}
+				}()
+			}
+			r.resp.Body = cancelOnClose{r.resp.Body, r.cancel}
+			return r.resp, nil
+		}
+	}
+	return nil, last.err
+}
+
+// HedgedRequests returns how many hedged requests WithHedging has sent, and
+// how many of them answered first.
+func (v *Validator) HedgedRequests() (sent, won int) {
+	if v.hedger == nil {
+		return 0, 0
+	}
+	v.hedger.mu.Lock()
+	defer v.hedger.mu.Unlock()
+	return v.hedger.sent, v.hedger.won
+}
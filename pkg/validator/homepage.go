@@ -0,0 +1,145 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// parkingHosts are domain marketplaces and parking services that parked
+// domains redirect to.
+var parkingHosts = []string{"sedo.com", "dan.com", "afternic.com", "hugedomains.com", "bodis.com", "parkingcrew.net", "above.com", "undeveloped.com"}
+
+// parkedMarkers are phrases of parked-domain pages, lowercased.
+var parkedMarkers = []string{
+	"this domain is for sale",
+	"this domain may be for sale",
+	"buy this domain",
+	"domain is parked",
+	"parked free",
+	"sedoparking",
+	"parkingcrew",
+}
+
+// homepageCache keeps what checking each homepage found in a run, so the
+// feeds of one site fetch it once.
+type homepageCache struct {
+	mu       sync.Mutex
+	problems map[string]string
+}
+
+func newHomepageCache() *homepageCache {
+	return &homepageCache{problems: make(map[string]string)}
+}
+
+// get returns what checking home found, and whether it has been checked.
+func (c *homepageCache) get(home string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	problem, ok := c.problems[home]
+	return problem, ok
+}
+
+func (c *homepageCache) put(home, problem string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.problems[home] = problem
+}
+
+// checkHomepage warns on result when the homepage j's feed links to is
+// unreachable or a parked domain; see WithHomepageCheck.
+func (v *Validator) checkHomepage(j *feedJob, result *Result, link string) {
+	base, err := url.Parse(RequestURL(j.url))
+	if err != nil || link == "" {
+		return
+	}
+	home, err := base.Parse(strings.TrimSpace(link))
+	if err != nil || (home.Scheme != "http" && home.Scheme != "https") {
+		return
+	}
+	problem, ok := j.run.homepages.get(home.String())
+	if !ok {
+		if problem, ok = v.fetchHomepage(j, home); !ok {
+			return
+		}
+	}
+	if problem != "" {
+		result.Warn(WarningHomepage, "Homepage %s %s", home, problem)
+	}
+}
+
+// fetchHomepage checks home for j as homepageProblem does, within the same
+// limits as feeds: under WithSerialHosts it waits its turn for home's host,
+// and its time counts towards the domain's WithDomainBudget. The outcome is
+// kept for the rest of the run. It returns false, with nothing kept, when
+// the check was skipped or cut off by cancellation.
+func (v *Validator) fetchHomepage(j *feedJob, home *url.URL) (string, bool) {
+	if host := strings.ToLower(home.Hostname()); j.hold != nil && host != feedHost(j.feed) {
+		free, ok := j.hold(host)
+		if !ok {
+			return "", false
+		}
+		defer free()
+		// Another feed of the site may have checked it meanwhile.
+		if problem, ok := j.run.homepages.get(home.String()); ok {
+			return problem, true
+		}
+	}
+	domain := registrableDomain(home.String())
+	if j.run.budget.exhausted(domain) {
+		return "", false
+	}
+	start := v.clock.Now()
+	problem := v.homepageProblem(j.ctx, home.String())
+	if j.run.budget.charge(domain, v.clock.Now().Sub(start)) {
+		v.logf("%s used up its %s budget; skipping its remaining feeds", domain, j.run.budget.limit)
+	}
+	// Homepages cut off by cancellation aren't counted as unreachable.
+	if j.ctx.Err() != nil {
+		return "", false
+	}
+	j.run.homepages.put(home.String(), problem)
+	return problem, true
+}
+
+// homepageProblem fetches the homepage and returns what is wrong with it,
+// such as "is unreachable: HTTP status 404", or "" if nothing.
+func (v *Validator) homepageProblem(ctx context.Context, home string) string {
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", home, nil)
+	if err != nil {
+		return "is unreachable: " + err.Error()
+	}
+	req.Header.Set("User-Agent", v.userAgent)
+	if err := v.limiter.wait(ctx, req.URL.Host); err != nil {
+		return ""
+	}
+	resp, err := v.fetcher.Do(req)
+	if err != nil {
+		return "is unreachable: " + err.Error()
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Sprintf("is unreachable: HTTP status %d", resp.StatusCode)
+	}
+	if resp.Request != nil && resp.Request.URL.Hostname() != req.URL.Hostname() {
+		host := resp.Request.URL.Hostname()
+		for _, parking := range parkingHosts {
+			if host == parking || strings.HasSuffix(host, "."+parking) {
+				return "redirects to the domain marketplace " + parking
+			}
+		}
+	}
+	head, _ := readLimited(resp.Body, headSize)
+	page := strings.ToLower(string(head))
+	for _, marker := range parkedMarkers {
+		if strings.Contains(page, marker) {
+			return fmt.Sprintf("looks like a parked domain (%q)", marker)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mmcdole/gofeed"
+)
+
+// Hook adds behavior around the stages of validating a feed, such as
+// injecting auth headers, recording metrics or applying quality checks of
+// one's own. A Validator's hooks run in order at each stage; any of a hook's
+// functions may be nil.
+type Hook struct {
+	// BeforeFetch is called with the request for a feed before it is first
+	// sent, and may change it; retries send it again as changed. An error
+	// ends validation with a transient result, as the feed wasn't checked.
+	BeforeFetch func(ctx context.Context, feed Feed, req *http.Request) error
+	// AfterFetch is called with each response received for a feed, whatever
+	// its status, before its body is read. It must not consume the body. An
+	// error ends validation with an invalid result.
+	AfterFetch func(ctx context.Context, feed Feed, resp *http.Response) error
+	// AfterParse is called once a feed has parsed and the built-in checks
+	// are done, and may change the result, e.g. to add a warning message or
+	// mark the feed invalid. It isn't called for feeds only probed.
+	AfterParse func(ctx context.Context, feed Feed, parsed *gofeed.Feed, result *Result)
+}
+
+func (v *Validator) beforeFetch(ctx context.Context, feed Feed, req *http.Request) error {
+	for _, h := range v.hooks {
+		if h.BeforeFetch != nil {
+			if err := h.BeforeFetch(ctx, feed, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *Validator) afterFetch(ctx context.Context, feed Feed, resp *http.Response) error {
+	for _, h := range v.hooks {
+		if h.AfterFetch != nil {
+			if err := h.AfterFetch(ctx, feed, resp); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *Validator) afterParse(ctx context.Context, feed Feed, parsed *gofeed.Feed, result *Result) {
+	for _, h := range v.hooks {
+		if h.AfterParse != nil {
+			h.AfterParse(ctx, feed, parsed, result)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strings"
+)
+
+// feedHost returns the host name a feed is fetched from, or its URL when it
+// has none, so that it is grouped with no other.
+func feedHost(feed Feed) string {
+	u, err := url.Parse(NormalizeURL(feed.URL))
+	if err != nil || u.Hostname() == "" {
+		return feed.URL
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// sendFeeds sends feeds to out until they run out or ctx is done, then
+// closes out.
+func sendFeeds(ctx context.Context, feeds iter.Seq[Feed], out chan<- Feed) {
+	defer close(out)
+	for feed := range feeds {
+		select {
+		case out <- feed:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// hostClaim asks dispatchByHost for a host other than a feed's, such as
+// that of a feed's homepage; granted is closed once the host is the
+// claimant's, until it sends the host to freed.
+type hostClaim struct {
+	host    string
+	granted chan struct{}
+}
+
+// dispatchByHost passes the feeds from incoming on to jobs, for
+// WithSerialHosts, holding back each feed while another feed of its host is
+// being validated: the host is busy from when a feed is passed on until its
+// job sends the host to freed. Feeds of other hosts go ahead meanwhile, in
+// the order they came. Claims are granted the same way, ahead of the feeds
+// waiting for the host, since their jobs are already under way. jobs is
+// closed once incoming is and every held feed has been passed on, or when
+// ctx is done.
+func dispatchByHost(ctx context.Context, incoming <-chan Feed, jobs chan<- Feed, freed <-chan string, claims <-chan hostClaim) {
+	defer close(jobs)
+	busy := make(map[string]bool)
+	held := make(map[string][]Feed)
+	waiting := make(map[string][]hostClaim)
+	var ready []Feed
+	for incoming != nil || len(busy) > 0 {
+		var out chan<- Feed
+		var next Feed
+		if len(ready) > 0 {
+			out, next = jobs, ready[0]
+		}
+		select {
+		case feed, ok := <-incoming:
+			if !ok {
+				incoming = nil
+				continue
+			}
+			if host := feedHost(feed); busy[host] {
+				held[host] = append(held[host], feed)
+			} else {
+				busy[host] = true
+				ready = append(ready, feed)
+			}
+		case claim := <-claims:
+			if busy[claim.host] {
+				waiting[claim.host] = append(waiting[claim.host], claim)
+			} else {
+				busy[claim.host] = true
+				close(claim.granted)
+			}
+		case host := <-freed:
+			if queue := waiting[host]; len(queue) > 0 {
+				close(queue[0].granted)
+				if waiting[host] = queue[1:]; len(waiting[host]) == 0 {
+					delete(waiting, host)
+				}
+			} else if queue := held[host]; len(queue) > 0 {
+				ready, held[host] = append(ready, queue[0]), queue[1:]
+			} else {
+				delete(busy, host)
+				delete(held, host)
+			}
+		case out <- next:
+			ready = ready[1:]
+		case <-ctx.Done():
+			// Let sendFeeds see ctx is done rather than block on incoming.
+			if incoming != nil {
+				for range incoming {
+				}
+			}
+			return
+		}
+	}
+}
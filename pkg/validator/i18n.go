@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Messages are written in English and translated only for display, so the
+// codes, messages and machine-readable reports stay the same whatever
+// language people read the console in. A locale maps each English format,
+// as passed to fmt.Sprintf, to its translation with the same verbs; text
+// without a translation is shown in English.
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// catalogs maps each language to its translations, by English format.
+var catalogs = sync.OnceValue(func() map[string]map[string]string {
+	files, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+	catalogs := make(map[string]map[string]string)
+	for _, f := range files {
+		data, err := localeFiles.ReadFile("locales/" + f.Name())
+		if err != nil {
+			panic(err)
+		}
+		var catalog map[string]string
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic(fmt.Sprintf("locales/%s: %v", f.Name(), err))
+		}
+		catalogs[strings.TrimSuffix(f.Name(), path.Ext(f.Name()))] = catalog
+	}
+	return catalogs
+})
+
+// messageFormat matches text produced by an English format, for
+// translating messages after they have been formatted.
+type messageFormat struct {
+	format string
+	re     *regexp.Regexp
+	// verbs holds each argument's verb, 'd' or 's'.
+	verbs []byte
+}
+
+var verbPattern = regexp.MustCompile(`%[ds]`)
+
+// messageFormats are the formats with arguments translated by some locale,
+// longest first so that the most specific matches.
+var messageFormats = sync.OnceValue(func() []messageFormat {
+	seen := make(map[string]bool)
+	var formats []messageFormat
+	for _, catalog := range catalogs() {
+		for format := range catalog {
+			if seen[format] || !verbPattern.MatchString(format) {
+				continue
+			}
+			seen[format] = true
+			f := messageFormat{format: format}
+			var pattern strings.Builder
+			last := 0
+			for _, loc := range verbPattern.FindAllStringIndex(format, -1) {
+				pattern.WriteString(regexp.QuoteMeta(format[last:loc[0]]))
+				verb := format[loc[1]-1]
+				if verb == 'd' {
+					pattern.WriteString(`(-?\d+)`)
+				} else {
+					pattern.WriteString(`(.*?)`)
+				}
+				f.verbs = append(f.verbs, verb)
+				last = loc[1]
+			}
+			pattern.WriteString(regexp.QuoteMeta(format[last:]))
+			f.re = regexp.MustCompile("^" + pattern.String() + "$")
+			formats = append(formats, f)
+		}
+	}
+	slices.SortFunc(formats, func(a, b messageFormat) int {
+		return len(b.format) - len(a.format)
+	})
+	return formats
+})
+
+// Languages returns the languages text can be localized to, "en" included.
+func Languages() []string {
+	langs := []string{"en"}
+	for lang := range catalogs() {
+		langs = append(langs, lang)
+	}
+	slices.Sort(langs)
+	return langs
+}
+
+// Localize translates text, a message already formatted, such as a Result's
+// Message, to lang. Its arguments are carried over, and text substituted
+// into a known message, such as the message after "Warning: ", is
+// translated in turn. Text in no known format, or in a language without a
+// locale, is returned unchanged.
+func Localize(lang, text string) string {
+	catalog := catalogs()[lang]
+	if catalog == nil {
+		return text
+	}
+	if t, ok := catalog[text]; ok {
+		return t
+	}
+	for _, f := range messageFormats() {
+		t, ok := catalog[f.format]
+		if !ok {
+			continue
+		}
+		m := f.re.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		args := make([]any, len(f.verbs))
+		for i, verb := range f.verbs {
+			if verb == 'd' {
+				args[i], _ = strconv.Atoi(m[i+1])
+			} else {
+				args[i] = Localize(lang, m[i+1])
+			}
+		}
+		return fmt.Sprintf(t, args...)
+	}
+	return text
+}
+
+// Localizef formats args with format translated to lang, or in English if
+// it has no translation.
+func Localizef(lang, format string, args ...any) string {
+	if t, ok := catalogs()[lang][format]; ok {
+		format = t
+	}
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		lang, text, want string
+	}{
+		{"es", "HTTP status 404", "Estado HTTP 404"},
+		{"fr", "Failed after 3 attempts, last status: 503", "Échec après 3 tentatives, dernier statut : 503"},
+		{"ru", "Warning: 2 of 5 sampled item links unreachable", "Предупреждение: Недоступно ссылок из выборки: 2 из 5"},
+		{"es", "Warning: No feed items", "Aviso: El feed no tiene elementos"},
+		{"ar", "Host not found: lookup x.invalid: no such host", "لم يُعثر على الخادم: lookup x.invalid: no such host"},
+		{"es", "Warning: something a check said", "Aviso: something a check said"},
+		{"es", "unexpected EOF", "unexpected EOF"},
+		{"en", "HTTP status 404", "HTTP status 404"},
+		{"xx", "HTTP status 404", "HTTP status 404"},
+	}
+	for _, tt := range tests {
+		if got := Localize(tt.lang, tt.text); got != tt.want {
+			t.Errorf("Localize(%q, %q) = %q, want %q", tt.lang, tt.text, got, tt.want)
+		}
+	}
+}
+
+// TestLocales checks that every locale translates the same messages, each
+// with the verbs of the English.
+func TestLocales(t *testing.T) {
+	reference := catalogs()["es"]
+	for lang, catalog := range catalogs() {
+		if !slices.Equal(slices.Sorted(maps.Keys(catalog)), slices.Sorted(maps.Keys(reference))) {
+			t.Errorf("%s doesn't translate the same messages as es", lang)
+		}
+		for format, translation := range catalog {
+			if got, want := verbPattern.FindAllString(translation, -1), verbPattern.FindAllString(format, -1); !slices.Equal(got, want) {
+				t.Errorf("%s: %q has verbs %q, want %q", lang, translation, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Package feedtest serves fixture feeds and misbehaving responses over HTTP
+// so validation can be tested without the network.
+package feedtest
+
+import (
+	"bytes"
+	"embed"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"time"
+)
+
+//go:embed fixtures
+var fixtures embed.FS
+
+// contentTypes are the types fixtures are served with, by extension.
+var contentTypes = map[string]string{
+	".xml":  "application/rss+xml; charset=utf-8",
+	".json": "application/feed+json",
+	".html": "text/html; charset=utf-8",
+}
+
+// Paths served besides the fixtures, which are served at /NAME and honor
+// Range requests:
+//
+//	/slow.xml       rss.xml after Slow, or until the request is canceled
+//	/redirect       a 301 to /rss.xml
+//	/redirect-loop  redirects to itself
+//	/429            429 Too Many Requests
+//	/walled         rss.xml with ConsentCookie set, and otherwise a 302 to
+//	                /consent, an HTML page asking for consent
+//	/walled-loop    rss.xml with ConsentCookie set, and otherwise a 302 to
+//	                /consent?auto, which sets it and redirects back
+//	/gdpr-moved     a 302 to /gdpr/rss.xml, a feed at a consent-like path
+//	/status/404     and any other status code: that status, with no body
+const (
+	SlowPath     = "/slow.xml"
+	RedirectPath = "/redirect"
+	LoopPath     = "/redirect-loop"
+	TooManyPath  = "/429"
+	ConsentPath  = "/walled"
+	ConsentLoop  = "/walled-loop"
+	GDPRMoved    = "/gdpr-moved"
+)
+
+// ConsentCookie is the cookie the consent paths want.
+var ConsentCookie = &http.Cookie{Name: "consent", Value: "yes"}
+
+// Slow is how long SlowPath takes to answer.
+const Slow = 5 * time.Second
+
+// NewServer starts a server of the fixtures. Callers close it.
+func NewServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{name}", func(w http.ResponseWriter, r *http.Request) {
+		serveFixture(w, r, r.PathValue("name"))
+	})
+	mux.HandleFunc("GET "+SlowPath, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(Slow):
+			serveFixture(w, r, "rss.xml")
+		case <-r.Context().Done():
+		}
+	})
+	mux.HandleFunc("GET "+RedirectPath, func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/rss.xml", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("GET "+LoopPath, func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, LoopPath, http.StatusFound)
+	})
+	mux.HandleFunc("GET "+TooManyPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	consented := func(next string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if c, err := r.Cookie(ConsentCookie.Name); err == nil && c.Value == ConsentCookie.Value {
+				serveFixture(w, r, "rss.xml")
+				return
+			}
+			http.Redirect(w, r, next, http.StatusFound)
+		}
+	}
+	mux.HandleFunc("GET "+ConsentPath, consented("/consent"))
+	mux.HandleFunc("GET "+ConsentLoop, consented("/consent?auto"))
+	mux.HandleFunc("GET "+GDPRMoved, func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/gdpr/rss.xml", http.StatusFound)
+	})
+	mux.HandleFunc("GET /gdpr/rss.xml", func(w http.ResponseWriter, r *http.Request) {
+		serveFixture(w, r, "rss.xml")
+	})
+	mux.HandleFunc("GET /consent", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("auto") {
+			http.SetCookie(w, &http.Cookie{Name: ConsentCookie.Name, Value: ConsentCookie.Value, Path: "/"})
+			http.Redirect(w, r, ConsentLoop, http.StatusFound)
+			return
+		}
+		w.Header().Set("Content-Type", contentTypes[".html"])
+		w.Write([]byte("<html><body><form>We use cookies. <button>Accept</button></form></body></html>"))
+	})
+	mux.HandleFunc("GET /status/{code}", func(w http.ResponseWriter, r *http.Request) {
+		code, err := strconv.Atoi(r.PathValue("code"))
+		if err != nil || code < 100 || code > 999 {
+			http.Error(w, "bad status code", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(code)
+	})
+	return httptest.NewServer(mux)
+}
+
+// Fixtures lists the names of the fixture files.
+func Fixtures() []string {
+	entries, _ := fixtures.ReadDir("fixtures")
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+	}
+	return names
+}
+
+func serveFixture(w http.ResponseWriter, r *http.Request, name string) {
+	data, err := fixtures.ReadFile(path.Join("fixtures", name))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", contentTypes[path.Ext(name)])
+	http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(data))
+}
@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultAcceptLanguage is the Accept-Language header sent for feeds
+// without a language.
+const DefaultAcceptLanguage = "en-US;q=0.7,en;q=0.3"
+
+// AcceptLanguage returns the Accept-Language header asking for a feed in
+// language, an ISO 639-1 code or a list of them such as "fr, en", in the
+// variant of country, an ISO 3166 alpha-2 code, if known: "fr" and "CA"
+// give "fr-CA, fr;q=0.9, *;q=0.1". Any language is accepted last, for
+// servers with none of those asked for. It returns DefaultAcceptLanguage
+// when language is empty.
+func AcceptLanguage(language, country string) string {
+	tags := strings.FieldsFunc(language, func(r rune) bool {
+		return r == ',' || r == ';' || r == '/' || r == ' '
+	})
+	if len(tags) == 0 {
+		return DefaultAcceptLanguage
+	}
+	country = strings.ToUpper(strings.TrimSpace(country))
+	var ranges []string
+	for _, tag := range tags {
+		base, region, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+		base = strings.ToLower(base)
+		if region == "" {
+			region = country
+		}
+		if region != "" {
+			ranges = append(ranges, base+"-"+strings.ToUpper(region))
+		}
+		ranges = append(ranges, base)
+	}
+	header := ranges[:1]
+	for i, r := range ranges[1:] {
+		header = append(header, fmt.Sprintf("%s;q=0.%d", r, max(9-i, 2)))
+	}
+	return strings.Join(append(header, "*;q=0.1"), ", ")
+}
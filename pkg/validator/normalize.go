@@ -0,0 +1,137 @@
+package validator
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+)
+
+// schemePrefix matches an http or https scheme at the start of a URL,
+// written correctly or with a common typo: a letter missing or doubled
+// (htp, htttp, hhttp), a semicolon for the colon, or the wrong number of
+// slashes. Scheme-like words without any separator, as in httpbin.org, don't
+// match.
+var schemePrefix = regexp.MustCompile(`(?i)^(?:h{1,2}t{1,3}p{1,2}|t{1,2}p{1,2})(s?)(?:[:;]+/*|/{2,})`)
+
+// quotes are the quote marks pasted around or into URLs that can't be part
+// of one. Apostrophes can, in paths, so are only dropped from the ends.
+const quotes = "\"`\u201c\u201d\u2018\u2019\u00ab\u00bb"
+
+// NormalizeURL returns the URL a dataset entry is fetched from: rawURL as
+// corrected by FixURL, with https: added to protocol-relative URLs such as
+// //cdn.example.com/rss and https:// to URLs without a scheme such as
+// example.com/feed. Other URLs, and entries that still have no host, such
+// as a bare path, are returned as corrected, to be validated, and fail, as
+// they are.
+func NormalizeURL(rawURL string) string {
+	fixed, _ := FixURL(rawURL)
+	var resolved string
+	switch {
+	case strings.HasPrefix(fixed, "//"):
+		resolved = "https:" + fixed
+	case !strings.Contains(fixed, "://") && looksLikeHost(fixed):
+		resolved = "https://" + fixed
+	default:
+		return fixed
+	}
+	if u, err := url.Parse(resolved); err != nil || u.Hostname() == "" {
+		return fixed
+	}
+	return resolved
+}
+
+// CanonicalURL returns the form of a feed URL used to tell whether two
+// entries are the same feed: NormalizeURL's, with the scheme, a leading
+// "www.", default ports, a trailing slash and the fragment dropped and the
+// host lowercased. Query strings are kept since they often select the feed.
+func CanonicalURL(rawURL string) string {
+	u, err := url.Parse(NormalizeURL(rawURL))
+	if err != nil || u.Host == "" {
+		return strings.TrimSpace(rawURL)
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+	canonical := host + strings.TrimSuffix(u.EscapedPath(), "/")
+	if u.RawQuery != "" {
+		canonical += "?" + u.RawQuery
+	}
+	return canonical
+}
+
+// RequestURL returns the URL requested for a dataset entry: NormalizeURL's,
+// with an internationalized host such as пример.рф, however written, in its
+// ASCII form, xn--e1afmkfd.xn--p1ai, as DNS and TLS need. Results keep the
+// URL as NormalizeURL returns it, in the script people read. Hosts that
+// aren't valid domain names are left for the request to fail on.
+func RequestURL(rawURL string) string {
+	normalized := NormalizeURL(rawURL)
+	u, err := url.Parse(normalized)
+	if err != nil || !strings.ContainsFunc(u.Hostname(), func(r rune) bool { return r > unicode.MaxASCII }) {
+		return normalized
+	}
+	host, err := idna.Lookup.ToASCII(u.Hostname())
+	if err != nil {
+		return normalized
+	}
+	if port := u.Port(); port != "" {
+		host = net.JoinHostPort(host, port)
+	}
+	u.Host = host
+	return u.String()
+}
+
+// looksLikeHost reports whether s, a URL without a scheme, starts with a
+// host name: a first segment with a dot in it, or localhost.
+func looksLikeHost(s string) bool {
+	host, _, _ := strings.Cut(s, "/")
+	host, _, _ = strings.Cut(host, "?")
+	host, _, _ = strings.Cut(host, "#")
+	if h, _, ok := strings.Cut(host, ":"); ok {
+		host = h
+	}
+	if host == "" || strings.ContainsAny(host, " @") {
+		return false
+	}
+	return host == "localhost" || strings.Contains(host, ".")
+}
+
+// FixURL returns rawURL, trimmed, with common typos fixed, and whether any
+// were: whitespace and quote marks inside it, and apostrophes and angle
+// brackets around it, are dropped, a mistyped http or https scheme such as
+// htp:// or http:/ is corrected, and a doubled scheme such as
+// http://https:// is reduced to the last one.
+func FixURL(rawURL string) (string, bool) {
+	trimmed := strings.TrimSpace(rawURL)
+	fixed := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || strings.ContainsRune(quotes, r) {
+			return -1
+		}
+		return r
+	}, trimmed)
+	fixed = strings.Trim(fixed, "'")
+	if strings.HasPrefix(fixed, "<") && strings.HasSuffix(fixed, ">") {
+		fixed = fixed[1 : len(fixed)-1]
+	}
+	for {
+		m := schemePrefix.FindStringSubmatch(fixed)
+		if m == nil {
+			break
+		}
+		rest := fixed[len(m[0]):]
+		if schemePrefix.MatchString(rest) {
+			fixed = rest
+			continue
+		}
+		if scheme := "http" + strings.ToLower(m[1]) + "://"; !strings.EqualFold(m[0], scheme) {
+			fixed = scheme + rest
+		}
+		break
+	}
+	return fixed, fixed != trimmed
+}
@@ -0,0 +1,249 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Option configures a Validator; see NewValidator.
+type Option func(*Validator)
+
+// WithFetcher sends requests through f instead of the default HTTP client.
+func WithFetcher(f Fetcher) Option {
+	return func(v *Validator) { v.fetcher = f }
+}
+
+// WithConcurrency sets how many feeds ValidateAll and its variants check at
+// once. Values below 1 are taken as 1.
+func WithConcurrency(n int) Option {
+	return func(v *Validator) { v.concurrency = max(n, 1) }
+}
+
+// WithParseConcurrency sets how many feeds ValidateAll and its variants
+// parse at once, while others are fetched. Values below 1 are taken as 1.
+// It defaults to the number of CPUs.
+func WithParseConcurrency(n int) Option {
+	return func(v *Validator) { v.parseConcurrency = max(n, 1) }
+}
+
+// WithTimeout bounds the validation of each feed, retries included, within
+// any deadline of the caller's context.
+func WithTimeout(d time.Duration) Option {
+	return func(v *Validator) { v.timeout = d }
+}
+
+// WithRetries sets how many times a failed fetch is retried, with
+// exponential backoff, before the feed is reported as transient. Client
+// errors other than 429 aren't retried.
+func WithRetries(n int) Option {
+	return func(v *Validator) { v.retries = max(n, 0) }
+}
+
+// WithSeed fixes the randomness of validation, the jitter of retry backoff,
+// so that runs against the same servers retry at the same times. Validators
+// otherwise draw a random seed.
+func WithSeed(seed uint64) Option {
+	return func(v *Validator) { v.seed = seed }
+}
+
+// WithUserAgent sets the User-Agent header of requests.
+func WithUserAgent(ua string) Option {
+	return func(v *Validator) { v.userAgent = ua }
+}
+
+// WithHomepageCheck also fetches the homepage each parsed feed links to,
+// its channel link, and warns with WarningHomepage when it is unreachable
+// or a parked domain, as a live feed of a dead site usually belongs to an
+// abandoned CMS that will soon go too. Feeds only probed aren't checked.
+// Each homepage is fetched once per run, subject to WithHostRateLimit,
+// WithSerialHosts and WithDomainBudget as feeds are.
+func WithHomepageCheck() Option {
+	return func(v *Validator) { v.homepageCheck = true }
+}
+
+// WithAcceptLanguage sets how the Accept-Language header of a feed's
+// requests is chosen, as multilingual publishers serve different feeds, or
+// none, depending on it. By default it is AcceptLanguage of the feed's
+// language column; callers that know the feed's country can pass it too.
+// An empty header is left out.
+func WithAcceptLanguage(acceptLanguage func(Feed) string) Option {
+	return func(v *Validator) { v.acceptLanguage = acceptLanguage }
+}
+
+// WithPartialFetch fetches only the first limit bytes of the feeds large
+// reports true for, with a Range request, enough for the channel and its
+// latest items, to check large feeds for freshness without downloading them
+// whole. The start of the feed is closed after its last complete item and
+// parsed; results are marked Partial. Feeds whose start holds no complete
+// item, and JSON feeds, are fetched again whole, and servers that don't
+// support ranges send them whole anyway.
+func WithPartialFetch(limit int64, large func(Feed) bool) Option {
+	return func(v *Validator) {
+		v.partial = nil
+		if limit > 0 && large != nil {
+			v.partial = &partialFetch{limit: limit, large: large}
+		}
+	}
+}
+
+// WithAccept sets the Accept header sent with feed requests, DefaultAccept
+// by default, or leaves it out for "". Feeds served with a Content-Type
+// other than the types it names, text/xml counting as application/xml, get
+// a WarningContentType warning.
+func WithAccept(accept string) Option {
+	return func(v *Validator) { v.accept = accept }
+}
+
+// WithMaxBodySize sets the largest feed body read, in bytes; larger feeds
+// are invalid with CodeTooLarge. 0 removes the limit.
+func WithMaxBodySize(n int64) Option {
+	return func(v *Validator) { v.maxBodySize = max(n, 0) }
+}
+
+// WithHostRateLimit spaces requests to the same host at least interval
+// apart, so outlets with many feeds aren't hit with them all at once. A
+// feed's first request waits its turn before its timeout starts.
+func WithHostRateLimit(interval time.Duration) Option {
+	return func(v *Validator) {
+		v.limiter = nil
+		if interval > 0 {
+			v.limiter = &hostLimiter{interval: interval, next: make(map[string]time.Time)}
+		}
+	}
+}
+
+// WithAdaptiveConcurrency makes the concurrency limit a ceiling: fewer
+// fetches are let in flight while many time out or get server errors, down
+// to minimum, and more again as they recover, up to the ceiling. The
+// adjustments are logged and returned by ConcurrencyChanges. Values of
+// minimum below 1 are taken as 1.
+func WithAdaptiveConcurrency(minimum int) Option {
+	return func(v *Validator) { v.adaptive = &aimd{min: max(minimum, 1)} }
+}
+
+// WithHedging sends a second request for a feed that hasn't answered after
+// the given delay, or, for 0, after the p95 of the latest response times
+// (once 20 have been seen), and takes whichever answers first, canceling
+// the other, so a few very slow servers don't hold up a whole run. Hedged
+// requests don't wait for WithHostRateLimit, and are counted by
+// HedgedRequests.
+func WithHedging(after time.Duration) Option {
+	return func(v *Validator) { v.hedger = &hedger{after: after} }
+}
+
+// WithSerialHosts has ValidateAll and ValidateStream validate one feed of
+// each host at a time, whatever the concurrency: a feed waits while another
+// of its host is being validated, and feeds of other hosts go ahead of it
+// meanwhile. Hedged requests are the one exception.
+func WithSerialHosts() Option {
+	return func(v *Validator) { v.serialHosts = true }
+}
+
+// WithDomainBudget caps the time each run of ValidateAll or ValidateStream
+// spends fetching the feeds of any one domain (the registrable domain, such
+// as bbc.co.uk for feeds.bbc.co.uk), retries included, so one slow
+// publisher can't take up much of a run. Once a domain's fetches add up to
+// limit, its feeds not yet started are transient with CodeOverBudget rather
+// than fetched; those in flight finish. The domains that ran out are logged
+// and returned by OverBudgetDomains. Runs going on at once, and calls of
+// ValidateFeed, each have a budget of their own. 0 removes the cap.
+func WithDomainBudget(limit time.Duration) Option {
+	return func(v *Validator) { v.budgetLimit = max(limit, 0) }
+}
+
+// WithCookieJar keeps the cookies sites set in jar and sends them back, so
+// feeds behind a consent wall that sets a cookie and redirects back can be
+// fetched, and cookies put in the jar beforehand, such as a site's consent
+// cookie, are sent with its requests. Feeds redirected to a consent page
+// all the same are invalid with CodeConsentWall. It only applies to the
+// default fetcher, not one given WithFetcher.
+func WithCookieJar(jar http.CookieJar) Option {
+	return func(v *Validator) { v.jar = jar }
+}
+
+// WithPrevious has validation skip parsing and analyzing feeds whose body
+// is the same as last time, for runs close enough together that most feeds
+// haven't changed. previous returns a feed's last valid result, if any;
+// when the body fetched has that result's BodyHash, the result is reported
+// again, marked Unchanged, with this fetch's latency and, should the feed
+// have become stale since, a stale warning. AfterParse hooks don't run for
+// unchanged feeds.
+func WithPrevious(previous func(Feed) (Result, bool)) Option {
+	return func(v *Validator) { v.previous = previous }
+}
+
+// WithDNSWarmup resolves the hosts of all the feeds given to ValidateAll and
+// its variants at once, before fetching any. Feeds whose host doesn't exist
+// are then invalid with CodeUnresolvable straight away, without retries, and
+// the default HTTP client dials the addresses found rather than resolving
+// each host again. Fetchers set with WithFetcher do their own resolving.
+func WithDNSWarmup() Option {
+	return func(v *Validator) { v.dnsWarmup = true }
+}
+
+// WithClock has validation tell the time and wait by c rather than the
+// system clock; see Clock.
+func WithClock(c Clock) Option {
+	return func(v *Validator) { v.clock = c }
+}
+
+// WithHooks adds hooks run around the stages of validating each feed, after
+// any added before.
+func WithHooks(hooks ...Hook) Option {
+	return func(v *Validator) { v.hooks = append(v.hooks, hooks...) }
+}
+
+// WithArchive keeps the bodies of feeds that parsed in a.
+func WithArchive(a Archive) Option {
+	return func(v *Validator) { v.archive = a }
+}
+
+// WithCapturer saves the responses of feeds found invalid with c.
+func WithCapturer(c Capturer) Option {
+	return func(v *Validator) { v.capturer = c }
+}
+
+// WithLogf sends retries and errors that don't change a result to logf, one
+// line per call. They are discarded by default.
+func WithLogf(logf func(format string, args ...any)) Option {
+	return func(v *Validator) { v.logf = logf }
+}
+
+// WithDebugHTTP logs every request, with the logf given WithLogf: its
+// status or error, and how long the DNS lookup, connection, TLS handshake
+// and first byte took, for diagnosing slow or misbehaving hosts. The
+// default HTTP client resolves hosts through its own cache, so its lookups
+// aren't timed.
+func WithDebugHTTP() Option {
+	return func(v *Validator) { v.debugHTTP = true }
+}
+
+// hostLimiter hands out request slots per host, interval apart.
+type hostLimiter struct {
+	interval time.Duration
+	clock    Clock
+	mu       sync.Mutex
+	next     map[string]time.Time
+}
+
+// wait blocks until host's next slot, or until ctx is done. A nil limiter
+// doesn't wait.
+func (l *hostLimiter) wait(ctx context.Context, host string) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := l.clock.Now()
+	at := l.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	l.next[host] = at.Add(l.interval)
+	l.mu.Unlock()
+	if !l.clock.Sleep(ctx, at.Sub(now)) {
+		return ctx.Err()
+	}
+	return nil
+}
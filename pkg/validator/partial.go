@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// partialFetch asks for the first limit bytes of the feeds large reports
+// true for; see WithPartialFetch.
+type partialFetch struct {
+	limit int64
+	large func(Feed) bool
+}
+
+// ranged reports whether feed is to be fetched partially.
+func (p *partialFetch) ranged(feed Feed) bool {
+	return p != nil && p.large(feed)
+}
+
+// setRange asks req for the first limit bytes, uncompressed, as a range of
+// a compressed body can't be decompressed.
+func (p *partialFetch) setRange(req *http.Request) {
+	req.Header.Set("Range", fmt.Sprintf("bytes=0-%d", p.limit-1))
+	req.Header.Set("Accept-Encoding", "identity")
+}
+
+// fullSize returns the size of the whole body of a 206 response, from its
+// Content-Range, or 0 if the server didn't say.
+func fullSize(resp *http.Response) int64 {
+	_, total, ok := strings.Cut(resp.Header.Get("Content-Range"), "/")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(total), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// closePartialFeed cuts the start of an RSS or Atom feed after its last
+// complete item and closes the elements left open, so it parses as a feed
+// of the items it holds. It reports false for bodies without a complete
+// item, and for JSON feeds, which can't be closed this way.
+func closePartialFeed(body []byte) ([]byte, bool) {
+	var end, closing string
+	switch {
+	case bytes.Contains(body, []byte("<rss")):
+		end, closing = "</item>", "\n</channel>\n</rss>\n"
+	case bytes.Contains(body, []byte("<rdf:RDF")):
+		end, closing = "</item>", "\n</rdf:RDF>\n"
+	case bytes.Contains(body, []byte("<feed")):
+		end, closing = "</entry>", "\n</feed>\n"
+	default:
+		return nil, false
+	}
+	i := bytes.LastIndex(body, []byte(end))
+	if i < 0 {
+		return nil, false
+	}
+	closed := append(body[:i+len(end):i+len(end)], closing...)
+	return closed, true
+}
+
+// fetchWhole requests req again without its Range, once, for feeds whose
+// first bytes didn't hold a complete item.
+func (v *Validator) fetchWhole(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Del("Range")
+	req.Header.Del("Accept-Encoding")
+	resp, err := v.do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("HTTP status %d", resp.StatusCode)
+	}
+	return resp, nil
+}
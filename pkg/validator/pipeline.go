@@ -0,0 +1,173 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Validating a feed runs in stages: fetch, network-bound, then parse,
+// CPU-bound, then analyze. ValidateAll gives each stage its own pool of
+// workers, so parsing keeps the CPUs busy while fetches wait on the network
+// rather than competing with them for the same slots.
+
+// feedJob is a feed on its way through the stages. A stage that decides the
+// result ends the job, and the stages after it are skipped.
+type feedJob struct {
+	run   *runState
+	feed  Feed
+	depth Depth
+	url   string
+	start time.Time
+	span  trace.Span
+	// ctx carries the feed's span and, once fetch has started, its timeout,
+	// which cancel releases.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// resp is the successful response once fetched, its body still to be
+	// read by parse unless fetch read it whole into body. Once parsed,
+	// parsed is the feed, head the start of the body, bodySize its size and
+	// bodyHash its hash, and body holds all of it when archiving.
+	resp     *http.Response
+	body     []byte
+	head     []byte
+	bodySize int64
+	bodyHash string
+	parsed   *gofeed.Feed
+	// partial is set when body is only the start of the feed, closed after
+	// its last complete item, and fullSize is then the whole feed's size
+	// if the server gave it; see WithPartialFetch.
+	partial  bool
+	fullSize int64
+	// consentPage is the URL of the consent page the request was
+	// redirected to, if any, should the body turn out not to be a feed.
+	consentPage string
+	result      Result
+	done        bool
+	// release, when set, is called once the job has ended, with or
+	// without a result.
+	release func()
+	// hold, when set, waits for WithSerialHosts to give the job host,
+	// letting go of its feed's host first, and returns the function
+	// freeing it, or false if the run ended first. It is called at most
+	// once, before the job ends.
+	hold func(host string) (free func(), ok bool)
+}
+
+// end makes r the job's result, skipping the remaining stages.
+func (j *feedJob) end(r Result) {
+	j.result, j.done = r, true
+}
+
+// runState is what a run of the validator keeps across its feeds. Each
+// call of ValidateFeed, ValidateAll or ValidateStream has its own, so
+// concurrent runs on the same Validator don't share budgets or lookups.
+type runState struct {
+	budget    *domainBudget
+	dns       *dnsCache
+	homepages *homepageCache
+}
+
+// newRun returns the state of a new run, with the whole domain budget and
+// nothing resolved or checked yet.
+func (v *Validator) newRun() *runState {
+	run := &runState{budget: newDomainBudget(v.budgetLimit), homepages: newHomepageCache()}
+	if v.cacheDNS {
+		run.dns = newDNSCache(v.lookupHost, v.clock)
+	}
+	return run
+}
+
+// newJob starts validating feed at its tier's depth as part of run, tracing
+// the work as a span under ctx.
+func (v *Validator) newJob(ctx context.Context, run *runState, feed Feed) *feedJob {
+	policy, ok := TierPolicies[feed.Tier]
+	if !ok {
+		policy = TierPolicies[DefaultTier]
+	}
+	url := NormalizeURL(feed.URL)
+	ctx, span := tracer.Start(ctx, "validate feed", trace.WithAttributes(
+		attribute.String("feed.url", url),
+		attribute.Int("feed.depth", int(policy.Depth)),
+	))
+	ctx = withDNSCache(ctx, run.dns)
+	return &feedJob{feed: feed, depth: policy.Depth, url: url, start: v.clock.Now(), span: span, ctx: ctx, run: run}
+}
+
+// stages are the stages of validation, in order.
+func (v *Validator) stages() []func(*feedJob) {
+	return []func(*feedJob){v.fetch, v.parse, v.analyze}
+}
+
+// runStage runs stage on j unless j has ended, turning a panic in the parser
+// or a hook into a transient CodeInternal result for that feed alone. The
+// panic and its stack are logged.
+func (v *Validator) runStage(j *feedJob, stage func(*feedJob)) {
+	if j.done {
+		return
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			v.logf("Panic validating %s: %v\n%s", j.url, p, debug.Stack())
+			j.end(failed(j.url, StatusTransient, NewError(CodeInternal, fmt.Errorf("panic: %v", p), fmt.Sprintf("Internal error: %v", p))))
+		}
+	}()
+	stage(j)
+}
+
+// finish returns j's result, releasing what the job held.
+func (v *Validator) finish(j *feedJob) Result {
+	result := j.result
+	result.ID, result.LatencyMS = j.feed.ID, v.clock.Now().Sub(j.start).Milliseconds()
+	if _, fixed := FixURL(j.feed.URL); fixed {
+		result.CorrectedFrom = strings.TrimSpace(j.feed.URL)
+	}
+	j.span.SetAttributes(attribute.String("feed.status", string(result.Status)), attribute.Int("feed.item_count", result.ItemCount))
+	if result.Status != StatusValid {
+		j.span.SetStatus(codes.Error, result.Message)
+	}
+	j.abandon()
+	return result
+}
+
+// abandon releases what the job held without a result.
+func (j *feedJob) abandon() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.span.End()
+	if j.resp != nil && j.body == nil && j.parsed == nil {
+		// Ended before parse read the body it was handed.
+		j.resp.Body.Close()
+	}
+	j.resp, j.body, j.head, j.parsed = nil, nil, nil, nil
+	if j.release != nil {
+		j.release()
+		j.release = nil
+	}
+}
+
+// startWorkers runs n copies of work, then done once all have returned.
+func startWorkers(n int, work func(), done func()) {
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work()
+		}()
+	}
+	go func() {
+		wg.Wait()
+		done()
+	}()
+}
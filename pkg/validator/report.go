@@ -0,0 +1,355 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Summary is the outcome of a run: its results and their counts.
+type Summary struct {
+	// SchemaVersion is the SchemaVersion the summary's JSON follows.
+	SchemaVersion string    `json:"schema_version"`
+	StartedAt     time.Time `json:"started_at"`
+	FinishedAt    time.Time `json:"finished_at"`
+	Valid         int       `json:"valid"`
+	// Warnings counts the valid results with a warning message.
+	Warnings  int `json:"warnings"`
+	Invalid   int `json:"invalid"`
+	Transient int `json:"transient"`
+	// Restricted counts the feeds whose terms forbid redistribution.
+	Restricted int      `json:"redistribution_restricted"`
+	Results    []Result `json:"results"`
+}
+
+// Summarize counts results of a run between startedAt and finishedAt.
+func Summarize(results []Result, startedAt, finishedAt time.Time) Summary {
+	s := Summary{SchemaVersion: SchemaVersion, StartedAt: startedAt, FinishedAt: finishedAt}
+	for _, r := range results {
+		s.Add(r)
+	}
+	s.Results = results
+	return s
+}
+
+// Add counts r, without keeping it in Results, for runs with too many
+// results to hold.
+func (s *Summary) Add(r Result) {
+	switch r.Status {
+	case StatusValid:
+		s.Valid++
+		if len(r.Warnings) > 0 {
+			s.Warnings++
+		}
+	case StatusInvalid:
+		s.Invalid++
+	case StatusTransient:
+		s.Transient++
+	}
+	if r.NoRedistribution {
+		s.Restricted++
+	}
+}
+
+// Total is how many feeds the run checked.
+func (s Summary) Total() int {
+	return s.Valid + s.Invalid + s.Transient
+}
+
+// Reporter receives a run's results as they complete and its summary at the
+// end. Result is called from one goroutine at a time.
+type Reporter interface {
+	Result(Result)
+	Finish(Summary) error
+}
+
+// Reporters sends a run to each of several reporters.
+type Reporters []Reporter
+
+// Result sends r to every reporter.
+func (rs Reporters) Result(r Result) {
+	for _, reporter := range rs {
+		reporter.Result(r)
+	}
+}
+
+// Finish finishes every reporter, even when some fail, and returns their
+// errors joined.
+func (rs Reporters) Finish(s Summary) error {
+	var errs []error
+	for _, reporter := range rs {
+		errs = append(errs, reporter.Finish(s))
+	}
+	return errors.Join(errs...)
+}
+
+// Run validates feeds like ValidateEach, sending each result to r as it
+// completes, then finishes r with the run's summary.
+func (v *Validator) Run(ctx context.Context, feeds []Feed, r Reporter) (Summary, error) {
+	start := v.clock.Now()
+	results := make([]Result, 0, len(feeds))
+	v.ValidateEach(ctx, feeds, func(result Result) {
+		results = append(results, result)
+		r.Result(result)
+	})
+	s := Summarize(results, start, v.clock.Now())
+	return s, r.Finish(s)
+}
+
+// consoleReporter prints a line per result as it completes and the counts at
+// the end, for people watching a run.
+type consoleReporter struct {
+	w    io.Writer
+	lang string
+}
+
+// NewConsoleReporter returns a Reporter printing to w.
+func NewConsoleReporter(w io.Writer) Reporter {
+	return consoleReporter{w: w}
+}
+
+// NewLocalizedConsoleReporter returns a Reporter printing to w in lang, one
+// of Languages.
+func NewLocalizedConsoleReporter(w io.Writer, lang string) Reporter {
+	return consoleReporter{w: w, lang: lang}
+}
+
+func (c consoleReporter) Result(r Result) {
+	statusSymbol := "✅"
+	if r.Status == StatusInvalid {
+		statusSymbol = "❌"
+	} else if r.Status == StatusTransient {
+		statusSymbol = "⚠️"
+	}
+
+	fmt.Fprintf(c.w, "%s %s → %s", statusSymbol, r.URL, Localize(c.lang, string(r.Status)))
+	if r.Message != "" {
+		fmt.Fprintf(c.w, " (%s)", Localize(c.lang, r.Message))
+	} else if r.ProbeOnly {
+		fmt.Fprintf(c.w, " (%s)", Localize(c.lang, "reachability probe"))
+	} else if r.Unchanged {
+		fmt.Fprintf(c.w, " (%s)", Localize(c.lang, "unchanged"))
+	}
+	if r.DuplicateOf != "" {
+		fmt.Fprintf(c.w, " [%s]", Localizef(c.lang, "same feed as %s", r.DuplicateOf))
+	}
+	if r.CorrectedFrom != "" {
+		fmt.Fprintf(c.w, " [%s]", Localizef(c.lang, "corrected from %s", r.CorrectedFrom))
+	}
+	fmt.Fprintln(c.w)
+}
+
+func (c consoleReporter) Finish(s Summary) error {
+	_, err := fmt.Fprintf(c.w, "\n%s\n✅ %s\n❌ %s\n⚠️ %s\n📜 %s\n%s\n",
+		Localize(c.lang, "Results Summary:"),
+		Localizef(c.lang, "Valid: %d (with %d warnings)", s.Valid, s.Warnings),
+		Localizef(c.lang, "Invalid: %d", s.Invalid),
+		Localizef(c.lang, "Transient Errors: %d", s.Transient),
+		Localizef(c.lang, "Redistribution restricted: %d", s.Restricted),
+		Localizef(c.lang, "Total: %d feeds checked", s.Total()))
+	return err
+}
+
+// jsonReporter writes the summary, results included, as JSON.
+type jsonReporter struct {
+	w io.Writer
+}
+
+// NewJSONReporter returns a Reporter writing the run to w as JSON when it
+// finishes.
+func NewJSONReporter(w io.Writer) Reporter {
+	return jsonReporter{w: w}
+}
+
+func (jsonReporter) Result(Result) {}
+
+func (j jsonReporter) Finish(s Summary) error {
+	enc := json.NewEncoder(j.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// ndjsonReporter writes each result as a line of JSON as it completes.
+type ndjsonReporter struct {
+	enc *json.Encoder
+	err error
+}
+
+// NewNDJSONReporter returns a Reporter writing each result to w as a line of
+// JSON as it completes, for streaming into other tools. Each line also
+// carries the schema_version.
+func NewNDJSONReporter(w io.Writer) Reporter {
+	return &ndjsonReporter{enc: json.NewEncoder(w)}
+}
+
+func (n *ndjsonReporter) Result(r Result) {
+	if n.err == nil {
+		n.err = n.enc.Encode(struct {
+			SchemaVersion string `json:"schema_version"`
+			Result
+		}{SchemaVersion, r})
+	}
+}
+
+// Finish returns the first error writing a result.
+func (n *ndjsonReporter) Finish(Summary) error {
+	return n.err
+}
+
+// csvReporter writes a row per result.
+type csvReporter struct {
+	w io.Writer
+}
+
+// NewCSVReporter returns a Reporter writing the run's results to w as CSV
+// when it finishes.
+func NewCSVReporter(w io.Writer) Reporter {
+	return csvReporter{w: w}
+}
+
+func (csvReporter) Result(Result) {}
+
+func (c csvReporter) Finish(s Summary) error {
+	w := csv.NewWriter(c.w)
+	w.Write([]string{"id", "url", "status", "error_code", "message", "item_count", "last_update", "latency_ms"})
+	for _, r := range s.Results {
+		lastUpdate := ""
+		if !r.LastUpdate.IsZero() {
+			lastUpdate = r.LastUpdate.UTC().Format(time.RFC3339)
+		}
+		var code string
+		if r.Err != nil {
+			code = string(r.Err.Code)
+		}
+		w.Write([]string{r.ID, r.URL, string(r.Status), code, r.Message, strconv.Itoa(r.ItemCount), lastUpdate, strconv.FormatInt(r.LatencyMS, 10)})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// junitReporter writes the run as a JUnit test suite with a test case per
+// feed, for CI systems that display JUnit reports. Invalid feeds are
+// failures; transient ones are skipped, as they may recover.
+type junitReporter struct {
+	w io.Writer
+}
+
+// NewJUnitReporter returns a Reporter writing the run to w as JUnit XML when
+// it finishes.
+func NewJUnitReporter(w io.Writer) Reporter {
+	return junitReporter{w: w}
+}
+
+type junitSuite struct {
+	XMLName   xml.Name    `xml:"testsuite"`
+	Name      string      `xml:"name,attr"`
+	Tests     int         `xml:"tests,attr"`
+	Failures  int         `xml:"failures,attr"`
+	Skipped   int         `xml:"skipped,attr"`
+	Time      string      `xml:"time,attr"`
+	Timestamp string      `xml:"timestamp,attr"`
+	Cases     []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure"`
+	Skipped   *junitMessage `xml:"skipped"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+func junitSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
+
+func (junitReporter) Result(Result) {}
+
+func (j junitReporter) Finish(s Summary) error {
+	suite := junitSuite{
+		Name:      "feeds",
+		Tests:     s.Total(),
+		Failures:  s.Invalid,
+		Skipped:   s.Transient,
+		Time:      junitSeconds(s.FinishedAt.Sub(s.StartedAt)),
+		Timestamp: s.StartedAt.UTC().Format("2006-01-02T15:04:05"),
+	}
+	for _, r := range s.Results {
+		c := junitCase{Name: r.URL, Classname: "feeds", Time: junitSeconds(time.Duration(r.LatencyMS) * time.Millisecond)}
+		switch r.Status {
+		case StatusInvalid:
+			c.Failure = &junitMessage{Message: r.Message}
+		case StatusTransient:
+			c.Skipped = &junitMessage{Message: r.Message}
+		}
+		suite.Cases = append(suite.Cases, c)
+	}
+	if _, err := io.WriteString(j.w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(j.w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(j.w, "\n")
+	return err
+}
+
+// webhookReporter posts the run's counts and failures as JSON.
+type webhookReporter struct {
+	url     string
+	fetcher Fetcher
+}
+
+// NewWebhookReporter returns a Reporter posting the summary of the run, with
+// only its invalid and transient results, to url when it finishes.
+func NewWebhookReporter(url string, fetcher Fetcher) Reporter {
+	return webhookReporter{url: url, fetcher: fetcher}
+}
+
+func (webhookReporter) Result(Result) {}
+
+func (wh webhookReporter) Finish(s Summary) error {
+	failures := make([]Result, 0, s.Invalid+s.Transient)
+	for _, r := range s.Results {
+		if r.Status != StatusValid {
+			failures = append(failures, r)
+		}
+	}
+	s.Results = failures
+	body, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "POST", wh.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", DefaultUserAgent)
+	resp, err := wh.fetcher.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s answered %s", wh.url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testSummary is a run with a result of each kind.
+func testSummary() Summary {
+	start := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	valid := Result{ID: "a1", URL: "https://news.example.com/rss.xml", Status: StatusValid, ItemCount: 20,
+		LastUpdate: start.Add(-time.Hour), LatencyMS: 120}
+	stale := Result{ID: "b2", URL: "https://old.example.com/feed", Status: StatusValid, ItemCount: 3,
+		LastUpdate: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), LatencyMS: 340, License: "No redistribution: © Old Media", NoRedistribution: true}
+	stale.Warn(WarningStale, "Feed hasn't been updated in over 6 months")
+	gone := failed("https://gone.example.com/rss", StatusInvalid, httpStatusError(404, "HTTP status 404"))
+	gone.ID, gone.LatencyMS = "c3", 80
+	slow := failed("https://slow.example.com/atom.xml", StatusTransient, NewError(CodeTimeout, nil, "Request timed out after 30 seconds"))
+	slow.ID, slow.LatencyMS = "d4", 30000
+	probe := Result{ID: "e5", URL: "https://probe.example.com/feed", Status: StatusValid, ProbeOnly: true, LatencyMS: 50}
+	return Summarize([]Result{valid, stale, gone, slow, probe}, start, start.Add(31*time.Second))
+}
+
+func TestReporters(t *testing.T) {
+	reporters := []struct {
+		golden string
+		new    func(w *bytes.Buffer) Reporter
+	}{
+		{"report.console.golden", func(w *bytes.Buffer) Reporter { return NewConsoleReporter(w) }},
+		{"report.console.es.golden", func(w *bytes.Buffer) Reporter { return NewLocalizedConsoleReporter(w, "es") }},
+		{"report.json.golden", func(w *bytes.Buffer) Reporter { return NewJSONReporter(w) }},
+		{"report.ndjson.golden", func(w *bytes.Buffer) Reporter { return NewNDJSONReporter(w) }},
+		{"report.csv.golden", func(w *bytes.Buffer) Reporter { return NewCSVReporter(w) }},
+		{"report.junit.golden", func(w *bytes.Buffer) Reporter { return NewJUnitReporter(w) }},
+	}
+	s := testSummary()
+	for _, rep := range reporters {
+		t.Run(rep.golden, func(t *testing.T) {
+			var buf bytes.Buffer
+			r := rep.new(&buf)
+			for _, result := range s.Results {
+				r.Result(result)
+			}
+			if err := r.Finish(s); err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, rep.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	s := testSummary()
+	if s.Total() != 5 || s.Valid != 3 || s.Warnings != 1 || s.Invalid != 1 || s.Transient != 1 || s.Restricted != 1 {
+		t.Errorf("got %d total, %d valid (%d with warnings), %d invalid, %d transient, %d restricted; want 5, 3 (1), 1, 1, 1",
+			s.Total(), s.Valid, s.Warnings, s.Invalid, s.Transient, s.Restricted)
+	}
+}
+
+// TestSchema checks that the schema describes every field of the outputs,
+// so it can't fall behind them.
+func TestSchema(t *testing.T) {
+	var s struct {
+		Defs map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal(Schema(), &s); err != nil {
+		t.Fatalf("schema isn't JSON: %v", err)
+	}
+	for def, v := range map[string]any{"report": Summary{}, "result": Result{}, "error": Error{}, "warning": Warning{}} {
+		typ := reflect.TypeOf(v)
+		for i := range typ.NumField() {
+			name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			if _, ok := s.Defs[def].Properties[name]; !ok {
+				t.Errorf("schema's %s doesn't describe %s.%s (%q)", def, typ.Name(), typ.Field(i).Name, name)
+			}
+		}
+	}
+	if !bytes.Contains(Schema(), []byte(`"const": "`+SchemaVersion+`"`)) {
+		t.Errorf("schema doesn't pin schema_version to %q", SchemaVersion)
+	}
+}
@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+)
+
+// RulesCheck returns a Hook evaluating the Starlark script in filename
+// against each feed that parsed, so validation policy can be changed without
+// recompiling. The script defines a check function taking the dataset row,
+// the result so far and the parsed feed, and reports with the predeclared
+// warn(code, message) and invalid(message):
+//
+//	def check(feed, result, parsed):
+//	    if "weekly" in feed.comments or result.age_days == None:
+//	        return
+//	    if result.age_days > 14:
+//	        invalid("Newest item is %d days old" % result.age_days)
+//
+// feed has the row's id, url, language, comments, status, tier, license and
+// paywall; result its status, item_count, warnings (their codes),
+// last_update and age_days (None if undated), license, access, ttl_minutes
+// and publish_interval_minutes; and parsed the feed's title, link, language,
+// categories and items, each with a title, link, published and categories.
+// Times are Unix seconds. A check that fails with an error adds a
+// WarningCheckFailed warning, and invalid marks the feed invalid with
+// CodeCheck.
+func RulesCheck(filename string) (Hook, error) {
+	predeclared := starlark.StringDict{
+		"warn":    starlark.NewBuiltin("warn", ruleWarn),
+		"invalid": starlark.NewBuiltin("invalid", ruleInvalid),
+	}
+	thread := &starlark.Thread{Name: filename}
+	globals, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, filename, nil, predeclared)
+	if err != nil {
+		return Hook{}, err
+	}
+	check, ok := globals["check"].(*starlark.Function)
+	if !ok {
+		return Hook{}, fmt.Errorf("%s doesn't define a check function", filename)
+	}
+
+	return Hook{AfterParse: func(ctx context.Context, feed Feed, parsed *gofeed.Feed, result *Result) {
+		thread := &starlark.Thread{Name: filename}
+		thread.SetLocal("result", result)
+		stop := context.AfterFunc(ctx, func() { thread.Cancel(context.Cause(ctx).Error()) })
+		defer stop()
+		args := starlark.Tuple{ruleFeed(feed), ruleResult(result), ruleParsed(parsed)}
+		if _, err := starlark.Call(thread, check, args, nil); err != nil {
+			result.Warn(WarningCheckFailed, "Rules %s: %v", filename, err)
+		}
+	}}, nil
+}
+
+func ruleWarn(thread *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var code, message string
+	if err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 2, &code, &message); err != nil {
+		return nil, err
+	}
+	thread.Local("result").(*Result).Warn(WarningCode(code), "%s", message)
+	return starlark.None, nil
+}
+
+func ruleInvalid(thread *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var message string
+	if err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 1, &message); err != nil {
+		return nil, err
+	}
+	if result := thread.Local("result").(*Result); result.Status == StatusValid {
+		result.Fail(StatusInvalid, NewError(CodeCheck, nil, message))
+	}
+	return starlark.None, nil
+}
+
+func ruleFeed(feed Feed) starlark.Value {
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"id":       starlark.String(feed.ID),
+		"url":      starlark.String(feed.URL),
+		"language": starlark.String(feed.Language),
+		"comments": starlark.String(feed.Comments),
+		"status":   starlark.String(feed.Status),
+		"tier":     starlark.MakeInt(feed.Tier),
+		"license":  starlark.String(feed.License),
+		"paywall":  starlark.String(feed.Paywall),
+	})
+}
+
+func ruleResult(r *Result) starlark.Value {
+	warnings := make([]starlark.Value, len(r.Warnings))
+	for i, w := range r.Warnings {
+		warnings[i] = starlark.String(w.Code)
+	}
+	var lastUpdate, age starlark.Value = starlark.None, starlark.None
+	if !r.LastUpdate.IsZero() {
+		lastUpdate = starlark.MakeInt64(r.LastUpdate.Unix())
+		age = starlark.Float(time.Since(r.LastUpdate).Hours() / 24)
+	}
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"status":                   starlark.String(r.Status),
+		"item_count":               starlark.MakeInt(r.ItemCount),
+		"warnings":                 starlark.NewList(warnings),
+		"last_update":              lastUpdate,
+		"age_days":                 age,
+		"license":                  starlark.String(r.License),
+		"access":                   starlark.String(r.Access),
+		"ttl_minutes":              starlark.MakeInt(r.TTLMinutes),
+		"publish_interval_minutes": starlark.MakeInt(r.PublishIntervalMinutes),
+	})
+}
+
+func ruleParsed(parsed *gofeed.Feed) starlark.Value {
+	items := make([]starlark.Value, len(parsed.Items))
+	for i, item := range parsed.Items {
+		published := starlark.Value(starlark.None)
+		if item.PublishedParsed != nil {
+			published = starlark.MakeInt64(item.PublishedParsed.Unix())
+		}
+		items[i] = starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+			"title":      starlark.String(item.Title),
+			"link":       starlark.String(item.Link),
+			"published":  published,
+			"categories": ruleStrings(item.Categories),
+		})
+	}
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"title":      starlark.String(parsed.Title),
+		"link":       starlark.String(parsed.Link),
+		"language":   starlark.String(parsed.Language),
+		"categories": ruleStrings(parsed.Categories),
+		"items":      starlark.NewList(items),
+	})
+}
+
+func ruleStrings(values []string) *starlark.List {
+	list := make([]starlark.Value, len(values))
+	for i, v := range values {
+		list[i] = starlark.String(v)
+	}
+	return starlark.NewList(list)
+}
@@ -0,0 +1,21 @@
+package validator
+
+import (
+	"bytes"
+	_ "embed"
+)
+
+// SchemaVersion is the version of the schema of the JSON outputs, which
+// carry it as schema_version. Within a version fields are only added; it
+// changes when a field changes meaning or goes away.
+const SchemaVersion = "1"
+
+//go:embed schema.json
+var schema []byte
+
+// Schema returns the JSON Schema of the JSON outputs: the Summary written
+// by the JSON reporter and posted by the webhook reporter, the lines of the
+// NDJSON reporter, and the CLI's run reports.
+func Schema() []byte {
+	return bytes.Clone(schema)
+}
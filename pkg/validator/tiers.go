@@ -0,0 +1,173 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Depth controls how much work validation does for a feed.
+type Depth int
+
+const (
+	// DepthProbe only checks that the feed URL answers with 200.
+	DepthProbe Depth = iota
+	// DepthFull fetches and parses the feed.
+	DepthFull
+	// DepthSample additionally checks that a few item links resolve.
+	DepthSample
+)
+
+// DefaultTier applies to feeds with an empty or unrecognized tier column.
+const DefaultTier = 2
+
+// itemLinkSamples is how many item links DepthSample checks per feed.
+const itemLinkSamples = 3
+
+// TierPolicy is how deeply and how often feeds of a tier are validated.
+// The validator only applies Depth; Interval is for callers scheduling
+// validations.
+type TierPolicy struct {
+	Depth    Depth
+	Interval time.Duration
+}
+
+// TierPolicies are the policies of the dataset's tiers.
+var TierPolicies = map[int]TierPolicy{
+	1: {Depth: DepthSample, Interval: 0},
+	2: {Depth: DepthFull, Interval: 24 * time.Hour},
+	3: {Depth: DepthProbe, Interval: 7 * 24 * time.Hour},
+}
+
+// ParseTier accepts "tier1", "1" and similar spellings.
+func ParseTier(s string) int {
+	s = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "tier")
+	tier, err := strconv.Atoi(strings.TrimSpace(s))
+	if _, ok := TierPolicies[tier]; err != nil || !ok {
+		return DefaultTier
+	}
+	return tier
+}
+
+// cadenceItems is how many of a feed's most recent dated items its publish
+// interval is estimated from.
+const cadenceItems = 10
+
+// publishInterval estimates how often a feed publishes as the median gap
+// between its most recent dated items, or 0 with fewer than three.
+func publishInterval(feed *gofeed.Feed) time.Duration {
+	var dates []time.Time
+	for _, item := range feed.Items {
+		switch {
+		case item.PublishedParsed != nil:
+			dates = append(dates, *item.PublishedParsed)
+		case item.UpdatedParsed != nil:
+			dates = append(dates, *item.UpdatedParsed)
+		}
+	}
+	if len(dates) < 3 {
+		return 0
+	}
+	slices.SortFunc(dates, func(a, b time.Time) int { return b.Compare(a) })
+	dates = dates[:min(len(dates), cadenceItems)]
+	gaps := make([]time.Duration, len(dates)-1)
+	for i := range gaps {
+		gaps[i] = dates[i].Sub(dates[i+1])
+	}
+	slices.Sort(gaps)
+	return gaps[len(gaps)/2]
+}
+
+var ttlElement = regexp.MustCompile(`<ttl>\s*(\d+)\s*</ttl>`)
+
+// syndicationPeriods are the sy:updatePeriod values (RSS 1.0 syndication
+// module).
+var syndicationPeriods = map[string]time.Duration{
+	"hourly":  time.Hour,
+	"daily":   24 * time.Hour,
+	"weekly":  7 * 24 * time.Hour,
+	"monthly": 30 * 24 * time.Hour,
+	"yearly":  365 * 24 * time.Hour,
+}
+
+// ttlHint returns how long a feed asks to be cached for, from RSS 2.0's ttl
+// element or the syndication module's updatePeriod and updateFrequency, or 0
+// if it doesn't say. gofeed's universal feed drops ttl, so it's read from the
+// body.
+func ttlHint(feed *gofeed.Feed, body []byte) time.Duration {
+	if m := ttlElement.FindSubmatch(body); m != nil {
+		if minutes, err := strconv.Atoi(string(m[1])); err == nil {
+			return time.Duration(minutes) * time.Minute
+		}
+	}
+	sy := feed.Extensions["sy"]
+	if len(sy["updatePeriod"]) == 0 {
+		return 0
+	}
+	period := syndicationPeriods[strings.TrimSpace(sy["updatePeriod"][0].Value)]
+	frequency := 1
+	if f := sy["updateFrequency"]; len(f) > 0 {
+		if n, err := strconv.Atoi(strings.TrimSpace(f[0].Value)); err == nil && n > 0 {
+			frequency = n
+		}
+	}
+	return period / time.Duration(frequency)
+}
+
+// sampleItemLinks checks up to itemLinkSamples item links and returns how
+// many were checked and how many failed to resolve.
+func (v *Validator) sampleItemLinks(ctx context.Context, items []*gofeed.Item) (checked, failed int) {
+	ctx, span := tracer.Start(ctx, "sample item links")
+	defer func() {
+		span.SetAttributes(attribute.Int("links.checked", checked), attribute.Int("links.failed", failed))
+		span.End()
+	}()
+	for _, item := range items {
+		if checked == itemLinkSamples {
+			break
+		}
+		if item.Link == "" {
+			continue
+		}
+		checked++
+		if !v.linkResolves(ctx, item.Link) {
+			failed++
+		}
+	}
+	return checked, failed
+}
+
+// linkResolves issues a HEAD request, falling back to GET for servers that
+// don't support HEAD, and reports whether the link answered successfully.
+func (v *Validator) linkResolves(ctx context.Context, link string) bool {
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	for _, method := range []string{"HEAD", "GET"} {
+		req, err := http.NewRequestWithContext(ctx, method, link, nil)
+		if err != nil {
+			return false
+		}
+		req.Header.Set("User-Agent", v.userAgent)
+		if err := v.limiter.wait(ctx, req.URL.Host); err != nil {
+			return false
+		}
+		resp, err := v.fetcher.Do(req)
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		if resp.StatusCode == 405 || resp.StatusCode == 501 {
+			continue
+		}
+		return resp.StatusCode < 400
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package validator
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer reports to the global tracer provider, which the embedding program
+// sets up if it exports spans.
+var tracer = otel.Tracer("github.com/reddot-watch/curated-world-news/pkg/validator")
+
+// endSpan records err, if any, on span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// traceHTTP returns a context that records a request's DNS lookup,
+// connection and TLS handshake as child spans of the span in ctx, so slow
+// fetches can be attributed to the network or to the server.
+func traceHTTP(ctx context.Context) context.Context {
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		return ctx
+	}
+	var mu sync.Mutex
+	var dns, handshake trace.Span
+	// Connections to several addresses may be attempted concurrently.
+	connects := make(map[string]trace.Span)
+
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart: func(info httptrace.DNSStartInfo) {
+			mu.Lock()
+			defer mu.Unlock()
+			_, dns = tracer.Start(ctx, "dns", trace.WithAttributes(attribute.String("net.host.name", info.Host)))
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			mu.Lock()
+			defer mu.Unlock()
+			if dns != nil {
+				dns.SetAttributes(attribute.Int("dns.addresses", len(info.Addrs)))
+				endSpan(dns, info.Err)
+			}
+		},
+		ConnectStart: func(network, addr string) {
+			mu.Lock()
+			defer mu.Unlock()
+			_, connects[network+" "+addr] = tracer.Start(ctx, "connect", trace.WithAttributes(attribute.String("net.peer.address", addr)))
+		},
+		ConnectDone: func(network, addr string, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if span, ok := connects[network+" "+addr]; ok {
+				endSpan(span, err)
+			}
+		},
+		TLSHandshakeStart: func() {
+			mu.Lock()
+			defer mu.Unlock()
+			_, handshake = tracer.Start(ctx, "tls handshake")
+		},
+		TLSHandshakeDone: func(state tls.ConnectionState, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if handshake != nil {
+				handshake.SetAttributes(attribute.String("tls.version", tls.VersionName(state.Version)))
+				endSpan(handshake, err)
+			}
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			trace.SpanFromContext(ctx).SetAttributes(attribute.Bool("http.connection_reused", info.Reused))
+		},
+		GotFirstResponseByte: func() {
+			trace.SpanFromContext(ctx).AddEvent("first response byte")
+		},
+	})
+}
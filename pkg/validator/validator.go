@@ -0,0 +1,521 @@
+// Package validator checks that news feeds answer and parse, the core of the
+// feed validator CLI, for embedding in other services.
+//
+//	v := validator.NewValidator(validator.WithTimeout(time.Minute))
+//	for r := range v.ValidateAll(ctx, feeds) {
+//		fmt.Println(r.URL, r.Status, r.Message)
+//	}
+//
+// A feed's Tier sets how deeply it is checked (see TierPolicies). Results
+// have a Status of "valid", "invalid" (the feed is broken) or "transient"
+// (it could not be reached this time and may recover).
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"runtime"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Defaults of the settings that Options change.
+const (
+	// DefaultConcurrency is how many feeds are validated at once.
+	DefaultConcurrency = 60
+	// DefaultTimeout bounds the validation of one feed, retries included.
+	DefaultTimeout = 30 * time.Second
+	// DefaultRetries is how many times a failed fetch is retried before the
+	// feed is reported as transient.
+	DefaultRetries = 2
+	// DefaultMaxBodySize is the largest feed body read, in bytes.
+	DefaultMaxBodySize = 20 << 20
+	// DefaultUserAgent is sent with every request.
+	DefaultUserAgent = "Mozilla/5.0 (compatible; FeedValidator/1.0)"
+)
+
+// Feed is a single row of the curated dataset.
+type Feed struct {
+	// ID identifies the feed across URL changes. It is read from the id
+	// column, or derived from the URL for rows that have not been assigned
+	// one yet.
+	ID       string
+	URL      string
+	Comments string
+	Language string
+	Status   string
+	// License holds license or access terms, e.g. "CC BY 4.0" or a terms
+	// page. Optional; the column may be absent.
+	License string
+	// Paywall is "paywall", "registration" or empty for open feeds. It is
+	// maintained by validation runs with --update-paywall.
+	Paywall string
+	// Tier (1-3) sets validation depth and frequency; see TierPolicies.
+	Tier int
+	// Headers are request headers the feed needs, such as a Referer or a
+	// specific Accept, as parsed by ParseHeaders. They are public, like
+	// the rest of the row, so hold no secrets.
+	Headers string
+	// Provenance, maintained by the add and intake commands: who added the
+	// feed, when (YYYY-MM-DD), and where the suggestion came from, e.g. an
+	// issue URL.
+	AddedBy   string
+	AddedDate string
+	Source    string
+	// File and Line locate the row; datasets may span several files.
+	File string
+	Line int
+}
+
+// Position returns the row's "file:line" location.
+func (f Feed) Position() string {
+	return fmt.Sprintf("%s:%d", f.File, f.Line)
+}
+
+// Result is the outcome of validating one feed.
+type Result struct {
+	// ID is the dataset's stable feed ID, so history can follow a feed
+	// across URL changes.
+	ID     string `json:"id,omitempty"`
+	URL    string `json:"url"`
+	Status Status `json:"status"`
+	// Err is why an invalid or transient feed failed, and Warnings the
+	// problems found with a valid one.
+	Err      *Error    `json:"error,omitempty"`
+	Warnings []Warning `json:"warnings,omitempty"`
+	// Message describes Err or the first warning, for display. Branch on
+	// Err and Warnings rather than its text.
+	Message    string    `json:"message,omitempty"`
+	ItemCount  int       `json:"item_count"`
+	LastUpdate time.Time `json:"last_update,omitzero"`
+	// License is a hint derived from the feed's copyright element or the
+	// publisher's known terms.
+	License          string `json:"license,omitempty"`
+	NoRedistribution bool   `json:"no_redistribution,omitempty"`
+	// Access is the paywall/registration restriction seen on this run;
+	// AccessObserved is false when the response didn't allow telling.
+	Access         string `json:"access,omitempty"`
+	AccessObserved bool   `json:"access_observed,omitempty"`
+	// ProbeOnly is set when the feed's tier only called for a reachability
+	// check, so the body was not parsed.
+	ProbeOnly bool `json:"probe_only,omitempty"`
+	// Snapshot is the archive hash of the fetched body, when archiving.
+	Snapshot string `json:"snapshot,omitempty"`
+	// BodyHash is the SHA-256 of the body of a feed that parsed, in hex.
+	BodyHash string `json:"body_hash,omitempty"`
+	// BodySize is the size of the feed's body in bytes. Partial is set
+	// when only its start was fetched, given WithPartialFetch, so ItemCount
+	// and the checks cover the items in it; BodySize is then the whole
+	// feed's size, or 0 if the server didn't say.
+	BodySize int64 `json:"body_size,omitempty"`
+	Partial  bool  `json:"partial,omitempty"`
+	// Unchanged is set when the body was the same as on the previous
+	// result given WithPrevious, which is reported again rather than
+	// parsing the feed; only the latency and staleness are new.
+	Unchanged bool `json:"unchanged,omitempty"`
+	// DuplicateOf is the URL of another row of the same feed, identical
+	// once canonicalized, whose result this is a copy of; the feed was
+	// fetched once for both.
+	DuplicateOf string `json:"duplicate_of,omitempty"`
+	// CorrectedFrom is the feed's URL as written, when it had typos that
+	// FixURL corrected before fetching; URL is the corrected one.
+	CorrectedFrom string `json:"corrected_from,omitempty"`
+	// LatencyMS is how long validation took, including retries.
+	LatencyMS int64 `json:"latency_ms,omitempty"`
+	// TTLMinutes is how long the feed asks to be cached for, from its ttl
+	// or syndication module elements.
+	TTLMinutes int `json:"ttl_minutes,omitempty"`
+	// PublishIntervalMinutes is the typical gap between the feed's recent
+	// items, or 0 if too few are dated.
+	PublishIntervalMinutes int `json:"publish_interval_minutes,omitempty"`
+	// Capture is the path of the saved response of an invalid feed, when
+	// capturing failures.
+	Capture string `json:"capture,omitempty"`
+	// Quarantine is why policy applied after validation flagged the feed
+	// for review, whatever its status.
+	Quarantine string `json:"quarantine,omitempty"`
+}
+
+// Archive stores the bodies of feeds that parsed, returning a reference
+// recorded as the result's Snapshot.
+type Archive interface {
+	Put(body []byte) (string, error)
+}
+
+// Capturer saves the responses of feeds found invalid, returning a reference
+// recorded as the result's Capture. At most Limit bytes of a body are read
+// for it; truncated reports that body is only the start of the response.
+type Capturer interface {
+	Limit() int
+	Capture(url string, resp *http.Response, body []byte, truncated bool) (string, error)
+}
+
+// Fetcher sends the HTTP requests of validation. *http.Client is a Fetcher;
+// others can answer from a cache or recording, go through a proxy, or stand
+// in for the network in tests.
+type Fetcher interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// FetcherFunc adapts a function to a Fetcher.
+type FetcherFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f FetcherFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Validator validates feeds, configured by the options it was created with.
+// It is safe for concurrent use.
+type Validator struct {
+	fetcher     Fetcher
+	concurrency int
+	// parseConcurrency is how many feeds ValidateAll parses at once.
+	parseConcurrency int
+	timeout          time.Duration
+	retries          int
+	userAgent        string
+	accept           string
+	acceptTypes      []string
+	acceptLanguage   func(Feed) string
+	maxBodySize      int64
+	seed             uint64
+	clock            Clock
+	limiter          *hostLimiter
+	previous         func(Feed) (Result, bool)
+	adaptive         *aimd
+	hedger           *hedger
+	serialHosts      bool
+	budgetLimit      time.Duration
+	lastBudget       atomic.Pointer[domainBudget]
+	partial          *partialFetch
+	jar              http.CookieJar
+	cacheDNS         bool
+	lookupHost       func(ctx context.Context, host string) ([]string, error)
+	dnsWarmup        bool
+	homepageCheck    bool
+	archive          Archive
+	capturer         Capturer
+	hooks            []Hook
+	logf             func(format string, args ...any)
+	debugHTTP        bool
+}
+
+// NewValidator returns a Validator with the given options applied over the
+// defaults: fetching with its own HTTP client, which resolves each host once
+// per run of ValidateAll, DefaultConcurrency feeds at a time, parsing as
+// many as there are CPUs, DefaultTimeout per feed and DefaultRetries
+// retries, bodies of up to DefaultMaxBodySize, without a rate limit, on the
+// system clock and with a random seed.
+func NewValidator(opts ...Option) *Validator {
+	v := &Validator{
+		concurrency:      DefaultConcurrency,
+		parseConcurrency: runtime.GOMAXPROCS(0),
+		timeout:          DefaultTimeout,
+		retries:          DefaultRetries,
+		userAgent:        DefaultUserAgent,
+		accept:           DefaultAccept,
+		acceptLanguage:   func(f Feed) string { return AcceptLanguage(f.Language, "") },
+		maxBodySize:      DefaultMaxBodySize,
+		seed:             rand.Uint64(),
+		clock:            realClock{},
+		lookupHost:       net.DefaultResolver.LookupHost,
+		logf:             func(string, ...any) {},
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	v.acceptTypes = acceptedTypes(v.accept)
+	v.cacheDNS = v.fetcher == nil || v.dnsWarmup
+	if v.fetcher == nil {
+		client := newHTTPClient(true)
+		client.Jar = v.jar
+		v.fetcher = client
+	}
+	if v.limiter != nil {
+		v.limiter.clock = v.clock
+	}
+	if a := v.adaptive; a != nil {
+		a.max, a.limit = v.concurrency, v.concurrency
+		a.min = min(a.min, v.concurrency)
+		a.clock, a.logf, a.wake = v.clock, v.logf, make(chan struct{})
+	}
+	return v
+}
+
+// NewHTTPClient returns the client used for fetching feeds. Requests are
+// bounded by per-request contexts rather than a client timeout.
+func NewHTTPClient() *http.Client {
+	return newHTTPClient(false)
+}
+
+// newHTTPClient returns the client used for fetching feeds, resolving hosts
+// through the dnsCache of each request's run when cached is set.
+func newHTTPClient(cached bool) *http.Client {
+	transport := &http.Transport{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+		DisableCompression:  false,
+		DisableKeepAlives:   false,
+		// Longer TLS handshake timeout
+		TLSHandshakeTimeout: 10 * time.Second,
+		// More generous connection timeouts
+		ResponseHeaderTimeout: 20 * time.Second,
+	}
+	if cached {
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+		transport.DialContext = cachedDial(dialer)
+	}
+
+	return &http.Client{
+		// Don't set client timeout - we're using context timeout instead
+		Transport:     transport,
+		CheckRedirect: checkRedirect,
+	}
+}
+
+// forwardedHeaders are the request headers kept on redirects off the host
+// requested. The rest, such as API keys a BeforeFetch hook adds, are only
+// sent to that host and its subdomains, as Go already does for
+// Authorization and Cookie.
+var forwardedHeaders = []string{"User-Agent", "Accept", "Accept-Language", "Accept-Encoding", "Range", "Referer"}
+
+// checkRedirect follows up to 10 redirects, as Go's client does by
+// default, dropping the headers not in forwardedHeaders when one leaves
+// the host first requested. Giving up on redirects through a consent page
+// says so, for consentWall.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		if !consentPage(via[0].URL) {
+			for _, r := range via[1:] {
+				if consentPage(r.URL) {
+					return &consentLoopError{page: r.URL.String()}
+				}
+			}
+		}
+		return errors.New("stopped after 10 redirects")
+	}
+	host, first := req.URL.Hostname(), via[0].URL.Hostname()
+	if host != first && !strings.HasSuffix(host, "."+first) {
+		for name := range req.Header {
+			if !slices.Contains(forwardedHeaders, name) {
+				req.Header.Del(name)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateFeed checks one feed at its tier's depth, running the stages in
+// turn, and traces the work as a span under ctx. The check ends early, with
+// a transient result, when ctx is canceled or its deadline passes. Each
+// call is a run of its own, with its own domain budget and DNS cache.
+func (v *Validator) ValidateFeed(ctx context.Context, feed Feed) Result {
+	j := v.newJob(ctx, v.newRun(), feed)
+	for _, stage := range v.stages() {
+		v.runStage(j, stage)
+	}
+	return v.finish(j)
+}
+
+// ValidateAll validates feeds concurrently and yields their results in the
+// order they complete. With WithDNSWarmup, the feeds' hosts are resolved
+// first, all at once. Each stage has a fixed pool of workers taking feeds
+// from the one before: as many as the concurrency limit to fetch and to
+// analyze, and as many as the parse concurrency to parse. At most as many
+// results as the concurrency limit wait to be consumed: when they aren't
+// taken, the workers stop, so memory stays flat however many feeds there
+// are. Stopping the iteration early cancels the checks in progress and
+// skips the remaining feeds. When ctx is canceled, feeds being fetched end
+// early with transient results and feeds not yet started are skipped.
+func (v *Validator) ValidateAll(ctx context.Context, feeds []Feed) iter.Seq[Result] {
+	return func(yield func(Result) bool) {
+		run := v.newRun()
+		v.lastBudget.Store(run.budget)
+		if v.dnsWarmup {
+			resolved, missing := run.dns.warm(ctx, feeds, v.concurrency)
+			v.logf("Resolved %d hosts ahead of fetching; %d don't exist", resolved, missing)
+		}
+		v.validate(ctx, run, slices.Values(feeds), min(v.concurrency, len(feeds)), min(v.parseConcurrency, len(feeds)), yield)
+	}
+}
+
+// ValidateStream validates feeds like ValidateAll, but takes them as they
+// come, such as from ScanCSV, rather than all up front, so only the feeds in
+// flight are held in memory. feeds is ranged over from another goroutine,
+// and not after the iteration ends. WithDNSWarmup has no feeds to resolve
+// ahead of time, so each host is only resolved once, when first fetched.
+func (v *Validator) ValidateStream(ctx context.Context, feeds iter.Seq[Feed]) iter.Seq[Result] {
+	return func(yield func(Result) bool) {
+		run := v.newRun()
+		v.lastBudget.Store(run.budget)
+		v.validate(ctx, run, feeds, v.concurrency, v.parseConcurrency, yield)
+	}
+}
+
+// validate runs feeds through the pipeline as run with fetchers and parsers
+// workers, yielding the results.
+func (v *Validator) validate(ctx context.Context, run *runState, feeds iter.Seq[Feed], fetchers, parsers int, yield func(Result) bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	jobs := make(chan Feed)
+	toParse := make(chan *feedJob)
+	toAnalyze := make(chan *feedJob)
+	results := make(chan Result, v.concurrency)
+	stopped := make(chan struct{})
+
+	// pass hands j to the next stage or, once it has ended, its result
+	// to the consumer. It reports false when the consumer has stopped.
+	pass := func(j *feedJob, next chan<- *feedJob) bool {
+		if j.done {
+			select {
+			case results <- v.finish(j):
+				return true
+			case <-stopped:
+				return false
+			}
+		}
+		select {
+		case next <- j:
+			return true
+		case <-stopped:
+			j.abandon()
+			return false
+		}
+	}
+
+	fed := make(chan struct{})
+	var freed chan string
+	var claims chan hostClaim
+	if v.serialHosts {
+		incoming := make(chan Feed)
+		freed, claims = make(chan string), make(chan hostClaim)
+		go sendFeeds(ctx, feeds, incoming)
+		go func() {
+			defer close(fed)
+			dispatchByHost(ctx, incoming, jobs, freed, claims)
+		}()
+	} else {
+		go func() {
+			defer close(fed)
+			sendFeeds(ctx, feeds, jobs)
+		}()
+	}
+	startWorkers(fetchers, func() {
+		for feed := range jobs {
+			if ctx.Err() != nil {
+				return
+			}
+			j := v.newJob(ctx, run, feed)
+			if freed != nil {
+				free := func(host string) {
+					select {
+					case freed <- host:
+					case <-ctx.Done():
+					}
+				}
+				host := feedHost(feed)
+				j.release = func() { free(host) }
+				j.hold = func(other string) (func(), bool) {
+					// The claim is made while the job still holds its
+					// feed's host, which keeps the dispatcher running,
+					// and waited for once it doesn't, so two jobs never
+					// wait for each other's hosts.
+					claim := hostClaim{host: other, granted: make(chan struct{})}
+					select {
+					case claims <- claim:
+					case <-ctx.Done():
+						return nil, false
+					}
+					if j.release != nil {
+						j.release()
+						j.release = nil
+					}
+					select {
+					case <-claim.granted:
+						return func() { free(other) }, true
+					case <-ctx.Done():
+						return nil, false
+					}
+				}
+			}
+			v.runStage(j, v.fetch)
+			if !pass(j, toParse) {
+				return
+			}
+		}
+	}, func() { close(toParse) })
+	// The later stages keep draining once the consumer has stopped, their
+	// jobs abandoned by pass, so that each channel is only closed once
+	// nothing upstream can send to it.
+	startWorkers(parsers, func() {
+		for j := range toParse {
+			v.runStage(j, v.parse)
+			pass(j, toAnalyze)
+		}
+	}, func() { close(toAnalyze) })
+	startWorkers(fetchers, func() {
+		for j := range toAnalyze {
+			v.runStage(j, v.analyze)
+			pass(j, nil)
+		}
+	}, func() { close(results) })
+
+	for result := range results {
+		if !yield(result) {
+			cancel()
+			close(stopped)
+			for range results {
+			}
+			break
+		}
+	}
+	<-fed
+}
+
+// ValidateEach validates feeds like ValidateAll, calling fn with each result
+// as it completes. fn is called from one goroutine at a time.
+func (v *Validator) ValidateEach(ctx context.Context, feeds []Feed, fn func(Result)) {
+	for result := range v.ValidateAll(ctx, feeds) {
+		fn(result)
+	}
+}
+
+// ValidateSlice validates feeds like ValidateAll and returns their results
+// in the order they completed, once all are done.
+func (v *Validator) ValidateSlice(ctx context.Context, feeds []Feed) []Result {
+	return slices.Collect(v.ValidateAll(ctx, feeds))
+}
+
+// readLimited reads at most limit bytes of body and reports whether more
+// remained.
+func readLimited(body io.Reader, limit int) ([]byte, bool) {
+	data, _ := io.ReadAll(io.LimitReader(body, int64(limit)+1))
+	if len(data) > limit {
+		return data[:limit], true
+	}
+	return data, false
+}
+
+// capture saves resp for an invalid result and records where, logging
+// rather than failing validation if it can't be saved.
+func (v *Validator) capture(result *Result, resp *http.Response, body []byte, truncated bool) {
+	if v.capturer == nil {
+		return
+	}
+	path, err := v.capturer.Capture(result.URL, resp, body, truncated)
+	if err != nil {
+		v.logf("Error capturing response of %s: %v", result.URL, err)
+		return
+	}
+	result.Capture = path
+}
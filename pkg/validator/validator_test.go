@@ -0,0 +1,943 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator/internal/feedtest"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// checkGolden compares got with testdata/name, or rewrites it with -update.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs (run with -update to accept):\n--- got\n%s\n--- want\n%s", name, got, want)
+	}
+}
+
+func TestClassification(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	// Item links point at news.example.com; they are sent to the server
+	// instead, where they aren't found.
+	offline := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "news.example.com" {
+			req = req.Clone(req.Context())
+			req.URL.Scheme, req.URL.Host = "http", strings.TrimPrefix(srv.URL, "http://")
+		}
+		return srv.Client().Do(req)
+	}))
+
+	cases := []struct {
+		name string
+		path string
+		tier int
+		opts []Option
+	}{
+		{name: "rss", path: "/rss.xml"},
+		{name: "atom", path: "/atom.xml"},
+		{name: "json feed", path: "/feed.json"},
+		{name: "sampled item links", path: "/rss.xml", tier: 1, opts: []Option{offline}},
+		{name: "probe only", path: "/rss.xml", tier: 3},
+		{name: "no items", path: "/empty.xml"},
+		{name: "stale", path: "/stale.xml"},
+		{name: "latin-1", path: "/latin1.xml"},
+		{name: "wrong charset", path: "/wrong-charset.xml"},
+		{name: "truncated xml", path: "/truncated.xml"},
+		{name: "html page", path: "/page.html"},
+		{name: "redirect", path: feedtest.RedirectPath},
+		{name: "redirect loop", path: feedtest.LoopPath, opts: []Option{WithRetries(0)}},
+		{name: "not found", path: "/status/404"},
+		{name: "payment required", path: "/status/402"},
+		{name: "server error", path: "/status/500", opts: []Option{WithRetries(0)}},
+		{name: "too many requests", path: feedtest.TooManyPath, opts: []Option{WithRetries(0)}},
+		{name: "slow", path: feedtest.SlowPath, opts: []Option{WithRetries(0), WithTimeout(time.Second)}},
+		{name: "invalid url", path: "/%zz"},
+	}
+
+	type outcome struct {
+		Case   string `json:"case"`
+		Result Result `json:"result"`
+	}
+	var outcomes []outcome
+	for _, c := range cases {
+		v := NewValidator(c.opts...)
+		r := v.ValidateFeed(context.Background(), Feed{URL: srv.URL + c.path, Tier: c.tier})
+		r.LatencyMS = 0
+		outcomes = append(outcomes, outcome{Case: c.name, Result: r})
+	}
+	got, err := json.MarshalIndent(outcomes, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The server's address changes from run to run.
+	got = []byte(strings.ReplaceAll(string(got), srv.URL, "http://feedtest") + "\n")
+	checkGolden(t, "classification.golden", got)
+}
+
+func TestValidateAllCanceled(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	feeds := []Feed{{URL: srv.URL + feedtest.SlowPath}, {URL: srv.URL + feedtest.SlowPath}}
+	results := NewValidator(WithConcurrency(1)).ValidateSlice(ctx, feeds)
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1: the feed not yet started is skipped", len(results))
+	}
+	if r := results[0]; r.Status != StatusTransient || r.Err.Code != CodeCanceled {
+		t.Errorf("got %s (%v), want a transient canceled result", r.Status, r.Err)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	a, b := NewValidator(WithSeed(7)), NewValidator(WithSeed(7))
+	for attempt := 1; attempt <= 3; attempt++ {
+		backoff := time.Second << (attempt - 1)
+		d := a.retryDelay("http://feedtest/rss.xml", attempt)
+		if d < backoff/2 || d >= backoff*3/2 {
+			t.Errorf("attempt %d: delay %s outside [%s, %s)", attempt, d, backoff/2, backoff*3/2)
+		}
+		if again := b.retryDelay("http://feedtest/rss.xml", attempt); again != d {
+			t.Errorf("attempt %d: delay %s with the same seed, want %s", attempt, again, d)
+		}
+	}
+	if a.retryDelay("http://feedtest/rss.xml", 1) == a.retryDelay("http://feedtest/atom.xml", 1) {
+		t.Error("feeds retried in lockstep: same delay for different URLs")
+	}
+}
+
+func TestPanicIsolation(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	var logged string
+	v := NewValidator(
+		WithLogf(func(format string, args ...any) { logged += fmt.Sprintf(format, args...) }),
+		WithHooks(Hook{AfterParse: func(ctx context.Context, feed Feed, parsed *gofeed.Feed, r *Result) {
+			if strings.HasSuffix(feed.URL, "/atom.xml") {
+				panic("check bug")
+			}
+		}}),
+	)
+	results := v.ValidateSlice(context.Background(), []Feed{{URL: srv.URL + "/rss.xml"}, {URL: srv.URL + "/atom.xml"}})
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for _, r := range results {
+		switch {
+		case strings.HasSuffix(r.URL, "/rss.xml") && r.Status != StatusValid:
+			t.Errorf("%s: got %s (%s), want valid", r.URL, r.Status, r.Message)
+		case strings.HasSuffix(r.URL, "/atom.xml") && (r.Status != StatusTransient || !errors.Is(r.Err, ErrInternal)):
+			t.Errorf("%s: got %s (%v), want transient internal", r.URL, r.Status, r.Err)
+		}
+	}
+	if !strings.Contains(logged, "check bug") || !strings.Contains(logged, "goroutine") {
+		t.Errorf("panic and stack not logged: %q", logged)
+	}
+}
+
+// fakeClock is a Clock whose sleeps return at once, moving its time on
+// and recording how long they were.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	slept []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.slept = append(c.slept, d)
+	return ctx.Err() == nil
+}
+
+func TestRetries(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		path     string
+		attempts int
+		status   Status
+	}{
+		{"/status/500", 3, StatusTransient},
+		{"/status/429", 3, StatusTransient},
+		{"/status/404", 1, StatusInvalid},
+		{"/rss.xml", 1, StatusValid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			var attempts atomic.Int32
+			clock := &fakeClock{now: time.Date(2099, 1, 2, 0, 0, 0, 0, time.UTC)}
+			v := NewValidator(WithRetries(2), WithClock(clock), WithSeed(1), WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+				attempts.Add(1)
+				return srv.Client().Do(req)
+			})))
+			feed := Feed{URL: srv.URL + tt.path}
+			r := v.ValidateFeed(context.Background(), feed)
+			if r.Status != tt.status || int(attempts.Load()) != tt.attempts {
+				t.Errorf("got %s after %d attempts, want %s after %d", r.Status, attempts.Load(), tt.status, tt.attempts)
+			}
+			if len(clock.slept) != tt.attempts-1 {
+				t.Fatalf("slept %d times, want once between each attempt", len(clock.slept))
+			}
+			var total time.Duration
+			for i, d := range clock.slept {
+				if want := v.retryDelay(feed.URL, i+1); d != want {
+					t.Errorf("retry %d: slept %s, want %s", i+1, d, want)
+				}
+				total += d
+			}
+			if latency := time.Duration(r.LatencyMS) * time.Millisecond; latency != total.Truncate(time.Millisecond) {
+				t.Errorf("latency %s, want the %s slept", latency, total)
+			}
+		})
+	}
+}
+
+func TestStaleness(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	for _, tt := range []struct {
+		now       time.Time
+		wantStale bool
+	}{
+		{time.Date(2099, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2099, 8, 1, 0, 0, 0, 0, time.UTC), true},
+	} {
+		r := NewValidator(WithClock(&fakeClock{now: tt.now})).ValidateFeed(context.Background(), Feed{URL: srv.URL + "/rss.xml"})
+		stale := slices.ContainsFunc(r.Warnings, func(w Warning) bool { return w.Code == WarningStale })
+		if stale != tt.wantStale {
+			t.Errorf("at %s: stale %t, want %t", tt.now.Format(time.DateOnly), stale, tt.wantStale)
+		}
+	}
+}
+
+// memoryArchive keeps the bodies put in it.
+type memoryArchive [][]byte
+
+func (a *memoryArchive) Put(body []byte) (string, error) {
+	*a = append(*a, body)
+	return fmt.Sprintf("body-%d", len(*a)), nil
+}
+
+func TestDNSWarmup(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+
+	v := NewValidator(WithDNSWarmup(), WithRetries(0))
+	var lookups atomic.Int32
+	v.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		lookups.Add(1)
+		if host == "feeds.example" {
+			return []string{u.Hostname()}, nil
+		}
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	feeds := []Feed{
+		{URL: "http://feeds.example:" + u.Port() + "/rss.xml"},
+		{URL: "http://feeds.example:" + u.Port() + "/atom.xml"},
+		{URL: "http://gone.example/rss.xml"},
+	}
+	for _, r := range v.ValidateSlice(context.Background(), feeds) {
+		want, code := StatusValid, ErrorCode("")
+		if strings.Contains(r.URL, "gone") {
+			want, code = StatusInvalid, CodeUnresolvable
+		}
+		if r.Status != want || (code != "" && !errors.Is(r.Err, &Error{Code: code})) {
+			t.Errorf("%s: got %s (%v), want %s %s", r.URL, r.Status, r.Err, want, code)
+		}
+	}
+	if n := lookups.Load(); n != 2 {
+		t.Errorf("%d lookups, want one per host", n)
+	}
+}
+
+func TestDebugHTTP(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	var mu sync.Mutex
+	var lines []string
+	logf := func(format string, args ...any) {
+		mu.Lock()
+		defer mu.Unlock()
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+	v := NewValidator(WithDebugHTTP(), WithLogf(logf), WithRetries(0))
+	v.ValidateSlice(context.Background(), []Feed{{URL: srv.URL + "/rss.xml"}, {URL: srv.URL + "/status/404"}})
+	slices.Sort(lines)
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want one per request: %q", len(lines), lines)
+	}
+	for i, want := range []string{"/rss.xml (attempt 1/1): 200 OK", "/status/404 (attempt 1/1): 404 Not Found"} {
+		if !strings.Contains(lines[i], want) || !strings.Contains(lines[i], "first byte") {
+			t.Errorf("got %q, want %q and its timings", lines[i], want)
+		}
+	}
+}
+
+func TestHedging(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	// The first request for the feed hangs; the hedged one answers.
+	var calls atomic.Int32
+	slowFirst := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		if calls.Add(1) == 1 {
+			req = req.Clone(req.Context())
+			req.URL.Path = feedtest.SlowPath
+		}
+		return srv.Client().Do(req)
+	}))
+	v := NewValidator(slowFirst, WithHedging(50*time.Millisecond), WithRetries(0))
+	start := time.Now()
+	r := v.ValidateFeed(context.Background(), Feed{URL: srv.URL + "/rss.xml"})
+	if r.Status != StatusValid || time.Since(start) > feedtest.Slow/2 {
+		t.Errorf("got %s (%s) after %s, want valid from the hedged request", r.Status, r.Message, time.Since(start))
+	}
+	if sent, won := v.HedgedRequests(); sent != 1 || won != 1 {
+		t.Errorf("%d hedged requests sent and %d won, want 1 and 1", sent, won)
+	}
+
+	h := &hedger{}
+	for i := range hedgeMinSamples {
+		if h.delay() != 0 {
+			t.Fatalf("hedging after %d responses, want %d first", i, hedgeMinSamples)
+		}
+		h.observe(time.Duration(i+1) * time.Millisecond)
+	}
+	if d := h.delay(); d != 19*time.Millisecond {
+		t.Errorf("delay %s, want the p95 of 1ms to 20ms, 19ms", d)
+	}
+}
+
+func TestSerialHosts(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	// Feeds of two hosts, served by srv, tracking how many of each host's
+	// requests are in flight.
+	var mu sync.Mutex
+	inFlight, most := make(map[string]int), make(map[string]int)
+	fetcher := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		host := req.URL.Host
+		mu.Lock()
+		inFlight[host]++
+		most[host] = max(most[host], inFlight[host])
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight[host]--
+			mu.Unlock()
+		}()
+		time.Sleep(10 * time.Millisecond)
+		req = req.Clone(req.Context())
+		req.URL.Host = strings.TrimPrefix(srv.URL, "http://")
+		return srv.Client().Do(req)
+	}))
+	var feeds []Feed
+	for i := range 4 {
+		feeds = append(feeds,
+			Feed{ID: fmt.Sprintf("a%d", i), URL: "http://a.example/rss.xml"},
+			Feed{ID: fmt.Sprintf("b%d", i), URL: "http://B.example/rss.xml"})
+	}
+	v := NewValidator(fetcher, WithSerialHosts(), WithConcurrency(4))
+	results := v.ValidateSlice(context.Background(), feeds)
+	if len(results) != len(feeds) {
+		t.Fatalf("got %d results, want %d", len(results), len(feeds))
+	}
+	for _, r := range results {
+		if r.Status != StatusValid {
+			t.Errorf("%s: %s (%s), want valid", r.ID, r.Status, r.Message)
+		}
+	}
+	if most["a.example"] != 1 || most["B.example"] != 1 {
+		t.Errorf("at most %v requests per host in flight, want 1", most)
+	}
+
+	// Stopping early doesn't leave the dispatcher waiting.
+	for range v.ValidateAll(context.Background(), feeds) {
+		break
+	}
+}
+
+func TestDomainBudget(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	// Feeds of slow.example take 30ms each; the budget lets two through.
+	fetcher := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Hostname(), "slow.example") {
+			time.Sleep(30 * time.Millisecond)
+		}
+		req = req.Clone(req.Context())
+		req.URL.Host = strings.TrimPrefix(srv.URL, "http://")
+		return srv.Client().Do(req)
+	}))
+	var feeds []Feed
+	for i := range 4 {
+		feeds = append(feeds,
+			Feed{ID: fmt.Sprintf("slow%d", i), URL: fmt.Sprintf("http://feeds%d.slow.example/rss.xml", i)},
+			Feed{ID: fmt.Sprintf("fast%d", i), URL: "http://fast.example/rss.xml"})
+	}
+	v := NewValidator(fetcher, WithDomainBudget(50*time.Millisecond), WithConcurrency(1))
+	got := make(map[string]int)
+	for _, r := range v.ValidateSlice(context.Background(), feeds) {
+		if errors.Is(r.Err, ErrOverBudget) {
+			if r.Status != StatusTransient {
+				t.Errorf("%s: over budget but %s", r.ID, r.Status)
+			}
+			got[strings.TrimRight(r.ID, "0123456789")+" over budget"]++
+			continue
+		}
+		got[strings.TrimRight(r.ID, "0123456789")+" "+string(r.Status)]++
+	}
+	want := map[string]int{"slow valid": 2, "slow over budget": 2, "fast valid": 4}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if domains := v.OverBudgetDomains(); !slices.Equal(domains, []string{"slow.example"}) {
+		t.Errorf("over budget: %v, want slow.example", domains)
+	}
+
+	// Each run starts with the whole budget.
+	if r := v.ValidateSlice(context.Background(), feeds[:1]); r[0].Status != StatusValid {
+		t.Errorf("next run: %s (%s), want valid", r[0].Status, r[0].Message)
+	}
+
+	// Runs going on at once each have their own budget, and so does each
+	// ValidateFeed call.
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := 0
+			for _, r := range v.ValidateSlice(context.Background(), feeds) {
+				if errors.Is(r.Err, ErrOverBudget) {
+					n++
+				}
+			}
+			if n != 2 {
+				t.Errorf("concurrent run: %d feeds over budget, want 2", n)
+			}
+		}()
+	}
+	wg.Wait()
+	if r := v.ValidateFeed(context.Background(), feeds[0]); r.Status != StatusValid {
+		t.Errorf("ValidateFeed after a run: %s (%s), want valid", r.Status, r.Message)
+	}
+}
+
+func TestConsentWall(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	newJar := func(seed ...*http.Cookie) http.CookieJar {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, _ := url.Parse(srv.URL)
+		jar.SetCookies(u, seed)
+		return jar
+	}
+	tests := []struct {
+		name string
+		path string
+		jar  http.CookieJar
+		want Status
+	}{
+		{"consent page", feedtest.ConsentPath, nil, StatusInvalid},
+		{"consent page, seeded cookie", feedtest.ConsentPath, newJar(feedtest.ConsentCookie), StatusValid},
+		{"consent loop", feedtest.ConsentLoop, nil, StatusInvalid},
+		{"consent loop, cookie jar", feedtest.ConsentLoop, newJar(), StatusValid},
+		{"feed at a consent-like path", feedtest.GDPRMoved, nil, StatusValid},
+		{"consent-like path requested", "/consent", nil, StatusInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewValidator(WithCookieJar(tt.jar), WithRetries(0))
+			r := v.ValidateFeed(context.Background(), Feed{URL: srv.URL + tt.path})
+			if r.Status != tt.want {
+				t.Fatalf("got %s (%s), want %s", r.Status, r.Message, tt.want)
+			}
+			if wall := tt.path != "/consent"; r.Status == StatusInvalid && errors.Is(r.Err, ErrConsentWall) != wall {
+				t.Errorf("got %v, want a consent wall: %v", r.Err, wall)
+			}
+		})
+	}
+}
+
+func TestAccept(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name        string
+		accept      string
+		contentType string
+		wantWarning bool
+	}{
+		{"feed type", DefaultAccept, "application/rss+xml; charset=utf-8", false},
+		{"text/xml", DefaultAccept, "text/xml", false},
+		{"html", DefaultAccept, "text/html; charset=utf-8", true},
+		{"no accept header", "", "text/html", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent string
+			fetcher := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+				sent = req.Header.Get("Accept")
+				resp, err := srv.Client().Do(req)
+				if err == nil {
+					resp.Header.Set("Content-Type", tt.contentType)
+				}
+				return resp, err
+			}))
+			v := NewValidator(fetcher, WithAccept(tt.accept), WithRetries(0))
+			r := v.ValidateFeed(context.Background(), Feed{URL: srv.URL + "/rss.xml"})
+			if r.Status != StatusValid {
+				t.Fatalf("got %s (%s), want valid", r.Status, r.Message)
+			}
+			if sent != tt.accept {
+				t.Errorf("sent Accept %q, want %q", sent, tt.accept)
+			}
+			warned := slices.ContainsFunc(r.Warnings, func(w Warning) bool { return w.Code == WarningContentType })
+			if warned != tt.wantWarning {
+				t.Errorf("content type warning = %v, want %v (%v)", warned, tt.wantWarning, r.Warnings)
+			}
+		})
+	}
+}
+
+func TestPartialFetch(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	// ignoreRange stands for servers without range support.
+	ignoreRange := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.Header.Del("Range")
+		return srv.Client().Do(req)
+	}))
+
+	tests := []struct {
+		name        string
+		path        string
+		limit       int64
+		opts        []Option
+		wantPartial bool
+		wantItems   int
+	}{
+		{"rss, one item in range", "/rss.xml", 400, nil, true, 1},
+		{"rss, no complete item in range", "/rss.xml", 200, nil, false, 2},
+		{"atom", "/atom.xml", 370, nil, true, 1},
+		{"json feed", "/feed.json", 200, nil, false, 1},
+		{"range ignored", "/rss.xml", 400, []Option{ignoreRange}, false, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]Option{WithRetries(0), WithPartialFetch(tt.limit, func(Feed) bool { return true })}, tt.opts...)
+			r := NewValidator(opts...).ValidateFeed(context.Background(), Feed{URL: srv.URL + tt.path})
+			if r.Status != StatusValid {
+				t.Fatalf("got %s (%s), want valid", r.Status, r.Message)
+			}
+			if r.Partial != tt.wantPartial || r.ItemCount != tt.wantItems {
+				t.Errorf("got partial %v with %d items, want partial %v with %d", r.Partial, r.ItemCount, tt.wantPartial, tt.wantItems)
+			}
+			if r.Partial && r.BodySize <= tt.limit {
+				t.Errorf("got body size %d, want the whole feed's", r.BodySize)
+			}
+		})
+	}
+}
+
+func TestFeedHeaders(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		headers string
+		want    Status
+		wantReq http.Header
+	}{
+		{"none", "", StatusValid, http.Header{"Accept": {DefaultAccept}, "Accept-Language": {DefaultAcceptLanguage}}},
+		{"referer and accept", "Referer: https://example.com/ | accept: application/xml", StatusValid,
+			http.Header{"Referer": {"https://example.com/"}, "Accept": {"application/xml"}}},
+		{"placeholder", "X-API-Key: ${HOME}", StatusInvalid, nil},
+		{"malformed", "Referer https://example.com/", StatusInvalid, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent http.Header
+			fetcher := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+				sent = req.Header.Clone()
+				return srv.Client().Do(req)
+			}))
+			r := NewValidator(fetcher, WithRetries(0)).ValidateFeed(context.Background(), Feed{URL: srv.URL + "/rss.xml", Headers: tt.headers})
+			if r.Status != tt.want {
+				t.Fatalf("got %s (%s), want %s", r.Status, r.Message, tt.want)
+			}
+			if r.Status != StatusValid && !errors.Is(r.Err, ErrHeaders) {
+				t.Errorf("got %v, want a headers error", r.Err)
+			}
+			for name, values := range tt.wantReq {
+				if !slices.Equal(sent[name], values) {
+					t.Errorf("sent %s %q, want %q", name, sent[name], values)
+				}
+			}
+		})
+	}
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		language, country, want string
+	}{
+		{"", "GB", DefaultAcceptLanguage},
+		{"en", "", "en, *;q=0.1"},
+		{"fr", "ca", "fr-CA, fr;q=0.9, *;q=0.1"},
+		{"pt_BR", "PT", "pt-BR, pt;q=0.9, *;q=0.1"},
+		{"fr, en", "CH", "fr-CH, fr;q=0.9, en-CH;q=0.8, en;q=0.7, *;q=0.1"},
+	}
+	for _, tt := range tests {
+		if got := AcceptLanguage(tt.language, tt.country); got != tt.want {
+			t.Errorf("AcceptLanguage(%q, %q) = %q, want %q", tt.language, tt.country, got, tt.want)
+		}
+	}
+}
+
+func TestHomepageCheck(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name        string
+		home        string
+		wantWarning bool
+	}{
+		{"live", "/page.html", false},
+		{"unreachable", "/status/404", true},
+		{"parked", "/parked.html", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The feed's channel link, https://news.example.com/, is sent
+			// to the server's tt.home instead.
+			fetcher := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Host == "news.example.com" {
+					req = req.Clone(req.Context())
+					req.URL.Scheme, req.URL.Host, req.URL.Path = "http", strings.TrimPrefix(srv.URL, "http://"), tt.home
+				}
+				return srv.Client().Do(req)
+			}))
+			r := NewValidator(fetcher, WithHomepageCheck(), WithRetries(0)).ValidateFeed(context.Background(), Feed{URL: srv.URL + "/rss.xml"})
+			if r.Status != StatusValid {
+				t.Fatalf("got %s (%s), want valid", r.Status, r.Message)
+			}
+			warned := slices.ContainsFunc(r.Warnings, func(w Warning) bool { return w.Code == WarningHomepage })
+			if warned != tt.wantWarning {
+				t.Errorf("homepage warning = %v, want %v (%v)", warned, tt.wantWarning, r.Warnings)
+			}
+		})
+	}
+
+	// Feeds of several sites sharing a homepage check it once in a run:
+	// with serial hosts the others wait for the first check's outcome.
+	var homeFetches atomic.Int32
+	fetcher := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		if req.URL.Host == "news.example.com" {
+			homeFetches.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			req.URL.Scheme, req.URL.Path = "http", "/parked.html"
+		}
+		req.URL.Host = strings.TrimPrefix(srv.URL, "http://")
+		return srv.Client().Do(req)
+	}))
+	var feeds []Feed
+	for i := range 6 {
+		feeds = append(feeds, Feed{ID: fmt.Sprint(i), URL: fmt.Sprintf("http://site%d.example/rss.xml", i)})
+	}
+	v := NewValidator(fetcher, WithHomepageCheck(), WithSerialHosts(), WithConcurrency(6), WithRetries(0))
+	for _, r := range v.ValidateSlice(context.Background(), feeds) {
+		if !slices.ContainsFunc(r.Warnings, func(w Warning) bool { return w.Code == WarningHomepage }) {
+			t.Errorf("%s: no homepage warning (%v)", r.ID, r.Warnings)
+		}
+	}
+	if n := homeFetches.Load(); n != 1 {
+		t.Errorf("homepage fetched %d times, want once", n)
+	}
+}
+
+func TestRedirectHeaders(t *testing.T) {
+	var sent http.Header
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = r.Header.Clone()
+		http.Error(w, "not here", http.StatusNotFound)
+	}))
+	defer target.Close()
+	// The target is reached by another name, so the redirect leaves the
+	// host requested.
+	elsewhere := strings.Replace(target.URL, "127.0.0.1", "localhost", 1)
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, elsewhere+"/rss.xml", http.StatusFound)
+	}))
+	defer origin.Close()
+
+	addKey := WithHooks(Hook{BeforeFetch: func(ctx context.Context, feed Feed, req *http.Request) error {
+		req.Header.Set("X-Api-Key", "secret")
+		return nil
+	}})
+	NewValidator(addKey, WithRetries(0)).ValidateFeed(context.Background(), Feed{URL: origin.URL + "/rss.xml"})
+	if sent == nil {
+		t.Fatal("redirect not followed")
+	}
+	if got := sent.Get("X-Api-Key"); got != "" {
+		t.Errorf("X-Api-Key %q sent to another host", got)
+	}
+	if sent.Get("User-Agent") != DefaultUserAgent {
+		t.Errorf("User-Agent %q not forwarded", sent.Get("User-Agent"))
+	}
+}
+
+func TestDNSCache(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	var mu sync.Mutex
+	lookups := make(map[string]int)
+	c := newDNSCache(func(ctx context.Context, host string) ([]string, error) {
+		mu.Lock()
+		lookups[host]++
+		mu.Unlock()
+		switch host {
+		case "gone.example":
+			return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+		case "flaky.example":
+			return nil, &net.DNSError{Err: "server misbehaving", Name: host, IsTemporary: true}
+		}
+		return []string{"192.0.2.1"}, nil
+	}, clock)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		for _, host := range []string{"feeds.example", "gone.example", "flaky.example"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.resolve(context.Background(), host)
+			}()
+		}
+	}
+	wg.Wait()
+	for _, host := range []string{"feeds.example", "gone.example", "flaky.example"} {
+		c.resolve(context.Background(), host)
+	}
+	if lookups["feeds.example"] != 1 || lookups["gone.example"] != 1 {
+		t.Errorf("looked up %v; want hosts that resolved or don't exist once", lookups)
+	}
+	if lookups["flaky.example"] < 2 {
+		t.Errorf("looked up flaky.example %d times; want failed lookups retried", lookups["flaky.example"])
+	}
+	if c.unresolvable("gone.example") == nil || c.unresolvable("feeds.example") != nil {
+		t.Error("want only gone.example unresolvable")
+	}
+
+	clock.Sleep(context.Background(), dnsCacheMaxAge+time.Second)
+	c.resolve(context.Background(), "feeds.example")
+	if lookups["feeds.example"] != 2 {
+		t.Errorf("looked up feeds.example %d times; want an expired lookup repeated", lookups["feeds.example"])
+	}
+}
+
+func TestUnchanged(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	feed := Feed{URL: srv.URL + "/rss.xml"}
+	first := NewValidator().ValidateFeed(context.Background(), feed)
+	if first.Status != StatusValid || first.BodyHash == "" || first.Unchanged || len(first.Warnings) != 0 {
+		t.Fatalf("first run: got %+v, want a valid, hashed result", first)
+	}
+
+	tests := []struct {
+		name          string
+		prev          Result
+		now           time.Time
+		wantUnchanged bool
+		wantStale     bool
+	}{
+		{"same body", first, time.Now(), true, false},
+		{"same body, since gone stale", first, first.LastUpdate.AddDate(1, 0, 0), true, true},
+		{"changed body", Result{Status: StatusValid, BodyHash: "0123", ItemCount: 99}, time.Now(), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewValidator(WithClock(&fakeClock{now: tt.now}), WithPrevious(func(f Feed) (Result, bool) {
+				return tt.prev, f.URL == feed.URL
+			}))
+			r := v.ValidateFeed(context.Background(), feed)
+			if r.Unchanged != tt.wantUnchanged || r.ItemCount != first.ItemCount || r.BodyHash != first.BodyHash {
+				t.Errorf("got unchanged %v with %d items, want %v with %d", r.Unchanged, r.ItemCount, tt.wantUnchanged, first.ItemCount)
+			}
+			if stale := slices.ContainsFunc(r.Warnings, func(w Warning) bool { return w.Code == WarningStale }); stale != tt.wantStale {
+				t.Errorf("got stale %v, want %v", stale, tt.wantStale)
+			}
+		})
+	}
+}
+
+func TestAdaptiveConcurrency(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	v := NewValidator(WithConcurrency(8), WithAdaptiveConcurrency(1), WithRetries(0))
+	feeds := func(path string, n int) []Feed {
+		feeds := make([]Feed, n)
+		for i := range feeds {
+			feeds[i] = Feed{URL: fmt.Sprintf("%s%s?%d", srv.URL, path, i)}
+		}
+		return feeds
+	}
+
+	// Each window of 10 fetches failing halves the limit, down to the
+	// minimum; each succeeding raises it by a tenth of the ceiling.
+	v.ValidateSlice(context.Background(), feeds("/status/503", 40))
+	v.ValidateSlice(context.Background(), feeds("/rss.xml", 20))
+	var got [][2]int
+	for _, c := range v.ConcurrencyChanges() {
+		got = append(got, [2]int{c.From, c.To})
+	}
+	want := [][2]int{{8, 4}, {4, 2}, {2, 1}, {1, 2}, {2, 3}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got changes %v, want %v", got, want)
+	}
+}
+
+func TestBodyLimits(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	feed := Feed{URL: srv.URL + "/rss.xml"}
+
+	r := NewValidator(WithMaxBodySize(100)).ValidateFeed(context.Background(), feed)
+	if r.Status != StatusInvalid || !errors.Is(r.Err, ErrTooLarge) {
+		t.Errorf("over the limit: got %s (%v), want invalid too_large", r.Status, r.Err)
+	}
+
+	// The archive gets the whole body, though it is parsed as it streams.
+	var archive memoryArchive
+	r = NewValidator(WithArchive(&archive)).ValidateFeed(context.Background(), feed)
+	resp, err := http.Get(feed.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	want, _ := io.ReadAll(resp.Body)
+	if r.Status != StatusValid || r.Snapshot != "body-1" || len(archive) != 1 || !bytes.Equal(archive[0], want) {
+		t.Errorf("archived %d bodies, snapshot %q; want the %d-byte body as body-1", len(archive), r.Snapshot, len(want))
+	}
+}
+
+func TestValidateAllBackpressure(t *testing.T) {
+	srv := feedtest.NewServer()
+	defer srv.Close()
+	var fetched atomic.Int32
+	counting := WithFetcher(FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		fetched.Add(1)
+		return srv.Client().Do(req)
+	}))
+
+	feeds := make([]Feed, 100)
+	for i := range feeds {
+		feeds[i] = Feed{URL: srv.URL + "/rss.xml"}
+	}
+	const concurrency, parsers = 3, 1
+	// While the consumer waits, at most a buffer of results and a feed per
+	// worker of each stage can be fetched ahead of it.
+	const ahead = 3*concurrency + parsers
+	consumed := 0
+	for range NewValidator(WithConcurrency(concurrency), WithParseConcurrency(parsers), counting).ValidateAll(context.Background(), feeds) {
+		consumed++
+		time.Sleep(20 * time.Millisecond)
+		if n := int(fetched.Load()); n > consumed+ahead {
+			t.Fatalf("%d feeds fetched with %d results consumed", n, consumed)
+		}
+		if consumed == 5 {
+			break
+		}
+	}
+	if n := int(fetched.Load()); n > 5+ahead {
+		t.Errorf("%d feeds fetched after stopping at 5 results", n)
+	}
+}
+
+func TestDetectAccessRestriction(t *testing.T) {
+	rss := func(channel, items string) string {
+		return `<rss version="2.0" xmlns:dcterms="http://purl.org/dc/terms/" xmlns:s="https://schema.org/"><channel><title>News</title>` +
+			channel + items + `</channel></rss>`
+	}
+	tests := []struct {
+		name, feed, want string
+	}{
+		{"articles about paywalls", rss("", strings.Repeat(`<item><title>Subscribe now to read: why paywalls fail</title><description>Premium content for subscribers only</description></item>`, 3)), AccessOpen},
+		{"channel description", rss(`<description>Subscriber-only articles</description>`, `<item><title>A</title></item>`), AccessPaywall},
+		{"premium category", rss("", strings.Repeat(`<item><title>A</title><category>Premium</category></item>`, 2)+`<item><title>B</title></item>`), AccessPaywall},
+		{"few premium items", rss("", `<item><title>A</title><category>premium</category></item>`+strings.Repeat(`<item><title>B</title></item>`, 3)), AccessOpen},
+		{"access rights", rss("", strings.Repeat(`<item><title>A</title><dcterms:accessRights>Free registration required</dcterms:accessRights></item>`, 2)), AccessRegistration},
+		{"not free", rss("", strings.Repeat(`<item><title>A</title><s:isAccessibleForFree>False</s:isAccessibleForFree></item>`, 2)), AccessPaywall},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := gofeed.NewParser().ParseString(tt.feed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := detectAccessRestriction(parsed); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// policyOutcome is what a matching policy rule does to a result.
+type policyOutcome string
+
+const (
+	// policyPass marks the feed valid, dropping its error and warnings.
+	policyPass policyOutcome = "pass"
+	// policyWarn keeps the feed valid and adds a warning.
+	policyWarn policyOutcome = "warn"
+	// policyFail marks the feed invalid.
+	policyFail policyOutcome = "fail"
+	// policyQuarantine keeps the feed's status but flags it for review.
+	policyQuarantine policyOutcome = "quarantine"
+)
+
+// yamlList is a list that may also be given as a single value.
+type yamlList[T any] []T
+
+func (l *yamlList[T]) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.SequenceNode {
+		var v T
+		if err := node.Decode(&v); err != nil {
+			return err
+		}
+		*l = yamlList[T]{v}
+		return nil
+	}
+	return node.Decode((*[]T)(l))
+}
+
+// policyCondition is the when of a policy rule. Every field set must match;
+// a list matches any of its values. Ages are of the feed's last update, and
+// undated feeds match neither bound.
+type policyCondition struct {
+	Status    yamlList[string] `yaml:"status"`
+	ErrorCode yamlList[string] `yaml:"error_code"`
+	Warning   yamlList[string] `yaml:"warning"`
+	Tier      yamlList[int]    `yaml:"tier"`
+	// Country holds ISO 3166-1 alpha-2 codes of the feed's country.
+	Country  yamlList[string] `yaml:"country"`
+	MinItems *int             `yaml:"min_items"`
+	MaxItems *int             `yaml:"max_items"`
+	MinAge   time.Duration    `yaml:"min_age"`
+	MaxAge   time.Duration    `yaml:"max_age"`
+}
+
+// policyRule maps a condition over results to an outcome.
+type policyRule struct {
+	Name    string          `yaml:"name"`
+	When    policyCondition `yaml:"when"`
+	Then    policyOutcome   `yaml:"then"`
+	Message string          `yaml:"message"`
+}
+
+// policy is the --policy file: rules evaluated in order against each result
+// after validation, the first matching rule deciding its outcome. Results no
+// rule matches are left as validated.
+type policy struct {
+	Rules []policyRule `yaml:"rules"`
+}
+
+// loadPolicy reads a YAML file of the form {rules: [...]}.
+func loadPolicy(path string) (*policy, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for i, rule := range p.Rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("%s: rule %d has no name", path, i+1)
+		}
+		switch rule.Then {
+		case policyPass, policyWarn, policyFail, policyQuarantine:
+		default:
+			return nil, fmt.Errorf("%s: rule %q: then must be pass, warn, fail or quarantine, not %q", path, rule.Name, rule.Then)
+		}
+		for _, code := range rule.When.Country {
+			if _, ok := lookupCountry(code); !ok {
+				return nil, fmt.Errorf("%s: rule %q: unknown country %q", path, rule.Name, code)
+			}
+		}
+	}
+	return &p, nil
+}
+
+func (c policyCondition) matches(feed Feed, r ValidationResult, now time.Time) bool {
+	if len(c.Status) > 0 && !slices.Contains(c.Status, string(r.Status)) {
+		return false
+	}
+	if len(c.ErrorCode) > 0 && (r.Err == nil || !slices.Contains(c.ErrorCode, string(r.Err.Code))) {
+		return false
+	}
+	if len(c.Warning) > 0 && !slices.ContainsFunc(r.Warnings, func(w validator.Warning) bool {
+		return slices.Contains(c.Warning, string(w.Code))
+	}) {
+		return false
+	}
+	if len(c.Tier) > 0 && !slices.Contains(c.Tier, feed.Tier) {
+		return false
+	}
+	if len(c.Country) > 0 {
+		country, ok := countryFromComments(feed.Comments)
+		if !ok || !slices.ContainsFunc(c.Country, func(code string) bool { return strings.EqualFold(code, country.Alpha2) }) {
+			return false
+		}
+	}
+	if c.MinItems != nil && r.ItemCount < *c.MinItems || c.MaxItems != nil && r.ItemCount > *c.MaxItems {
+		return false
+	}
+	if c.MinAge > 0 || c.MaxAge > 0 {
+		if r.LastUpdate.IsZero() {
+			return false
+		}
+		age := now.Sub(r.LastUpdate)
+		if c.MinAge > 0 && age < c.MinAge || c.MaxAge > 0 && age > c.MaxAge {
+			return false
+		}
+	}
+	return true
+}
+
+// apply changes r by the first rule matching it. A nil policy changes
+// nothing.
+func (p *policy) apply(feed Feed, r *ValidationResult, now time.Time) {
+	if p == nil {
+		return
+	}
+	for _, rule := range p.Rules {
+		if !rule.When.matches(feed, *r, now) {
+			continue
+		}
+		message := rule.Message
+		if message == "" {
+			message = "Policy " + rule.Name
+		}
+		switch rule.Then {
+		case policyPass:
+			r.Status, r.Err, r.Warnings, r.Message = validator.StatusValid, nil, nil, ""
+		case policyWarn:
+			if r.Status != validator.StatusValid {
+				r.Status, r.Err, r.Message = validator.StatusValid, nil, ""
+			}
+			r.Warn(validator.WarningPolicy, "%s", message)
+		case policyFail:
+			r.Fail(validator.StatusInvalid, validator.NewError(validator.CodePolicy, nil, message))
+		case policyQuarantine:
+			r.Quarantine = message
+		}
+		return
+	}
+}
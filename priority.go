@@ -0,0 +1,59 @@
+package main
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// Failure-first scheduling puts feeds whose results are likely to need
+// attention at the start of a run, so they show up first rather than
+// behind thousands of known-good feeds.
+const (
+	priorityFailing = iota
+	priorityUnknown
+	priorityHealthy
+)
+
+// feedPriority ranks a feed by its state and by lastStatus, its latest
+// result in the history, either of which may be missing: failing or
+// flapping first, then feeds last seen transient or not seen at all, then
+// feeds last seen valid.
+func feedPriority(fs *feedState, lastStatus string) int {
+	switch {
+	case fs != nil && (fs.Flapping || fs.FailedRuns > 0 || fs.LastStatus == "invalid"), lastStatus == "invalid":
+		return priorityFailing
+	case lastStatus == "valid", lastStatus == "" && fs != nil && fs.LastStatus == "valid":
+		return priorityHealthy
+	}
+	return priorityUnknown
+}
+
+// orderFailuresFirst returns feeds ordered by feedPriority, keeping their order
+// within each rank, and how many are failing.
+func orderFailuresFirst(feeds []Feed, state *validatorState, lastStatuses map[string]string) ([]Feed, int) {
+	ranks := make(map[string]int, len(feeds))
+	failing := 0
+	for _, feed := range feeds {
+		var fs *feedState
+		if state != nil {
+			fs = state.Feeds[feed.URL]
+		}
+		ranks[feed.URL] = feedPriority(fs, lastStatuses[feed.URL])
+		if ranks[feed.URL] == priorityFailing {
+			failing++
+		}
+	}
+	ordered := slices.Clone(feeds)
+	slices.SortStableFunc(ordered, func(a, b Feed) int { return ranks[a.URL] - ranks[b.URL] })
+	return ordered, failing
+}
+
+// shuffleFeeds returns feeds in an order shuffled by seed, so feeds listed
+// together in the dataset, often from the same publisher, aren't fetched in
+// one burst. The same seed and feeds always give the same order.
+func shuffleFeeds(feeds []Feed, seed uint64) []Feed {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	shuffled := slices.Clone(feeds)
+	rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	return shuffled
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	runtimepprof "runtime/pprof"
+)
+
+// profilingFlags are the diagnostics of the long-running commands: the
+// pprof endpoints while they run, and CPU and heap profiles written when
+// they finish.
+type profilingFlags struct {
+	pprofAddr  *string
+	cpuProfile *string
+	memProfile *string
+}
+
+func addProfilingFlags(fs *flag.FlagSet) *profilingFlags {
+	return &profilingFlags{
+		pprofAddr:  fs.String("pprof", "", "serve the net/http/pprof endpoints under /debug/pprof/ on this address, e.g. localhost:6060"),
+		cpuProfile: fs.String("cpuprofile", "", "write a CPU profile of the run to this file"),
+		memProfile: fs.String("memprofile", "", "write a heap profile to this file when the run finishes"),
+	}
+}
+
+// start begins profiling as the flags ask. stop writes the profiles, and
+// must be called before exiting for them to be complete.
+func (f *profilingFlags) start() (stop func(), err error) {
+	if *f.pprofAddr != "" {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+		go func() {
+			if err := http.ListenAndServe(*f.pprofAddr, mux); err != nil {
+				fmt.Fprintf(os.Stderr, "Error serving pprof: %v\n", err)
+			}
+		}()
+	}
+
+	var cpu *os.File
+	if *f.cpuProfile != "" {
+		if cpu, err = os.Create(*f.cpuProfile); err != nil {
+			return nil, err
+		}
+		if err := runtimepprof.StartCPUProfile(cpu); err != nil {
+			cpu.Close()
+			return nil, err
+		}
+	}
+
+	return func() {
+		if cpu != nil {
+			runtimepprof.StopCPUProfile()
+			if err := cpu.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *f.cpuProfile, err)
+			}
+		}
+		if *f.memProfile != "" {
+			if err := writeHeapProfile(*f.memProfile); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *f.memProfile, err)
+			}
+		}
+	}, nil
+}
+
+// writeHeapProfile writes the heap profile as of the last garbage
+// collection, forced first so it is up to date.
+func writeHeapProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	runtime.GC()
+	if err := runtimepprof.WriteHeapProfile(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
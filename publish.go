@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// gcsEndpoint is Cloud Storage's S3-compatible XML API, used for gs://
+// destinations with HMAC keys.
+const gcsEndpoint = "https://storage.googleapis.com"
+
+// latestCacheControl lets consumers of latest/ see a new publish within
+// minutes; dated copies never change.
+const (
+	latestCacheControl = "public, max-age=300"
+	datedCacheControl  = "public, max-age=31536000, immutable"
+)
+
+// bucketTarget is an s3:// or gs:// destination.
+type bucketTarget struct {
+	Scheme, Bucket, Prefix string
+}
+
+func parseBucketURL(s string) (bucketTarget, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return bucketTarget{}, err
+	}
+	if (u.Scheme != "s3" && u.Scheme != "gs") || u.Host == "" {
+		return bucketTarget{}, fmt.Errorf("%q is not an s3://BUCKET/PREFIX or gs://BUCKET/PREFIX URL", s)
+	}
+	return bucketTarget{Scheme: u.Scheme, Bucket: u.Host, Prefix: strings.Trim(u.Path, "/")}, nil
+}
+
+func (t bucketTarget) url(key string) string {
+	return t.Scheme + "://" + t.Bucket + "/" + key
+}
+
+// newBucketClient configures an S3 client from the standard AWS_*
+// environment and config files. Cloud Storage is reached through its
+// interoperability API, with HMAC keys given as AWS_ACCESS_KEY_ID and
+// AWS_SECRET_ACCESS_KEY. endpoint overrides the service URL, for
+// S3-compatible stores such as MinIO or R2.
+func newBucketClient(ctx context.Context, target bucketTarget, endpoint string) (*s3.Client, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if target.Scheme == "gs" {
+		cfg.Region = "auto"
+		if endpoint == "" {
+			endpoint = gcsEndpoint
+		}
+		// Cloud Storage rejects the checksum headers newer SDKs send by
+		// default.
+		cfg.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		cfg.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+			o.UsePathStyle = true
+		}
+	}), nil
+}
+
+// contentType guesses a published file's media type from its extension.
+func contentType(name string) string {
+	if filepath.Base(name) == manifestName {
+		return "text/plain; charset=utf-8"
+	}
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".minisig":
+		return "text/plain; charset=utf-8"
+	case ".opml":
+		return "text/x-opml; charset=utf-8"
+	case ".atom":
+		return "application/atom+xml; charset=utf-8"
+	case ".csv":
+		return "text/csv; charset=utf-8"
+	}
+	if t := mime.TypeByExtension(filepath.Ext(name)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// upload puts a local file at key.
+func upload(ctx context.Context, client *s3.Client, target bucketTarget, key, file, cacheControl string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return put(ctx, client, target, key, f, cacheControl)
+}
+
+// put stores body at key, typed by the key's name.
+func put(ctx context.Context, client *s3.Client, target bucketTarget, key string, body io.Reader, cacheControl string) error {
+	_, err := client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:       aws.String(target.Bucket),
+		Key:          aws.String(key),
+		Body:         body,
+		ContentType:  aws.String(contentType(key)),
+		CacheControl: aws.String(cacheControl),
+	})
+	return err
+}
+
+func runPublish(args []string) int {
+	fs := flag.NewFlagSet("publish", flag.ExitOnError)
+	to := fs.String("to", "", "destination: s3://BUCKET/PREFIX or gs://BUCKET/PREFIX")
+	endpoint := fs.String("endpoint", "", "S3-compatible endpoint URL, for stores other than AWS and Cloud Storage")
+	date := fs.String("date", time.Now().UTC().Format("2006-01-02"), "dated directory to publish under")
+	noLatest := fs.Bool("no-latest", false, "don't update the latest/ copies")
+	withManifest := fs.Bool("manifest", false, "also upload a "+manifestName+" manifest of the files, signed when SIGNING_KEY is set (see sign)")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s publish --to s3://BUCKET/PREFIX [--endpoint URL] FILE...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Each FILE is uploaded to PREFIX/DATE/NAME and PREFIX/latest/NAME.\n")
+		fs.PrintDefaults()
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if *to == "" || len(files) == 0 {
+		fs.Usage()
+		return 2
+	}
+	target, err := parseBucketURL(*to)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	// The manifest and its signature are uploaded after the files they
+	// cover in each directory.
+	extras := make(map[string][]byte)
+	if *withManifest {
+		key, err := loadSigningKey()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 2
+		}
+		manifest, err := sha256Manifest(files)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		extras[manifestName] = manifest
+		if key != nil {
+			extras[manifestName+".minisig"] = key.sign(manifest, manifestName, time.Now())
+		}
+	}
+
+	ctx := context.Background()
+	client, err := newBucketClient(ctx, target, *endpoint)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring storage client: %v\n", err)
+		return 1
+	}
+	// Dated copies go first, so latest/ never points at something that
+	// failed to publish.
+	dirs := []string{*date}
+	if !*noLatest {
+		dirs = append(dirs, "latest")
+	}
+	for _, dir := range dirs {
+		cacheControl := datedCacheControl
+		if dir == "latest" {
+			cacheControl = latestCacheControl
+		}
+		for _, file := range files {
+			key := path.Join(target.Prefix, dir, filepath.Base(file))
+			if err := upload(ctx, client, target, key, file, cacheControl); err != nil {
+				fmt.Fprintf(os.Stderr, "Error uploading %s to %s: %v\n", file, target.url(key), err)
+				return 1
+			}
+			fmt.Printf("Uploaded %s\n", target.url(key))
+		}
+		for _, name := range []string{manifestName, manifestName + ".minisig"} {
+			data, ok := extras[name]
+			if !ok {
+				continue
+			}
+			key := path.Join(target.Prefix, dir, name)
+			if err := put(ctx, client, target, key, bytes.NewReader(data), cacheControl); err != nil {
+				fmt.Fprintf(os.Stderr, "Error uploading %s: %v\n", target.url(key), err)
+				return 1
+			}
+			fmt.Printf("Uploaded %s\n", target.url(key))
+		}
+	}
+	return 0
+}
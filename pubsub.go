@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+const (
+	// sinkBuffer is how many events may wait to be published before
+	// further ones are dropped, so a slow broker never holds up validation.
+	sinkBuffer = 4096
+	// sinkBatchSize is the most events sent in one Kafka REST request.
+	sinkBatchSize = 100
+)
+
+// sinkEvent is a message published by --pubsub: a result as each feed
+// finishes, or a status transition once the run's results are recorded.
+type sinkEvent struct {
+	SchemaVersion string            `json:"schema_version"`
+	Type          string            `json:"type"`
+	Input         string            `json:"input"`
+	RunStartedAt  time.Time         `json:"run_started_at"`
+	Result        *ValidationResult `json:"result"`
+	// Transition is "died", "recovered", "flapping" or "stable", for
+	// transition events.
+	Transition string `json:"transition,omitempty"`
+}
+
+// key is the message key, so a feed's events stay in order on one Kafka
+// partition.
+func (e sinkEvent) key() string {
+	if e.Result.ID != "" {
+		return e.Result.ID
+	}
+	return e.Result.URL
+}
+
+// broker is a message system events are published to.
+type broker interface {
+	publish(ctx context.Context, events []sinkEvent) error
+	Close() error
+}
+
+// resultSink publishes events from a background goroutine, in batches of
+// whatever has queued up while the previous batch was sent. Publishing
+// errors are logged rather than failing the run.
+type resultSink struct {
+	broker broker
+	target string
+	events chan sinkEvent
+	done   chan struct{}
+	// mu guards dropped.
+	mu      sync.Mutex
+	dropped int
+}
+
+// openSink connects to a broker given as nats://HOST:4222/SUBJECT (tls://
+// for TLS; NATS_CREDS names a credentials file) or
+// kafka+http(s)://REST-PROXY:8082/TOPIC, for a Kafka REST Proxy or
+// Redpanda's HTTP Proxy. User info in a Kafka URL is sent as basic auth.
+// It returns nil if rawURL is empty.
+func openSink(rawURL string) (*resultSink, error) {
+	if rawURL == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimPrefix(u.Path, "/")
+	if name == "" {
+		return nil, fmt.Errorf("%s names no subject or topic", rawURL)
+	}
+	var b broker
+	switch u.Scheme {
+	case "nats", "tls":
+		b, err = dialNATS(u, name)
+	case "kafka+http", "kafka+https":
+		b = newKafkaREST(u, name)
+	default:
+		return nil, fmt.Errorf("%s is not a nats://, tls://, kafka+http:// or kafka+https:// URL", rawURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := &resultSink{broker: b, target: u.Redacted(), events: make(chan sinkEvent, sinkBuffer), done: make(chan struct{})}
+	go s.run()
+	return s, nil
+}
+
+func (s *resultSink) run() {
+	defer close(s.done)
+	for e := range s.events {
+		batch := []sinkEvent{e}
+	fill:
+		for len(batch) < sinkBatchSize {
+			select {
+			case e, ok := <-s.events:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, e)
+			default:
+				break fill
+			}
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if err := s.broker.publish(ctx, batch); err != nil {
+			fmt.Fprintf(os.Stderr, "Error publishing %d events to %s: %v\n", len(batch), s.target, err)
+		}
+		cancel()
+	}
+}
+
+// send queues an event. A nil *resultSink publishes nothing.
+func (s *resultSink) send(e sinkEvent) {
+	if s == nil {
+		return
+	}
+	e.SchemaVersion = validator.SchemaVersion
+	select {
+	case s.events <- e:
+	default:
+		s.mu.Lock()
+		s.dropped++
+		s.mu.Unlock()
+	}
+}
+
+// result publishes a feed's result as it completes.
+func (s *resultSink) result(input string, startedAt time.Time, r ValidationResult) {
+	s.send(sinkEvent{Type: "result", Input: input, RunStartedAt: startedAt, Result: &r})
+}
+
+// transitions publishes the status changes recordResults found in a run.
+func (s *resultSink) transitions(input string, startedAt time.Time, t runTransitions) {
+	for _, r := range t.Died {
+		s.send(sinkEvent{Type: "transition", Input: input, RunStartedAt: startedAt, Result: &r, Transition: "died"})
+	}
+	for _, r := range t.Recovered {
+		s.send(sinkEvent{Type: "transition", Input: input, RunStartedAt: startedAt, Result: &r, Transition: "recovered"})
+	}
+	for _, c := range t.Flaps {
+		transition := "stable"
+		if c.Flapping {
+			transition = "flapping"
+		}
+		s.send(sinkEvent{Type: "transition", Input: input, RunStartedAt: startedAt, Result: &c.Result, Transition: transition})
+	}
+	for _, r := range t.Quarantined {
+		s.send(sinkEvent{Type: "transition", Input: input, RunStartedAt: startedAt, Result: &r, Transition: "quarantined"})
+	}
+}
+
+// Close publishes the events still queued and disconnects.
+func (s *resultSink) Close() error {
+	if s == nil {
+		return nil
+	}
+	close(s.events)
+	<-s.done
+	if s.dropped > 0 {
+		fmt.Fprintf(os.Stderr, "Dropped %d events the broker at %s couldn't keep up with\n", s.dropped, s.target)
+	}
+	return s.broker.Close()
+}
+
+// natsBroker publishes each event as a message on one subject.
+type natsBroker struct {
+	conn    *nats.Conn
+	subject string
+}
+
+func dialNATS(u *url.URL, subject string) (*natsBroker, error) {
+	opts := []nats.Option{nats.Name("feed-validator")}
+	if creds := os.Getenv("NATS_CREDS"); creds != "" {
+		opts = append(opts, nats.UserCredentials(creds))
+	}
+	server := *u
+	server.Path = ""
+	conn, err := nats.Connect(server.String(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to NATS at %s: %w", u.Host, err)
+	}
+	return &natsBroker{conn: conn, subject: subject}, nil
+}
+
+func (b *natsBroker) publish(ctx context.Context, events []sinkEvent) error {
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if err := b.conn.Publish(b.subject, data); err != nil {
+			return err
+		}
+	}
+	return b.conn.FlushWithContext(ctx)
+}
+
+func (b *natsBroker) Close() error {
+	b.conn.Close()
+	return nil
+}
+
+// kafkaREST produces to a topic through the Kafka REST Proxy's v2 API.
+type kafkaREST struct {
+	endpoint string
+	user     *url.Userinfo
+	client   *http.Client
+}
+
+func newKafkaREST(u *url.URL, topic string) *kafkaREST {
+	base := url.URL{Scheme: strings.TrimPrefix(u.Scheme, "kafka+"), Host: u.Host}
+	return &kafkaREST{endpoint: base.String() + "/topics/" + url.PathEscape(topic), user: u.User, client: &http.Client{}}
+}
+
+func (k *kafkaREST) publish(ctx context.Context, events []sinkEvent) error {
+	type record struct {
+		Key   string    `json:"key"`
+		Value sinkEvent `json:"value"`
+	}
+	var body struct {
+		Records []record `json:"records"`
+	}
+	for _, e := range events {
+		body.Records = append(body.Records, record{Key: e.key(), Value: e})
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", k.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	if k.user != nil {
+		password, _ := k.user.Password()
+		req.SetBasicAuth(k.user.Username(), password)
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	var result struct {
+		Message string `json:"message"`
+		Offsets []struct {
+			ErrorCode *int   `json:"error_code"`
+			Error     string `json:"error"`
+		} `json:"offsets"`
+	}
+	json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&result)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP status %d: %s", resp.StatusCode, result.Message)
+	}
+	failed := 0
+	var first string
+	for _, o := range result.Offsets {
+		if o.ErrorCode != nil {
+			if failed == 0 {
+				first = o.Error
+			}
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d records rejected: %s", failed, first)
+	}
+	return nil
+}
+
+func (k *kafkaREST) Close() error {
+	return nil
+}
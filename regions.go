@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// UN M49 regions. Sub-Saharan Africa and Latin America are broken down into
+// their intermediate regions, which is the granularity editors plan with.
+const (
+	regionAfrica   = "Africa"
+	regionAmericas = "Americas"
+	regionAsia     = "Asia"
+	regionEurope   = "Europe"
+	regionOceania  = "Oceania"
+
+	// regionGlobal covers feeds with a worldwide focus; regionUnassigned
+	// those whose comments don't name a recognizable place.
+	regionGlobal     = "Global"
+	regionUnassigned = "Unassigned"
+)
+
+// regionOrder is the order regions are listed in reports.
+var regionOrder = []string{regionAfrica, regionAmericas, regionAsia, regionEurope, regionOceania, regionGlobal, regionUnassigned}
+
+type unRegion struct {
+	Region    string
+	SubRegion string
+}
+
+// countryRegions maps ISO alpha-2 codes to their UN M49 region.
+var countryRegions = map[string]unRegion{
+	"DZ": {regionAfrica, "Northern Africa"}, "EG": {regionAfrica, "Northern Africa"}, "LY": {regionAfrica, "Northern Africa"},
+	"MA": {regionAfrica, "Northern Africa"}, "SD": {regionAfrica, "Northern Africa"}, "TN": {regionAfrica, "Northern Africa"},
+	"EH": {regionAfrica, "Northern Africa"},
+
+	"BI": {regionAfrica, "Eastern Africa"}, "KM": {regionAfrica, "Eastern Africa"}, "DJ": {regionAfrica, "Eastern Africa"},
+	"ER": {regionAfrica, "Eastern Africa"}, "ET": {regionAfrica, "Eastern Africa"}, "KE": {regionAfrica, "Eastern Africa"},
+	"MG": {regionAfrica, "Eastern Africa"}, "MW": {regionAfrica, "Eastern Africa"}, "MU": {regionAfrica, "Eastern Africa"},
+	"YT": {regionAfrica, "Eastern Africa"}, "MZ": {regionAfrica, "Eastern Africa"}, "RE": {regionAfrica, "Eastern Africa"},
+	"RW": {regionAfrica, "Eastern Africa"}, "SC": {regionAfrica, "Eastern Africa"}, "SO": {regionAfrica, "Eastern Africa"},
+	"SS": {regionAfrica, "Eastern Africa"}, "UG": {regionAfrica, "Eastern Africa"}, "TZ": {regionAfrica, "Eastern Africa"},
+	"ZM": {regionAfrica, "Eastern Africa"}, "ZW": {regionAfrica, "Eastern Africa"}, "IO": {regionAfrica, "Eastern Africa"},
+	"TF": {regionAfrica, "Eastern Africa"},
+
+	"AO": {regionAfrica, "Middle Africa"}, "CM": {regionAfrica, "Middle Africa"}, "CF": {regionAfrica, "Middle Africa"},
+	"TD": {regionAfrica, "Middle Africa"}, "CG": {regionAfrica, "Middle Africa"}, "CD": {regionAfrica, "Middle Africa"},
+	"GQ": {regionAfrica, "Middle Africa"}, "GA": {regionAfrica, "Middle Africa"}, "ST": {regionAfrica, "Middle Africa"},
+
+	"BW": {regionAfrica, "Southern Africa"}, "SZ": {regionAfrica, "Southern Africa"}, "LS": {regionAfrica, "Southern Africa"},
+	"NA": {regionAfrica, "Southern Africa"}, "ZA": {regionAfrica, "Southern Africa"},
+
+	"BJ": {regionAfrica, "Western Africa"}, "BF": {regionAfrica, "Western Africa"}, "CV": {regionAfrica, "Western Africa"},
+	"CI": {regionAfrica, "Western Africa"}, "GM": {regionAfrica, "Western Africa"}, "GH": {regionAfrica, "Western Africa"},
+	"GN": {regionAfrica, "Western Africa"}, "GW": {regionAfrica, "Western Africa"}, "LR": {regionAfrica, "Western Africa"},
+	"ML": {regionAfrica, "Western Africa"}, "MR": {regionAfrica, "Western Africa"}, "NE": {regionAfrica, "Western Africa"},
+	"NG": {regionAfrica, "Western Africa"}, "SH": {regionAfrica, "Western Africa"}, "SN": {regionAfrica, "Western Africa"},
+	"SL": {regionAfrica, "Western Africa"}, "TG": {regionAfrica, "Western Africa"},
+
+	"AI": {regionAmericas, "Caribbean"}, "AG": {regionAmericas, "Caribbean"}, "AW": {regionAmericas, "Caribbean"},
+	"BS": {regionAmericas, "Caribbean"}, "BB": {regionAmericas, "Caribbean"}, "BQ": {regionAmericas, "Caribbean"},
+	"VG": {regionAmericas, "Caribbean"}, "KY": {regionAmericas, "Caribbean"}, "CU": {regionAmericas, "Caribbean"},
+	"CW": {regionAmericas, "Caribbean"}, "DM": {regionAmericas, "Caribbean"}, "DO": {regionAmericas, "Caribbean"},
+	"GD": {regionAmericas, "Caribbean"}, "GP": {regionAmericas, "Caribbean"}, "HT": {regionAmericas, "Caribbean"},
+	"JM": {regionAmericas, "Caribbean"}, "MQ": {regionAmericas, "Caribbean"}, "MS": {regionAmericas, "Caribbean"},
+	"PR": {regionAmericas, "Caribbean"}, "BL": {regionAmericas, "Caribbean"}, "KN": {regionAmericas, "Caribbean"},
+	"LC": {regionAmericas, "Caribbean"}, "MF": {regionAmericas, "Caribbean"}, "VC": {regionAmericas, "Caribbean"},
+	"SX": {regionAmericas, "Caribbean"}, "TT": {regionAmericas, "Caribbean"}, "TC": {regionAmericas, "Caribbean"},
+	"VI": {regionAmericas, "Caribbean"},
+
+	"BZ": {regionAmericas, "Central America"}, "CR": {regionAmericas, "Central America"}, "SV": {regionAmericas, "Central America"},
+	"GT": {regionAmericas, "Central America"}, "HN": {regionAmericas, "Central America"}, "MX": {regionAmericas, "Central America"},
+	"NI": {regionAmericas, "Central America"}, "PA": {regionAmericas, "Central America"},
+
+	"AR": {regionAmericas, "South America"}, "BO": {regionAmericas, "South America"}, "BV": {regionAmericas, "South America"},
+	"BR": {regionAmericas, "South America"}, "CL": {regionAmericas, "South America"}, "CO": {regionAmericas, "South America"},
+	"EC": {regionAmericas, "South America"}, "FK": {regionAmericas, "South America"}, "GF": {regionAmericas, "South America"},
+	"GY": {regionAmericas, "South America"}, "PY": {regionAmericas, "South America"}, "PE": {regionAmericas, "South America"},
+	"GS": {regionAmericas, "South America"}, "SR": {regionAmericas, "South America"}, "UY": {regionAmericas, "South America"},
+	"VE": {regionAmericas, "South America"},
+
+	"BM": {regionAmericas, "Northern America"}, "CA": {regionAmericas, "Northern America"}, "GL": {regionAmericas, "Northern America"},
+	"PM": {regionAmericas, "Northern America"}, "US": {regionAmericas, "Northern America"},
+
+	"KZ": {regionAsia, "Central Asia"}, "KG": {regionAsia, "Central Asia"}, "TJ": {regionAsia, "Central Asia"},
+	"TM": {regionAsia, "Central Asia"}, "UZ": {regionAsia, "Central Asia"},
+
+	"CN": {regionAsia, "Eastern Asia"}, "HK": {regionAsia, "Eastern Asia"}, "MO": {regionAsia, "Eastern Asia"},
+	"KP": {regionAsia, "Eastern Asia"}, "JP": {regionAsia, "Eastern Asia"}, "MN": {regionAsia, "Eastern Asia"},
+	"KR": {regionAsia, "Eastern Asia"}, "TW": {regionAsia, "Eastern Asia"},
+
+	"BN": {regionAsia, "South-eastern Asia"}, "KH": {regionAsia, "South-eastern Asia"}, "ID": {regionAsia, "South-eastern Asia"},
+	"LA": {regionAsia, "South-eastern Asia"}, "MY": {regionAsia, "South-eastern Asia"}, "MM": {regionAsia, "South-eastern Asia"},
+	"PH": {regionAsia, "South-eastern Asia"}, "SG": {regionAsia, "South-eastern Asia"}, "TH": {regionAsia, "South-eastern Asia"},
+	"TL": {regionAsia, "South-eastern Asia"}, "VN": {regionAsia, "South-eastern Asia"},
+
+	"AF": {regionAsia, "Southern Asia"}, "BD": {regionAsia, "Southern Asia"}, "BT": {regionAsia, "Southern Asia"},
+	"IN": {regionAsia, "Southern Asia"}, "IR": {regionAsia, "Southern Asia"}, "MV": {regionAsia, "Southern Asia"},
+	"NP": {regionAsia, "Southern Asia"}, "PK": {regionAsia, "Southern Asia"}, "LK": {regionAsia, "Southern Asia"},
+
+	"AM": {regionAsia, "Western Asia"}, "AZ": {regionAsia, "Western Asia"}, "BH": {regionAsia, "Western Asia"},
+	"CY": {regionAsia, "Western Asia"}, "GE": {regionAsia, "Western Asia"}, "IQ": {regionAsia, "Western Asia"},
+	"IL": {regionAsia, "Western Asia"}, "JO": {regionAsia, "Western Asia"}, "KW": {regionAsia, "Western Asia"},
+	"LB": {regionAsia, "Western Asia"}, "OM": {regionAsia, "Western Asia"}, "QA": {regionAsia, "Western Asia"},
+	"SA": {regionAsia, "Western Asia"}, "PS": {regionAsia, "Western Asia"}, "SY": {regionAsia, "Western Asia"},
+	"TR": {regionAsia, "Western Asia"}, "AE": {regionAsia, "Western Asia"}, "YE": {regionAsia, "Western Asia"},
+
+	"BY": {regionEurope, "Eastern Europe"}, "BG": {regionEurope, "Eastern Europe"}, "CZ": {regionEurope, "Eastern Europe"},
+	"HU": {regionEurope, "Eastern Europe"}, "PL": {regionEurope, "Eastern Europe"}, "MD": {regionEurope, "Eastern Europe"},
+	"RO": {regionEurope, "Eastern Europe"}, "RU": {regionEurope, "Eastern Europe"}, "SK": {regionEurope, "Eastern Europe"},
+	"UA": {regionEurope, "Eastern Europe"},
+
+	"AX": {regionEurope, "Northern Europe"}, "DK": {regionEurope, "Northern Europe"}, "EE": {regionEurope, "Northern Europe"},
+	"FO": {regionEurope, "Northern Europe"}, "FI": {regionEurope, "Northern Europe"}, "GG": {regionEurope, "Northern Europe"},
+	"IS": {regionEurope, "Northern Europe"}, "IE": {regionEurope, "Northern Europe"}, "IM": {regionEurope, "Northern Europe"},
+	"JE": {regionEurope, "Northern Europe"}, "LV": {regionEurope, "Northern Europe"}, "LT": {regionEurope, "Northern Europe"},
+	"NO": {regionEurope, "Northern Europe"}, "SJ": {regionEurope, "Northern Europe"}, "SE": {regionEurope, "Northern Europe"},
+	"GB": {regionEurope, "Northern Europe"},
+
+	"AL": {regionEurope, "Southern Europe"}, "AD": {regionEurope, "Southern Europe"}, "BA": {regionEurope, "Southern Europe"},
+	"HR": {regionEurope, "Southern Europe"}, "GI": {regionEurope, "Southern Europe"}, "GR": {regionEurope, "Southern Europe"},
+	"VA": {regionEurope, "Southern Europe"}, "IT": {regionEurope, "Southern Europe"}, "MT": {regionEurope, "Southern Europe"},
+	"ME": {regionEurope, "Southern Europe"}, "MK": {regionEurope, "Southern Europe"}, "PT": {regionEurope, "Southern Europe"},
+	"SM": {regionEurope, "Southern Europe"}, "RS": {regionEurope, "Southern Europe"}, "SI": {regionEurope, "Southern Europe"},
+	"ES": {regionEurope, "Southern Europe"}, "XK": {regionEurope, "Southern Europe"},
+
+	"AT": {regionEurope, "Western Europe"}, "BE": {regionEurope, "Western Europe"}, "FR": {regionEurope, "Western Europe"},
+	"DE": {regionEurope, "Western Europe"}, "LI": {regionEurope, "Western Europe"}, "LU": {regionEurope, "Western Europe"},
+	"MC": {regionEurope, "Western Europe"}, "NL": {regionEurope, "Western Europe"}, "CH": {regionEurope, "Western Europe"},
+
+	"AU": {regionOceania, "Australia and New Zealand"}, "CX": {regionOceania, "Australia and New Zealand"},
+	"CC": {regionOceania, "Australia and New Zealand"}, "HM": {regionOceania, "Australia and New Zealand"},
+	"NZ": {regionOceania, "Australia and New Zealand"}, "NF": {regionOceania, "Australia and New Zealand"},
+
+	"FJ": {regionOceania, "Melanesia"}, "NC": {regionOceania, "Melanesia"}, "PG": {regionOceania, "Melanesia"},
+	"SB": {regionOceania, "Melanesia"}, "VU": {regionOceania, "Melanesia"},
+
+	"GU": {regionOceania, "Micronesia"}, "KI": {regionOceania, "Micronesia"}, "MH": {regionOceania, "Micronesia"},
+	"FM": {regionOceania, "Micronesia"}, "NR": {regionOceania, "Micronesia"}, "MP": {regionOceania, "Micronesia"},
+	"PW": {regionOceania, "Micronesia"}, "UM": {regionOceania, "Micronesia"},
+
+	"AS": {regionOceania, "Polynesia"}, "CK": {regionOceania, "Polynesia"}, "PF": {regionOceania, "Polynesia"},
+	"NU": {regionOceania, "Polynesia"}, "PN": {regionOceania, "Polynesia"}, "WS": {regionOceania, "Polynesia"},
+	"TK": {regionOceania, "Polynesia"}, "TO": {regionOceania, "Polynesia"}, "TV": {regionOceania, "Polynesia"},
+	"WF": {regionOceania, "Polynesia"},
+}
+
+// regionAliases maps region-level comments ("Middle East", "Caribbean") to a
+// region for feeds that don't name a single country.
+var regionAliases = map[string]unRegion{
+	"africa":          {regionAfrica, ""},
+	"african":         {regionAfrica, ""},
+	"east africa":     {regionAfrica, "Eastern Africa"},
+	"west africa":     {regionAfrica, "Western Africa"},
+	"north africa":    {regionAfrica, "Northern Africa"},
+	"southern africa": {regionAfrica, "Southern Africa"},
+	"americas":        {regionAmericas, ""},
+	"latin america":   {regionAmericas, ""},
+	"south america":   {regionAmericas, "South America"},
+	"central america": {regionAmericas, "Central America"},
+	"caribbean":       {regionAmericas, "Caribbean"},
+	"asia":            {regionAsia, ""},
+	"asia-pacific":    {regionAsia, ""},
+	"asia pacific":    {regionAsia, ""},
+	"central asia":    {regionAsia, "Central Asia"},
+	"south asia":      {regionAsia, "Southern Asia"},
+	"southeast asia":  {regionAsia, "South-eastern Asia"},
+	"middle east":     {regionAsia, "Western Asia"},
+	"europe":          {regionEurope, ""},
+	"european":        {regionEurope, ""},
+	"eastern europe":  {regionEurope, "Eastern Europe"},
+	"oceania":         {regionOceania, ""},
+	"pacific":         {regionOceania, ""},
+	"global":          {regionGlobal, ""},
+	"world":           {regionGlobal, ""},
+	"international":   {regionGlobal, ""},
+}
+
+// regionForFeed returns the UN region for a feed's country or, for
+// region-level comments, the region they name.
+func regionForFeed(feed Feed) unRegion {
+	if c, ok := countryFromComments(feed.Comments); ok {
+		if r, ok := countryRegions[c.Alpha2]; ok {
+			return r
+		}
+	}
+	for _, part := range strings.Split(feed.Comments, ",") {
+		if r, ok := regionAliases[strings.ToLower(strings.TrimSpace(part))]; ok {
+			return r
+		}
+	}
+	return unRegion{Region: regionUnassigned}
+}
+
+// printRegionSummary prints validation outcomes aggregated by region.
+func printRegionSummary(feeds []Feed, results []ValidationResult, lang string) {
+	rows := indexRows(feeds)
+
+	type tally struct{ valid, invalid, transient int }
+	tallies := make(map[string]*tally)
+	for _, r := range results {
+		feed, _ := rows.of(r)
+		region := regionForFeed(feed).Region
+		if region == "" {
+			region = regionUnassigned
+		}
+		t := tallies[region]
+		if t == nil {
+			t = &tally{}
+			tallies[region] = t
+		}
+		switch r.Status {
+		case "valid":
+			t.valid++
+		case "invalid":
+			t.invalid++
+		case "transient":
+			t.transient++
+		}
+	}
+
+	fmt.Printf("\n%s\n", validator.Localize(lang, "Results by Region:"))
+	for _, region := range regionOrder {
+		if t := tallies[region]; t != nil {
+			fmt.Printf("  %s: ✅ %d  ❌ %d  ⚠️ %d\n", region, t.valid, t.invalid, t.transient)
+		}
+	}
+}
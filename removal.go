@@ -0,0 +1,232 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// outageSpan is a stretch of consecutive runs that failed the same way.
+type outageSpan struct {
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Runs    int       `json:"runs"`
+	Status  string    `json:"status"`
+	Message string    `json:"message,omitempty"`
+}
+
+// deadFeed is a feed whose definite results have been invalid since
+// DeadSince.
+type deadFeed struct {
+	Feed      Feed
+	DeadSince time.Time
+	LastValid time.Time // zero if never seen valid
+	History   []outageSpan
+}
+
+// findDeadFeeds returns dataset feeds that have been failing, with no valid
+// result, for at least minAge before now, longest-dead first. Transient
+// errors neither start nor end an outage but appear in its history.
+func findDeadFeeds(feeds []Feed, reports []*runReport, minAge time.Duration, now time.Time) []deadFeed {
+	history := indexHistory(reports)
+
+	var dead []deadFeed
+	for _, feed := range feeds {
+		obs := history.forFeed(feed)
+		d := deadFeed{Feed: feed}
+		for _, o := range obs {
+			switch o.result.Status {
+			case "valid":
+				d.LastValid, d.DeadSince, d.History = o.at, time.Time{}, nil
+				continue
+			case "invalid":
+				if d.DeadSince.IsZero() {
+					d.DeadSince = o.at
+				}
+			}
+			if d.DeadSince.IsZero() {
+				continue
+			}
+			if n := len(d.History); n > 0 && d.History[n-1].Status == string(o.result.Status) && d.History[n-1].Message == o.result.Message {
+				d.History[n-1].To = o.at
+				d.History[n-1].Runs++
+			} else {
+				d.History = append(d.History, outageSpan{From: o.at, To: o.at, Runs: 1, Status: string(o.result.Status), Message: o.result.Message})
+			}
+		}
+		if !d.DeadSince.IsZero() && now.Sub(d.DeadSince) >= minAge {
+			dead = append(dead, d)
+		}
+	}
+	sort.SliceStable(dead, func(i, j int) bool { return dead[i].DeadSince.Before(dead[j].DeadSince) })
+	return dead
+}
+
+// writeRemovalProposal renders the proposal as markdown, suitable as a pull
+// request body.
+func writeRemovalProposal(w io.Writer, dead []deadFeed, minDays int, reports []*runReport, now time.Time) {
+	fmt.Fprintf(w, "# Removal proposal\n\n")
+	fmt.Fprintf(w, "Feeds failing validation for more than %d days as of %s: %d", minDays, now.Format("2006-01-02"), len(dead))
+	if len(reports) > 0 {
+		fmt.Fprintf(w, " (based on %d runs since %s)", len(reports), reports[0].StartedAt.Format("2006-01-02"))
+	}
+	fmt.Fprintf(w, "\n")
+	if len(dead) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n| Feed | Focus | Dead since | Last valid | Failure history |\n| --- | --- | --- | --- | --- |\n")
+	for _, d := range dead {
+		lastValid := "never seen"
+		if !d.LastValid.IsZero() {
+			lastValid = d.LastValid.Format("2006-01-02")
+		}
+		var spans []string
+		for _, s := range d.History {
+			span := s.From.Format("2006-01-02")
+			if s.Runs > 1 {
+				span += fmt.Sprintf(" – %s (%d runs)", s.To.Format("2006-01-02"), s.Runs)
+			}
+			spans = append(spans, fmt.Sprintf("%s: %s %s", span, s.Status, s.Message))
+		}
+		fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n", d.Feed.URL, d.Feed.Comments, d.DeadSince.Format("2006-01-02"), lastValid,
+			strings.ReplaceAll(strings.Join(spans, "<br>"), "|", "\\|"))
+	}
+}
+
+func runProposeRemovals(args []string) int {
+	fs := flag.NewFlagSet("propose-removals", flag.ExitOnError)
+	historyGlob := fs.String("history", "runs/*.json", "history database, or glob of run reports written by validation with --json")
+	minDays := fs.Int("days", 30, "propose feeds that have been dead for at least this many days")
+	outPath := fs.String("o", "", "write the proposal (markdown) to this file (default stdout)")
+	apply := fs.Bool("apply", false, "remove the proposed feeds from the input file")
+	openPR := fs.Bool("open-pr", false, "open a pull request removing the feeds (uses GITHUB_TOKEN and GITHUB_REPOSITORY)")
+	base := fs.String("base", "main", "base branch for --open-pr")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s propose-removals [--history DB|GLOB] [--days N] [-o FILE] [--apply | --open-pr] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	reports, err := loadHistory(*historyGlob)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+		return 1
+	}
+	if len(reports) == 0 {
+		fmt.Fprintf(os.Stderr, "No run reports match %s\n", *historyGlob)
+		return 1
+	}
+
+	now := time.Now()
+	dead := findDeadFeeds(feeds, reports, time.Duration(*minDays)*24*time.Hour, now)
+
+	var w io.Writer = os.Stdout
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outPath, err)
+			return 1
+		}
+		defer file.Close()
+		w = file
+	}
+	writeRemovalProposal(w, dead, *minDays, reports, now)
+
+	if len(dead) == 0 || (!*apply && !*openPR) {
+		return 0
+	}
+	urls := make(map[string]bool, len(dead))
+	for _, d := range dead {
+		urls[d.Feed.URL] = true
+	}
+	var files []string
+	for _, d := range dead {
+		if !slices.Contains(files, d.Feed.File) {
+			files = append(files, d.Feed.File)
+		}
+	}
+
+	if *apply {
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", file, err)
+				return 1
+			}
+			updated, removed, err := removeDatasetRows(data, !*noHeader, urls)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", file, err)
+				return 1
+			}
+			// Write beside the file and rename, so an interrupted write
+			// never leaves it truncated.
+			tmp := file + ".tmp"
+			err = os.WriteFile(tmp, updated, 0o644)
+			if err == nil {
+				err = os.Rename(tmp, file)
+			}
+			if err != nil {
+				os.Remove(tmp)
+				fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", file, err)
+				return 1
+			}
+			fmt.Fprintf(os.Stderr, "Removed %d feeds from %s\n", removed, file)
+		}
+	}
+
+	if *openPR {
+		gh, err := newGitHubClient()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 2
+		}
+		var body strings.Builder
+		writeRemovalProposal(&body, dead, *minDays, reports, now)
+		var paths []string
+		for _, file := range files {
+			paths = append(paths, filepath.ToSlash(file))
+		}
+		edit := func(_ string, data []byte) ([]byte, error) {
+			updated, _, err := removeDatasetRows(data, !*noHeader, urls)
+			return updated, err
+		}
+		// The time keeps a second proposal the same day from reusing the
+		// branch of the first.
+		branch := "removals/" + now.UTC().Format("2006-01-02-150405")
+		prURL, err := gh.openPullRequest(pullRequest{
+			Base:          *base,
+			Branch:        branch,
+			Paths:         paths,
+			CommitMessage: fmt.Sprintf("Remove %d feeds dead for more than %d days", len(dead), *minDays),
+			Title:         fmt.Sprintf("Remove %d dead feeds", len(dead)),
+			Body:          body.String(),
+		}, edit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening pull request: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "Opened %s\n", prURL)
+	}
+	return 0
+}
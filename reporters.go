@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// openReporters returns the reporters of a run: the console, in lang, and
+// one for each --report FORMAT=DEST, printing to stdout. DEST is a file, or
+// "-" for stdout, except for webhook reports where it is the URL posted to.
+// The returned function closes the files written to.
+func openReporters(specs []string, lang string) (validator.Reporters, func() error, error) {
+	reporters := validator.Reporters{validator.NewLocalizedConsoleReporter(os.Stdout, lang)}
+	var files []*os.File
+	closeFiles := func() error {
+		var errs []error
+		for _, f := range files {
+			errs = append(errs, f.Close())
+		}
+		return errors.Join(errs...)
+	}
+	for _, spec := range specs {
+		format, dest, ok := strings.Cut(spec, "=")
+		if !ok || dest == "" {
+			closeFiles()
+			return nil, nil, fmt.Errorf("--report %q is not FORMAT=DEST", spec)
+		}
+		if format == "webhook" {
+			reporters = append(reporters, validator.NewWebhookReporter(dest, validator.NewHTTPClient()))
+			continue
+		}
+		newReporter, ok := map[string]func(io.Writer) validator.Reporter{
+			"json":   validator.NewJSONReporter,
+			"ndjson": validator.NewNDJSONReporter,
+			"csv":    validator.NewCSVReporter,
+			"junit":  validator.NewJUnitReporter,
+		}[format]
+		if !ok {
+			closeFiles()
+			return nil, nil, fmt.Errorf("unknown report format %q (want json, ndjson, csv, junit or webhook)", format)
+		}
+		w := io.Writer(os.Stdout)
+		if dest != "-" {
+			f, err := os.Create(dest)
+			if err != nil {
+				closeFiles()
+				return nil, nil, err
+			}
+			files = append(files, f)
+			w = f
+		}
+		reporters = append(reporters, newReporter(w))
+	}
+	return reporters, closeFiles, nil
+}
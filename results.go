@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// runReport is the machine-readable record of one validation run, written
+// with --json.
+type runReport struct {
+	// SchemaVersion is the validator.SchemaVersion of reports written with
+	// --json; reports loaded from history don't have one.
+	SchemaVersion string `json:"schema_version,omitempty"`
+	// ID is the run's number in a history database; reports written with
+	// --json don't have one.
+	ID         int64              `json:"id,omitempty"`
+	Input      string             `json:"input"`
+	StartedAt  time.Time          `json:"started_at"`
+	FinishedAt time.Time          `json:"finished_at"`
+	Results    []ValidationResult `json:"results"`
+}
+
+func (r *runReport) save(path string) error {
+	r.SchemaVersion = validator.SchemaVersion
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func loadRunReport(path string) (*runReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var r runReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &r, nil
+}
+
+// loadRunReports loads the run reports matching a glob, oldest first.
+func loadRunReports(pattern string) ([]*runReport, error) {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var reports []*runReport
+	for _, path := range paths {
+		// Run manifests are often written alongside reports.
+		if strings.HasSuffix(path, ".manifest.json") {
+			continue
+		}
+		report, err := loadRunReport(path)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].StartedAt.Before(reports[j].StartedAt) })
+	return reports, nil
+}
@@ -0,0 +1,846 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// feedStatus is the daemon's view of one feed, served by its API.
+type feedStatus struct {
+	SchemaVersion string    `json:"schema_version"`
+	ID            string    `json:"id"`
+	URL           string    `json:"url"`
+	Tier          int       `json:"tier"`
+	Country       string    `json:"country,omitempty"`
+	Status        string    `json:"status"`
+	Flapping      bool      `json:"flapping,omitempty"`
+	Message       string    `json:"message,omitempty"`
+	ItemCount     int       `json:"item_count,omitempty"`
+	LastUpdate    time.Time `json:"last_update,omitzero"`
+	LastValidated time.Time `json:"last_validated,omitzero"`
+	NextDue       time.Time `json:"next_due,omitzero"`
+	// Uptime is only available when the daemon records --history.
+	Uptime []uptimeWindow `json:"uptime,omitempty"`
+}
+
+// daemon revalidates a dataset as its feeds fall due and keeps the latest
+// outcome of each in memory for the API.
+type daemon struct {
+	input     string
+	hasHeader bool
+	statePath string
+	archive   *snapshotStore
+	validator *validator.Validator
+	workers   []string
+	resultDir string
+	// skipUnchanged keeps each feed's last valid result for the validator
+	// to reuse while its body stays the same.
+	skipUnchanged bool
+	// failuresFirst validates the feeds the state shows failing or
+	// flapping first in each cycle.
+	failuresFirst bool
+	// partialKB fetches only the start of feeds last larger than that.
+	partialKB int
+	// config and settings are the daemon's flags and validation limits, for
+	// the run manifests written to resultDir.
+	config    map[string]string
+	settings  runSettings
+	history   historyStore
+	keepDays  int
+	lastPrune time.Time
+	bigquery  *bigqueryTable
+	sink      *resultSink
+	metrics   *validatorMetrics
+	notifiers notifiers
+	events    *eventHub
+	// alertsPath, maintenancePath and policyPath are reread on SIGHUP.
+	alertsPath      string
+	maintenancePath string
+	maintenance     maintenanceWindows
+	policyPath      string
+	policy          *policy
+	// ready is set once a cycle has completed, and cleared again while
+	// draining for shutdown.
+	ready atomic.Bool
+	// notifying tracks the notifications sent in the background, waited
+	// for on shutdown so none are cut off.
+	notifying sync.WaitGroup
+
+	mu        sync.RWMutex
+	feeds     []Feed
+	state     *validatorState
+	latest    map[string]ValidationResult
+	uptime    map[string][]uptimeWindow
+	lastCycle time.Time
+	// started is when the daemon started; see checkAt.
+	started time.Time
+}
+
+// cycle reloads the dataset, validates the feeds that are due and persists
+// the outcome. The dataset is reread every cycle so merged changes are picked
+// up without a restart.
+func (d *daemon) cycle() error {
+	feeds, err := loadDataset(d.input, d.hasHeader)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", d.input, err)
+	}
+
+	now := time.Now()
+	var due []Feed
+	d.mu.Lock()
+	d.feeds = feeds
+	for _, feed := range feeds {
+		if !now.Before(d.checkAt(feed)) {
+			due = append(due, feed)
+		}
+	}
+	d.metrics.setStatusesFromState(feeds, d.state)
+	if d.failuresFirst {
+		due, _ = orderFailuresFirst(due, d.state, nil)
+	}
+	d.mu.Unlock()
+	if len(due) == 0 {
+		return nil
+	}
+
+	ctx, span := tracer.Start(context.Background(), "validation cycle", trace.WithAttributes(
+		attribute.Int("feeds.due", len(due)),
+	))
+	d.mu.RLock()
+	rules, windows := d.policy, d.maintenance
+	d.mu.RUnlock()
+	progress := startRun(d.events, d.sink, d.input, now, len(due))
+	// Failures inside a maintenance window are held back and checked again
+	// when the window ends.
+	results, held := validateAll(ctx, due, d.validator, rules, windows, d.workers, outputMode{}, progress, validator.NewConsoleReporter(os.Stdout))
+	progress.finish()
+	span.End()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	recordHeld(d.state, due, held)
+	// A cycle whose failure rates jump far above recent runs' points to
+	// the daemon's host or network; only its valid results are recorded.
+	var anomalies []runAnomaly
+	if d.history != nil {
+		if anomalies, err = runAnomalies(d.history, results); err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading history: %v\n", err)
+		}
+	}
+	recorded := results
+	if len(anomalies) > 0 {
+		recorded = validOnly(results)
+	}
+	changes := recordResults(d.state, due, recorded, now)
+	if d.skipUnchanged {
+		recordLastValid(d.state, due, recorded)
+	}
+	d.sink.transitions(d.input, now, changes)
+	transitions := updatePaywallState(d.state, feeds, recorded)
+	rows := indexRows(due)
+	for _, r := range results {
+		if feed, ok := rows.of(r); ok {
+			d.latest[feed.URL] = r
+		}
+	}
+	d.lastCycle = now
+	d.metrics.observe(results, now)
+	d.metrics.setStatusesFromState(feeds, d.state)
+
+	var invalid int
+	for _, r := range results {
+		if r.Status == "invalid" {
+			invalid++
+		}
+	}
+	fmt.Printf("%s: validated %d of %d feeds, %d invalid\n", now.Format(time.RFC3339), len(results), len(feeds), invalid)
+	for _, h := range held {
+		fmt.Println(h)
+	}
+	for _, a := range anomalies {
+		fmt.Println(a)
+	}
+	for _, r := range changes.Died {
+		fmt.Printf("[Died] %s (%s)\n", r.URL, r.Message)
+	}
+	for _, r := range changes.Recovered {
+		fmt.Printf("[Recovered] %s\n", r.URL)
+	}
+	for _, c := range changes.Flaps {
+		fmt.Println(c)
+	}
+	alerts := d.notifiers.alerts.check(feeds, d.state)
+	for _, a := range anomalies {
+		alerts = append(alerts, a.alert())
+	}
+	for _, a := range alerts {
+		fmt.Println(a)
+	}
+	// Notifications are sent in the background so retries don't hold up
+	// the API.
+	notifiers := d.notifiers
+	d.notifying.Go(func() {
+		if err := notifiers.notify(d.input, now, feeds, results, len(feeds)-len(due), changes, alerts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error sending notifications: %v\n", err)
+		}
+	})
+	if d.notifiers.issues != nil {
+		if err := d.notifiers.issues.sync(d.state, feeds, recorded, now); err != nil {
+			fmt.Fprintf(os.Stderr, "Error syncing GitHub issues: %v\n", err)
+		}
+	}
+	for _, t := range transitions {
+		fmt.Printf("[Paywall] %s (%s → %s)\n", t.URL, accessLabel(t.From), accessLabel(t.To))
+	}
+
+	if err := d.state.save(d.statePath); err != nil {
+		return fmt.Errorf("saving state: %w", err)
+	}
+	if d.archive != nil {
+		if err := pruneArchive(d.archive, d.state); err != nil {
+			fmt.Fprintf(os.Stderr, "Error pruning snapshots: %v\n", err)
+		}
+	}
+	report := &runReport{Input: d.input, StartedAt: now, FinishedAt: time.Now(), Results: results}
+	if d.history != nil {
+		if _, err := d.history.recordRun(report); err != nil {
+			return fmt.Errorf("recording history: %w", err)
+		}
+		recent, err := d.history.runs(now.AddDate(0, 0, -uptimeWindows[len(uptimeWindows)-1]))
+		if err != nil {
+			return fmt.Errorf("reading history: %w", err)
+		}
+		d.uptime = feedUptimes(feeds, recent, now)
+		if d.keepDays > 0 && now.Sub(d.lastPrune) >= 24*time.Hour {
+			pruned, err := d.history.prune(pruneCutoff(now, d.keepDays))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error pruning history: %v\n", err)
+			} else {
+				d.lastPrune = now
+				if pruned > 0 {
+					fmt.Printf("Pruned %d runs from history\n", pruned)
+				}
+			}
+		}
+	}
+	if d.bigquery != nil {
+		// BigQuery being unavailable doesn't fail the cycle; that run's rows
+		// are missing from the table.
+		if err := d.bigquery.insert(ctx, report, feeds); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting to BigQuery: %v\n", err)
+		}
+	}
+	if d.resultDir != "" {
+		stamp := now.UTC().Format("20060102T150405Z")
+		path := filepath.Join(d.resultDir, stamp+".json")
+		if err := report.save(path); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+		manifestPath := filepath.Join(d.resultDir, stamp+".manifest.json")
+		manifest, err := newRunManifest(report, len(feeds), d.config, d.settings)
+		if err == nil {
+			err = manifest.save(manifestPath)
+		}
+		if err != nil {
+			return fmt.Errorf("writing %s: %w", manifestPath, err)
+		}
+	}
+	return nil
+}
+
+// checkAt is when the daemon next validates a feed (see nextCheck). Feeds
+// never validated or already overdue when the daemon started are spread
+// over its first minCheckInterval, rather than all validated in the first
+// cycle. Callers hold d.mu.
+func (d *daemon) checkAt(feed Feed) time.Time {
+	if fs, ok := d.state.Feeds[feed.URL]; ok && !fs.LastValidated.IsZero() {
+		if at := nextCheck(feed, fs); !at.Before(d.started) {
+			return at
+		}
+	}
+	return slotAfter(feed.URL, d.started, minCheckInterval)
+}
+
+// statuses returns the current status of every feed in dataset order. Feeds
+// not validated since the daemon started fall back to the persisted state.
+func (d *daemon) statuses() []feedStatus {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	statuses := make([]feedStatus, 0, len(d.feeds))
+	for _, feed := range d.feeds {
+		s := feedStatus{SchemaVersion: validator.SchemaVersion, ID: feed.ID, URL: feed.URL, Tier: feed.Tier, Status: "unknown", Uptime: d.uptime[feed.URL]}
+		if c, ok := countryFromComments(feed.Comments); ok {
+			s.Country = c.Alpha2
+		}
+		if fs, ok := d.state.Feeds[feed.URL]; ok {
+			s.LastValidated = fs.LastValidated
+			if fs.LastStatus != "" {
+				s.Status = fs.LastStatus
+			}
+			s.Flapping = fs.Flapping
+		}
+		s.NextDue = d.checkAt(feed)
+		if r, ok := d.latest[feed.URL]; ok {
+			s.Message, s.ItemCount, s.LastUpdate = r.Message, r.ItemCount, r.LastUpdate
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+// handleReady reports whether the daemon should receive traffic: not before
+// its first cycle has loaded the dataset, and not while shutting down.
+func (d *daemon) handleReady(w http.ResponseWriter, r *http.Request) {
+	if !d.ready.Load() {
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
+
+// reload rereads the configuration that can change without a restart. The
+// dataset itself is reread every cycle.
+func (d *daemon) reload() {
+	if d.alertsPath != "" {
+		rules, err := loadAlertRules(d.alertsPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reloading alert rules, keeping the previous ones: %v\n", err)
+		} else {
+			d.mu.Lock()
+			d.notifiers.alerts = rules
+			d.mu.Unlock()
+			fmt.Printf("Reloaded %d alert rules from %s\n", len(rules), d.alertsPath)
+		}
+	}
+	if d.maintenancePath != "" {
+		windows, err := loadMaintenanceWindows(d.maintenancePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reloading maintenance windows, keeping the previous ones: %v\n", err)
+		} else {
+			d.mu.Lock()
+			d.maintenance = windows
+			d.mu.Unlock()
+			fmt.Printf("Reloaded %d maintenance windows from %s\n", len(windows), d.maintenancePath)
+		}
+	}
+	if d.policyPath != "" {
+		rules, err := loadPolicy(d.policyPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reloading policy, keeping the previous one: %v\n", err)
+		} else {
+			d.mu.Lock()
+			d.policy = rules
+			d.mu.Unlock()
+			fmt.Printf("Reloaded %d policy rules from %s\n", len(rules.Rules), d.policyPath)
+		}
+	}
+}
+
+func (d *daemon) handleStatus(w http.ResponseWriter, r *http.Request) {
+	summary := struct {
+		SchemaVersion string         `json:"schema_version"`
+		Input         string         `json:"input"`
+		Feeds         int            `json:"feeds"`
+		Statuses      map[string]int `json:"statuses"`
+		LastCycle     time.Time      `json:"last_cycle,omitzero"`
+		NextDue       time.Time      `json:"next_due,omitzero"`
+	}{SchemaVersion: validator.SchemaVersion, Input: d.input, Statuses: make(map[string]int)}
+	for _, s := range d.statuses() {
+		summary.Feeds++
+		summary.Statuses[s.Status]++
+		if summary.NextDue.IsZero() || s.NextDue.Before(summary.NextDue) {
+			summary.NextDue = s.NextDue
+		}
+	}
+	d.mu.RLock()
+	summary.LastCycle = d.lastCycle
+	d.mu.RUnlock()
+	writeJSON(w, summary)
+}
+
+func (d *daemon) handleFeeds(w http.ResponseWriter, r *http.Request) {
+	statuses := d.statuses()
+	if r.URL.Query().Get("format") != "csv" {
+		writeJSON(w, statuses)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	cw := csv.NewWriter(w)
+	cw.Write(append([]string{"id", "url", "tier", "status", "message", "item_count", "last_update", "last_validated", "next_due"}, uptimeColumns()...))
+	for _, s := range statuses {
+		cw.Write(append([]string{s.ID, s.URL, strconv.Itoa(s.Tier), s.Status, s.Message, strconv.Itoa(s.ItemCount),
+			formatTime(s.LastUpdate), formatTime(s.LastValidated), formatTime(s.NextDue)}, uptimeCells(s.Uptime)...))
+	}
+	cw.Flush()
+}
+
+func (d *daemon) handleFeed(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	for _, s := range d.statuses() {
+		if s.ID == id {
+			writeJSON(w, s)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// countryHealth summarizes the feeds covering one country.
+type countryHealth struct {
+	SchemaVersion string         `json:"schema_version"`
+	Country       string         `json:"country"`
+	Name          string         `json:"name"`
+	Statuses      map[string]int `json:"statuses"`
+	Flapping      int            `json:"flapping"`
+	// Healthy is the percentage of feeds with a definite status that are
+	// valid.
+	Healthy *float64     `json:"healthy_percent"`
+	Feeds   []feedStatus `json:"feeds"`
+}
+
+// handleCountryHealth serves /countries/{code}/health, where code is an ISO
+// alpha-2 or alpha-3 code or a country name.
+func (d *daemon) handleCountryHealth(w http.ResponseWriter, r *http.Request) {
+	c, ok := lookupCountry(r.PathValue("code"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	health := countryHealth{SchemaVersion: validator.SchemaVersion, Country: c.Alpha2, Name: c.Name, Statuses: make(map[string]int)}
+	var valid, definite int
+	for _, s := range d.statuses() {
+		if s.Country != c.Alpha2 {
+			continue
+		}
+		health.Feeds = append(health.Feeds, s)
+		health.Statuses[s.Status]++
+		if s.Flapping {
+			health.Flapping++
+		}
+		if s.Status == "valid" || s.Status == "invalid" {
+			definite++
+			if s.Status == "valid" {
+				valid++
+			}
+		}
+	}
+	if len(health.Feeds) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	health.Healthy = percent(valid, definite)
+	writeJSON(w, health)
+}
+
+// handleRuns lists the most recent runs in the history database, ?limit=
+// (20 by default) at a time.
+func (d *daemon) handleRuns(w http.ResponseWriter, r *http.Request) {
+	if d.history == nil {
+		http.Error(w, "history is not recorded; start serve with --history", http.StatusNotFound)
+		return
+	}
+	limit := 20
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+	runs, err := d.history.recentRuns(limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, runs)
+}
+
+func (d *daemon) handleRun(w http.ResponseWriter, r *http.Request) {
+	if d.history == nil {
+		http.Error(w, "history is not recorded; start serve with --history", http.StatusNotFound)
+		return
+	}
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	report, err := d.history.run(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if report == nil {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, report)
+}
+
+// handleFeedHistory serves a feed's results over the last ?days= (30 by
+// default) from the history database.
+func (d *daemon) handleFeedHistory(w http.ResponseWriter, r *http.Request) {
+	if d.history == nil {
+		http.Error(w, "history is not recorded; start serve with --history", http.StatusNotFound)
+		return
+	}
+	days := 30
+	if v := r.URL.Query().Get("days"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			http.Error(w, "invalid days", http.StatusBadRequest)
+			return
+		}
+		days = n
+	}
+	id := r.PathValue("id")
+	d.mu.RLock()
+	i := slices.IndexFunc(d.feeds, func(f Feed) bool { return f.ID == id })
+	var feed Feed
+	if i >= 0 {
+		feed = d.feeds[i]
+	}
+	d.mu.RUnlock()
+	if i < 0 {
+		http.NotFound(w, r)
+		return
+	}
+	points, err := d.history.feedHistory(feed, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, struct {
+		ID      string      `json:"id"`
+		URL     string      `json:"url"`
+		Days    int         `json:"days"`
+		Results []feedPoint `json:"results"`
+	}{feed.ID, feed.URL, days, points})
+}
+
+// handleStatusFeed serves the Atom feed of recent status changes.
+func (d *daemon) handleStatusFeed(w http.ResponseWriter, r *http.Request) {
+	if d.history == nil {
+		http.Error(w, "history is not recorded; start serve with --history", http.StatusNotFound)
+		return
+	}
+	reports, err := d.history.runs(time.Now().AddDate(0, 0, -statusFeedDays))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	d.mu.RLock()
+	changes := datasetChanges(reports, d.feeds)
+	d.mu.RUnlock()
+	writeStatusFeed(w, d.input, scheme+"://"+r.Host+r.URL.Path, changes, time.Now())
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// lastValid returns the feed's last valid result, read under d.mu while
+// cycles record new ones.
+func (d *daemon) lastValid(f Feed) (ValidationResult, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.state.lastValid(f)
+}
+
+// largerThan is validatorState.largerThan, read while cycles may update
+// the state.
+func (d *daemon) largerThan(size int64) func(Feed) bool {
+	return func(f Feed) bool {
+		d.mu.RLock()
+		defer d.mu.RUnlock()
+		return d.state.largerThan(size)(f)
+	}
+}
+
+// runServe keeps validating feeds as their tiers, TTL hints and publishing
+// cadence make them due (see nextCheck), instead of validating everything
+// once and exiting.
+func runServe(args []string) int {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "address to serve the status API on")
+	statePath := fs.String("state", "state.json", "file persisting per-feed state across cycles and restarts")
+	archiveDir := fs.String("archive", "", "keep each feed's last successfully fetched body in this directory")
+	captureDir := fs.String("capture-failures", "", "save the headers and start of the body of each invalid feed's response to this directory")
+	captureKB := fs.Int("capture-kb", defaultCaptureKB, "how many KB of each failing response's body --capture-failures keeps")
+	maintenancePath := fs.String("maintenance", "", "hold failures of feeds inside the maintenance windows in this JSON file until the window ends, instead of reporting them; reread on SIGHUP")
+	policyPath := fs.String("policy", "", "apply the pass, warn, fail and quarantine rules in this YAML file to each result after validation; reread on SIGHUP")
+	resultDir := fs.String("results", "", "write each cycle's results as JSON into this directory")
+	historyPath := fs.String("history", "", "record each cycle's results in this history database: a SQLite file or postgres:// URL")
+	keepDays := fs.Int("history-keep-days", 0, "once a day, roll up history older than this many days into daily totals (0 keeps everything)")
+	bigqueryID := fs.String("bigquery", "", "stream each cycle's results into this BigQuery table, given as PROJECT.DATASET.TABLE")
+	pubsubURL := fs.String("pubsub", "", "publish each result and status transition to this NATS subject (nats://HOST:4222/SUBJECT) or Kafka topic (kafka+http://REST-PROXY:8082/TOPIC)")
+	var workers stringList
+	fs.Var(&workers, "workers", "shard validation across these worker URLs (see the worker command) instead of validating locally; repeatable")
+	grpcAddr := fs.String("grpc-addr", "", "also serve the gRPC API on this address")
+	validateEndpoint := fs.Bool("validate-endpoint", false, "also serve POST /validate and the gRPC ValidateFeed and ValidateBatch, which validate any URL they are given; requires VALIDATE_TOKEN as a bearer token")
+	interval := fs.Duration("interval", 5*time.Minute, "how often to look for feeds that are due")
+	skipUnchanged := fs.Bool("skip-unchanged", false, "reuse the last valid result of feeds whose body hasn't changed since, instead of parsing them again")
+	failuresFirst := fs.Bool("failures-first", false, "in each cycle, validate feeds that were failing or flapping first")
+	partialKB := fs.Int("partial-kb", 0, "fetch only the first this many KB, with a Range request, of feeds last larger than that")
+	drainDelay := fs.Duration("drain-delay", 5*time.Second, "on SIGTERM, how long /readyz fails before the servers stop accepting requests")
+	notify := addNotifierFlags(fs)
+	validation := addValidatorFlags(fs)
+	profiling := addProfilingFlags(fs)
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s serve [--addr :8080] [--state state.json] [--interval 5m] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	state, err := loadState(*statePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading state: %v\n", err)
+		return 1
+	}
+	d := &daemon{
+		input:           inputFile,
+		hasHeader:       !*noHeader,
+		statePath:       *statePath,
+		resultDir:       *resultDir,
+		config:          flagSnapshot(fs),
+		settings:        validation.settings(),
+		alertsPath:      *notify.alertsPath,
+		maintenancePath: *maintenancePath,
+		policyPath:      *policyPath,
+		workers:         workers,
+		skipUnchanged:   *skipUnchanged,
+		failuresFirst:   *failuresFirst,
+		partialKB:       *partialKB,
+		keepDays:        *keepDays,
+		state:           state,
+		latest:          make(map[string]ValidationResult),
+		started:         time.Now(),
+		metrics:         newValidatorMetrics(),
+		events:          newEventHub(),
+	}
+	if *archiveDir != "" && len(workers) > 0 {
+		fmt.Fprintln(os.Stderr, "--archive can't be used with --workers")
+		return 2
+	}
+	if *archiveDir != "" {
+		d.archive = &snapshotStore{dir: *archiveDir}
+	}
+	if *captureDir != "" && len(workers) > 0 {
+		fmt.Fprintln(os.Stderr, "--capture-failures can't be used with --workers")
+		return 2
+	}
+	validatorOptions, err := validation.options()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	if d.skipUnchanged {
+		validatorOptions = append(validatorOptions, validator.WithPrevious(d.lastValid))
+	}
+	if d.partialKB > 0 {
+		limit := int64(d.partialKB) << 10
+		validatorOptions = append(validatorOptions, validator.WithPartialFetch(limit, d.largerThan(limit)))
+	}
+	d.validator = newValidator(d.archive, newCaptureStore(*captureDir, *captureKB), validatorOptions...)
+	// Validating on request, over HTTP or gRPC, is only offered with
+	// --validate-endpoint.
+	var validateHandler http.Handler
+	var validateToken string
+	var validateOpts []validator.Option
+	if *validateEndpoint {
+		validateToken = os.Getenv("VALIDATE_TOKEN")
+		if validateToken == "" {
+			fmt.Fprintln(os.Stderr, "--validate-endpoint needs VALIDATE_TOKEN set")
+			return 2
+		}
+		if validateOpts, err = validation.anonymousOptions(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 2
+		}
+		validateHandler = requireBearer(validateToken, validator.Handler(validateOpts...))
+	}
+	if d.policy, err = loadPolicy(*policyPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	if d.maintenance, err = loadMaintenanceWindows(*maintenancePath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	if d.notifiers, err = notify.notifiers(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	if *bigqueryID != "" {
+		if d.bigquery, err = newBigQueryTable(context.Background(), *bigqueryID); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 2
+		}
+	}
+	if d.sink, err = openSink(*pubsubURL); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	defer d.sink.Close()
+	if *historyPath != "" {
+		if d.history, err = openHistory(*historyPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening history: %v\n", err)
+			return 1
+		}
+		defer d.history.Close()
+	}
+	if d.resultDir != "" {
+		if err := os.MkdirAll(d.resultDir, 0o755); err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", d.resultDir, err)
+			return 1
+		}
+	}
+
+	shutdownTracing, err := setupTracing(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error setting up tracing: %v\n", err)
+		return 1
+	}
+	defer shutdownTracing(context.Background())
+	stopProfiling, err := profiling.start()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error starting profiling: %v\n", err)
+		return 1
+	}
+	defer stopProfiling()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) { fmt.Fprintln(w, "ok") })
+	mux.HandleFunc("GET /readyz", d.handleReady)
+	mux.HandleFunc("GET /status", d.handleStatus)
+	mux.HandleFunc("GET /feeds", d.handleFeeds)
+	mux.HandleFunc("GET /feeds/{id}", d.handleFeed)
+	mux.HandleFunc("GET /feeds/{id}/history", d.handleFeedHistory)
+	mux.HandleFunc("GET /countries/{code}/health", d.handleCountryHealth)
+	mux.HandleFunc("GET /runs", d.handleRuns)
+	mux.HandleFunc("GET /runs/{id}", d.handleRun)
+	mux.HandleFunc("GET /changes.atom", d.handleStatusFeed)
+	mux.HandleFunc("GET /badges/feeds.json", d.handleBadge)
+	mux.HandleFunc("GET /badges/countries/{file}", d.handleBadge)
+	mux.Handle("GET /metrics", d.metrics.handler())
+	mux.Handle("GET /events", d.events)
+	if validateHandler != nil {
+		mux.Handle("POST /validate", validateHandler)
+	}
+	mux.Handle("GET /", dashboardHandler())
+	server := &http.Server{Addr: *addr, Handler: mux}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- server.ListenAndServe() }()
+	fmt.Printf("Serving status for %s on %s\n", inputFile, *addr)
+	if *grpcAddr != "" {
+		listener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		grpcServer := newGRPCServer(d, validateToken, validateOpts)
+		defer grpcServer.GracefulStop()
+		go func() { serveErr <- grpcServer.Serve(listener) }()
+		fmt.Printf("Serving gRPC on %s\n", *grpcAddr)
+	}
+
+	// A cycle in progress is allowed to finish on shutdown so its results
+	// reach the state file, and its notifications to be delivered. SIGHUP
+	// reloads the configuration and starts a cycle straight away.
+	defer d.notifying.Wait()
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		if err := d.cycle(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		} else {
+			d.ready.Store(true)
+		}
+		select {
+		case <-ticker.C:
+		case <-hup:
+			d.reload()
+		case err := <-serveErr:
+			fmt.Fprintf(os.Stderr, "Error serving: %v\n", err)
+			return 1
+		case <-ctx.Done():
+			// Failing readiness first lets load balancers stop routing
+			// here before connections are refused. Event streams never go
+			// idle, so they are ended before draining the rest.
+			d.ready.Store(false)
+			fmt.Printf("Shutting down; draining for %s\n", *drainDelay)
+			time.Sleep(*drainDelay)
+			d.events.close()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				fmt.Fprintf(os.Stderr, "Error shutting down: %v\n", err)
+			}
+			return 0
+		}
+	}
+}
+
+// requireBearer wraps h so that requests without token as their bearer
+// token are refused.
+func requireBearer(token string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !hasBearer(r, token) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
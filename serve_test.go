@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+func TestDaemonCycleSchemelessRows(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "feeds.csv")
+	if err := os.WriteFile(input, []byte("url\nfeeds.example.com/rss\n//feeds.example.org/rss\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rss := fmt.Sprintf(`<?xml version="1.0"?><rss version="2.0"><channel><title>News</title><link>https://example.com/</link><description>News</description>`+
+		`<item><title>Story</title><link>https://example.com/story</link><pubDate>%s</pubDate></item></channel></rss>`, time.Now().Format(time.RFC1123Z))
+	fetcher := validator.FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/rss+xml"}},
+			Body:       io.NopCloser(strings.NewReader(rss)),
+			Request:    req,
+		}, nil
+	})
+	d := &daemon{
+		input:     input,
+		hasHeader: true,
+		statePath: filepath.Join(dir, "state.json"),
+		validator: validator.NewValidator(validator.WithFetcher(fetcher), validator.WithRetries(0)),
+		metrics:   newValidatorMetrics(),
+		state:     &validatorState{Feeds: make(map[string]*feedState)},
+		latest:    make(map[string]ValidationResult),
+		started:   time.Now().Add(-minCheckInterval),
+	}
+	if err := d.cycle(); err != nil {
+		t.Fatal(err)
+	}
+	d.notifying.Wait()
+
+	if _, ok := d.state.Feeds[""]; ok {
+		t.Error("results recorded under an empty URL")
+	}
+	statuses := lastStatuses(d.state, nil, nil)
+	for _, s := range d.statuses() {
+		if s.Status != "valid" || s.LastValidated.IsZero() || s.ItemCount != 1 {
+			t.Errorf("%s: status %q, validated %v, %d items; want valid with its result", s.URL, s.Status, s.LastValidated, s.ItemCount)
+		}
+		if got := statuses(Feed{URL: s.URL}); got != "valid" {
+			t.Errorf("%s: reported %q, want valid", s.URL, got)
+		}
+		if !s.NextDue.After(time.Now()) {
+			t.Errorf("%s: due again at %v", s.URL, s.NextDue)
+		}
+	}
+}
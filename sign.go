@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// manifestName is the checksum manifest written next to signed artifacts,
+// in the format sha256sum -c reads.
+const manifestName = "SHA256SUMS"
+
+// sha256Manifest lists each file's SHA-256 and base name.
+func sha256Manifest(files []string) ([]byte, error) {
+	var b bytes.Buffer
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", file, err)
+		}
+		fmt.Fprintf(&b, "%x  %s\n", h.Sum(nil), filepath.Base(file))
+	}
+	return b.Bytes(), nil
+}
+
+// signingKey is an Ed25519 key that signs in minisign's format, so
+// consumers can verify with minisign itself as well as with verify. It is
+// read from SIGNING_KEY, as generated by sign --keygen: the base64 of the
+// 8-byte key ID followed by the private key.
+type signingKey struct {
+	id  [8]byte
+	key ed25519.PrivateKey
+}
+
+// loadSigningKey returns nil if SIGNING_KEY is unset.
+func loadSigningKey() (*signingKey, error) {
+	s := os.Getenv("SIGNING_KEY")
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(raw) != 8+ed25519.PrivateKeySize {
+		return nil, errors.New("SIGNING_KEY is not a key generated by sign --keygen")
+	}
+	k := &signingKey{key: ed25519.PrivateKey(raw[8:])}
+	copy(k.id[:], raw[:8])
+	return k, nil
+}
+
+func generateSigningKey() (*signingKey, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	k := &signingKey{key: key}
+	if _, err := rand.Read(k.id[:]); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *signingKey) secret() string {
+	return base64.StdEncoding.EncodeToString(append(k.id[:], k.key...))
+}
+
+// keyID is the key ID as minisign prints it.
+func keyID(id [8]byte) string {
+	return fmt.Sprintf("%016X", binary.LittleEndian.Uint64(id[:]))
+}
+
+// publicKey is the key in minisign's public key format.
+func (k *signingKey) publicKey() string {
+	raw := append([]byte("Ed"), k.id[:]...)
+	return base64.StdEncoding.EncodeToString(append(raw, k.key.Public().(ed25519.PublicKey)...))
+}
+
+// sign returns a minisign signature of data: a prehashed (BLAKE2b-512)
+// Ed25519 signature, and a second signature binding the trusted comment to
+// it.
+func (k *signingKey) sign(data []byte, name string, now time.Time) []byte {
+	hash := blake2b.Sum512(data)
+	sig := ed25519.Sign(k.key, hash[:])
+	trusted := fmt.Sprintf("timestamp:%d\tfile:%s\thashed", now.Unix(), name)
+	global := ed25519.Sign(k.key, append(sig[:len(sig):len(sig)], trusted...))
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "untrusted comment: signature from feed-validator key %s\n", keyID(k.id))
+	fmt.Fprintf(&b, "%s\n", base64.StdEncoding.EncodeToString(append(append([]byte("ED"), k.id[:]...), sig...)))
+	fmt.Fprintf(&b, "trusted comment: %s\n", trusted)
+	fmt.Fprintf(&b, "%s\n", base64.StdEncoding.EncodeToString(global))
+	return b.Bytes()
+}
+
+// verifyMinisign checks a minisign signature of data against a public key
+// in minisign's format, returning the trusted comment.
+func verifyMinisign(publicKey string, data, signature []byte) (string, error) {
+	pk, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil || len(pk) != 2+8+ed25519.PublicKeySize || string(pk[:2]) != "Ed" {
+		return "", errors.New("not a minisign public key")
+	}
+	lines := strings.Split(strings.TrimSpace(string(signature)), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[2], "trusted comment: ") {
+		return "", errors.New("not a minisign signature")
+	}
+	sig, err := base64.StdEncoding.DecodeString(lines[1])
+	if err != nil || len(sig) != 2+8+ed25519.SignatureSize {
+		return "", errors.New("not a minisign signature")
+	}
+	if !bytes.Equal(sig[2:10], pk[2:10]) {
+		var id [8]byte
+		copy(id[:], sig[2:10])
+		return "", fmt.Errorf("signed with key %s, not this one", keyID(id))
+	}
+	key := ed25519.PublicKey(pk[10:])
+	message := data
+	switch string(sig[:2]) {
+	case "ED":
+		hash := blake2b.Sum512(data)
+		message = hash[:]
+	case "Ed":
+	default:
+		return "", errors.New("unsupported signature algorithm")
+	}
+	if !ed25519.Verify(key, message, sig[10:]) {
+		return "", errors.New("signature does not match")
+	}
+	trusted := strings.TrimPrefix(lines[2], "trusted comment: ")
+	global, err := base64.StdEncoding.DecodeString(lines[3])
+	if err != nil || !ed25519.Verify(key, append(sig[10:len(sig):len(sig)], trusted...), global) {
+		return "", errors.New("trusted comment signature does not match")
+	}
+	return trusted, nil
+}
+
+func runSign(args []string) int {
+	fs := flag.NewFlagSet("sign", flag.ExitOnError)
+	outPath := fs.String("o", manifestName, "write the SHA-256 manifest to this file; the signature goes to FILE.minisig")
+	keygen := fs.Bool("keygen", false, "generate a signing key and print it with its public key")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s sign [-o SHA256SUMS] FILE...\n       %s sign --keygen\n", os.Args[0], os.Args[0])
+		fmt.Fprintf(os.Stderr, "The manifest is signed when SIGNING_KEY is set.\n")
+		fs.PrintDefaults()
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if *keygen {
+		k, err := generateSigningKey()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error generating key: %v\n", err)
+			return 1
+		}
+		fmt.Printf("SIGNING_KEY=%s\n", k.secret())
+		fmt.Printf("Public key %s (publish this for verify --public-key and minisign -P):\n%s\n", keyID(k.id), k.publicKey())
+		return 0
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	key, err := loadSigningKey()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	manifest, err := sha256Manifest(files)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err := os.WriteFile(*outPath, manifest, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *outPath, err)
+		return 1
+	}
+	fmt.Printf("Wrote checksums of %d files to %s\n", len(files), *outPath)
+	if key != nil {
+		sigPath := *outPath + ".minisig"
+		if err := os.WriteFile(sigPath, key.sign(manifest, filepath.Base(*outPath), time.Now()), 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", sigPath, err)
+			return 1
+		}
+		fmt.Printf("Signed with key %s: %s\n", keyID(key.id), sigPath)
+	}
+	return 0
+}
+
+func runVerify(args []string) int {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	publicKey := fs.String("public-key", "", "check the manifest's .minisig signature against this public key")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s verify [--public-key KEY] [SHA256SUMS]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Files are looked up next to the manifest.\n")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	manifestPath := manifestName
+	if len(positional) > 0 {
+		manifestPath = positional[0]
+	}
+	manifest, err := os.ReadFile(manifestPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if *publicKey != "" {
+		sig, err := os.ReadFile(manifestPath + ".minisig")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		trusted, err := verifyMinisign(*publicKey, manifest, sig)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error verifying %s: %v\n", manifestPath, err)
+			return 1
+		}
+		fmt.Printf("Signature OK (%s)\n", trusted)
+	}
+
+	dir := filepath.Dir(manifestPath)
+	failed := 0
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	for scanner.Scan() {
+		sum, name, ok := strings.Cut(scanner.Text(), "  ")
+		if !ok {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, filepath.Base(name)))
+		if err != nil {
+			fmt.Printf("[Missing] %s\n", name)
+			failed++
+			continue
+		}
+		actual := sha256.Sum256(data)
+		if hex.EncodeToString(actual[:]) != sum {
+			fmt.Printf("[Mismatch] %s\n", name)
+			failed++
+			continue
+		}
+		fmt.Printf("[OK] %s\n", name)
+	}
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "%d files failed verification\n", failed)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// snapshotStore is a content-addressed archive of fetched feed bodies,
+// stored gzipped under DIR/<first two hex digits>/<sha256>.xml.gz.
+type snapshotStore struct {
+	dir string
+}
+
+func (s *snapshotStore) path(hash string) string {
+	return filepath.Join(s.dir, hash[:2], hash+".xml.gz")
+}
+
+// Put stores body unless an identical body is already archived and returns
+// its hash.
+func (s *snapshotStore) Put(body []byte) (string, error) {
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+	path := s.path(hash)
+	if _, err := os.Stat(path); err == nil {
+		return hash, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".snapshot-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	zw := gzip.NewWriter(tmp)
+	if _, err := zw.Write(body); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	return hash, os.Rename(tmp.Name(), path)
+}
+
+// open returns a reader for an archived body.
+func (s *snapshotStore) open(hash string) (io.ReadCloser, error) {
+	file, err := os.Open(s.path(hash))
+	if err != nil {
+		return nil, err
+	}
+	zr, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{zr, file}, nil
+}
+
+// prune removes archived bodies whose hash is not in keep and returns how
+// many were removed.
+func (s *snapshotStore) prune(keep map[string]bool) (int, error) {
+	removed := 0
+	err := filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		hash, ok := strings.CutSuffix(d.Name(), ".xml.gz")
+		if !ok || keep[hash] {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	return removed, err
+}
+
+// runSnapshot writes a feed's last-known-good body to stdout.
+func runSnapshot(args []string) int {
+	fs := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	statePath := fs.String("state", "validator-state.json", "state file written by validation with --state")
+	archiveDir := fs.String("archive", "snapshots", "snapshot directory given to validation with --archive")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s snapshot [--state FILE] [--archive DIR] FEED_URL\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return 2
+	}
+	feedURL := positional[0]
+
+	state, err := loadState(*statePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading state: %v\n", err)
+		return 1
+	}
+	fstate, ok := state.Feeds[feedURL]
+	if !ok || fstate.Snapshot == "" {
+		fmt.Fprintf(os.Stderr, "No snapshot archived for %s\n", feedURL)
+		return 1
+	}
+
+	store := &snapshotStore{dir: *archiveDir}
+	body, err := store.open(fstate.Snapshot)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening snapshot: %v\n", err)
+		return 1
+	}
+	defer body.Close()
+	fmt.Fprintf(os.Stderr, "Snapshot of %s fetched %s\n", feedURL, fstate.SnapshotAt.Format("2006-01-02 15:04 MST"))
+	if _, err := io.Copy(os.Stdout, body); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading snapshot: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// runSplit converts a single-file dataset into the per-country directory
+// layout, preserving row order within each file.
+func runSplit(args []string) int {
+	fs := flag.NewFlagSet("split", flag.ExitOnError)
+	outDir := fs.String("o", "feeds", "directory to write per-country files to")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s split [-o feeds] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadFeeds(inputFile, true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	columns, err := datasetHeaderColumns(inputFile, true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outDir, err)
+		return 1
+	}
+
+	byFile := make(map[string][][]string)
+	var order []string
+	for _, feed := range feeds {
+		file, err := datasetFileFor(*outDir, feed)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		if _, ok := byFile[file]; !ok {
+			if _, err := os.Stat(file); err == nil {
+				fmt.Fprintf(os.Stderr, "%s already exists; split into an empty directory\n", file)
+				return 1
+			}
+			order = append(order, file)
+		}
+		byFile[file] = append(byFile[file], feedRecord(feed, columns))
+	}
+
+	for _, file := range order {
+		out, err := os.Create(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", file, err)
+			return 1
+		}
+		w := csv.NewWriter(out)
+		w.Write(columns)
+		w.WriteAll(byFile[file])
+		if err := w.Error(); err != nil {
+			out.Close()
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", file, err)
+			return 1
+		}
+		if err := out.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", file, err)
+			return 1
+		}
+	}
+	fmt.Printf("Split %d feeds from %s into %d files in %s\n", len(feeds), inputFile, len(order), *outDir)
+	return 0
+}
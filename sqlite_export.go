@@ -0,0 +1,286 @@
+package main
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const sqliteSchema = `
+CREATE TABLE metadata (
+	key   TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+CREATE TABLE feeds (
+	id        INTEGER PRIMARY KEY,
+	feed_id   TEXT NOT NULL UNIQUE,
+	url       TEXT NOT NULL,
+	domain    TEXT NOT NULL,
+	comments  TEXT NOT NULL,
+	language  TEXT NOT NULL,
+	status    TEXT NOT NULL,
+	license   TEXT NOT NULL,
+	paywall   TEXT NOT NULL,
+	tier      INTEGER NOT NULL,
+	country    TEXT,
+	region     TEXT,
+	subregion  TEXT,
+	added_by   TEXT,
+	added_date TEXT,
+	source     TEXT
+);
+CREATE INDEX feeds_url ON feeds (url);
+CREATE INDEX feeds_domain ON feeds (domain);
+CREATE INDEX feeds_country ON feeds (country);
+CREATE INDEX feeds_region ON feeds (region, subregion);
+CREATE INDEX feeds_language ON feeds (language);
+CREATE TABLE publishers (
+	domain      TEXT PRIMARY KEY,
+	wikidata_id TEXT NOT NULL,
+	name        TEXT NOT NULL,
+	owner       TEXT NOT NULL,
+	founded     TEXT NOT NULL,
+	fetched     TEXT NOT NULL
+);
+CREATE TABLE runs (
+	id          INTEGER PRIMARY KEY,
+	started_at  TEXT NOT NULL,
+	finished_at TEXT NOT NULL,
+	source      TEXT NOT NULL
+);
+CREATE INDEX runs_started_at ON runs (started_at);
+CREATE TABLE validation_results (
+	run_id      INTEGER NOT NULL REFERENCES runs (id),
+	feed_id     TEXT,
+	url         TEXT NOT NULL,
+	status      TEXT NOT NULL,
+	message     TEXT NOT NULL,
+	item_count  INTEGER NOT NULL,
+	last_update TEXT,
+	license     TEXT NOT NULL,
+	access      TEXT NOT NULL
+);
+CREATE INDEX validation_results_feed ON validation_results (feed_id, run_id);
+CREATE INDEX validation_results_url ON validation_results (url, run_id);
+CREATE INDEX validation_results_run ON validation_results (run_id, status);
+CREATE TABLE feed_uptime (
+	feed_id      TEXT NOT NULL REFERENCES feeds (feed_id),
+	days         INTEGER NOT NULL,
+	runs         INTEGER NOT NULL,
+	availability REAL,
+	freshness    REAL,
+	PRIMARY KEY (feed_id, days)
+);
+`
+
+// nullString maps empty strings to SQL NULL, so derived columns that could
+// not be determined are queryable with IS NULL.
+func nullString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// datasetSHA256 hashes the content of a dataset's files in order.
+func datasetSHA256(spec string) (string, error) {
+	files, err := datasetFiles(spec)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeSQLite builds the database in a single transaction.
+func writeSQLite(db *sql.DB, feeds []Feed, publishers wikidataCache, reports []*runReport, metadata map[string]string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(sqliteSchema); err != nil {
+		return fmt.Errorf("creating schema: %w", err)
+	}
+
+	for key, value := range metadata {
+		if _, err := tx.Exec(`INSERT INTO metadata (key, value) VALUES (?, ?)`, key, value); err != nil {
+			return err
+		}
+	}
+
+	insertFeed, err := tx.Prepare(`INSERT INTO feeds (feed_id, url, domain, comments, language, status, license, paywall, tier, country, region, subregion, added_by, added_date, source) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insertFeed.Close()
+	for _, feed := range feeds {
+		var country string
+		if c, ok := countryFromComments(feed.Comments); ok {
+			country = c.Alpha2
+		}
+		region := regionForFeed(feed)
+		if _, err := insertFeed.Exec(feed.ID, feed.URL, registrableDomain(feedHost(feed.URL)), feed.Comments, feed.Language, feed.Status,
+			feed.License, feed.Paywall, feed.Tier, nullString(country), nullString(region.Region), nullString(region.SubRegion),
+			nullString(feed.AddedBy), nullString(feed.AddedDate), nullString(feed.Source)); err != nil {
+			return fmt.Errorf("inserting %s: %w", feed.URL, err)
+		}
+	}
+
+	insertPublisher, err := tx.Prepare(`INSERT INTO publishers (domain, wikidata_id, name, owner, founded, fetched) VALUES (?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insertPublisher.Close()
+	for domain, p := range publishers {
+		if p.WikidataID == "" {
+			continue
+		}
+		if _, err := insertPublisher.Exec(domain, p.WikidataID, p.Name, p.Owner, p.Founded, p.Fetched.Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+
+	insertResult, err := tx.Prepare(`INSERT INTO validation_results (run_id, feed_id, url, status, message, item_count, last_update, license, access) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insertResult.Close()
+	for _, report := range reports {
+		res, err := tx.Exec(`INSERT INTO runs (started_at, finished_at, source) VALUES (?, ?, ?)`,
+			report.StartedAt.Format(time.RFC3339), report.FinishedAt.Format(time.RFC3339), report.Input)
+		if err != nil {
+			return err
+		}
+		runID, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		for _, r := range report.Results {
+			var lastUpdate any
+			if !r.LastUpdate.IsZero() {
+				lastUpdate = r.LastUpdate.Format(time.RFC3339)
+			}
+			if _, err := insertResult.Exec(runID, nullString(r.ID), r.URL, r.Status, r.Message, r.ItemCount, lastUpdate, r.License, r.Access); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(reports) > 0 {
+		insertUptime, err := tx.Prepare(`INSERT OR IGNORE INTO feed_uptime (feed_id, days, runs, availability, freshness) VALUES (?, ?, ?, ?, ?)`)
+		if err != nil {
+			return err
+		}
+		defer insertUptime.Close()
+		uptimes := feedUptimes(feeds, reports, time.Now())
+		for _, feed := range feeds {
+			for _, w := range uptimes[feed.URL] {
+				if _, err := insertUptime.Exec(feed.ID, w.Days, w.Runs, w.Availability, w.Freshness); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func runExportSQLite(args []string) int {
+	fs := flag.NewFlagSet("export-sqlite", flag.ExitOnError)
+	outPath := fs.String("o", "feeds.db", "output database file")
+	resultsGlob := fs.String("results", "", "history database, or glob of run reports written by validation with --json, e.g. 'runs/*.json'")
+	cachePath := fs.String("wikidata-cache", "wikidata-cache.json", "publisher cache written by the wikidata command")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s export-sqlite [-o feeds.db] [--results DB|GLOB] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	// feed_id is the table's key, so each would keep only one of its rows.
+	if duplicates := duplicateIDs(feeds); len(duplicates) > 0 {
+		printDuplicateIDs(os.Stderr, duplicates)
+		fmt.Fprintf(os.Stderr, "Error: %d feed IDs are shared by more than one row\n", len(duplicates))
+		return 1
+	}
+
+	publishers, err := loadWikidataCache(*cachePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading Wikidata cache: %v\n", err)
+		return 1
+	}
+
+	var reports []*runReport
+	if *resultsGlob != "" {
+		if reports, err = loadHistory(*resultsGlob); err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+			return 1
+		}
+	}
+
+	checksum, err := datasetSHA256(inputFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	metadata := map[string]string{
+		"source":        inputFile,
+		"source_sha256": checksum,
+		"exported_at":   time.Now().UTC().Format(time.RFC3339),
+		"feed_count":    fmt.Sprint(len(feeds)),
+		"run_count":     fmt.Sprint(len(reports)),
+	}
+
+	// Build into a temporary file so an existing database is only replaced
+	// by a complete one.
+	tmpPath := *outPath + ".tmp"
+	os.Remove(tmpPath)
+	db, err := sql.Open("sqlite3", tmpPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outPath, err)
+		return 1
+	}
+	err = writeSQLite(db, feeds, publishers, reports, metadata)
+	if closeErr := db.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, *outPath)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *outPath, err)
+		return 1
+	}
+
+	fmt.Printf("Wrote %d feeds and %d validation runs to %s\n", len(feeds), len(reports), *outPath)
+	return 0
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// feedState is what the validator remembers about a feed between runs.
+type feedState struct {
+	// Paywall is the stabilized access flag (see paywallStabilityRuns);
+	// PaywallCandidate and PaywallStreak track a pending change.
+	Paywall          string `json:"paywall,omitempty"`
+	PaywallCandidate string `json:"paywall_candidate,omitempty"`
+	PaywallStreak    int    `json:"paywall_streak,omitempty"`
+	// LastValidated, the time of the last definite result, and the feed's
+	// own TTLMinutes hint drive tier-based scheduling; serve also paces
+	// feeds by PublishIntervalMinutes.
+	LastValidated          time.Time `json:"last_validated,omitempty"`
+	TTLMinutes             int       `json:"ttl_minutes,omitempty"`
+	PublishIntervalMinutes int       `json:"publish_interval_minutes,omitempty"`
+	// BodySize is the size of the feed's body when last valid, for
+	// --partial-kb to pick out large feeds.
+	BodySize int64 `json:"body_size,omitempty"`
+	// LastStatus is the last definite (valid or invalid) outcome; transient
+	// errors don't change it.
+	LastStatus string `json:"last_status,omitempty"`
+	// StatusChanges are when LastStatus changed within flapWindow; Flapping
+	// is set from FlappingSince while they are too frequent.
+	StatusChanges []time.Time `json:"status_changes,omitempty"`
+	Flapping      bool        `json:"flapping,omitempty"`
+	FlappingSince time.Time   `json:"flapping_since,omitzero"`
+	// Quarantined is set while the feed's last definite result was
+	// quarantined by a --policy rule.
+	Quarantined bool `json:"quarantined,omitempty"`
+	// FailedRuns counts consecutive invalid runs, and Outage holds their
+	// failure history; Issue is the GitHub issue opened for the outage, if
+	// any (see issueTracker).
+	FailedRuns int          `json:"failed_runs,omitempty"`
+	Outage     []outageSpan `json:"outage,omitempty"`
+	Issue      int          `json:"issue,omitempty"`
+	// Snapshot is the hash of the last successfully parsed body in the
+	// --archive store, fetched at SnapshotAt.
+	Snapshot   string    `json:"snapshot,omitempty"`
+	SnapshotAt time.Time `json:"snapshot_at,omitzero"`
+	// HeldUntil is the end of the maintenance window a failure was held
+	// for; the feed is due again then.
+	HeldUntil time.Time `json:"held_until,omitzero"`
+	// LastValid is the last valid result, kept with --skip-unchanged so a
+	// body fetched again unchanged isn't parsed again.
+	LastValid *ValidationResult `json:"last_valid,omitempty"`
+}
+
+// validatorState is persisted to the file given by --state.
+type validatorState struct {
+	Feeds map[string]*feedState `json:"feeds"`
+}
+
+func loadState(path string) (*validatorState, error) {
+	state := &validatorState{Feeds: make(map[string]*feedState)}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if state.Feeds == nil {
+		state.Feeds = make(map[string]*feedState)
+	}
+	return state, nil
+}
+
+// feed returns the state for a dataset row, creating it on first sight with
+// the flags currently recorded in the dataset.
+func (s *validatorState) feed(f Feed) *feedState {
+	fs, ok := s.Feeds[f.URL]
+	if !ok {
+		fs = &feedState{Paywall: f.Paywall}
+		s.Feeds[f.URL] = fs
+	}
+	return fs
+}
+
+// lastValid returns the feed's last valid result, if one was kept.
+func (s *validatorState) lastValid(f Feed) (ValidationResult, bool) {
+	fs := s.Feeds[f.URL]
+	if fs == nil || fs.LastValid == nil {
+		return ValidationResult{}, false
+	}
+	return *fs.LastValid, true
+}
+
+// largerThan returns whether a feed's body was larger than size when last
+// valid, for validator.WithPartialFetch.
+func (s *validatorState) largerThan(size int64) func(Feed) bool {
+	return func(f Feed) bool {
+		fs := s.Feeds[f.URL]
+		return fs != nil && fs.BodySize > size
+	}
+}
+
+// recordLastValid keeps the valid results with a body hash, for
+// --skip-unchanged to reuse while the body stays the same.
+func recordLastValid(state *validatorState, feeds []Feed, results []ValidationResult) {
+	rows := indexRows(feeds)
+	for _, r := range results {
+		feed, ok := rows.of(r)
+		if !ok {
+			continue
+		}
+		fs := state.Feeds[feed.URL]
+		if fs == nil || r.Status != "valid" || r.BodyHash == "" {
+			continue
+		}
+		r.Unchanged = false
+		fs.LastValid = &r
+	}
+}
+
+func (s *validatorState) save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
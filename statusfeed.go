@@ -0,0 +1,223 @@
+package main
+
+import (
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// statusFeedEntries caps how many changes the status feed carries.
+const statusFeedEntries = 100
+
+// statusFeedDays is how far back serve looks for changes to publish.
+const statusFeedDays = 90
+
+// datasetChange is a feed going down, recovering or, once added to the
+// dataset, validated for the first time.
+type datasetChange struct {
+	Kind   string // added, died or recovered
+	RunID  int64
+	At     time.Time
+	Result ValidationResult
+}
+
+// datasetChanges walks runs oldest first and returns the changes they
+// record, newest first. A feed of the dataset is added in the first run
+// since its added_date, unless that predates the runs; feeds without an
+// added_date, and feeds a run merely didn't cover before, such as lower
+// tiers in tiered runs, are never additions. Transient errors are not
+// changes.
+func datasetChanges(reports []*runReport, feeds []Feed) []datasetChange {
+	added := make(map[string]time.Time)
+	for _, feed := range feeds {
+		date, err := time.Parse("2006-01-02", feed.AddedDate)
+		if err != nil || len(reports) == 0 || date.Before(reports[0].StartedAt) {
+			continue
+		}
+		if feed.ID != "" {
+			added[feed.ID] = date
+		}
+		added[feed.URL] = date
+	}
+	last := make(map[string]string)
+	var changes []datasetChange
+	for _, report := range reports {
+		for _, r := range report.Results {
+			key := r.ID
+			if key == "" {
+				key = r.URL
+			}
+			prev, seen := last[key]
+			change := datasetChange{RunID: report.ID, At: report.StartedAt, Result: r}
+			date, isNew := added[key]
+			switch {
+			case !seen && isNew && !report.StartedAt.Before(date):
+				change.Kind = "added"
+			case prev == "valid" && r.Status == "invalid":
+				change.Kind = "died"
+			case prev == "invalid" && r.Status == "valid":
+				change.Kind = "recovered"
+			}
+			if change.Kind != "" {
+				changes = append(changes, change)
+			}
+			if r.Status != "transient" {
+				last[key] = string(r.Status)
+			} else if !seen {
+				last[key] = ""
+			}
+		}
+	}
+	slices.Reverse(changes)
+	return changes
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    []atomLink  `xml:"link,omitempty"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title    string       `xml:"title"`
+	ID       string       `xml:"id"`
+	Updated  string       `xml:"updated"`
+	Link     atomLink     `xml:"link"`
+	Category atomCategory `xml:"category"`
+	Summary  string       `xml:"summary"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+var changeTitles = map[string]string{
+	"added":     "Added",
+	"died":      "Down",
+	"recovered": "Recovered",
+}
+
+// writeStatusFeed renders changes as an Atom feed. selfURL, if known, is
+// where the feed itself is published.
+func writeStatusFeed(w io.Writer, dataset, selfURL string, changes []datasetChange, now time.Time) error {
+	feed := atomFeed{
+		Title:   "Feed status changes: " + dataset,
+		ID:      "urn:feed-validator:status:" + dataset,
+		Updated: now.UTC().Format(time.RFC3339),
+		Author:  atomAuthor{Name: "feed-validator"},
+	}
+	if selfURL != "" {
+		feed.Link = append(feed.Link, atomLink{Href: selfURL, Rel: "self"})
+	}
+	if len(changes) > 0 {
+		feed.Updated = changes[0].At.UTC().Format(time.RFC3339)
+	}
+	for _, c := range changes[:min(len(changes), statusFeedEntries)] {
+		r := c.Result
+		var summary string
+		switch c.Kind {
+		case "died":
+			summary = fmt.Sprintf("%s stopped validating in run %d: %s", r.URL, c.RunID, r.Message)
+		case "recovered":
+			summary = fmt.Sprintf("%s validates again as of run %d.", r.URL, c.RunID)
+		default:
+			summary = fmt.Sprintf("%s was first validated in run %d: %s", r.URL, c.RunID, r.Status)
+			if r.Message != "" {
+				summary += " (" + r.Message + ")"
+			}
+		}
+		key := r.ID
+		if key == "" {
+			key = validator.DeriveID(r.URL)
+		}
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:    changeTitles[c.Kind] + ": " + r.URL,
+			ID:       fmt.Sprintf("urn:feed-validator:%s:%s:%d", c.Kind, key, c.At.Unix()),
+			Updated:  c.At.UTC().Format(time.RFC3339),
+			Link:     atomLink{Href: r.URL},
+			Category: atomCategory{Term: c.Kind},
+			Summary:  summary,
+		})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(feed); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func runStatusFeed(args []string) int {
+	fs := flag.NewFlagSet("status-feed", flag.ExitOnError)
+	historyPath := fs.String("history", "history.db", "history database, or glob of run reports written by validation with --json")
+	outPath := fs.String("o", "", "write the Atom feed to this file (default stdout)")
+	selfURL := fs.String("url", "", "URL the feed will be published at, for its self link")
+	feedsPath := fs.String("feeds", "", "dataset whose added_date column tells additions (default the input of the latest run)")
+	noHeader := fs.Bool("no-header", false, "dataset has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s status-feed [--history DB|GLOB] [--url URL] [-o FILE]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	if _, err := parseArgs(fs, args); err != nil {
+		return 2
+	}
+
+	reports, err := loadHistory(*historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+		return 1
+	}
+	if len(reports) == 0 {
+		fmt.Fprintf(os.Stderr, "No run reports match %s\n", *historyPath)
+		return 1
+	}
+	dataset := reports[len(reports)-1].Input
+	if *feedsPath == "" {
+		*feedsPath = dataset
+	}
+	feeds, err := loadFeeds(*feedsPath, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading %s: %v\n", *feedsPath, err)
+		return 1
+	}
+
+	var w io.Writer = os.Stdout
+	if *outPath != "" {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", *outPath, err)
+			return 1
+		}
+		defer file.Close()
+		w = file
+	}
+	if err := writeStatusFeed(w, dataset, *selfURL, datasetChanges(reports, feeds), time.Now()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing feed: %v\n", err)
+		return 1
+	}
+	return 0
+}
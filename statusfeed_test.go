@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+func TestDatasetChanges(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 6, 0, 0, 0, time.UTC) }
+	result := func(id string, status validator.Status) ValidationResult {
+		return ValidationResult{ID: id, URL: "https://" + id + ".example/rss", Status: status}
+	}
+	feeds := []Feed{
+		{ID: "new", AddedDate: "2026-01-02"},
+		{ID: "old", AddedDate: "2025-12-01"},
+		{ID: "undated"},
+		{ID: "late", AddedDate: "2026-01-02"},
+	}
+	reports := []*runReport{
+		{ID: 1, StartedAt: day(1), Results: []ValidationResult{result("old", validator.StatusValid), result("undated", validator.StatusTransient)}},
+		{ID: 2, StartedAt: day(2), Results: []ValidationResult{result("new", validator.StatusValid), result("old", validator.StatusInvalid), result("undated", validator.StatusValid)}},
+		// A feed only added later is added when first seen; a transient
+		// error is no change.
+		{ID: 3, StartedAt: day(3), Results: []ValidationResult{result("old", validator.StatusValid), result("new", validator.StatusTransient), result("late", validator.StatusInvalid)}},
+	}
+
+	var got []string
+	for _, c := range datasetChanges(reports, feeds) {
+		got = append(got, fmt.Sprintf("%d %s %s", c.RunID, c.Kind, c.Result.ID))
+	}
+	want := []string{"3 added late", "3 recovered old", "2 died old", "2 added new"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if changes := datasetChanges(nil, feeds); len(changes) != 0 {
+		t.Errorf("got %d changes without runs", len(changes))
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// streamFlags are the flags of a run that --stream supports: those that
+// apply to feeds one at a time. The rest need the whole dataset, or every
+// result, at once.
+var streamFlags = []string{"stream", "no-header", "lang", "report", "dataset-version", "capture-failures", "capture-kb", "pprof", "cpuprofile", "memprofile"}
+
+// checkStreamFlags returns why a flag set on fs can't be used with --stream,
+// or nil.
+func checkStreamFlags(fs *flag.FlagSet, reportSpecs []string) error {
+	allowed := slices.Clone(streamFlags)
+	validation := flag.NewFlagSet("", flag.ContinueOnError)
+	addValidatorFlags(validation)
+	validation.VisitAll(func(f *flag.Flag) { allowed = append(allowed, f.Name) })
+
+	var unsupported []string
+	fs.Visit(func(f *flag.Flag) {
+		if !slices.Contains(allowed, f.Name) {
+			unsupported = append(unsupported, "--"+f.Name)
+		}
+	})
+	if len(unsupported) > 0 {
+		return fmt.Errorf("--stream can't be used with %s", strings.Join(unsupported, ", "))
+	}
+	for _, spec := range reportSpecs {
+		if !strings.HasPrefix(spec, "ndjson=") {
+			return fmt.Errorf("--stream only reports as ndjson, as other reports are written once all results are in")
+		}
+	}
+	return nil
+}
+
+// streamFeeds yields the feeds of the dataset at spec as its rows are read,
+// warning of malformed rows. Unlike loadDataset, rows repeated across the
+// files of a directory layout are all validated.
+func streamFeeds(spec string, hasHeader bool, errp *error) iter.Seq[Feed] {
+	return func(yield func(Feed) bool) {
+		files := []string{spec}
+		if spec != "-" {
+			var err error
+			if files, err = datasetFiles(spec); err != nil {
+				*errp = err
+				return
+			}
+		}
+		for _, file := range files {
+			var r io.Reader = os.Stdin
+			if file != "-" {
+				f, err := os.Open(file)
+				if err != nil {
+					*errp = err
+					return
+				}
+				defer f.Close()
+				r = f
+			}
+			for feed, err := range validator.ScanCSV(r, hasHeader) {
+				var rowErr *validator.RowError
+				if errors.As(err, &rowErr) {
+					fmt.Fprintf(os.Stderr, "Warning: Skipping %v\n", err)
+					continue
+				}
+				if err != nil {
+					*errp = fmt.Errorf("%s: %w", file, err)
+					return
+				}
+				feed.File = file
+				if !yield(feed) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// runStream validates the dataset at inputFile as its rows are read, for
+// lists too large to load, such as candidates from autodiscovery. Only the
+// counts of the results are kept, and the exit code follows them as for a
+// run.
+func runStream(inputFile string, hasHeader bool, v *validator.Validator, reporters validator.Reporters) int {
+	var readErr error
+	summary := validator.Summarize(nil, time.Now(), time.Time{})
+	for result := range v.ValidateStream(context.Background(), streamFeeds(inputFile, hasHeader, &readErr)) {
+		reporters.Result(result)
+		summary.Add(result)
+	}
+	summary.FinishedAt = time.Now()
+	if err := reporters.Finish(summary); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reporting the run: %v\n", err)
+	}
+	if readErr != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, readErr)
+		return 1
+	}
+	return runExitCode(summary)
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// deathConfirmDays is how long a feed's final run of failures must last
+// before survival analysis counts it as dead rather than down.
+const deathConfirmDays = 7
+
+// survivalMilestones are the ages, in days, survival is reported at.
+var survivalMilestones = []int{30, 90, 180, 365, 730}
+
+// feedLifespan is how long one feed lived, from being added until it died
+// or, if it hasn't, until it was last seen (then it is censored).
+type feedLifespan struct {
+	URL     string
+	Country string
+	Added   time.Time
+	// End is when the feed died: the first failure of the run of failures
+	// it never recovered from. For feeds still alive or removed while
+	// alive, it is when they were last seen.
+	End  time.Time
+	Died bool
+	// Removed is set for feeds in the history but no longer in the
+	// dataset, whose country isn't known.
+	Removed bool
+}
+
+func (l feedLifespan) days() float64 {
+	return l.End.Sub(l.Added).Hours() / 24
+}
+
+// lifespanOf derives a feed's lifespan from its results, oldest first. A
+// feed is added on its added_date, or else when first validated.
+func lifespanOf(obs []observation, added time.Time, now time.Time) feedLifespan {
+	if added.IsZero() || obs[0].at.Before(added) {
+		added = obs[0].at
+	}
+	l := feedLifespan{Added: added, End: now}
+	// The final run of failures starts after the last valid result.
+	var streakStart time.Time
+	for _, o := range obs {
+		switch {
+		case o.result.Status == "valid":
+			streakStart = time.Time{}
+		case o.result.Status == "invalid" && streakStart.IsZero():
+			streakStart = o.at
+		}
+	}
+	if !streakStart.IsZero() && now.Sub(streakStart) >= deathConfirmDays*24*time.Hour {
+		l.End, l.Died = streakStart, true
+	}
+	return l
+}
+
+// feedLifespans returns the lifespan of every feed in the history: the
+// dataset's feeds, and feeds since removed from it.
+func feedLifespans(feeds []Feed, history historyIndex, now time.Time) []feedLifespan {
+	var spans []feedLifespan
+	seen := make(map[string]bool)
+	for _, feed := range feeds {
+		seen[feed.URL], seen[feed.ID] = true, true
+		obs := history.forFeed(feed)
+		if len(obs) == 0 {
+			continue
+		}
+		added, _ := time.Parse("2006-01-02", feed.AddedDate)
+		l := lifespanOf(obs, added, now)
+		l.URL, l.Country = feed.URL, feedCountry(feed)
+		spans = append(spans, l)
+	}
+	for key, obs := range history {
+		if seen[key] || seen[obs[0].result.URL] {
+			continue
+		}
+		l := lifespanOf(obs, time.Time{}, now)
+		if !l.Died {
+			l.End = obs[len(obs)-1].at
+		}
+		l.URL, l.Removed = obs[len(obs)-1].result.URL, true
+		spans = append(spans, l)
+	}
+	return spans
+}
+
+// survivalCurve is a Kaplan-Meier estimate of the share of feeds still
+// alive at each age (in days) at which one died. Feeds still alive, or
+// removed while alive, count as at risk until their last day.
+type survivalCurve struct {
+	ages     []float64
+	survival []float64
+}
+
+func newSurvivalCurve(spans []feedLifespan) survivalCurve {
+	sorted := slices.Clone(spans)
+	// At equal ages, deaths come first: a feed censored that day was still
+	// at risk.
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if di, dj := sorted[i].days(), sorted[j].days(); di != dj {
+			return di < dj
+		}
+		return sorted[i].Died && !sorted[j].Died
+	})
+	var c survivalCurve
+	s := 1.0
+	for i, l := range sorted {
+		if !l.Died {
+			continue
+		}
+		s *= 1 - 1/float64(len(sorted)-i)
+		c.ages = append(c.ages, l.days())
+		c.survival = append(c.survival, s)
+	}
+	return c
+}
+
+// at returns the estimated share of feeds alive at age days.
+func (c survivalCurve) at(days float64) float64 {
+	s := 1.0
+	for i, age := range c.ages {
+		if age > days {
+			break
+		}
+		s = c.survival[i]
+	}
+	return s
+}
+
+// median returns the age by which half the feeds have died, or false if
+// more than half are estimated to outlive the data.
+func (c survivalCurve) median() (float64, bool) {
+	for i, s := range c.survival {
+		if s <= 0.5 {
+			return c.ages[i], true
+		}
+	}
+	return 0, false
+}
+
+// countryChurn is how many of a country's feeds died within a period.
+type countryChurn struct {
+	Country string
+	// Feeds were alive at some point in the period, and Died of them died
+	// in it.
+	Feeds, Died int
+	// MedianAge is the median age at death of those that died, in days.
+	MedianAge float64
+}
+
+func (c countryChurn) rate() float64 {
+	return 100 * float64(c.Died) / float64(c.Feeds)
+}
+
+// churnByCountry counts deaths since since by country, highest churn first.
+// Feeds removed from the dataset are grouped under "(removed)".
+func churnByCountry(spans []feedLifespan, since time.Time) []countryChurn {
+	byCountry := make(map[string]*countryChurn)
+	ages := make(map[string][]float64)
+	for _, l := range spans {
+		if l.End.Before(since) {
+			continue
+		}
+		country := l.Country
+		switch {
+		case l.Removed:
+			country = "(removed)"
+		case country == "":
+			country = "(no country)"
+		}
+		c := byCountry[country]
+		if c == nil {
+			c = &countryChurn{Country: country}
+			byCountry[country] = c
+		}
+		c.Feeds++
+		if l.Died {
+			c.Died++
+			ages[country] = append(ages[country], l.days())
+		}
+	}
+	churn := make([]countryChurn, 0, len(byCountry))
+	for country, c := range byCountry {
+		if a := ages[country]; len(a) > 0 {
+			sort.Float64s(a)
+			c.MedianAge = a[len(a)/2]
+		}
+		churn = append(churn, *c)
+	}
+	sort.Slice(churn, func(i, j int) bool {
+		if ri, rj := churn[i].rate(), churn[j].rate(); ri != rj {
+			return ri > rj
+		}
+		return churn[i].Country < churn[j].Country
+	})
+	return churn
+}
+
+func runSurvival(args []string) int {
+	fs := flag.NewFlagSet("survival", flag.ExitOnError)
+	historyPath := fs.String("history", "history.db", "history database, or glob of run reports written by validation with --json")
+	days := fs.Int("days", 365, "report churn by country over this many days")
+	format := fs.String("format", "text", "output format: text, or csv for the churn by country")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s survival [--history DB|GLOB] [--days 365] [--format text|csv] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+	if *format != "text" && *format != "csv" {
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	reports, err := loadHistory(*historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+		return 1
+	}
+	if len(reports) == 0 {
+		fmt.Fprintln(os.Stderr, "No runs in the history")
+		return 1
+	}
+
+	now := time.Now()
+	spans := feedLifespans(feeds, indexHistory(reports), now)
+	churn := churnByCountry(spans, now.AddDate(0, 0, -*days))
+
+	if *format == "csv" {
+		w := csv.NewWriter(os.Stdout)
+		w.Write([]string{"country", "feeds", "died", "churn_percent", "median_age_at_death_days"})
+		for _, c := range churn {
+			w.Write([]string{c.Country, strconv.Itoa(c.Feeds), strconv.Itoa(c.Died),
+				strconv.FormatFloat(c.rate(), 'f', 1, 64), strconv.FormatFloat(math.Round(c.MedianAge), 'f', 0, 64)})
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing churn: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	var died, removed int
+	for _, l := range spans {
+		if l.Died {
+			died++
+		} else if l.Removed {
+			removed++
+		}
+	}
+	curve := newSurvivalCurve(spans)
+	fmt.Printf("Feed survival from %d runs, %s to %s\n\n", len(reports),
+		reports[0].StartedAt.Format("2006-01-02"), reports[len(reports)-1].StartedAt.Format("2006-01-02"))
+	fmt.Printf("Feeds observed: %d (%d died, %d removed from the dataset while alive)\n", len(spans), died, removed)
+	if median, ok := curve.median(); ok {
+		fmt.Printf("Median time to death: %.0f days\n", median)
+	} else {
+		fmt.Println("Median time to death: not reached (over half the feeds outlive the history)")
+	}
+	// Milestones beyond the oldest feed's age can't be estimated.
+	var oldest float64
+	for _, l := range spans {
+		oldest = max(oldest, l.days())
+	}
+	var alive []string
+	for _, d := range survivalMilestones {
+		if float64(d) <= oldest {
+			alive = append(alive, fmt.Sprintf("%d days %.1f%%", d, 100*curve.at(float64(d))))
+		}
+	}
+	if len(alive) == 0 {
+		alive = []string{"history too short to tell"}
+	}
+	fmt.Printf("Still alive after: %s", strings.Join(alive, ", "))
+	fmt.Printf("\n\nChurn by country over the last %d days:\n\n", *days)
+	fmt.Printf("%-28s %6s %5s %6s %s\n", "Country", "Feeds", "Died", "Churn", "Median age at death")
+	for _, c := range churn {
+		age := ""
+		if c.Died > 0 {
+			age = fmt.Sprintf("%.0f days", c.MedianAge)
+		}
+		fmt.Printf("%-28s %6d %5d %5.1f%% %s\n", c.Country, c.Feeds, c.Died, c.rate(), age)
+	}
+	return 0
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"hash/fnv"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// maxTTLHint caps how far a feed's own caching hint can push back its next
+// validation.
+const maxTTLHint = 7 * 24 * time.Hour
+
+// dueTolerance lets a run validate feeds falling due within a tenth of their
+// interval after it starts, so a scheduled run starting a little earlier
+// than the last doesn't put them off until the one after.
+const dueTolerance = 10
+
+// nextDue returns when a feed should next be validated: its tier's interval
+// after it was last validated, or later if the feed asked to be cached for
+// longer.
+func nextDue(feed Feed, fs *feedState) time.Time {
+	if !fs.HeldUntil.IsZero() {
+		return fs.HeldUntil
+	}
+	interval := validator.TierPolicies[feed.Tier].Interval
+	if ttl := min(time.Duration(fs.TTLMinutes)*time.Minute, maxTTLHint); ttl > interval {
+		interval = ttl
+	}
+	return fs.LastValidated.Add(interval)
+}
+
+// isDue reports whether a feed should be validated on a run starting at
+// now. Maintenance holds are kept to exactly.
+func isDue(feed Feed, fs *feedState, now time.Time) bool {
+	due := nextDue(feed, fs)
+	if fs.HeldUntil.IsZero() {
+		due = due.Add(-due.Sub(fs.LastValidated) / dueTolerance)
+	}
+	return !now.Before(due)
+}
+
+// serve checks a feed at least daily and at most hourly, as often as it
+// publishes, unless its tier or TTL hint call for less.
+const (
+	minCheckInterval = time.Hour
+	maxCheckInterval = 24 * time.Hour
+)
+
+// nextCheck is when serve next validates a feed: when its maintenance window
+// ends if a failure was held for it, otherwise nextDue, but no sooner
+// than the feed's publish interval (clamped to minCheckInterval and
+// maxCheckInterval) after its last validation. Feeds whose interval isn't
+// known yet are checked hourly. The time is then moved to the nearest of the
+// feed's slots for that interval (see slotOffset), so feeds validated
+// together spread out instead of falling due together again.
+func nextCheck(feed Feed, fs *feedState) time.Time {
+	if !fs.HeldUntil.IsZero() {
+		return fs.HeldUntil
+	}
+	cadence := max(min(time.Duration(fs.PublishIntervalMinutes)*time.Minute, maxCheckInterval), minCheckInterval)
+	due := nextDue(feed, fs)
+	if paced := fs.LastValidated.Add(cadence); paced.After(due) {
+		due = paced
+	}
+	if fs.LastValidated.IsZero() {
+		return due
+	}
+	return nearestSlot(feed.URL, due, due.Sub(fs.LastValidated))
+}
+
+// slotOffset returns how far t is past the last of a feed's slots for
+// period. A feed's slots are period apart, at a phase hashed from its URL,
+// so feeds sharing a period are checked evenly across it.
+func slotOffset(url string, t time.Time, period time.Duration) time.Duration {
+	h := fnv.New64a()
+	h.Write([]byte(url))
+	phase := int64(h.Sum64() % uint64(period))
+	offset := (t.UnixNano() - phase) % int64(period)
+	if offset < 0 {
+		offset += int64(period)
+	}
+	return time.Duration(offset)
+}
+
+// nearestSlot returns the feed's slot closest to t, which is less than half
+// a period away.
+func nearestSlot(url string, t time.Time, period time.Duration) time.Time {
+	offset := slotOffset(url, t, period)
+	if offset < period/2 {
+		return t.Add(-offset)
+	}
+	return t.Add(period - offset)
+}
+
+// slotAfter returns the feed's first slot at or after t.
+func slotAfter(url string, t time.Time, period time.Duration) time.Time {
+	if offset := slotOffset(url, t, period); offset > 0 {
+		return t.Add(period - offset)
+	}
+	return t
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsDue(t *testing.T) {
+	last := time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		tier int
+		fs   feedState
+		now  time.Time
+		want bool
+	}{
+		{"daily, a day later", 2, feedState{LastValidated: last}, last.Add(24 * time.Hour), true},
+		{"daily, run a minute early", 2, feedState{LastValidated: last}, last.Add(24*time.Hour - time.Minute), true},
+		{"daily, same day", 2, feedState{LastValidated: last}, last.Add(12 * time.Hour), false},
+		{"weekly, run a minute early", 3, feedState{LastValidated: last}, last.Add(7*24*time.Hour - time.Minute), true},
+		{"weekly, a day early", 3, feedState{LastValidated: last}, last.Add(6 * 24 * time.Hour), false},
+		{"TTL hint, run a minute early", 2, feedState{LastValidated: last, TTLMinutes: 48 * 60}, last.Add(48*time.Hour - time.Minute), true},
+		{"TTL hint, a day later", 2, feedState{LastValidated: last, TTLMinutes: 48 * 60}, last.Add(24 * time.Hour), false},
+		{"held, a minute early", 2, feedState{LastValidated: last, HeldUntil: last.Add(2 * time.Hour)}, last.Add(2*time.Hour - time.Minute), false},
+		{"held, window over", 2, feedState{LastValidated: last, HeldUntil: last.Add(2 * time.Hour)}, last.Add(2 * time.Hour), true},
+		{"every run", 1, feedState{LastValidated: last}, last, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDue(Feed{Tier: tt.tier}, &tt.fs, tt.now); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
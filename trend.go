@@ -0,0 +1,492 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// freshnessDriftPoints is how many percentage points a feed's freshness must
+// fall week over week to be reported as drifting.
+const freshnessDriftPoints = 25
+
+// volumeCollapseFactor is how many times fewer items a feed must carry than
+// the week before for its volume to count as collapsed. A feed that still
+// validates but suddenly lists a handful of items has often been moved to a
+// new CMS that left the old feed behind.
+const volumeCollapseFactor = 10
+
+// period is a span of history, [Start, End).
+type period struct {
+	Start, End time.Time
+}
+
+// weekBefore is the seven days up to end.
+func weekBefore(end time.Time) period {
+	return period{Start: end.AddDate(0, 0, -7), End: end}
+}
+
+func (p period) contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// periodStats is the state of the dataset at the end of a period.
+type periodStats struct {
+	Runs      int
+	Valid     int
+	Invalid   int
+	Unknown   int // no definite result yet
+	Freshness *float64
+}
+
+// trendFeed is a feed that changed status between the periods, with the
+// result that settled it.
+type trendFeed struct {
+	Feed   Feed
+	Result ValidationResult
+}
+
+// freshnessDrift is a feed that publishes less often than it did.
+type freshnessDrift struct {
+	Feed          Feed
+	Before, After float64
+}
+
+// volumeCollapse is a feed whose typical item count fell by
+// volumeCollapseFactor or more.
+type volumeCollapse struct {
+	Feed          Feed
+	Before, After int
+}
+
+func (v volumeCollapse) String() string {
+	return fmt.Sprintf("[Volume] %s (median %d items the previous week, %d in the last week)", v.Feed.URL, v.Before, v.After)
+}
+
+// countryTrend counts a country's healthy feeds at the end of each period.
+type countryTrend struct {
+	Country       string
+	Feeds         int
+	Before, After int
+}
+
+// trendReport compares the dataset over two periods: the weekly trend
+// report's last two weeks, or the weeks before any two dates for compare.
+type trendReport struct {
+	Dataset                   string
+	BeforePeriod, AfterPeriod period
+	Before, After             periodStats
+	Lost                      []trendFeed
+	Recovered                 []trendFeed
+	Drift                     []freshnessDrift
+	Collapsed                 []volumeCollapse
+	Degraded                  []countryTrend
+	// Slowest are the later period's slowest feeds by p95 latency.
+	Slowest []feedLatency
+}
+
+// weekly reports whether t compares a week with the one before it, as
+// trend does, rather than the windows up to two dates apart.
+func (t *trendReport) weekly() bool {
+	return t.AfterPeriod.Start.Equal(t.BeforePeriod.End) && t.AfterPeriod.End.Sub(t.AfterPeriod.Start) == 7*24*time.Hour
+}
+
+// Heading is the report's title.
+func (t *trendReport) Heading() string {
+	if t.weekly() {
+		return "Weekly feed trends"
+	}
+	return "Feed comparison"
+}
+
+// Intro says what the report compares.
+func (t *trendReport) Intro() string {
+	lastDay := func(p period) string { return p.End.AddDate(0, 0, -1).Format("2006-01-02") }
+	if t.weekly() {
+		return fmt.Sprintf("Week of %s to %s compared with the week before.", t.AfterPeriod.Start.Format("2006-01-02"), lastDay(t.AfterPeriod))
+	}
+	days := int(t.AfterPeriod.End.Sub(t.AfterPeriod.Start).Hours() / 24)
+	return fmt.Sprintf("Feeds as of the end of %s compared with %s. Freshness, item counts and latency cover the %d days up to each date.",
+		lastDay(t.BeforePeriod), lastDay(t.AfterPeriod), days)
+}
+
+// BeforeLabel and AfterLabel name the two periods in tables.
+func (t *trendReport) BeforeLabel() string {
+	if t.weekly() {
+		return "last week"
+	}
+	return "on " + t.BeforePeriod.End.AddDate(0, 0, -1).Format("2006-01-02")
+}
+
+func (t *trendReport) AfterLabel() string {
+	if t.weekly() {
+		return "this week"
+	}
+	return "on " + t.AfterPeriod.End.AddDate(0, 0, -1).Format("2006-01-02")
+}
+
+// settledAt returns a feed's last definite result before t, ignoring
+// transient errors, and whether there was one.
+func settledAt(obs []observation, t time.Time) (ValidationResult, bool) {
+	var last ValidationResult
+	var ok bool
+	for _, o := range obs {
+		if !o.at.Before(t) {
+			break
+		}
+		if o.result.Status != "transient" {
+			last, ok = o.result, true
+		}
+	}
+	return last, ok
+}
+
+// freshCounts counts a feed's valid, dated results in p and how many of
+// them had published within freshWithin.
+func freshCounts(obs []observation, p period) (fresh, dated int) {
+	for _, o := range obs {
+		if !p.contains(o.at) || o.result.Status != "valid" || o.result.LastUpdate.IsZero() {
+			continue
+		}
+		dated++
+		if o.at.Sub(o.result.LastUpdate) <= freshWithin {
+			fresh++
+		}
+	}
+	return fresh, dated
+}
+
+// medianItems is the median item count of a feed's parsed, valid results in
+// p, and whether there were any.
+func medianItems(obs []observation, p period) (int, bool) {
+	var counts []int
+	for _, o := range obs {
+		if !p.contains(o.at) || o.result.Status != "valid" || o.result.ProbeOnly {
+			continue
+		}
+		counts = append(counts, o.result.ItemCount)
+	}
+	if len(counts) == 0 {
+		return 0, false
+	}
+	sort.Ints(counts)
+	return counts[len(counts)/2], true
+}
+
+// volumeCollapses compares each feed's median item count over the after
+// period with the before period. Medians keep a single short fetch from
+// counting.
+func volumeCollapses(feeds []Feed, history historyIndex, before, after period) []volumeCollapse {
+	var collapses []volumeCollapse
+	for _, feed := range feeds {
+		obs := history.forFeed(feed)
+		b, ok := medianItems(obs, before)
+		if !ok || b < volumeCollapseFactor {
+			continue
+		}
+		if a, ok := medianItems(obs, after); ok && a*volumeCollapseFactor <= b {
+			collapses = append(collapses, volumeCollapse{Feed: feed, Before: b, After: a})
+		}
+	}
+	sort.SliceStable(collapses, func(i, j int) bool {
+		return collapses[i].Before-collapses[i].After > collapses[j].Before-collapses[j].After
+	})
+	return collapses
+}
+
+// buildTrendReport compares the dataset's feeds over the two weeks before
+// end.
+func buildTrendReport(feeds []Feed, reports []*runReport, end time.Time) *trendReport {
+	after := weekBefore(end)
+	return compareWeeks(feeds, reports, weekBefore(after.Start), after)
+}
+
+// compareWeeks compares the dataset's feeds over two periods. Feeds are lost
+// or recovered when their settled status differs between the ends of the
+// periods, so a feed that broke and recovered in between is not reported.
+// Freshness, item counts and latency are compared within the periods.
+func compareWeeks(feeds []Feed, reports []*runReport, before, after period) *trendReport {
+	t := &trendReport{BeforePeriod: before, AfterPeriod: after}
+	for _, report := range reports {
+		if before.contains(report.StartedAt) {
+			t.Before.Runs++
+		}
+		if after.contains(report.StartedAt) {
+			t.After.Runs++
+		}
+	}
+
+	history := indexHistory(reports)
+	countries := make(map[string]*countryTrend)
+	var beforeFresh, beforeDated, afterFresh, afterDated int
+	count := func(s *periodStats, r ValidationResult, ok bool) bool {
+		switch {
+		case !ok:
+			s.Unknown++
+		case r.Status == "valid":
+			s.Valid++
+			return true
+		default:
+			s.Invalid++
+		}
+		return false
+	}
+	for _, feed := range feeds {
+		obs := history.forFeed(feed)
+		settledBefore, hadBefore := settledAt(obs, before.End)
+		settledAfter, hadAfter := settledAt(obs, after.End)
+		healthyBefore := count(&t.Before, settledBefore, hadBefore)
+		healthyAfter := count(&t.After, settledAfter, hadAfter)
+		switch {
+		case healthyBefore && hadAfter && !healthyAfter:
+			t.Lost = append(t.Lost, trendFeed{feed, settledAfter})
+		case hadBefore && !healthyBefore && healthyAfter:
+			t.Recovered = append(t.Recovered, trendFeed{feed, settledAfter})
+		}
+
+		fb, db := freshCounts(obs, before)
+		fa, da := freshCounts(obs, after)
+		beforeFresh, beforeDated, afterFresh, afterDated = beforeFresh+fb, beforeDated+db, afterFresh+fa, afterDated+da
+		if pb, pa := percent(fb, db), percent(fa, da); pb != nil && pa != nil && *pb-*pa >= freshnessDriftPoints {
+			t.Drift = append(t.Drift, freshnessDrift{Feed: feed, Before: *pb, After: *pa})
+		}
+
+		if name := feedCountry(feed); name != "" {
+			c := countries[name]
+			if c == nil {
+				c = &countryTrend{Country: name}
+				countries[name] = c
+			}
+			c.Feeds++
+			if healthyBefore {
+				c.Before++
+			}
+			if healthyAfter {
+				c.After++
+			}
+		}
+	}
+	t.Collapsed = volumeCollapses(feeds, history, before, after)
+	t.Slowest = feedLatencies(feeds, history, after.Start, after.End)
+	if len(t.Slowest) > slowestFeedsShown {
+		t.Slowest = t.Slowest[:slowestFeedsShown]
+	}
+	t.Before.Freshness = percent(beforeFresh, beforeDated)
+	t.After.Freshness = percent(afterFresh, afterDated)
+
+	for _, c := range countries {
+		if c.After < c.Before {
+			t.Degraded = append(t.Degraded, *c)
+		}
+	}
+	sort.Slice(t.Degraded, func(i, j int) bool {
+		a, b := t.Degraded[i], t.Degraded[j]
+		if a.Before-a.After != b.Before-b.After {
+			return a.Before-a.After > b.Before-b.After
+		}
+		return a.Country < b.Country
+	})
+	sort.SliceStable(t.Drift, func(i, j int) bool {
+		return t.Drift[i].Before-t.Drift[i].After > t.Drift[j].Before-t.Drift[j].After
+	})
+	return t
+}
+
+// weekChange renders a difference between periods, e.g. "+3".
+func weekChange(before, after int) string {
+	return fmt.Sprintf("%+d", after-before)
+}
+
+// capitalize upper-cases a label's first letter for table headers.
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func (t *trendReport) markdown() string {
+	var b strings.Builder
+	before, after := t.BeforeLabel(), t.AfterLabel()
+	fmt.Fprintf(&b, "# %s: %s\n\n", t.Heading(), t.Dataset)
+	fmt.Fprintf(&b, "%s\n\n", t.Intro())
+	fmt.Fprintf(&b, "| | %s | %s | Change |\n| --- | --- | --- | --- |\n", capitalize(before), capitalize(after))
+	fmt.Fprintf(&b, "| Runs | %d | %d | %s |\n", t.Before.Runs, t.After.Runs, weekChange(t.Before.Runs, t.After.Runs))
+	fmt.Fprintf(&b, "| Valid | %d | %d | %s |\n", t.Before.Valid, t.After.Valid, weekChange(t.Before.Valid, t.After.Valid))
+	fmt.Fprintf(&b, "| Invalid | %d | %d | %s |\n", t.Before.Invalid, t.After.Invalid, weekChange(t.Before.Invalid, t.After.Invalid))
+	if t.Before.Unknown > 0 || t.After.Unknown > 0 {
+		fmt.Fprintf(&b, "| Not yet validated | %d | %d | %s |\n", t.Before.Unknown, t.After.Unknown, weekChange(t.Before.Unknown, t.After.Unknown))
+	}
+	fmt.Fprintf(&b, "| Fresh (%%) | %s | %s | |\n", formatPercent(t.Before.Freshness), formatPercent(t.After.Freshness))
+
+	if len(t.Lost) > 0 {
+		fmt.Fprintf(&b, "\n## Lost (%d)\n\n| Feed | Country | Error |\n| --- | --- | --- |\n", len(t.Lost))
+		for _, f := range t.Lost {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", f.Feed.URL, feedCountry(f.Feed), strings.ReplaceAll(f.Result.Message, "|", "\\|"))
+		}
+	}
+	if len(t.Recovered) > 0 {
+		fmt.Fprintf(&b, "\n## Recovered (%d)\n\n", len(t.Recovered))
+		for _, f := range t.Recovered {
+			fmt.Fprintf(&b, "- %s\n", f.Feed.URL)
+		}
+	}
+	if len(t.Degraded) > 0 {
+		fmt.Fprintf(&b, "\n## Countries with fewer healthy feeds (%d)\n\n| Country | Feeds | Healthy %s | Healthy %s |\n| --- | --- | --- | --- |\n", len(t.Degraded), before, after)
+		for _, c := range t.Degraded {
+			fmt.Fprintf(&b, "| %s | %d | %d | %d |\n", c.Country, c.Feeds, c.Before, c.After)
+		}
+	}
+	if len(t.Drift) > 0 {
+		fmt.Fprintf(&b, "\n## Publishing less often (%d)\n\nFeeds whose share of fresh results fell by %d points or more.\n\n| Feed | Fresh %s (%%) | Fresh %s (%%) |\n| --- | --- | --- |\n", len(t.Drift), freshnessDriftPoints, before, after)
+		for _, d := range t.Drift {
+			fmt.Fprintf(&b, "| %s | %.1f | %.1f |\n", d.Feed.URL, d.Before, d.After)
+		}
+	}
+	if len(t.Collapsed) > 0 {
+		fmt.Fprintf(&b, "\n## Item counts collapsed (%d)\n\nFeeds listing %d times fewer items than before, often a sign the site moved to a new CMS.\n\n| Feed | Items %s | Items %s |\n| --- | --- | --- |\n", len(t.Collapsed), volumeCollapseFactor, before, after)
+		for _, c := range t.Collapsed {
+			fmt.Fprintf(&b, "| %s | %d | %d |\n", c.Feed.URL, c.Before, c.After)
+		}
+	}
+	if len(t.Lost) == 0 && len(t.Recovered) == 0 && len(t.Degraded) == 0 && len(t.Drift) == 0 && len(t.Collapsed) == 0 {
+		fmt.Fprintf(&b, "\nNo feeds were lost or recovered, and no country's coverage degraded.\n")
+	}
+	if len(t.Slowest) > 0 {
+		fmt.Fprintf(&b, "\n## Slowest feeds\n\nValidation time %s, retries included.\n\n| Feed | p50 | p95 | Max |\n| --- | --- | --- | --- |\n", after)
+		for _, l := range t.Slowest {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", l.Feed.URL, formatMillis(l.P50), formatMillis(l.P95), formatMillis(l.Max))
+		}
+	}
+	return b.String()
+}
+
+var trendHTML = template.Must(template.New("trend").Funcs(template.FuncMap{
+	"change":     weekChange,
+	"percent":    formatPercent,
+	"country":    feedCountry,
+	"capitalize": capitalize,
+	"factor":     func() int { return volumeCollapseFactor },
+	"millis":     formatMillis,
+}).Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>{{.Heading}}: {{.Dataset}}</title></head>
+<body style="font-family: sans-serif">
+<h1>{{.Heading}}: {{.Dataset}}</h1>
+<p>{{.Intro}}</p>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th></th><th>{{capitalize .BeforeLabel}}</th><th>{{capitalize .AfterLabel}}</th><th>Change</th></tr>
+<tr><td>Runs</td><td>{{.Before.Runs}}</td><td>{{.After.Runs}}</td><td>{{change .Before.Runs .After.Runs}}</td></tr>
+<tr><td>Valid</td><td>{{.Before.Valid}}</td><td>{{.After.Valid}}</td><td>{{change .Before.Valid .After.Valid}}</td></tr>
+<tr><td>Invalid</td><td>{{.Before.Invalid}}</td><td>{{.After.Invalid}}</td><td>{{change .Before.Invalid .After.Invalid}}</td></tr>
+{{if or .Before.Unknown .After.Unknown}}<tr><td>Not yet validated</td><td>{{.Before.Unknown}}</td><td>{{.After.Unknown}}</td><td>{{change .Before.Unknown .After.Unknown}}</td></tr>{{end}}
+<tr><td>Fresh (%)</td><td>{{percent .Before.Freshness}}</td><td>{{percent .After.Freshness}}</td><td></td></tr>
+</table>
+{{with .Lost}}<h2>Lost ({{len .}})</h2>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Feed</th><th>Country</th><th>Error</th></tr>
+{{range .}}<tr><td><a href="{{.Feed.URL}}">{{.Feed.URL}}</a></td><td>{{country .Feed}}</td><td>{{.Result.Message}}</td></tr>
+{{end}}</table>{{end}}
+{{with .Recovered}}<h2>Recovered ({{len .}})</h2>
+<ul>{{range .}}<li>{{.Feed.URL}}</li>{{end}}</ul>{{end}}
+{{with .Degraded}}<h2>Countries with fewer healthy feeds ({{len .}})</h2>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Country</th><th>Feeds</th><th>Healthy {{$.BeforeLabel}}</th><th>Healthy {{$.AfterLabel}}</th></tr>
+{{range .}}<tr><td>{{.Country}}</td><td>{{.Feeds}}</td><td>{{.Before}}</td><td>{{.After}}</td></tr>
+{{end}}</table>{{end}}
+{{with .Drift}}<h2>Publishing less often ({{len .}})</h2>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Feed</th><th>Fresh {{$.BeforeLabel}} (%)</th><th>Fresh {{$.AfterLabel}} (%)</th></tr>
+{{range .}}<tr><td>{{.Feed.URL}}</td><td>{{printf "%.1f" .Before}}</td><td>{{printf "%.1f" .After}}</td></tr>
+{{end}}</table>{{end}}
+{{with .Collapsed}}<h2>Item counts collapsed ({{len .}})</h2>
+<p>Feeds listing {{factor}} times fewer items than before, often a sign the site moved to a new CMS.</p>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Feed</th><th>Items {{$.BeforeLabel}}</th><th>Items {{$.AfterLabel}}</th></tr>
+{{range .}}<tr><td>{{.Feed.URL}}</td><td>{{.Before}}</td><td>{{.After}}</td></tr>
+{{end}}</table>{{end}}
+{{with .Slowest}}<h2>Slowest feeds</h2>
+<p>Validation time {{$.AfterLabel}}, retries included.</p>
+<table border="1" cellpadding="4" style="border-collapse: collapse">
+<tr><th>Feed</th><th>p50</th><th>p95</th><th>Max</th></tr>
+{{range .}}<tr><td>{{.Feed.URL}}</td><td>{{millis .P50}}</td><td>{{millis .P95}}</td><td>{{millis .Max}}</td></tr>
+{{end}}</table>{{end}}
+</body></html>
+`))
+
+// writeTrendReport writes a report as Markdown or HTML to outPath, or to
+// stdout if it is empty.
+func writeTrendReport(report *trendReport, format, outPath string) error {
+	var w io.Writer = os.Stdout
+	if outPath != "" {
+		file, err := os.Create(outPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	if format == "html" {
+		return trendHTML.Execute(w, report)
+	}
+	_, err := io.WriteString(w, report.markdown())
+	return err
+}
+
+func runTrend(args []string) int {
+	fs := flag.NewFlagSet("trend", flag.ExitOnError)
+	historyPath := fs.String("history", "history.db", "history database, or glob of run reports written by validation with --json")
+	weekEnding := fs.String("week-ending", "", "last day (YYYY-MM-DD, UTC) of the week to report on (default: the seven days up to now)")
+	format := fs.String("format", "markdown", "output format: markdown or html")
+	outPath := fs.String("o", "", "write the report to this file (default stdout)")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s trend [--history DB|GLOB] [--week-ending DATE] [--format markdown|html] [-o FILE] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+	if *format != "markdown" && *format != "html" {
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	end := time.Now()
+	if *weekEnding != "" {
+		day, err := time.Parse("2006-01-02", *weekEnding)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: --week-ending: %v\n", err)
+			return 2
+		}
+		end = day.AddDate(0, 0, 1)
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	reports, err := loadHistory(*historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+		return 1
+	}
+
+	report := buildTrendReport(feeds, reports, end)
+	report.Dataset = inputFile
+	if err := writeTrendReport(report, *format, *outPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing report: %v\n", err)
+		return 1
+	}
+	return 0
+}
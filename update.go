@@ -0,0 +1,390 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultReleaseRepo is the GitHub repository whose releases update fetches.
+const defaultReleaseRepo = "reddot-watch/curated-world-news"
+
+// Releases, built by .github/workflows/release.yml, carry the dataset as
+// feeds.csv, the tool as one binary per platform named by binaryAsset, and
+// a SHA256SUMS manifest of them written by sign, with its .minisig
+// signature.
+
+// releasePublicKey is the public key release builds embed, with
+// -ldflags "-X main.releasePublicKey=...", to check the signature of later
+// releases by. Other builds have none.
+var releasePublicKey string
+
+// release is a GitHub release and its downloadable assets.
+type release struct {
+	Tag    string `json:"tag_name"`
+	Assets []struct {
+		Name string `json:"name"`
+		URL  string `json:"browser_download_url"`
+	} `json:"assets"`
+}
+
+// asset returns the download URL of the named asset.
+func (r *release) asset(name string) (string, bool) {
+	for _, a := range r.Assets {
+		if a.Name == name {
+			return a.URL, true
+		}
+	}
+	return "", false
+}
+
+// binaryAsset is the name of the release asset holding the tool built for
+// the running platform.
+func binaryAsset() string {
+	name := fmt.Sprintf("feed-validator_%s_%s", runtime.GOOS, runtime.GOARCH)
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// releaseClient downloads releases from GitHub, authenticating with
+// GITHUB_TOKEN when set to raise the API's rate limit.
+type releaseClient struct {
+	repo  string
+	token string
+	http  *http.Client
+}
+
+func (c *releaseClient) get(url string, w io.Writer) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	if c.token != "" && strings.HasPrefix(url, githubAPI) {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: HTTP status %d", url, resp.StatusCode)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// latest returns the repository's latest release.
+func (c *releaseClient) latest() (*release, error) {
+	var b bytes.Buffer
+	if err := c.get(githubAPI+"/repos/"+c.repo+"/releases/latest", &b); err != nil {
+		return nil, err
+	}
+	var r release
+	if err := json.Unmarshal(b.Bytes(), &r); err != nil {
+		return nil, fmt.Errorf("decoding release: %w", err)
+	}
+	return &r, nil
+}
+
+// tagged returns the repository's release tagged tag.
+func (c *releaseClient) tagged(tag string) (*release, error) {
+	var b bytes.Buffer
+	if err := c.get(githubAPI+"/repos/"+c.repo+"/releases/tags/"+url.PathEscape(tag), &b); err != nil {
+		return nil, err
+	}
+	var r release
+	if err := json.Unmarshal(b.Bytes(), &r); err != nil {
+		return nil, fmt.Errorf("decoding release: %w", err)
+	}
+	return &r, nil
+}
+
+// download fetches the named asset of r.
+func (c *releaseClient) download(r *release, name string) ([]byte, error) {
+	url, ok := r.asset(name)
+	if !ok {
+		return nil, fmt.Errorf("release %s has no %s", r.Tag, name)
+	}
+	var b bytes.Buffer
+	if err := c.get(url, &b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// parseChecksums reads a SHA256SUMS manifest into the sums by file name.
+func parseChecksums(manifest []byte) map[string]string {
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	for scanner.Scan() {
+		sum, name, ok := strings.Cut(scanner.Text(), "  ")
+		if ok {
+			sums[filepath.Base(name)] = sum
+		}
+	}
+	return sums
+}
+
+// checkSum reports whether data has the SHA-256 the manifest lists for name.
+func checkSum(sums map[string]string, name string, data []byte) error {
+	want, ok := sums[name]
+	if !ok {
+		return fmt.Errorf("%s is not listed in %s", name, manifestName)
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != want {
+		return fmt.Errorf("%s does not match its checksum in %s", name, manifestName)
+	}
+	return nil
+}
+
+// datasetAsset is the release asset holding the dataset.
+const datasetAsset = "feeds.csv"
+
+// downloadDataset fetches the dataset of r, checked against the release's
+// checksums.
+func (c *releaseClient) downloadDataset(r *release, sums map[string]string) ([]byte, error) {
+	data, err := c.download(r, datasetAsset)
+	if err != nil {
+		return nil, err
+	}
+	return data, checkSum(sums, datasetAsset, data)
+}
+
+// datasetVersionPath returns the path of the dataset of the release tagged
+// version, downloading it from the project's releases, built by the release
+// workflow, the first time, and checking it against their checksums, signed
+// by releasePublicKey in release builds. Releases don't change once tagged,
+// so later runs reuse the copy in the user's cache directory.
+func datasetVersionPath(version string) (string, error) {
+	if version == "" || strings.ContainsAny(version, `/\`) || version == "." || version == ".." {
+		return "", fmt.Errorf("%q is not a release tag", version)
+	}
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(cache, "feed-validator", "datasets", version, datasetAsset)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	client := &releaseClient{repo: defaultReleaseRepo, token: os.Getenv("GITHUB_TOKEN"), http: &http.Client{Timeout: 5 * time.Minute}}
+	rel, err := client.tagged(version)
+	if err != nil {
+		return "", fmt.Errorf("finding release %s: %w", version, err)
+	}
+	manifest, err := client.download(rel, manifestName)
+	if err != nil {
+		return "", err
+	}
+	if releasePublicKey != "" {
+		sig, err := client.download(rel, manifestName+".minisig")
+		if err != nil {
+			return "", err
+		}
+		if _, err := verifyMinisign(releasePublicKey, manifest, sig); err != nil {
+			return "", fmt.Errorf("verifying %s of release %s: %w", manifestName, version, err)
+		}
+	}
+	data, err := client.downloadDataset(rel, parseChecksums(manifest))
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	return path, replaceFile(path, data, 0o644)
+}
+
+// replaceFile writes data to path through a temporary file in the same
+// directory, so readers never see a partial file.
+func replaceFile(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if runtime.GOOS == "windows" {
+		// A running executable can't be replaced on Windows, but it can be
+		// moved aside.
+		os.Remove(path + ".old")
+		if err := os.Rename(path, path+".old"); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// newerVersion reports whether tag is a later release than version, both
+// vMAJOR.MINOR.PATCH. Builds without a release version, such as go run's
+// "(devel)", are never considered current.
+func newerVersion(tag, version string) bool {
+	parse := func(v string) ([3]int, bool) {
+		var n [3]int
+		v, _, _ = strings.Cut(strings.TrimPrefix(v, "v"), "-")
+		parts := strings.Split(v, ".")
+		if len(parts) != 3 {
+			return n, false
+		}
+		for i, p := range parts {
+			x, err := strconv.Atoi(p)
+			if err != nil {
+				return n, false
+			}
+			n[i] = x
+		}
+		return n, true
+	}
+	latest, ok := parse(tag)
+	if !ok {
+		return false
+	}
+	current, ok := parse(version)
+	if !ok {
+		return true
+	}
+	for i := range latest {
+		if latest[i] != current[i] {
+			return latest[i] > current[i]
+		}
+	}
+	return false
+}
+
+func runUpdate(args []string) int {
+	fs := flag.NewFlagSet("update", flag.ExitOnError)
+	outPath := fs.String("o", "feeds.csv", "write the dataset to this file")
+	repo := fs.String("repo", defaultReleaseRepo, "fetch releases of this GitHub repository (OWNER/NAME)")
+	tag := fs.String("version", "", "fetch the dataset of the release with this tag (e.g. v2024.6.0) rather than the latest")
+	publicKey := fs.String("public-key", releasePublicKey, "check the release's SHA256SUMS.minisig signature against this public key (default the key release builds embed)")
+	binary := fs.Bool("binary", false, "also replace this program with the release's build for this platform, if the release is newer")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s update [-o feeds.csv] [--version TAG] [--binary] [--public-key KEY]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Downloads the dataset from the latest or a given release, checking it against the release's SHA256SUMS.\n")
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) > 0 {
+		fs.Usage()
+		return 2
+	}
+	if *binary && *tag != "" {
+		fmt.Fprintln(os.Stderr, "--binary only updates to the latest release and can't be used with --version")
+		return 2
+	}
+	if *binary && *publicKey == "" {
+		fmt.Fprintln(os.Stderr, "--binary replaces this program, so it needs the release's signature checked: pass --public-key (release builds embed it)")
+		return 2
+	}
+
+	client := &releaseClient{repo: *repo, token: os.Getenv("GITHUB_TOKEN"), http: &http.Client{Timeout: 5 * time.Minute}}
+	var rel *release
+	if *tag != "" {
+		rel, err = client.tagged(*tag)
+	} else {
+		rel, err = client.latest()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error finding the release: %v\n", err)
+		return 1
+	}
+	manifest, err := client.download(rel, manifestName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if *publicKey != "" {
+		sig, err := client.download(rel, manifestName+".minisig")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		trusted, err := verifyMinisign(*publicKey, manifest, sig)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error verifying %s of release %s: %v\n", manifestName, rel.Tag, err)
+			return 1
+		}
+		fmt.Printf("Signature OK (%s)\n", trusted)
+	}
+	sums := parseChecksums(manifest)
+
+	if current, err := os.ReadFile(*outPath); err == nil && checkSum(sums, datasetAsset, current) == nil {
+		fmt.Printf("%s is already the dataset of release %s\n", *outPath, rel.Tag)
+	} else {
+		data, err := client.downloadDataset(rel, sums)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		if err := replaceFile(*outPath, data, 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *outPath, err)
+			return 1
+		}
+		fmt.Printf("Updated %s to the dataset of release %s\n", *outPath, rel.Tag)
+	}
+
+	if !*binary {
+		return 0
+	}
+	version := currentTool().Version
+	if !newerVersion(rel.Tag, version) {
+		fmt.Printf("This program (%s) is up to date with release %s\n", version, rel.Tag)
+		return 0
+	}
+	name := binaryAsset()
+	data, err := client.download(rel, name)
+	if err == nil {
+		err = checkSum(sums, name, data)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	exe, err := os.Executable()
+	if err == nil {
+		exe, err = filepath.EvalSymlinks(exe)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error locating this program: %v\n", err)
+		return 1
+	}
+	if err := replaceFile(exe, data, 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error replacing %s: %v\n", exe, err)
+		return 1
+	}
+	fmt.Printf("Updated %s from %s to %s\n", exe, version, rel.Tag)
+	return 0
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// uptimeWindows are the rolling periods, in days, that availability and
+// freshness are computed over.
+var uptimeWindows = []int{7, 30, 90}
+
+// freshWithin is how recently a feed must have published for a valid result
+// to count as fresh.
+const freshWithin = 7 * 24 * time.Hour
+
+// uptimeWindow summarizes a feed's results over the last Days days.
+// Availability is the share of runs that found the feed valid; Freshness is
+// the share of valid, dated results that had published within freshWithin.
+// Either is nil when there are no results to base it on.
+type uptimeWindow struct {
+	Days         int      `json:"days"`
+	Runs         int      `json:"runs"`
+	Availability *float64 `json:"availability"`
+	Freshness    *float64 `json:"freshness"`
+}
+
+func percent(n, total int) *float64 {
+	if total == 0 {
+		return nil
+	}
+	p := 100 * float64(n) / float64(total)
+	return &p
+}
+
+// formatPercent renders a percentage for tables, or "" if unknown.
+func formatPercent(p *float64) string {
+	if p == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*p, 'f', 1, 64)
+}
+
+// feedUptime computes a feed's uptimeWindows from its observations.
+// Transient errors count against availability: a feed that times out every
+// other night is not reliably available.
+func feedUptime(obs []observation, now time.Time) []uptimeWindow {
+	windows := make([]uptimeWindow, 0, len(uptimeWindows))
+	for _, days := range uptimeWindows {
+		since := now.AddDate(0, 0, -days)
+		var runs, valid, dated, fresh int
+		for _, o := range obs {
+			if o.at.Before(since) {
+				continue
+			}
+			runs++
+			if o.result.Status != "valid" {
+				continue
+			}
+			valid++
+			if !o.result.LastUpdate.IsZero() {
+				dated++
+				if o.at.Sub(o.result.LastUpdate) <= freshWithin {
+					fresh++
+				}
+			}
+		}
+		windows = append(windows, uptimeWindow{Days: days, Runs: runs, Availability: percent(valid, runs), Freshness: percent(fresh, dated)})
+	}
+	return windows
+}
+
+// feedUptimes computes uptime for every dataset feed, keyed by URL.
+func feedUptimes(feeds []Feed, reports []*runReport, now time.Time) map[string][]uptimeWindow {
+	history := indexHistory(reports)
+	uptimes := make(map[string][]uptimeWindow, len(feeds))
+	for _, feed := range feeds {
+		uptimes[feed.URL] = feedUptime(history.forFeed(feed), now)
+	}
+	return uptimes
+}
+
+// uptimeColumns names the CSV columns written by uptimeCells.
+func uptimeColumns() []string {
+	var columns []string
+	for _, days := range uptimeWindows {
+		columns = append(columns, fmt.Sprintf("availability_%dd", days), fmt.Sprintf("freshness_%dd", days))
+	}
+	return columns
+}
+
+func uptimeCells(windows []uptimeWindow) []string {
+	cells := make([]string, 2*len(uptimeWindows))
+	for i, w := range windows {
+		cells[2*i], cells[2*i+1] = formatPercent(w.Availability), formatPercent(w.Freshness)
+	}
+	return cells
+}
+
+func runUptime(args []string) int {
+	fs := flag.NewFlagSet("uptime", flag.ExitOnError)
+	historyPath := fs.String("history", "history.db", "history database, or glob of run reports written by validation with --json")
+	format := fs.String("format", "text", "output format: text or csv")
+	below := fs.Float64("below", 100, "only list feeds whose 30-day availability is below this percentage")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s uptime [--history DB|GLOB] [--format text|csv] [--below PCT] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+	reports, err := loadHistory(*historyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading run reports: %v\n", err)
+		return 1
+	}
+
+	uptimes := feedUptimes(feeds, reports, time.Now())
+	// The 30-day window decides what's listed and in which order; feeds
+	// without results in it sort last.
+	availability30 := func(feed Feed) float64 {
+		if a := uptimes[feed.URL][1].Availability; a != nil {
+			return *a
+		}
+		return 101
+	}
+	var listed []Feed
+	for _, feed := range feeds {
+		if availability30(feed) < *below || *below >= 100 {
+			listed = append(listed, feed)
+		}
+	}
+	sort.SliceStable(listed, func(i, j int) bool { return availability30(listed[i]) < availability30(listed[j]) })
+
+	switch *format {
+	case "csv":
+		w := csv.NewWriter(os.Stdout)
+		w.Write(append([]string{"id", "url"}, uptimeColumns()...))
+		for _, feed := range listed {
+			w.Write(append([]string{feed.ID, feed.URL}, uptimeCells(uptimes[feed.URL])...))
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing uptime: %v\n", err)
+			return 1
+		}
+	case "text":
+		writeUptimeTable(os.Stdout, listed, uptimes, len(reports))
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown format %q\n", *format)
+		return 2
+	}
+	return 0
+}
+
+func writeUptimeTable(w io.Writer, feeds []Feed, uptimes map[string][]uptimeWindow, runs int) {
+	fmt.Fprintf(w, "Availability and freshness (%%) over %d runs:\n\n", runs)
+	fmt.Fprintf(w, "%6s %6s %6s %7s  %s\n", "7d", "30d", "90d", "fresh30", "Feed")
+	for _, feed := range feeds {
+		windows := uptimes[feed.URL]
+		cell := func(p *float64) string {
+			if p == nil {
+				return "-"
+			}
+			return formatPercent(p)
+		}
+		fmt.Fprintf(w, "%6s %6s %6s %7s  %s\n", cell(windows[0].Availability), cell(windows[1].Availability), cell(windows[2].Availability),
+			cell(windows[1].Freshness), feed.URL)
+	}
+}
@@ -2,324 +2,1057 @@ package main
 
 import (
 	"context"
-	"encoding/csv"
+	"errors"
+	"flag"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
+	"slices"
 	"strings"
-	"sync"
 	"time"
 
-	"github.com/mmcdole/gofeed"
-	"golang.org/x/sync/semaphore"
-)
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
-const (
-	concurrencyLimit = 60
-	timeoutSeconds   = 30
-	maxRetries       = 3
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
 )
 
-type ValidationResult struct {
-	URL        string
-	Status     string
-	Message    string
-	ItemCount  int
-	LastUpdate time.Time
-}
-
-func validateFeed(url string, client *http.Client, parser *gofeed.Parser) ValidationResult {
-	url = strings.TrimSpace(url)
+// ValidationResult is the outcome of validating one feed.
+type ValidationResult = validator.Result
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
-	defer cancel()
+// validatorFlags are the validation settings of the commands that fetch
+// feeds.
+type validatorFlags struct {
+	concurrency  *int
+	adaptiveMin  *int
+	timeout      *time.Duration
+	retries      *int
+	userAgent    *string
+	accept       *string
+	hostInterval *time.Duration
+	serialHosts  *bool
+	domainBudget *time.Duration
+	maxBodyMB    *int
+	dnsWarmup    *bool
+	homepage     *bool
+	debugHTTP    *bool
+	hedge        *bool
+	credentials  *string
+	cookieJar    *bool
+	cookies      *string
+	checks       stringList
+	plugins      stringList
+	rules        stringList
+}
 
-	req, reqErr := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if reqErr != nil {
-		return ValidationResult{URL: url, Status: "invalid", Message: "Invalid URL: " + reqErr.Error()}
-	}
+func addValidatorFlags(fs *flag.FlagSet) *validatorFlags {
+	f := &validatorFlags{}
+	f.concurrency = fs.Int("concurrency", validator.DefaultConcurrency, "how many feeds to validate at once")
+	f.adaptiveMin = fs.Int("adaptive-concurrency", 0, "treat --concurrency as a ceiling, letting fewer fetches in flight, down to this many, while many time out or get server errors (0 disables)")
+	f.timeout = fs.Duration("timeout", validator.DefaultTimeout, "how long each feed's validation may take, retries included")
+	f.retries = fs.Int("retries", validator.DefaultRetries, "how many times to retry a failed fetch before reporting the feed as transient")
+	f.userAgent = fs.String("user-agent", validator.DefaultUserAgent, "User-Agent header sent with requests")
+	f.accept = fs.String("accept", validator.DefaultAccept, "Accept header sent with requests; feeds served as other types get a content_type warning")
+	f.maxBodyMB = fs.Int("max-body-mb", validator.DefaultMaxBodySize>>20, "largest feed body to read, in MB; larger feeds are invalid (0 for no limit)")
+	f.hostInterval = fs.Duration("host-interval", 0, "space requests to the same host at least this far apart, e.g. 500ms (0 disables)")
+	f.serialHosts = fs.Bool("serial-hosts", false, "validate one feed of each host at a time, whatever --concurrency, while feeds of other hosts go ahead")
+	f.domainBudget = fs.Duration("domain-budget", 0, "stop fetching a domain's feeds once they have taken this long in total, e.g. 5m, reporting the rest as transient (0 disables)")
+	f.dnsWarmup = fs.Bool("dns-warmup", false, "resolve every feed's host before fetching any, failing feeds whose host doesn't exist straight away")
+	f.homepage = fs.Bool("check-homepage", false, "also fetch the homepage each feed links to, warning when it is unreachable or a parked domain")
+	f.hedge = fs.Bool("hedge", false, "send a second request for a feed that takes longer to answer than 95% of the run's recent responses, taking whichever answers first")
+	f.debugHTTP = fs.Bool("debug-http", false, "log every request to stderr with its status and the time taken by DNS, connecting, TLS and the first byte")
+	f.credentials = fs.String("credentials", "", "send the basic-auth credentials or headers in this JSON file with requests for the feeds they match")
+	f.cookieJar = fs.Bool("cookie-jar", false, "keep the cookies sites set and send them back, so consent walls that set one and redirect back let feeds through")
+	f.cookies = fs.String("cookies", "", "send the cookies in this JSON file, such as sites' consent cookies, to their domains (implies --cookie-jar)")
+	fs.Var(&f.checks, "check", "also check each parsed feed with this program, which reads the feed as JSON on stdin and writes its findings to stdout; repeatable")
+	fs.Var(&f.plugins, "check-plugin", "also check each feed with the Check hook of this Go plugin (.so); repeatable")
+	fs.Var(&f.rules, "rules", "also check each parsed feed with the check function of this Starlark script; repeatable")
+	return f
+}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; FeedValidator/1.0)")
-	req.Header.Set("Accept-Language", "en-US;q=0.7,en;q=0.3")
+// acceptLanguage asks for a feed in its language column's language, in
+// the variant of the country its comments name, if any.
+func acceptLanguage(feed Feed) string {
+	country, _ := countryFromComments(feed.Comments)
+	return validator.AcceptLanguage(feed.Language, country.Alpha2)
+}
 
-	var resp *http.Response
-	var err error
-	var backoff time.Duration = 1
+func (f *validatorFlags) options() ([]validator.Option, error) {
+	return f.buildOptions(true)
+}
 
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		resp, err = client.Do(req)
+// anonymousOptions is options without the --credentials hook, for
+// validating URLs that callers rather than the dataset supply.
+func (f *validatorFlags) anonymousOptions() ([]validator.Option, error) {
+	return f.buildOptions(false)
+}
 
+func (f *validatorFlags) buildOptions(credentials bool) ([]validator.Option, error) {
+	hooks, err := checkHooks(f.checks, f.plugins, f.rules)
+	if err != nil {
+		return nil, err
+	}
+	if credentials && *f.credentials != "" {
+		creds, err := loadCredentials(*f.credentials)
 		if err != nil {
-			// Check specifically for context canceled errors
-			if strings.Contains(err.Error(), "context canceled") || strings.Contains(err.Error(), "context deadline exceeded") {
-				fmt.Fprintf(os.Stderr, "Timeout on attempt %d/%d for %s: %v\n", attempt, maxRetries, url, err)
-			} else {
-				fmt.Fprintf(os.Stderr, "Error on attempt %d/%d for %s: %v\n", attempt, maxRetries, url, err)
-			}
-
-			if attempt == maxRetries {
-				break
-			}
-
-			time.Sleep(backoff * time.Second)
-			backoff *= 2 // Exponential backoff
-			continue
+			return nil, fmt.Errorf("loading credentials: %w", err)
+		}
+		hooks = append([]validator.Hook{creds.hook()}, hooks...)
+	}
+	opts := []validator.Option{
+		validator.WithConcurrency(*f.concurrency),
+		validator.WithTimeout(*f.timeout),
+		validator.WithRetries(*f.retries),
+		validator.WithUserAgent(*f.userAgent),
+		validator.WithAccept(*f.accept),
+		validator.WithAcceptLanguage(acceptLanguage),
+		validator.WithHostRateLimit(*f.hostInterval),
+		validator.WithDomainBudget(*f.domainBudget),
+		validator.WithMaxBodySize(int64(*f.maxBodyMB) << 20),
+		validator.WithHooks(hooks...),
+	}
+	if *f.serialHosts {
+		opts = append(opts, validator.WithSerialHosts())
+	}
+	if *f.cookieJar || *f.cookies != "" {
+		jar, err := newCookieJar(*f.cookies)
+		if err != nil {
+			return nil, fmt.Errorf("loading cookies: %w", err)
 		}
+		opts = append(opts, validator.WithCookieJar(jar))
+	}
+	if *f.dnsWarmup {
+		opts = append(opts, validator.WithDNSWarmup())
+	}
+	if *f.homepage {
+		opts = append(opts, validator.WithHomepageCheck())
+	}
+	if *f.adaptiveMin > 0 {
+		opts = append(opts, validator.WithAdaptiveConcurrency(*f.adaptiveMin))
+	}
+	if *f.hedge {
+		opts = append(opts, validator.WithHedging(0))
+	}
+	if *f.debugHTTP {
+		opts = append(opts, validator.WithDebugHTTP())
+	}
+	return opts, nil
+}
 
-		if resp.StatusCode != 200 {
-			errMsg := fmt.Sprintf("HTTP status %d", resp.StatusCode)
-			resp.Body.Close()
+func (f *validatorFlags) settings() runSettings {
+	return runSettings{
+		Concurrency:         *f.concurrency,
+		AdaptiveMin:         *f.adaptiveMin,
+		TimeoutSeconds:      int(*f.timeout / time.Second),
+		MaxRetries:          *f.retries,
+		HostIntervalMS:      f.hostInterval.Milliseconds(),
+		MaxBodyMB:           *f.maxBodyMB,
+		DNSWarmup:           *f.dnsWarmup,
+		Hedge:               *f.hedge,
+		SerialHosts:         *f.serialHosts,
+		DomainBudgetSeconds: int(*f.domainBudget / time.Second),
+		CookieJar:           *f.cookieJar || *f.cookies != "",
+		CheckHomepage:       *f.homepage,
+	}
+}
 
-			// Don't retry client errors (4xx) except 429 (too many requests)
-			if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != 429 {
-				return ValidationResult{URL: url, Status: "invalid", Message: errMsg}
-			}
+// newValidator returns a validator logging to stderr, keeping bodies in
+// archive and failing responses in captures when they are set.
+func newValidator(archive *snapshotStore, captures *captureStore, opts ...validator.Option) *validator.Validator {
+	opts = append(opts, validator.WithLogf(func(format string, args ...any) {
+		fmt.Fprintf(os.Stderr, format+"\n", args...)
+	}))
+	if archive != nil {
+		opts = append(opts, validator.WithArchive(archive))
+	}
+	if captures != nil {
+		opts = append(opts, validator.WithCapturer(captures))
+	}
+	return validator.NewValidator(opts...)
+}
 
-			fmt.Fprintf(os.Stderr, "Retry %d/%d for %s: %v\n", attempt, maxRetries, url, errMsg)
+// outputMode is how validateAll passes results on.
+type outputMode struct {
+	// ordered passes results on in the order of the feeds, holding each
+	// back until those before it are in, rather than as they complete.
+	ordered bool
+	// deterministic also leaves timings out of results, so that runs
+	// against the same servers give identical output. It implies ordered.
+	deterministic bool
+}
 
-			if attempt == maxRetries {
-				break
+// validateAll validates feeds concurrently at their tier's depth, applying
+// rules to each result and then reporting and publishing it as mode says.
+// Failures inside one of windows are held back instead, before anything
+// hears of them, and returned apart. With workers, validation is sharded
+// across them instead of done locally.
+func validateAll(ctx context.Context, feeds []Feed, v *validator.Validator, rules *policy, windows maintenanceWindows, workers []string, mode outputMode, progress *runProgress, report validator.Reporter) ([]ValidationResult, []heldFailure) {
+	unique, copies := dedupeFeeds(feeds)
+	each := func(fn func(ValidationResult)) { v.ValidateEach(ctx, unique, fn) }
+	if len(workers) > 0 {
+		each = func(fn func(ValidationResult)) { validateRemote(ctx, unique, workers, fn) }
+	}
+	byID := make(map[string]Feed, len(feeds))
+	for _, feed := range feeds {
+		byID[feed.ID] = feed
+	}
+	var results []ValidationResult
+	var held []heldFailure
+	emit := func(result ValidationResult) {
+		if result.Status != validator.StatusValid {
+			if h, ok := windows.heldFor(byID[result.ID], result, time.Now()); ok {
+				held = append(held, h)
+				return
+			}
+		}
+		results = append(results, result)
+		progress.add(result)
+		report.Result(result)
+	}
+	var order *resultOrder
+	if mode.ordered || mode.deterministic {
+		order = newResultOrder(feeds, emit)
+		emit = order.add
+	}
+	each(func(result ValidationResult) {
+		shared := []ValidationResult{result}
+		for _, dup := range copies[result.ID] {
+			r := result
+			r.ID, r.URL, r.DuplicateOf = dup.ID, validator.NormalizeURL(dup.URL), result.URL
+			r.CorrectedFrom = ""
+			if _, fixed := validator.FixURL(dup.URL); fixed {
+				r.CorrectedFrom = strings.TrimSpace(dup.URL)
 			}
+			r.Warnings = slices.Clone(result.Warnings)
+			shared = append(shared, r)
+		}
+		for _, result := range shared {
+			rules.apply(byID[result.ID], &result, time.Now())
+			if mode.deterministic {
+				result.LatencyMS = 0
+			}
+			emit(result)
+		}
+	})
+	if order != nil {
+		order.flush()
+	}
+	return results, held
+}
 
-			time.Sleep(backoff * time.Second)
-			backoff *= 2
+// dedupeFeeds returns feeds without the rows whose URL is the same, once
+// canonicalized, as an earlier row's, so each feed is fetched once. The rows
+// left out are returned by the ID of the row kept, to share its result.
+func dedupeFeeds(feeds []Feed) (unique []Feed, copies map[string][]Feed) {
+	kept := make(map[string]Feed)
+	copies = make(map[string][]Feed)
+	for _, feed := range feeds {
+		key := validator.CanonicalURL(feed.URL)
+		if first, ok := kept[key]; ok {
+			copies[first.ID] = append(copies[first.ID], feed)
 			continue
 		}
-
-		// If we got here, we have a successful response
-		break
+		kept[key] = feed
+		unique = append(unique, feed)
 	}
+	return unique, copies
+}
 
-	if err != nil {
-		// Check specifically for timeout errors
-		if strings.Contains(err.Error(), "context canceled") || strings.Contains(err.Error(), "context deadline exceeded") {
-			return ValidationResult{URL: url, Status: "transient", Message: "Request timed out after " + fmt.Sprintf("%d", timeoutSeconds) + " seconds"}
-		}
-		return ValidationResult{URL: url, Status: "transient", Message: err.Error()}
+// rowIndex finds the dataset rows results are for. A result carries its
+// row's ID and the row's URL normalized, which differs from the URL as
+// written for scheme-less or corrected rows; rows sharing an ID are told
+// apart by that normalized URL.
+type rowIndex map[string][]Feed
+
+func indexRows(feeds []Feed) rowIndex {
+	idx := make(rowIndex, len(feeds))
+	for _, feed := range feeds {
+		idx[feed.ID] = append(idx[feed.ID], feed)
 	}
+	return idx
+}
 
-	if resp == nil || resp.StatusCode != 200 {
-		statusCode := 0
-		if resp != nil {
-			statusCode = resp.StatusCode
+// of returns the row r is for.
+func (idx rowIndex) of(r ValidationResult) (Feed, bool) {
+	rows := idx[r.ID]
+	for _, feed := range rows {
+		if validator.NormalizeURL(feed.URL) == r.URL {
+			return feed, true
 		}
-		return ValidationResult{URL: url, Status: "transient", Message: fmt.Sprintf("Failed after %d attempts, last status: %d", maxRetries, statusCode)}
 	}
+	if len(rows) == 0 {
+		return Feed{}, false
+	}
+	return rows[0], true
+}
 
-	defer resp.Body.Close()
+// resultOrder puts results back in the order of their feeds, passing each
+// on once the results of the feeds before it have been.
+type resultOrder struct {
+	// positions are each ID's positions among the feeds not yet passed on;
+	// an ID listed twice has both.
+	positions map[string][]int
+	pending   map[int]ValidationResult
+	next      int
+	total     int
+	emit      func(ValidationResult)
+}
 
-	// Read the entire body to avoid "unexpected EOF" errors
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return ValidationResult{URL: url, Status: "transient", Message: "Error reading response: " + err.Error()}
+func newResultOrder(feeds []Feed, emit func(ValidationResult)) *resultOrder {
+	o := &resultOrder{positions: make(map[string][]int), pending: make(map[int]ValidationResult), total: len(feeds), emit: emit}
+	for i, feed := range feeds {
+		o.positions[feed.ID] = append(o.positions[feed.ID], i)
 	}
+	return o
+}
 
-	bodyReader := strings.NewReader(string(bodyBytes))
-	feed, parseErr := parser.Parse(bodyReader)
+func (o *resultOrder) add(r ValidationResult) {
+	positions := o.positions[r.ID]
+	if len(positions) == 0 {
+		o.emit(r)
+		return
+	}
+	o.pending[positions[0]], o.positions[r.ID] = r, positions[1:]
+	for ; o.next < o.total; o.next++ {
+		r, ok := o.pending[o.next]
+		if !ok {
+			return
+		}
+		delete(o.pending, o.next)
+		o.emit(r)
+	}
+}
 
-	if parseErr != nil {
-		// Check if it might be a different format than expected
-		if strings.Contains(parseErr.Error(), "EOF") || strings.Contains(parseErr.Error(), "no XML") {
-			return ValidationResult{URL: url, Status: "invalid", Message: "Not a valid feed format"}
+// flush passes on the results still held, in order, skipping feeds that
+// had none, such as those a canceled run didn't start.
+func (o *resultOrder) flush() {
+	for ; o.next < o.total; o.next++ {
+		if r, ok := o.pending[o.next]; ok {
+			delete(o.pending, o.next)
+			o.emit(r)
 		}
-		return ValidationResult{URL: url, Status: "invalid", Message: parseErr.Error()}
 	}
+}
 
-	result := ValidationResult{
-		URL:       url,
-		ItemCount: len(feed.Items),
-		Status:    "valid",
+// runTransitions are the status changes found by a run.
+type runTransitions struct {
+	// Died were valid on their previous definite run and are now invalid;
+	// Recovered the reverse. Flapping feeds are left out of both.
+	Died, Recovered []ValidationResult
+	// Flaps are feeds that started or stopped flapping.
+	Flaps []flapChange
+	// Quarantined are feeds a --policy rule newly quarantined.
+	Quarantined []ValidationResult
+}
+
+// recordResults stores a run's outcomes in state and returns the status
+// changes they represent.
+func recordResults(state *validatorState, due []Feed, results []ValidationResult, now time.Time) runTransitions {
+	rows := indexRows(due)
+	var t runTransitions
+	for _, r := range results {
+		feed, ok := rows.of(r)
+		if !ok {
+			continue
+		}
+		fs := state.feed(feed)
+		fs.HeldUntil = time.Time{}
+		// A transient error doesn't count as a check, so the feed stays
+		// due and is tried again next time.
+		if r.Status != "transient" {
+			fs.LastValidated = now
+		}
+		if r.Status == "valid" && !r.ProbeOnly {
+			fs.TTLMinutes, fs.PublishIntervalMinutes = r.TTLMinutes, r.PublishIntervalMinutes
+			// Partial results only know the whole size if the server said.
+			if r.BodySize > 0 {
+				fs.BodySize = r.BodySize
+			}
+		}
+		if r.Snapshot != "" {
+			fs.Snapshot, fs.SnapshotAt = r.Snapshot, now
+		}
+		wasFlapping := fs.Flapping
+		fs.noteOutage(r, now)
+		change, changed := fs.noteStatus(r, now)
+		if changed {
+			t.Flaps = append(t.Flaps, change)
+		}
+		if !wasFlapping && !fs.Flapping {
+			switch {
+			case r.Status == "invalid" && fs.LastStatus == "valid":
+				t.Died = append(t.Died, r)
+			case r.Status == "valid" && fs.LastStatus == "invalid":
+				t.Recovered = append(t.Recovered, r)
+			}
+		}
+		if r.Status != "transient" {
+			fs.LastStatus = string(r.Status)
+			if r.Quarantine != "" && !fs.Quarantined {
+				t.Quarantined = append(t.Quarantined, r)
+			}
+			fs.Quarantined = r.Quarantine != ""
+		}
 	}
+	return t
+}
 
-	// Check update time if available
-	if feed.UpdatedParsed != nil {
-		result.LastUpdate = *feed.UpdatedParsed
-	} else if len(feed.Items) > 0 && feed.Items[0].PublishedParsed != nil {
-		result.LastUpdate = *feed.Items[0].PublishedParsed
+// runExitCode is the exit code of a run with summary: 1 when feeds are
+// invalid, unless IGNORE_INVALID_FEEDS is "true", or transient when
+// FAIL_ON_TRANSIENT is.
+func runExitCode(summary validator.Summary) int {
+	// Consider transient errors as success but log them clearly
+	exitCode := 0
+	if summary.Invalid > 0 {
+		exitCode = 1
+		// Allow setting environment variable to control exit behavior
+		if os.Getenv("IGNORE_INVALID_FEEDS") == "true" {
+			exitCode = 0
+		}
 	}
 
-	// Add warnings for potential issues but don't mark as invalid
-	if len(feed.Items) == 0 {
-		result.Message = "Warning: No feed items"
-	} else if result.LastUpdate.Before(time.Now().AddDate(0, -6, 0)) {
-		result.Message = "Warning: Feed hasn't been updated in over 6 months"
+	// Option to fail on any errors including transient
+	if summary.Transient > 0 && os.Getenv("FAIL_ON_TRANSIENT") == "true" {
+		exitCode = 1
 	}
+	return exitCode
+}
 
-	return result
+// pruneArchive drops snapshots no feed refers to; only the latest good body
+// of each feed is kept.
+func pruneArchive(archive *snapshotStore, state *validatorState) error {
+	keep := make(map[string]bool, len(state.Feeds))
+	for _, fs := range state.Feeds {
+		keep[fs.Snapshot] = true
+	}
+	_, err := archive.prune(keep)
+	return err
 }
 
 func main() {
-	inputFile := "feeds.csv"
 	if len(os.Args) > 1 {
-		inputFile = os.Args[1]
+		if cmd, ok := commands[os.Args[1]]; ok {
+			os.Exit(cmd(os.Args[2:]))
+		}
 	}
 
-	file, err := os.Open(inputFile)
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	updateLicense := fs.Bool("update-license", false, "fill empty license cells in the input file with hints found during validation")
+	statePath := fs.String("state", "", "file persisting per-feed state (such as paywall detection) across runs")
+	updatePaywall := fs.Bool("update-paywall", false, "write stabilized paywall flags to the input file's paywall column (requires --state)")
+	allTiers := fs.Bool("all-tiers", false, "validate every feed regardless of when its tier last required it")
+	canary := fs.Int("canary", 0, "only validate this many feeds, picked across countries and tiers, as a quick smoke check (implies --all-tiers)")
+	var seed uint64
+	fs.Uint64Var(&seed, "seed", 1, "seed for picking the --canary subset, the --shuffle order and, with --deterministic, the retry jitter; the same seed picks the same")
+	fs.Uint64Var(&seed, "canary-seed", 1, "deprecated: use --seed")
+	jsonPath := fs.String("json", "", "also write the run's results as JSON to this file")
+	printSchema := fs.Bool("schema", false, "print the JSON Schema of the JSON outputs (--json and the json and ndjson reports) and exit")
+	manifestPath := fs.String("manifest", "", "write a manifest of the run (tool version, settings, input file hashes, counts and environment) as JSON to this file")
+	archiveDir := fs.String("archive", "", "keep each feed's last successfully fetched body in this directory (requires --state)")
+	captureDir := fs.String("capture-failures", "", "save the headers and start of the body of each invalid feed's response to this directory")
+	captureKB := fs.Int("capture-kb", defaultCaptureKB, "how many KB of each failing response's body --capture-failures keeps")
+	historyPath := fs.String("history", "", "record the run's results in this history database: a SQLite file or postgres:// URL")
+	bigqueryID := fs.String("bigquery", "", "stream the run's results into this BigQuery table, given as PROJECT.DATASET.TABLE")
+	policyPath := fs.String("policy", "", "apply the pass, warn, fail and quarantine rules in this YAML file to each result after validation")
+	gatePath := fs.String("gate", "", "pass or fail the run by the thresholds in this YAML file, such as the share of feeds invalid, instead of failing on any invalid feed")
+	maintenancePath := fs.String("maintenance", "", "hold failures of feeds inside the maintenance windows in this JSON file until the window ends, instead of reporting them")
+	skipHealthyWithin := fs.Duration("skip-healthy-within", 0, "skip feeds the history shows valid within this long, e.g. 6h (requires --history)")
+	skipUnchanged := fs.Bool("skip-unchanged", false, "reuse the last valid result of feeds whose body hasn't changed since, instead of parsing them again (requires --state)")
+	shuffle := fs.Bool("shuffle", false, "validate feeds in an order shuffled by --seed rather than dataset order, spreading the load across publishers")
+	failuresFirst := fs.Bool("failures-first", false, "validate feeds that were failing or flapping on recent runs first, then new ones, then those last valid (requires --state or --history)")
+	partialKB := fs.Int("partial-kb", 0, "fetch only the first this many KB, with a Range request, of feeds last larger than that (requires --state)")
+	notify := addNotifierFlags(fs)
+	validation := addValidatorFlags(fs)
+	profiling := addProfilingFlags(fs)
+	eventsAddr := fs.String("events", "", "stream progress as Server-Sent Events on this address's /events during the run")
+	pubsubURL := fs.String("pubsub", "", "publish each result and status transition to this NATS subject (nats://HOST:4222/SUBJECT) or Kafka topic (kafka+http://REST-PROXY:8082/TOPIC)")
+	var workers stringList
+	fs.Var(&workers, "workers", "shard validation across these worker URLs (see the worker command) instead of validating locally; repeatable")
+	heartbeatURL := fs.String("heartbeat-url", "", "when the run completes, POST its status as JSON to this URL, e.g. a healthchecks.io check")
+	statusPath := fs.String("status-file", "", "when the run completes, write its status and finish time as JSON to this file")
+	badgesDir := fs.String("badges", "", "write Shields.io endpoint badges of dataset health to this directory: feeds.json and countries/XX.json")
+	ordered := fs.Bool("ordered", false, "print and report results in the order of the input rather than as they complete")
+	deterministic := fs.Bool("deterministic", false, "make the output reproducible, for golden-file checks: results in input order, retry jitter fixed by --seed, and no timings or run times")
+	lang := fs.String("lang", "en", "print results and the summary in this language: "+strings.Join(validator.Languages(), ", ")+"; reports stay in English")
+	datasetVersion := fs.String("dataset-version", "", "validate the dataset of this release (e.g. v2024.6.0), downloaded once and checked against its SHA256SUMS, instead of a local file")
+	stream := fs.Bool("stream", false, "validate rows as they are read, keeping only the counts, for lists too large to load; only validation, profiling, --report ndjson and --capture-failures flags apply")
+	metricsPath := fs.String("metrics", "", "write Prometheus metrics to this file, for node_exporter's textfile collector")
+	var reportSpecs stringList
+	fs.Var(&reportSpecs, "report", "also report the run as json, ndjson, csv or junit to a file (FORMAT=PATH, - for stdout) or post it to a webhook (webhook=URL); repeatable")
+	positional, err := parseArgs(fs, os.Args[1:])
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error opening file: %v\n", err)
-		os.Exit(1)
+		os.Exit(2)
 	}
-	defer file.Close()
 
-	reader := csv.NewReader(file)
+	if *printSchema {
+		os.Stdout.Write(validator.Schema())
+		os.Exit(0)
+	}
+	if *stream {
+		if err := checkStreamFlags(fs, reportSpecs); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
 
-	reader.FieldsPerRecord = -1 // Allow varying number of fields
-	reader.LazyQuotes = true    // Handle quotes more flexibly
-	reader.TrimLeadingSpace = true
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+	if *datasetVersion != "" {
+		if len(positional) > 0 || *updateLicense || *updatePaywall {
+			fmt.Fprintln(os.Stderr, "--dataset-version can't be used with an input file, --update-license or --update-paywall")
+			os.Exit(2)
+		}
+		inputFile, err = datasetVersionPath(*datasetVersion)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching dataset %s: %v\n", *datasetVersion, err)
+			os.Exit(1)
+		}
+	}
 
-	hasHeader := true
+	// With the dataset read from stdin, stdout carries the results as NDJSON
+	// and everything else printed goes to stderr.
+	var pipe *os.File
+	if inputFile == "-" {
+		if *updateLicense || *updatePaywall {
+			fmt.Fprintln(os.Stderr, "--update-license and --update-paywall can't update a dataset read from stdin")
+			os.Exit(2)
+		}
+		pipe = os.Stdout
+		os.Stdout = os.Stderr
+	}
+	if *updatePaywall && *statePath == "" {
+		fmt.Fprintln(os.Stderr, "--update-paywall requires --state")
+		os.Exit(2)
+	}
+	if notify.enabled() && *statePath == "" {
+		fmt.Fprintln(os.Stderr, "--webhook, --slack, --discord, --email-to, --github-issues and --alerts require --state")
+		os.Exit(2)
+	}
+	notifiers, err := notify.notifiers()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if *archiveDir != "" && *statePath == "" {
+		fmt.Fprintln(os.Stderr, "--archive requires --state")
+		os.Exit(2)
+	}
+	if !slices.Contains(validator.Languages(), *lang) {
+		fmt.Fprintf(os.Stderr, "--lang %q isn't supported (want %s)\n", *lang, strings.Join(validator.Languages(), ", "))
+		os.Exit(2)
+	}
+	if *skipHealthyWithin > 0 && *historyPath == "" {
+		fmt.Fprintln(os.Stderr, "--skip-healthy-within requires --history")
+		os.Exit(2)
+	}
+	if *failuresFirst && *statePath == "" && *historyPath == "" {
+		fmt.Fprintln(os.Stderr, "--failures-first requires --state or --history")
+		os.Exit(2)
+	}
+	if *skipUnchanged && *statePath == "" {
+		fmt.Fprintln(os.Stderr, "--skip-unchanged requires --state")
+		os.Exit(2)
+	}
+	if *partialKB > 0 && *statePath == "" {
+		fmt.Fprintln(os.Stderr, "--partial-kb requires --state")
+		os.Exit(2)
+	}
+	if *archiveDir != "" && len(workers) > 0 {
+		fmt.Fprintln(os.Stderr, "--archive can't be used with --workers")
+		os.Exit(2)
+	}
+	if *captureDir != "" && len(workers) > 0 {
+		fmt.Fprintln(os.Stderr, "--capture-failures can't be used with --workers")
+		os.Exit(2)
+	}
+	captures := newCaptureStore(*captureDir, *captureKB)
+	validatorOptions, err := validation.options()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if *deterministic {
+		validatorOptions = append(validatorOptions, validator.WithSeed(seed))
+	}
+	windows, err := loadMaintenanceWindows(*maintenancePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	rules, err := loadPolicy(*policyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	gate, err := loadQualityGate(*gatePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	var archive *snapshotStore
+	if *archiveDir != "" {
+		archive = &snapshotStore{dir: *archiveDir}
+	}
+	var bigquery *bigqueryTable
+	if *bigqueryID != "" {
+		bigquery, err = newBigQueryTable(context.Background(), *bigqueryID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+	}
+	sink, err := openSink(*pubsubURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	reporters, closeReports, err := openReporters(reportSpecs, *lang)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if pipe != nil {
+		reporters = append(reporters, validator.NewNDJSONReporter(pipe))
+	}
+	stopProfiling, err := profiling.start()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error starting profiling: %v\n", err)
+		os.Exit(1)
+	}
+	if *stream {
+		exitCode := runStream(inputFile, !*noHeader, newValidator(nil, captures, validatorOptions...), reporters)
+		if err := closeReports(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing reports: %v\n", err)
+		}
+		stopProfiling()
+		os.Exit(exitCode)
+	}
 
-	if len(os.Args) > 2 && os.Args[2] == "--no-header" {
-		hasHeader = false
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		os.Exit(1)
+	}
+	// A canary run only validates a subset, but alerts, badges, metrics
+	// and the dataset updates still see every feed.
+	candidates := feeds
+	if *canary > 0 {
+		fmt.Printf("Canary run: validating %d of %d feeds (seed %d)\n", min(*canary, len(feeds)), len(feeds), seed)
+		candidates = canarySubset(feeds, *canary, seed)
+		*allTiers = true
 	}
 
-	if hasHeader {
-		_, err = reader.Read() // Skip header
+	var state *validatorState
+	if *statePath != "" {
+		state, err = loadState(*statePath)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error reading header: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error loading state: %v\n", err)
 			os.Exit(1)
 		}
 	}
 
-	var urls []string
-	lineNum := 1
-	if hasHeader {
-		lineNum = 2
+	// Skip feeds whose tier says they were validated recently enough.
+	var due []Feed
+	now := time.Now()
+	for _, feed := range candidates {
+		if state != nil && !*allTiers {
+			if fs, ok := state.Feeds[feed.URL]; ok && !isDue(feed, fs, now) {
+				continue
+			}
+		}
+		due = append(due, feed)
 	}
+	skipped := len(candidates) - len(due)
 
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
+	// Feeds the history shows valid shortly before are reported as cached
+	// rather than fetched again.
+	var cached int
+	if *skipHealthyWithin > 0 {
+		valid, err := recentlyValid(*historyPath, due, now.Add(-*skipHealthyWithin))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: Skipping line %d due to error: %v\n", lineNum, err)
-			lineNum++
-			continue
+			fmt.Fprintf(os.Stderr, "Error reading history: %v\n", err)
+			os.Exit(1)
 		}
-		if len(record) == 0 {
-			lineNum++
-			continue
+		var uncached []Feed
+		for _, feed := range due {
+			if at, ok := valid[feed.URL]; ok {
+				fmt.Printf("💾 %s → cached (valid at %s)\n", feed.URL, at.Format(time.RFC3339))
+				cached++
+				continue
+			}
+			uncached = append(uncached, feed)
 		}
+		due = uncached
+	}
 
-		url := record[0]
-		if url != "" && !strings.HasPrefix(url, "#") {
-			urls = append(urls, url)
+	if len(due) == 0 {
+		if cached > 0 {
+			fmt.Printf("All %d due feeds were valid within %s; nothing to validate\n", cached, *skipHealthyWithin)
+		} else if skipped > 0 {
+			fmt.Printf("All %d feeds were validated recently; nothing is due\n", skipped)
+		} else {
+			fmt.Println("No URLs found to validate")
 		}
-		lineNum++
+		reportCompletion(*statusPath, *heartbeatURL, newRunStatus(inputFile, now, len(feeds), nil, skipped+cached, 0))
+		stopProfiling()
+		os.Exit(0)
 	}
 
-	if len(urls) == 0 {
-		fmt.Println("No URLs found to validate")
-		os.Exit(0)
+	if *shuffle {
+		due = shuffleFeeds(due, seed)
+	}
+	// Feeds likely to need attention are validated, and so reported,
+	// before those that were fine; shuffled feeds stay shuffled within
+	// each group.
+	if *failuresFirst {
+		var statuses map[string]string
+		if *historyPath != "" {
+			if statuses, err = recentStatuses(*historyPath, due, now.Add(-flapWindow)); err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading history: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		var failing int
+		due, failing = orderFailuresFirst(due, state, statuses)
+		fmt.Printf("Validating %d previously failing feeds first\n", failing)
 	}
 
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 10,
-		IdleConnTimeout:     90 * time.Second,
-		DisableCompression:  false,
-		DisableKeepAlives:   false,
-		// Longer TLS handshake timeout
-		TLSHandshakeTimeout: 10 * time.Second,
-		// More generous connection timeouts
-		ResponseHeaderTimeout: 20 * time.Second,
+	shutdownTracing, err := setupTracing(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error setting up tracing: %v\n", err)
+		os.Exit(1)
+	}
+	ctx, span := tracer.Start(context.Background(), "validation run", trace.WithAttributes(
+		attribute.String("dataset", inputFile),
+		attribute.Int("feeds.due", len(due)),
+		attribute.Int("feeds.skipped", len(feeds)-len(due)),
+	))
+	var events *eventHub
+	stopEvents := func() {}
+	if *eventsAddr != "" {
+		if events, stopEvents, err = serveEvents(*eventsAddr); err != nil {
+			fmt.Fprintf(os.Stderr, "Error serving events: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	progress := startRun(events, sink, inputFile, now, len(due))
+	if *skipUnchanged {
+		validatorOptions = append(validatorOptions, validator.WithPrevious(state.lastValid))
+	}
+	if *partialKB > 0 {
+		limit := int64(*partialKB) << 10
+		validatorOptions = append(validatorOptions, validator.WithPartialFetch(limit, state.largerThan(limit)))
+	}
+	v := newValidator(archive, captures, validatorOptions...)
+	results, held := validateAll(ctx, due, v, rules, windows, workers, outputMode{ordered: *ordered, deterministic: *deterministic}, progress, reporters)
+	progress.finish()
+	stopEvents()
+	span.End()
+	if err := shutdownTracing(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error exporting traces: %v\n", err)
 	}
 
-	client := &http.Client{
-		// Don't set client timeout - we're using context timeout instead
-		Transport: transport,
+	// Failures inside a maintenance window were left out of the report and
+	// are checked again when the window ends.
+	for _, h := range held {
+		fmt.Println(h)
 	}
+	recordHeld(state, due, held)
 
-	parser := gofeed.NewParser()
-	parser.UserAgent = "Mozilla/5.0 (compatible; FeedValidator/1.0)"
+	// Generate report. Failures of feeds already known to be flapping are
+	// counted but not listed.
+	var flapping, quarantined, duplicates, corrected int
+	dueRows := indexRows(due)
+	for _, r := range results {
+		feed, _ := dueRows.of(r)
+		quiet := state.isFlapping(feed.URL)
+		if quiet {
+			flapping++
+		}
+		if r.DuplicateOf != "" {
+			duplicates++
+		}
+		if r.CorrectedFrom != "" {
+			corrected++
+			fmt.Println(validator.Localizef(*lang, "[Corrected URL] %s → %s (%s, line %d)", r.CorrectedFrom, r.URL, feed.File, feed.Line))
+		}
+		if r.Quarantine != "" {
+			quarantined++
+			fmt.Println(validator.Localizef(*lang, "[Quarantine] %s (%s)", r.URL, r.Quarantine))
+		}
+		switch r.Status {
+		case "valid":
+			if r.NoRedistribution {
+				fmt.Println(validator.Localizef(*lang, "[License] %s (%s)", r.URL, r.License))
+			}
+		case "invalid":
+			if !quiet {
+				fmt.Println(validator.Localizef(*lang, "[Invalid] %s (%s)", r.URL, validator.Localize(*lang, r.Message)))
+				if r.Capture != "" {
+					fmt.Println("  " + validator.Localizef(*lang, "Response saved to %s", r.Capture))
+				}
+			}
+		case "transient":
+			if !quiet {
+				fmt.Println(validator.Localizef(*lang, "[Transient] %s (%s)", r.URL, validator.Localize(*lang, r.Message)))
+			}
+		}
+	}
 
-	sem := semaphore.NewWeighted(int64(concurrencyLimit))
+	// A deterministic run's reports claim to have run at the Unix epoch;
+	// history and other records keep the real times.
+	report := &runReport{Input: inputFile, StartedAt: now, FinishedAt: time.Now(), Results: results}
+	output := *report
+	if *deterministic {
+		output.StartedAt, output.FinishedAt = time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC()
+	}
+	summary := validator.Summarize(results, output.StartedAt, output.FinishedAt)
+	if err := reporters.Finish(summary); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reporting the run: %v\n", err)
+	}
+	if err := closeReports(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing reports: %v\n", err)
+	}
+	if flapping > 0 {
+		fmt.Println("🔁 " + validator.Localizef(*lang, "Flapping (failures not listed): %d", flapping))
+	}
+	if skipped > 0 {
+		fmt.Println("⏭️ " + validator.Localizef(*lang, "Skipped (not due for their tier): %d", skipped))
+	}
+	if cached > 0 {
+		fmt.Println("💾 " + validator.Localizef(*lang, "Cached (valid within %s): %d", *skipHealthyWithin, cached))
+	}
+	if len(held) > 0 {
+		fmt.Println("🛠️ " + validator.Localizef(*lang, "Held (failed during maintenance): %d", len(held)))
+	}
+	if quarantined > 0 {
+		fmt.Println("🚧 " + validator.Localizef(*lang, "Quarantined for review: %d", quarantined))
+	}
+	if duplicates > 0 {
+		fmt.Println("👯 " + validator.Localizef(*lang, "Duplicate URLs (fetched once): %d", duplicates))
+	}
+	if corrected > 0 {
+		fmt.Println("✏️ " + validator.Localizef(*lang, "Corrected URLs (fix the dataset): %d", corrected))
+	}
+	if domains := v.OverBudgetDomains(); len(domains) > 0 {
+		var skipped int
+		for _, r := range results {
+			if errors.Is(r.Err, validator.ErrOverBudget) {
+				skipped++
+			}
+		}
+		fmt.Println("⏳ " + validator.Localizef(*lang, "Skipped over their domain's budget: %d (%s)", skipped, strings.Join(domains, ", ")))
+	}
+	if sent, won := v.HedgedRequests(); sent > 0 {
+		fmt.Println("🏁 " + validator.Localizef(*lang, "Hedged requests: %d (%d answered first)", sent, won))
+	}
 
-	var wg sync.WaitGroup
-	resultsChan := make(chan ValidationResult, len(urls))
+	printRegionSummary(feeds, results, *lang)
 
-	for _, url := range urls {
-		// Acquire semaphore before creating goroutine to ensure controlled concurrency
-		if err := sem.Acquire(context.Background(), 1); err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to acquire semaphore: %v\n", err)
-			continue
+	if *jsonPath != "" {
+		if err := output.save(*jsonPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *jsonPath, err)
+			os.Exit(1)
+		}
+	}
+	if *manifestPath != "" {
+		manifest, err := newRunManifest(report, len(feeds), flagSnapshot(fs), validation.settings())
+		if err == nil {
+			manifest.DatasetVersion = *datasetVersion
+			manifest.ConcurrencyChanges = v.ConcurrencyChanges()
+			err = manifest.save(*manifestPath)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *manifestPath, err)
+			os.Exit(1)
+		}
+	}
+	// A run whose failure rates jump far above recent runs' points to the
+	// runner rather than the feeds; its failures aren't recorded in state.
+	var anomalies []runAnomaly
+	if *historyPath != "" {
+		if anomalies, err = checkAnomalies(*historyPath, results); err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading history: %v\n", err)
+			os.Exit(1)
 		}
+		if len(anomalies) > 0 {
+			fmt.Printf("\nAnomalies (failures are not recorded against feeds):\n")
+			for _, a := range anomalies {
+				fmt.Println(a)
+			}
+		}
+		if err := recordHistory(*historyPath, report); err != nil {
+			fmt.Fprintf(os.Stderr, "Error recording history: %v\n", err)
+			os.Exit(1)
+		}
+		collapses, err := recentVolumeCollapses(*historyPath, feeds, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading history: %v\n", err)
+			os.Exit(1)
+		}
+		if len(collapses) > 0 {
+			fmt.Printf("\nItem Counts Collapsed:\n")
+			for _, c := range collapses {
+				fmt.Println(c)
+			}
+		}
+	}
+	if bigquery != nil {
+		if err := bigquery.insert(ctx, report, feeds); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting to BigQuery: %v\n", err)
+			os.Exit(1)
+		}
+	}
 
-		wg.Add(1)
+	if *updateLicense {
+		hints := make(map[string]string)
+		for _, r := range results {
+			if feed, ok := dueRows.of(r); ok && r.License != "" {
+				hints[feed.URL] = r.License
+			}
+		}
+		updated, err := updateDatasetColumnFiles(inputFile, !*noHeader, "license", hints, false)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error updating license column: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Updated license for %d feeds in %s\n", updated, inputFile)
+	}
 
-		go func(feedURL string) {
-			defer wg.Done()
-			defer sem.Release(1)
+	var alerts []alertViolation
+	if state != nil {
+		recorded := results
+		if len(anomalies) > 0 {
+			recorded = validOnly(results)
+		}
+		changes := recordResults(state, due, recorded, now)
+		if *skipUnchanged {
+			recordLastValid(state, due, recorded)
+		}
+		sink.transitions(inputFile, now, changes)
 
-			result := validateFeed(feedURL, client, parser)
-			resultsChan <- result
+		if len(changes.Died) > 0 {
+			fmt.Printf("\nNewly Invalid Feeds:\n")
+			for _, r := range changes.Died {
+				feed, _ := dueRows.of(r)
+				if fs := state.Feeds[feed.URL]; archive != nil && fs != nil && fs.Snapshot != "" {
+					fmt.Printf("[Died] %s (%s; last good snapshot from %s: %s)\n", r.URL, r.Message, fs.SnapshotAt.Format("2006-01-02"), archive.path(fs.Snapshot))
+				} else {
+					fmt.Printf("[Died] %s (%s)\n", r.URL, r.Message)
+				}
+			}
+		}
 
-			statusSymbol := "✅"
-			if result.Status == "invalid" {
-				statusSymbol = "❌"
-			} else if result.Status == "transient" {
-				statusSymbol = "⚠️"
+		if len(changes.Recovered) > 0 {
+			fmt.Printf("\nRecovered Feeds:\n")
+			for _, r := range changes.Recovered {
+				fmt.Printf("[Recovered] %s\n", r.URL)
 			}
+		}
 
-			fmt.Printf("%s %s → %s", statusSymbol, result.URL, result.Status)
-			if result.Message != "" {
-				fmt.Printf(" (%s)", result.Message)
+		if len(changes.Flaps) > 0 {
+			fmt.Printf("\nFlapping Feeds:\n")
+			for _, c := range changes.Flaps {
+				fmt.Println(c)
 			}
-			fmt.Println()
-		}(url)
-	}
+		}
 
-	go func() {
-		wg.Wait()
-		close(resultsChan)
-	}()
+		alerts = notifiers.alerts.check(feeds, state)
+		for _, a := range anomalies {
+			alerts = append(alerts, a.alert())
+		}
+		if len(alerts) > 0 {
+			fmt.Printf("\nAlerts:\n")
+			for _, a := range alerts {
+				fmt.Println(a)
+			}
+		}
 
-	var results []ValidationResult
-	for result := range resultsChan {
-		results = append(results, result)
-	}
+		if err := notifiers.notify(inputFile, now, feeds, results, skipped, changes, alerts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error sending notifications: %v\n", err)
+		}
 
-	// Generate report
-	var valid, invalid, transient, warnings int
-	for _, r := range results {
-		switch r.Status {
-		case "valid":
-			valid++
-			if r.Message != "" {
-				warnings++
+		if notifiers.issues != nil {
+			if err := notifiers.issues.sync(state, feeds, recorded, now); err != nil {
+				fmt.Fprintf(os.Stderr, "Error syncing GitHub issues: %v\n", err)
 			}
-		case "invalid":
-			invalid++
-			fmt.Printf("[Invalid] %s (%s)\n", r.URL, r.Message)
-		case "transient":
-			transient++
-			fmt.Printf("[Transient] %s (%s)\n", r.URL, r.Message)
+		}
+
+		transitions := updatePaywallState(state, feeds, recorded)
+		if len(transitions) > 0 {
+			fmt.Printf("\nPaywall Changes:\n")
+			for _, t := range transitions {
+				fmt.Printf("[Paywall] %s (%s → %s)\n", t.URL, accessLabel(t.From), accessLabel(t.To))
+			}
+		}
+
+		if err := state.save(*statePath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving state: %v\n", err)
+			os.Exit(1)
+		}
+
+		if archive != nil {
+			if err := pruneArchive(archive, state); err != nil {
+				fmt.Fprintf(os.Stderr, "Error pruning snapshots: %v\n", err)
+			}
+		}
+
+		if *updatePaywall {
+			flags := make(map[string]string, len(state.Feeds))
+			for url, fs := range state.Feeds {
+				flags[url] = fs.Paywall
+			}
+			updated, err := updateDatasetColumnFiles(inputFile, !*noHeader, "paywall", flags, true)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error updating paywall column: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Updated paywall flag for %d feeds in %s\n", updated, inputFile)
 		}
 	}
+	if err := sink.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error closing %s: %v\n", *pubsubURL, err)
+	}
 
-	total := len(results)
-	fmt.Printf("\nResults Summary:\n")
-	fmt.Printf("✅ Valid: %d (with %d warnings)\n", valid, warnings)
-	fmt.Printf("❌ Invalid: %d\n", invalid)
-	fmt.Printf("⚠️ Transient Errors: %d\n", transient)
-	fmt.Printf("Total: %d feeds checked\n", total)
+	if *metricsPath != "" {
+		metrics := newValidatorMetrics()
+		metrics.observe(results, now)
+		if state != nil {
+			metrics.setStatusesFromState(feeds, state)
+		} else {
+			metrics.setStatuses(feeds, lastStatuses(nil, feeds, results), 0)
+		}
+		if err := metrics.writeTextfile(*metricsPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing metrics: %v\n", err)
+			os.Exit(1)
+		}
+	}
 
-	// Consider transient errors as success but log them clearly
-	exitCode := 0
-	if invalid > 0 {
-		exitCode = 1
-		// Allow setting environment variable to control exit behavior
-		if os.Getenv("IGNORE_INVALID_FEEDS") == "true" {
-			exitCode = 0
+	if *badgesDir != "" {
+		if err := writeBadges(*badgesDir, feeds, lastStatuses(state, feeds, results)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing badges: %v\n", err)
+			os.Exit(1)
 		}
 	}
 
-	// Option to fail on any errors including transient
-	if transient > 0 && os.Getenv("FAIL_ON_TRANSIENT") == "true" {
-		exitCode = 1
+	exitCode := runExitCode(summary)
+
+	// Alert rules and the quality gate replace the blanket policy: the run
+	// fails only when a rule is violated or the gate isn't met.
+	gatePassed := true
+	if gate != nil {
+		gatePassed = writeGateChecks(os.Stdout, gate.check(due, results))
+	}
+	if notifiers.alerts != nil || gate != nil {
+		exitCode = 0
+		if len(alerts) > 0 || !gatePassed {
+			exitCode = 1
+		}
 	}
 
+	reportCompletion(*statusPath, *heartbeatURL, newRunStatus(inputFile, now, len(feeds), results, skipped+cached, exitCode))
+	stopProfiling()
 	os.Exit(exitCode)
 }
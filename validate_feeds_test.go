@@ -0,0 +1,87 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+func TestRecordResults(t *testing.T) {
+	before := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := before.Add(24 * time.Hour)
+	tests := []struct {
+		name          string
+		lastStatus    string
+		status        validator.Status
+		wantValidated time.Time
+		wantStatus    string
+		wantDied      bool
+		wantRecovered bool
+	}{
+		{"valid", "valid", validator.StatusValid, now, "valid", false, false},
+		{"died", "valid", validator.StatusInvalid, now, "invalid", true, false},
+		{"recovered", "invalid", validator.StatusValid, now, "valid", false, true},
+		{"transient", "valid", validator.StatusTransient, before, "valid", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := Feed{URL: "https://example.com/rss"}
+			state := &validatorState{Feeds: map[string]*feedState{
+				feed.URL: {LastValidated: before, LastStatus: tt.lastStatus},
+			}}
+			transitions := recordResults(state, []Feed{feed}, []ValidationResult{{URL: feed.URL, Status: tt.status}}, now)
+			fs := state.Feeds[feed.URL]
+			if !fs.LastValidated.Equal(tt.wantValidated) {
+				t.Errorf("last validated %v, want %v", fs.LastValidated, tt.wantValidated)
+			}
+			if fs.LastStatus != tt.wantStatus {
+				t.Errorf("last status %q, want %q", fs.LastStatus, tt.wantStatus)
+			}
+			if died := len(transitions.Died) == 1; died != tt.wantDied {
+				t.Errorf("died: %v, want %v", died, tt.wantDied)
+			}
+			if recovered := len(transitions.Recovered) == 1; recovered != tt.wantRecovered {
+				t.Errorf("recovered: %v, want %v", recovered, tt.wantRecovered)
+			}
+		})
+	}
+
+	// Results carry the row's URL normalized, so a scheme-less row's state
+	// is found by its ID.
+	schemeless := []Feed{{ID: "a", URL: "example.com/a"}, {ID: "b", URL: "example.org/b"}}
+	state := &validatorState{Feeds: map[string]*feedState{}}
+	recordResults(state, schemeless, []ValidationResult{
+		{ID: "a", URL: "https://example.com/a", Status: validator.StatusValid},
+		{ID: "b", URL: "https://example.org/b", Status: validator.StatusInvalid},
+	}, now)
+	if _, ok := state.Feeds[""]; ok {
+		t.Error("results recorded under an empty URL")
+	}
+	for feed, want := range map[string]string{"example.com/a": "valid", "example.org/b": "invalid"} {
+		if fs := state.Feeds[feed]; fs == nil || fs.LastStatus != want || !fs.LastValidated.Equal(now) {
+			t.Errorf("%s: state %+v, want %s validated at %v", feed, fs, want, now)
+		}
+	}
+
+	// A quarantine is reported when it starts, not again while it lasts,
+	// and a transient result doesn't end it.
+	feed := Feed{URL: "https://example.com/rss"}
+	state = &validatorState{Feeds: map[string]*feedState{}}
+	quarantined := ValidationResult{URL: feed.URL, Status: validator.StatusValid, Quarantine: "too few items"}
+	for i, step := range []struct {
+		result ValidationResult
+		want   int
+	}{
+		{quarantined, 1},
+		{quarantined, 0},
+		{ValidationResult{URL: feed.URL, Status: validator.StatusTransient}, 0},
+		{quarantined, 0},
+		{ValidationResult{URL: feed.URL, Status: validator.StatusValid}, 0},
+		{quarantined, 1},
+	} {
+		if got := len(recordResults(state, []Feed{feed}, []ValidationResult{step.result}, now).Quarantined); got != step.want {
+			t.Errorf("run %d: %d quarantined, want %d", i+1, got, step.want)
+		}
+	}
+}
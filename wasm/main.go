@@ -0,0 +1,78 @@
+//go:build js && wasm
+
+// Command wasm is the validator compiled to WebAssembly for the browser
+// demo page, so contributors can check a feed before suggesting it with the
+// same classification as the CLI. Requests go through the browser's fetch,
+// so a feed is only reachable when it allows cross-origin requests or
+// through a CORS proxy.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall/js"
+
+	"github.com/reddot-watch/curated-world-news/pkg/validator"
+)
+
+// browserFetcher sends requests with the browser's fetch, through proxy when
+// it is set: the feed URL is appended to it.
+func browserFetcher(proxy string) validator.Fetcher {
+	client := validator.NewHTTPClient()
+	return validator.FetcherFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		// Browsers set their own User-Agent, and asking for another makes
+		// the request preflighted, which most feed servers don't answer.
+		req.Header.Del("User-Agent")
+		if proxy != "" {
+			u, err := url.Parse(proxy + req.URL.String())
+			if err != nil {
+				return nil, err
+			}
+			req.URL, req.Host = u, u.Host
+		}
+		return client.Do(req)
+	})
+}
+
+// validateFeed is exposed to JavaScript as validateFeed(url, tier, proxy),
+// returning a promise of the result, as the CLI's JSON has it. The promise
+// is rejected when no URL is given.
+func validateFeed(this js.Value, args []js.Value) any {
+	if len(args) == 0 || args[0].Type() != js.TypeString {
+		err := js.Global().Get("Error").New("validateFeed: the feed URL must be given as a string")
+		return js.Global().Get("Promise").Call("reject", err)
+	}
+	feed := validator.Feed{URL: args[0].String(), Tier: validator.DefaultTier}
+	if len(args) > 1 && args[1].Type() == js.TypeNumber {
+		feed.Tier = args[1].Int()
+	}
+	var proxy string
+	if len(args) > 2 && args[2].Type() == js.TypeString {
+		proxy = strings.TrimSpace(args[2].String())
+	}
+
+	return js.Global().Get("Promise").New(js.FuncOf(func(this js.Value, promise []js.Value) any {
+		resolve, reject := promise[0], promise[1]
+		// Calls from JavaScript must not block, so validation runs on its
+		// own goroutine.
+		go func() {
+			v := validator.NewValidator(validator.WithFetcher(browserFetcher(proxy)))
+			data, err := json.Marshal(v.ValidateFeed(context.Background(), feed))
+			if err != nil {
+				reject.Invoke(js.Global().Get("Error").New(err.Error()))
+				return
+			}
+			resolve.Invoke(js.Global().Get("JSON").Call("parse", string(data)))
+		}()
+		return nil
+	}))
+}
+
+func main() {
+	js.Global().Set("validateFeed", js.FuncOf(validateFeed))
+	select {}
+}
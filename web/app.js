@@ -0,0 +1,153 @@
+"use strict";
+
+const statusColors = { valid: "#2e7d32", invalid: "#c62828", transient: "#ef6c00" };
+const countryNames = new Intl.DisplayNames(undefined, { type: "region" });
+
+let feeds = [];
+
+function el(tag, attrs = {}, ...children) {
+  const node = document.createElement(tag);
+  for (const [key, value] of Object.entries(attrs)) {
+    node.setAttribute(key, value);
+  }
+  node.append(...children);
+  return node;
+}
+
+function svg(tag, attrs = {}) {
+  const node = document.createElementNS("http://www.w3.org/2000/svg", tag);
+  for (const [key, value] of Object.entries(attrs)) {
+    node.setAttribute(key, value);
+  }
+  return node;
+}
+
+function date(value) {
+  return value ? new Date(value).toLocaleString() : "";
+}
+
+function countryName(code) {
+  try {
+    return countryNames.of(code);
+  } catch {
+    return code;
+  }
+}
+
+function uptime(feed) {
+  const window = (feed.uptime || []).find((w) => w.days === 30);
+  return window && window.availability !== null ? `${window.availability.toFixed(1)}%` : "";
+}
+
+function matches(feed) {
+  const country = document.getElementById("country").value;
+  const status = document.getElementById("status").value;
+  const search = document.getElementById("search").value.toLowerCase();
+  if (country && feed.country !== country) return false;
+  if (status === "flapping" ? !feed.flapping : status && feed.status !== status) return false;
+  if (search && !`${feed.url} ${feed.message || ""}`.toLowerCase().includes(search)) return false;
+  return true;
+}
+
+function render() {
+  const body = document.getElementById("feeds");
+  body.replaceChildren();
+  const shown = feeds.filter(matches);
+  for (const feed of shown) {
+    const status = feed.flapping ? "flapping" : feed.status;
+    const row = el("tr", { class: "feed" },
+      el("td", {}, el("span", { class: `badge ${status}` }, status)),
+      el("td", {}, el("div", { class: "url" }, feed.url), el("div", { class: "message" }, feed.message || "")),
+      el("td", {}, feed.country ? countryName(feed.country) : ""),
+      el("td", {}, String(feed.tier)),
+      el("td", {}, feed.item_count ? String(feed.item_count) : ""),
+      el("td", {}, date(feed.last_update)),
+      el("td", {}, date(feed.last_validated)),
+      el("td", {}, uptime(feed)));
+    row.addEventListener("click", () => toggleHistory(row, feed));
+    body.append(row);
+  }
+
+  const counts = {};
+  for (const feed of feeds) counts[feed.status] = (counts[feed.status] || 0) + 1;
+  const parts = Object.entries(counts).map(([status, n]) => `${n} ${status}`);
+  document.getElementById("summary").textContent =
+    `${feeds.length} feeds: ${parts.join(", ")}. Showing ${shown.length}.`;
+}
+
+async function toggleHistory(row, feed) {
+  const next = row.nextElementSibling;
+  if (next && next.classList.contains("history")) {
+    next.remove();
+    return;
+  }
+  const cell = el("td", { colspan: "8" }, "Loading history…");
+  row.after(el("tr", { class: "history" }, cell));
+  const resp = await fetch(`feeds/${encodeURIComponent(feed.id)}/history?days=30`);
+  if (!resp.ok) {
+    cell.textContent = (await resp.text()).trim();
+    return;
+  }
+  const history = await resp.json();
+  cell.replaceChildren(history.results && history.results.length
+    ? chart(history.results)
+    : "No runs recorded in the last 30 days.");
+}
+
+// chart draws a strip of run statuses over a line of fetch latency.
+function chart(results) {
+  const width = 800, strip = 16, height = 120, pad = 30;
+  const start = new Date(results[0].at).getTime();
+  const end = Math.max(new Date(results[results.length - 1].at).getTime(), start + 1);
+  const x = (at) => pad + ((new Date(at).getTime() - start) / (end - start)) * (width - 2 * pad);
+  const maxLatency = Math.max(...results.map((r) => r.latency_ms), 1);
+  const y = (ms) => height - 15 - (ms / maxLatency) * (height - strip - 30);
+
+  const root = svg("svg", { width, height, viewBox: `0 0 ${width} ${height}` });
+  const step = (width - 2 * pad) / results.length;
+  for (const r of results) {
+    const rect = svg("rect", {
+      x: x(r.at) - step / 2, y: 0, width: Math.max(step, 2), height: strip,
+      fill: statusColors[r.status] || "#757575",
+    });
+    const title = svg("title");
+    title.textContent = `${date(r.at)}: ${r.status}${r.message ? ` (${r.message})` : ""}, ${r.latency_ms} ms`;
+    rect.append(title);
+    root.append(rect);
+  }
+  root.append(svg("polyline", {
+    points: results.map((r) => `${x(r.at)},${y(r.latency_ms)}`).join(" "),
+    fill: "none", stroke: "#1565c0", "stroke-width": 1.5,
+  }));
+  const label = (text, attrs) => {
+    const node = svg("text", attrs);
+    node.textContent = text;
+    root.append(node);
+  };
+  label(`${maxLatency} ms`, { x: 0, y: strip + 22 });
+  label(date(results[0].at), { x: pad, y: height - 2 });
+  label(date(results[results.length - 1].at), { x: width - pad, y: height - 2, "text-anchor": "end" });
+  return root;
+}
+
+async function load() {
+  const resp = await fetch("feeds");
+  feeds = await resp.json();
+  const select = document.getElementById("country");
+  const selected = select.value;
+  const codes = [...new Set(feeds.map((f) => f.country).filter(Boolean))];
+  codes.sort((a, b) => countryName(a).localeCompare(countryName(b)));
+  select.replaceChildren(el("option", { value: "" }, "All"),
+    ...codes.map((code) => el("option", { value: code }, countryName(code))));
+  select.value = selected;
+  render();
+}
+
+for (const id of ["country", "status", "search"]) {
+  document.getElementById(id).addEventListener("input", render);
+}
+load();
+// Refreshing re-renders the table, so it waits while a chart is open.
+setInterval(() => {
+  if (!document.querySelector("tr.history")) load();
+}, 60000);
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	wikidataEndpoint  = "https://query.wikidata.org/sparql"
+	wikidataUserAgent = "FeedValidator/1.0 (+https://github.com/reddot-watch/curated-world-news)"
+	wikidataBatchSize = 25
+)
+
+// Publisher is the Wikidata entity behind a feed's domain.
+type Publisher struct {
+	WikidataID string    `json:"wikidata_id,omitempty"`
+	Name       string    `json:"name,omitempty"`
+	Owner      string    `json:"owner,omitempty"`
+	Founded    string    `json:"founded,omitempty"`
+	Fetched    time.Time `json:"fetched"`
+}
+
+// wikidataCache maps registrable domains to resolved publishers. Domains
+// without a Wikidata match are cached too (with an empty ID) so they aren't
+// queried again on every run.
+type wikidataCache map[string]Publisher
+
+func loadWikidataCache(path string) (wikidataCache, error) {
+	cache := make(wikidataCache)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return cache, nil
+}
+
+func (c wikidataCache) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// publisherFor returns the cached publisher for a feed URL, if one resolved.
+func (c wikidataCache) publisherFor(feedURL string) (Publisher, bool) {
+	p, ok := c[registrableDomain(feedHost(feedURL))]
+	return p, ok && p.WikidataID != ""
+}
+
+// websiteVariants lists the official-website values a domain is commonly
+// recorded under, since Wikidata stores P856 as full URLs.
+func websiteVariants(domain string) []string {
+	var variants []string
+	for _, scheme := range []string{"https://", "http://"} {
+		for _, host := range []string{domain, "www." + domain} {
+			variants = append(variants, scheme+host, scheme+host+"/")
+		}
+	}
+	return variants
+}
+
+// queryWikidata resolves a batch of domains through the SPARQL endpoint.
+func queryWikidata(client *http.Client, domains []string) (map[string]Publisher, error) {
+	var values strings.Builder
+	for _, domain := range domains {
+		for _, site := range websiteVariants(domain) {
+			fmt.Fprintf(&values, "<%s> ", site)
+		}
+	}
+
+	query := `SELECT ?site ?item ?itemLabel ?ownerLabel ?inception WHERE {
+  VALUES ?site { ` + values.String() + `}
+  ?item wdt:P856 ?site .
+  OPTIONAL { ?item wdt:P127 ?owner . }
+  OPTIONAL { ?item wdt:P571 ?inception . }
+  SERVICE wikibase:label { bd:serviceParam wikibase:language "en". }
+}`
+
+	req, err := http.NewRequest("POST", wikidataEndpoint, strings.NewReader(url.Values{"query": {query}}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/sparql-results+json")
+	req.Header.Set("User-Agent", wikidataUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("HTTP status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Results struct {
+			Bindings []map[string]struct {
+				Value string `json:"value"`
+			} `json:"bindings"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	publishers := make(map[string]Publisher)
+	for _, b := range body.Results.Bindings {
+		domain := feedHost(b["site"].Value)
+		if _, seen := publishers[domain]; seen {
+			// Entities with several owners or inception dates produce one
+			// row per value; keep the first.
+			continue
+		}
+		p := Publisher{
+			WikidataID: strings.TrimPrefix(b["item"].Value, "http://www.wikidata.org/entity/"),
+			Name:       b["itemLabel"].Value,
+			Owner:      b["ownerLabel"].Value,
+		}
+		if inception := b["inception"].Value; len(inception) >= 10 {
+			p.Founded = inception[:10]
+		}
+		publishers[domain] = p
+	}
+	return publishers, nil
+}
+
+func runWikidata(args []string) int {
+	fs := flag.NewFlagSet("wikidata", flag.ExitOnError)
+	cachePath := fs.String("cache", "wikidata-cache.json", "publisher cache file")
+	maxAge := fs.Duration("max-age", 30*24*time.Hour, "re-query cached domains older than this")
+	noHeader := fs.Bool("no-header", false, "input file has no header row")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s wikidata [--cache FILE] [--max-age DURATION] [feeds.csv]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return 2
+	}
+	inputFile := "feeds.csv"
+	if len(positional) > 0 {
+		inputFile = positional[0]
+	}
+
+	feeds, err := loadDataset(inputFile, !*noHeader)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		return 1
+	}
+
+	cache, err := loadWikidataCache(*cachePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading cache: %v\n", err)
+		return 1
+	}
+
+	seen := make(map[string]bool)
+	var pending []string
+	for _, feed := range feeds {
+		domain := registrableDomain(feedHost(feed.URL))
+		if domain == "" || seen[domain] {
+			continue
+		}
+		seen[domain] = true
+		if p, ok := cache[domain]; ok && time.Since(p.Fetched) < *maxAge {
+			continue
+		}
+		pending = append(pending, domain)
+	}
+	sort.Strings(pending)
+
+	fmt.Printf("Resolving %d of %d domains (%d cached)\n", len(pending), len(seen), len(seen)-len(pending))
+
+	client := &http.Client{Timeout: 60 * time.Second}
+	var resolved, failed int
+	for start := 0; start < len(pending); start += wikidataBatchSize {
+		batch := pending[start:min(start+wikidataBatchSize, len(pending))]
+
+		publishers, err := queryWikidata(client, batch)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Wikidata query failed for batch %d: %v\n", start/wikidataBatchSize+1, err)
+			failed += len(batch)
+			continue
+		}
+
+		now := time.Now().UTC()
+		for _, domain := range batch {
+			p := publishers[domain]
+			p.Fetched = now
+			cache[domain] = p
+			if p.WikidataID != "" {
+				resolved++
+				fmt.Printf("✅ %s → %s (%s)\n", domain, p.WikidataID, p.Name)
+			}
+		}
+
+		// Save as we go so an interrupted run keeps its progress.
+		if err := cache.save(*cachePath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving cache: %v\n", err)
+			return 1
+		}
+
+		// Stay well within the query service's usage policy.
+		time.Sleep(time.Second)
+	}
+
+	fmt.Printf("\nResolved %d domains, %d unmatched, %d failed\n", resolved, len(pending)-resolved-failed, failed)
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}